@@ -52,6 +52,7 @@ const (
 	defaultAllowHighFees           = false
 	defaultAccountGapLimit         = wallet.DefaultAccountGapLimit
 	defaultDisableCoinTypeUpgrades = false
+	defaultRescanWorkers           = 1
 	defaultCircuitLimit            = 32
 
 	// ticket buyer options
@@ -102,6 +103,7 @@ type config struct {
 	RelayFee                *cfgutil.AmountFlag `long:"txfee" description:"Transaction fee per kilobyte"`
 	AccountGapLimit         int                 `long:"accountgaplimit" description:"Allowed gap of unused accounts"`
 	DisableCoinTypeUpgrades bool                `long:"disablecointypeupgrades" description:"Never upgrade from legacy to SLIP0044 coin type keys"`
+	RescanWorkers           int                 `long:"rescanworkers" description:"Number of block ranges filtered concurrently during rescans"`
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Network address of dcrd RPC server"`
@@ -344,6 +346,7 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		PoolAddress:             cfgutil.NewAddressFlag(),
 		AccountGapLimit:         defaultAccountGapLimit,
 		DisableCoinTypeUpgrades: defaultDisableCoinTypeUpgrades,
+		RescanWorkers:           defaultRescanWorkers,
 		CircuitLimit:            defaultCircuitLimit,
 
 		// Ticket Buyer Options
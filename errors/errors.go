@@ -74,6 +74,9 @@ const (
 	Protocol                        // Protocol violation
 	NoPeers                         // Decred network is unreachable due to lack of peers or dcrd RPC connections
 	Deployment                      // Inactive consensus deployment
+	TooManyInputs                   // Transaction requires too many inputs to be created
+	DustOutput                      // Transaction output value is dust
+	FeeExceedsLimit                 // Transaction fee exceeds the maximum allowed fee
 )
 
 func (k Kind) String() string {
@@ -120,6 +123,12 @@ func (k Kind) String() string {
 		return "Decred network is unreachable"
 	case Deployment:
 		return "inactive deployment"
+	case TooManyInputs:
+		return "too many inputs"
+	case DustOutput:
+		return "dust output"
+	case FeeExceedsLimit:
+		return "fee exceeds limit"
 	default:
 		return "unknown error kind"
 	}
@@ -41,6 +41,7 @@ type Loader struct {
 	gapLimit                int
 	accountGapLimit         int
 	disableCoinTypeUpgrades bool
+	rescanWorkers           int
 	allowHighFees           bool
 	relayFee                float64
 
@@ -60,7 +61,8 @@ type StakeOptions struct {
 
 // NewLoader constructs a Loader.
 func NewLoader(chainParams *chaincfg.Params, dbDirPath string, stakeOptions *StakeOptions, gapLimit int,
-	allowHighFees bool, relayFee float64, accountGapLimit int, disableCoinTypeUpgrades bool) *Loader {
+	allowHighFees bool, relayFee float64, accountGapLimit int, disableCoinTypeUpgrades bool,
+	rescanWorkers int) *Loader {
 
 	return &Loader{
 		chainParams:             chainParams,
@@ -69,6 +71,7 @@ func NewLoader(chainParams *chaincfg.Params, dbDirPath string, stakeOptions *Sta
 		gapLimit:                gapLimit,
 		accountGapLimit:         accountGapLimit,
 		disableCoinTypeUpgrades: disableCoinTypeUpgrades,
+		rescanWorkers:           rescanWorkers,
 		allowHighFees:           allowHighFees,
 		relayFee:                relayFee,
 	}
@@ -158,7 +161,8 @@ func (l *Loader) CreateWatchingOnlyWallet(ctx context.Context, extendedPubKey st
 	}
 
 	// Initialize the watch-only database for the wallet before opening.
-	err = wallet.CreateWatchOnly(ctx, db, extendedPubKey, pubPass, l.chainParams)
+	err = wallet.CreateWatchOnly(ctx, db, extendedPubKey, pubPass, l.chainParams,
+		l.chainParams.SLIP0044CoinType)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -177,6 +181,7 @@ func (l *Loader) CreateWatchingOnlyWallet(ctx context.Context, extendedPubKey st
 		GapLimit:                l.gapLimit,
 		AccountGapLimit:         l.accountGapLimit,
 		DisableCoinTypeUpgrades: l.disableCoinTypeUpgrades,
+		RescanWorkers:           l.rescanWorkers,
 		StakePoolColdExtKey:     so.StakePoolColdExtKey,
 		AllowHighFees:           l.allowHighFees,
 		RelayFee:                l.relayFee,
@@ -249,7 +254,8 @@ func (l *Loader) CreateNewWallet(ctx context.Context, pubPassphrase, privPassphr
 	}
 
 	// Initialize the newly created database for the wallet before opening.
-	err = wallet.Create(ctx, db, pubPassphrase, privPassphrase, seed, l.chainParams)
+	err = wallet.Create(ctx, db, pubPassphrase, privPassphrase, seed, l.chainParams,
+		l.chainParams.SLIP0044CoinType)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -268,6 +274,7 @@ func (l *Loader) CreateNewWallet(ctx context.Context, pubPassphrase, privPassphr
 		GapLimit:                l.gapLimit,
 		AccountGapLimit:         l.accountGapLimit,
 		DisableCoinTypeUpgrades: l.disableCoinTypeUpgrades,
+		RescanWorkers:           l.rescanWorkers,
 		StakePoolColdExtKey:     so.StakePoolColdExtKey,
 		AllowHighFees:           l.allowHighFees,
 		RelayFee:                l.relayFee,
@@ -329,6 +336,7 @@ func (l *Loader) OpenExistingWallet(ctx context.Context, pubPassphrase []byte) (
 		GapLimit:                l.gapLimit,
 		AccountGapLimit:         l.accountGapLimit,
 		DisableCoinTypeUpgrades: l.disableCoinTypeUpgrades,
+		RescanWorkers:           l.rescanWorkers,
 		StakePoolColdExtKey:     so.StakePoolColdExtKey,
 		AllowHighFees:           l.allowHighFees,
 		RelayFee:                l.relayFee,
@@ -1339,7 +1339,7 @@ func (s *Server) importScript(ctx context.Context, icmd interface{}) (interface{
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "empty script")
 	}
 
-	err = w.ImportScript(ctx, rs)
+	_, err = w.ImportScript(ctx, rs, false)
 	if err != nil {
 		switch {
 		case errors.Is(err, errors.Exist):
@@ -1373,7 +1373,8 @@ func (s *Server) importXpub(ctx context.Context, icmd interface{}) (interface{},
 		return nil, err
 	}
 
-	return nil, w.ImportXpubAccount(ctx, cmd.Name, xpub)
+	_, err = w.ImportXpubAccount(ctx, cmd.Name, xpub)
+	return nil, err
 }
 
 // createNewAccount handles a createnewaccount request by creating and
@@ -2002,14 +2003,14 @@ func (s *Server) listAccounts(ctx context.Context, icmd interface{}) (interface{
 }
 
 // listLockUnspent handles a listlockunspent request by returning an slice of
-// all locked outpoints.
+// all locked outpoints along with their amounts and accounts.
 func (s *Server) listLockUnspent(ctx context.Context, icmd interface{}) (interface{}, error) {
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	return w.LockedOutpoints(), nil
+	return w.LockedOutpoints(ctx)
 }
 
 // listReceivedByAccount handles a listreceivedbyaccount request by returning
@@ -2343,18 +2344,25 @@ func (s *Server) lockUnspent(ctx context.Context, icmd interface{}) (interface{}
 
 	switch {
 	case cmd.Unlock && len(cmd.Transactions) == 0:
-		w.ResetLockedOutpoints()
+		err := w.ResetLockedOutpoints(ctx)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		for _, input := range cmd.Transactions {
 			txSha, err := chainhash.NewHashFromStr(input.Txid)
 			if err != nil {
 				return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 			}
-			op := wire.OutPoint{Hash: *txSha, Index: input.Vout}
+			op := wire.OutPoint{Hash: *txSha, Index: input.Vout, Tree: input.Tree}
 			if cmd.Unlock {
-				w.UnlockOutpoint(op)
+				err = w.UnlockOutpoint(ctx, op)
 			} else {
-				w.LockOutpoint(op)
+				persistent := cmd.Persistent != nil && *cmd.Persistent
+				err = w.LockOutpoint(ctx, op, persistent)
+			}
+			if err != nil {
+				return nil, err
 			}
 		}
 	}
@@ -3705,14 +3713,11 @@ func (s *Server) verifyMessage(ctx context.Context, icmd interface{}) (interface
 		return nil, err
 	}
 
-	// Addresses must have an associated secp256k1 private key and therefore
-	// must be P2PK or P2PKH (P2SH is not allowed).
-	switch a := addr.(type) {
-	case *dcrutil.AddressSecpPubKey:
-	case *dcrutil.AddressPubKeyHash:
-		if a.DSA() != dcrec.STEcdsaSecp256k1 {
-			goto WrongAddrKind
-		}
+	// Addresses must have an associated public key and therefore must be
+	// P2PK or P2PKH (P2SH is not allowed).
+	switch addr.(type) {
+	case *dcrutil.AddressSecpPubKey, *dcrutil.AddressSecSchnorrPubKey,
+		*dcrutil.AddressEdwardsPubKey, *dcrutil.AddressPubKeyHash:
 	default:
 		goto WrongAddrKind
 	}
@@ -3723,7 +3728,7 @@ func (s *Server) verifyMessage(ctx context.Context, icmd interface{}) (interface
 	return err == nil && valid, nil
 
 WrongAddrKind:
-	return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "address must be secp256k1 P2PK or P2PKH")
+	return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "address must be P2PK or P2PKH")
 }
 
 // version handles the version command by returning the RPC API versions of the
@@ -49,7 +49,6 @@ import (
 	"github.com/decred/dcrd/blockchain/stake/v3"
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/chaincfg/v3"
-	"github.com/decred/dcrd/dcrec"
 	"github.com/decred/dcrd/dcrutil/v3"
 	"github.com/decred/dcrd/hdkeychain/v3"
 	"github.com/decred/dcrd/txscript/v3"
@@ -58,9 +57,9 @@ import (
 
 // Public API version constants
 const (
-	semverString = "7.3.0"
+	semverString = "7.4.0"
 	semverMajor  = 7
-	semverMinor  = 3
+	semverMinor  = 4
 	semverPatch  = 0
 )
 
@@ -108,6 +107,12 @@ func errorCode(err error) codes.Code {
 		case errors.Protocol:
 		case errors.NoPeers:
 			return codes.Unavailable
+		case errors.TooManyInputs:
+			return codes.ResourceExhausted
+		case errors.DustOutput:
+			return codes.InvalidArgument
+		case errors.FeeExceedsLimit:
+			return codes.FailedPrecondition
 		}
 	}
 	if errors.Is(err, hdkeychain.ErrInvalidSeedLen) {
@@ -613,7 +618,7 @@ func (s *walletServer) ImportScript(ctx context.Context,
 		return nil, err
 	}
 
-	err = s.wallet.ImportScript(ctx, req.Script)
+	p2sh, err := s.wallet.ImportScript(ctx, req.Script, false)
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -622,11 +627,6 @@ func (s *walletServer) ImportScript(ctx context.Context,
 		go s.wallet.RescanFromHeight(context.Background(), n, req.ScanFrom)
 	}
 
-	p2sh, err := dcrutil.NewAddressScriptHash(req.Script, s.wallet.ChainParams())
-	if err != nil {
-		return nil, translateError(err)
-	}
-
 	return &pb.ImportScriptResponse{P2ShAddress: p2sh.String(), Redeemable: redeemable}, nil
 }
 
@@ -1770,15 +1770,12 @@ func (s *walletServer) signMessage(ctx context.Context, address, message string)
 		return nil, err
 	}
 
-	// Addresses must have an associated secp256k1 private key and therefore
-	// must be P2PK or P2PKH (P2SH is not allowed).
+	// Addresses must have an associated public key and therefore must be
+	// P2PK or P2PKH (P2SH is not allowed).
 	var sig []byte
-	switch a := addr.(type) {
-	case *dcrutil.AddressSecpPubKey:
-	case *dcrutil.AddressPubKeyHash:
-		if a.DSA() != dcrec.STEcdsaSecp256k1 {
-			goto WrongAddrKind
-		}
+	switch addr.(type) {
+	case *dcrutil.AddressSecpPubKey, *dcrutil.AddressSecSchnorrPubKey,
+		*dcrutil.AddressEdwardsPubKey, *dcrutil.AddressPubKeyHash:
 	default:
 		goto WrongAddrKind
 	}
@@ -1791,7 +1788,7 @@ func (s *walletServer) signMessage(ctx context.Context, address, message string)
 
 WrongAddrKind:
 	return nil, status.Error(codes.InvalidArgument,
-		"address must be secp256k1 P2PK or P2PKH")
+		"address must be P2PK or P2PKH")
 }
 
 func (s *walletServer) SignMessage(ctx context.Context, req *pb.SignMessageRequest) (*pb.SignMessageResponse, error) {
@@ -2127,6 +2124,46 @@ func (s *walletServer) AccountNotifications(req *pb.AccountNotificationsRequest,
 	}
 }
 
+func (s *walletServer) AuthoredTransactionNotifications(req *pb.AuthoredTransactionNotificationsRequest,
+	svr pb.WalletService_AuthoredTransactionNotificationsServer) error {
+
+	policy := wallet.AuthoredTxBuffer
+	if req.Drop {
+		policy = wallet.AuthoredTxDrop
+	}
+	n := s.wallet.NtfnServer.AuthoredTxNotifications(policy, int(req.BufferSize))
+	defer n.Done()
+
+	ctxDone := svr.Context().Done()
+	for {
+		select {
+		case v := <-n.C:
+			inputs := make([]*pb.AuthoredTransactionNotificationsResponse_Input, len(v.Inputs))
+			for i := range v.Inputs {
+				in := &v.Inputs[i]
+				inputs[i] = &pb.AuthoredTransactionNotificationsResponse_Input{
+					TransactionHash: in.OutPoint.Hash[:],
+					Index:           in.OutPoint.Index,
+					Tree:            int32(in.OutPoint.Tree),
+					Amount:          int64(in.Amount),
+				}
+			}
+			resp := pb.AuthoredTransactionNotificationsResponse{
+				UnsignedTransaction:  v.UnsignedTx,
+				Inputs:               inputs,
+				DroppedNotifications: n.Dropped(),
+			}
+			err := svr.Send(&resp)
+			if err != nil {
+				return translateError(err)
+			}
+
+		case <-ctxDone:
+			return nil
+		}
+	}
+}
+
 func (s *walletServer) ConfirmationNotifications(svr pb.WalletService_ConfirmationNotificationsServer) error {
 	c := s.wallet.NtfnServer.ConfirmationNotifications(svr.Context())
 	errOut := make(chan error, 2)
@@ -2924,14 +2961,11 @@ func (s *messageVerificationServer) VerifyMessage(ctx context.Context, req *pb.V
 		return nil, translateError(err)
 	}
 
-	// Addresses must have an associated secp256k1 private key and therefore
-	// must be P2PK or P2PKH (P2SH is not allowed).
-	switch a := addr.(type) {
-	case *dcrutil.AddressSecpPubKey:
-	case *dcrutil.AddressPubKeyHash:
-		if a.DSA() != dcrec.STEcdsaSecp256k1 {
-			goto WrongAddrKind
-		}
+	// Addresses must have an associated public key and therefore must be
+	// P2PK or P2PKH (P2SH is not allowed).
+	switch addr.(type) {
+	case *dcrutil.AddressSecpPubKey, *dcrutil.AddressSecSchnorrPubKey,
+		*dcrutil.AddressEdwardsPubKey, *dcrutil.AddressPubKeyHash:
 	default:
 		goto WrongAddrKind
 	}
@@ -2943,7 +2977,7 @@ func (s *messageVerificationServer) VerifyMessage(ctx context.Context, req *pb.V
 	return &pb.VerifyMessageResponse{Valid: valid}, nil
 
 WrongAddrKind:
-	return nil, status.Error(codes.InvalidArgument, "address must be secp256k1 P2PK or P2PKH")
+	return nil, status.Error(codes.InvalidArgument, "address must be P2PK or P2PKH")
 }
 
 // StartDecodeMessageService starts the MessageDecode service
@@ -331,6 +331,13 @@ var helpDescsEnUS = map[string]string{
 	// ListLockUnspentCmd help.
 	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
 
+	// ListLockUnspentResult help.
+	"listlockunspentresult-txid":    "The transaction hash of the referenced output",
+	"listlockunspentresult-vout":    "The output index of the referenced output",
+	"listlockunspentresult-tree":    "The tree of the referenced output",
+	"listlockunspentresult-amount":  "The amount of the output valued in decred, or zero if the output is unknown to the wallet",
+	"listlockunspentresult-account": "The account of the output, or empty if the output is unknown to the wallet",
+
 	// TransactionInput help.
 	"transactioninput-amount": "The the previous output amount",
 	"transactioninput-txid":   "The transaction hash of the referenced output",
@@ -426,10 +433,12 @@ var helpDescsEnUS = map[string]string{
 	// LockUnspentCmd help.
 	"lockunspent--synopsis": "Locks or unlocks an unspent output.\n" +
 		"Locked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\n" +
-		"Locked outputs are volatile and are not saved across wallet restarts.\n" +
+		"Locked outputs are volatile and are not saved across wallet restarts unless locked persistently.\n" +
+		"Unlocking an output removes both volatile and persistent locks.\n" +
 		"If unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.",
 	"lockunspent-unlock":       "True to unlock outputs, false to lock",
 	"lockunspent-transactions": "Transaction outputs to lock or unlock",
+	"lockunspent-persistent":   "Record locks in the wallet database so outputs remain locked after the wallet is restarted",
 	"lockunspent--result0":     "The boolean 'true'",
 
 	// SendFromCmd help.
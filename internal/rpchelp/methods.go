@@ -71,7 +71,7 @@ var Methods = []struct {
 	{"listaccounts", []interface{}{(*map[string]float64)(nil)}},
 	{"listaddresstransactions", returnsLTRArray},
 	{"listalltransactions", returnsLTRArray},
-	{"listlockunspent", []interface{}{(*[]dcrdtypes.TransactionInput)(nil)}},
+	{"listlockunspent", []interface{}{(*[]types.ListLockUnspentResult)(nil)}},
 	{"listreceivedbyaccount", []interface{}{(*[]types.ListReceivedByAccountResult)(nil)}},
 	{"listreceivedbyaddress", []interface{}{(*[]types.ListReceivedByAddressResult)(nil)}},
 	{"listscripts", []interface{}{(*types.ListScriptsResult)(nil)}},
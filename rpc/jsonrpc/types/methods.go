@@ -801,14 +801,16 @@ func NewListUnspentCmd(minConf, maxConf *int, addresses *[]string) *ListUnspentC
 type LockUnspentCmd struct {
 	Unlock       bool
 	Transactions []dcrdtypes.TransactionInput
+	Persistent   *bool `jsonrpcdefault:"false"`
 }
 
 // NewLockUnspentCmd returns a new instance which can be used to issue a
 // lockunspent JSON-RPC command.
-func NewLockUnspentCmd(unlock bool, transactions []dcrdtypes.TransactionInput) *LockUnspentCmd {
+func NewLockUnspentCmd(unlock bool, transactions []dcrdtypes.TransactionInput, persistent *bool) *LockUnspentCmd {
 	return &LockUnspentCmd{
 		Unlock:       unlock,
 		Transactions: transactions,
+		Persistent:   persistent,
 	}
 }
 
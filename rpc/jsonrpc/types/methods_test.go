@@ -792,7 +792,7 @@ func TestWalletSvrCmds(t *testing.T) {
 				txInputs := []dcrdtypes.TransactionInput{
 					{Txid: "123", Vout: 1},
 				}
-				return NewLockUnspentCmd(true, txInputs)
+				return NewLockUnspentCmd(true, txInputs, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"lockunspent","params":[true,[{"txid":"123","vout":1,"tree":0}]],"id":1}`,
 			unmarshalled: &LockUnspentCmd{
@@ -800,6 +800,27 @@ func TestWalletSvrCmds(t *testing.T) {
 				Transactions: []dcrdtypes.TransactionInput{
 					{Txid: "123", Vout: 1},
 				},
+				Persistent: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "lockunspent optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("lockunspent", false, `[{"txid":"123","vout":1}]`, true)
+			},
+			staticCmd: func() interface{} {
+				txInputs := []dcrdtypes.TransactionInput{
+					{Txid: "123", Vout: 1},
+				}
+				return NewLockUnspentCmd(false, txInputs, dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"lockunspent","params":[false,[{"txid":"123","vout":1,"tree":0}],true],"id":1}`,
+			unmarshalled: &LockUnspentCmd{
+				Unlock: false,
+				Transactions: []dcrdtypes.TransactionInput{
+					{Txid: "123", Vout: 1},
+				},
+				Persistent: dcrjson.Bool(true),
 			},
 		},
 		{
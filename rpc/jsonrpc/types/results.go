@@ -277,6 +277,16 @@ type ListTransactionsResult struct {
 	OtherAccount      string                  `json:"otheraccount,omitempty"`
 }
 
+// ListLockUnspentResult models a locked output returned by the
+// listlockunspent command.
+type ListLockUnspentResult struct {
+	TxID    string  `json:"txid"`
+	Vout    uint32  `json:"vout"`
+	Tree    int8    `json:"tree"`
+	Amount  float64 `json:"amount"`
+	Account string  `json:"account"`
+}
+
 // ListReceivedByAccountResult models the data from the listreceivedbyaccount
 // command.
 type ListReceivedByAccountResult struct {
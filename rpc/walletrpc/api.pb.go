@@ -9665,6 +9665,180 @@ func (m *SweepAccountResponse) GetEstimatedSignedSize() uint32 {
 	return 0
 }
 
+type AuthoredTransactionNotificationsRequest struct {
+	Drop                 bool     `protobuf:"varint,1,opt,name=drop,proto3" json:"drop,omitempty"`
+	BufferSize           uint32   `protobuf:"varint,2,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthoredTransactionNotificationsRequest) Reset() {
+	*m = AuthoredTransactionNotificationsRequest{}
+}
+func (m *AuthoredTransactionNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthoredTransactionNotificationsRequest) ProtoMessage()    {}
+func (*AuthoredTransactionNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{161}
+}
+
+func (m *AuthoredTransactionNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthoredTransactionNotificationsRequest.Unmarshal(m, b)
+}
+func (m *AuthoredTransactionNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthoredTransactionNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthoredTransactionNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthoredTransactionNotificationsRequest.Merge(m, src)
+}
+func (m *AuthoredTransactionNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthoredTransactionNotificationsRequest.Size(m)
+}
+func (m *AuthoredTransactionNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthoredTransactionNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthoredTransactionNotificationsRequest proto.InternalMessageInfo
+
+func (m *AuthoredTransactionNotificationsRequest) GetDrop() bool {
+	if m != nil {
+		return m.Drop
+	}
+	return false
+}
+
+func (m *AuthoredTransactionNotificationsRequest) GetBufferSize() uint32 {
+	if m != nil {
+		return m.BufferSize
+	}
+	return 0
+}
+
+type AuthoredTransactionNotificationsResponse struct {
+	UnsignedTransaction []byte                                            `protobuf:"bytes,1,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
+	Inputs              []*AuthoredTransactionNotificationsResponse_Input `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The total number of notifications dropped for the client.
+	DroppedNotifications uint64   `protobuf:"varint,3,opt,name=dropped_notifications,json=droppedNotifications,proto3" json:"dropped_notifications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthoredTransactionNotificationsResponse) Reset() {
+	*m = AuthoredTransactionNotificationsResponse{}
+}
+func (m *AuthoredTransactionNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthoredTransactionNotificationsResponse) ProtoMessage()    {}
+func (*AuthoredTransactionNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{162}
+}
+
+func (m *AuthoredTransactionNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthoredTransactionNotificationsResponse.Unmarshal(m, b)
+}
+func (m *AuthoredTransactionNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthoredTransactionNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *AuthoredTransactionNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthoredTransactionNotificationsResponse.Merge(m, src)
+}
+func (m *AuthoredTransactionNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_AuthoredTransactionNotificationsResponse.Size(m)
+}
+func (m *AuthoredTransactionNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthoredTransactionNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthoredTransactionNotificationsResponse proto.InternalMessageInfo
+
+func (m *AuthoredTransactionNotificationsResponse) GetUnsignedTransaction() []byte {
+	if m != nil {
+		return m.UnsignedTransaction
+	}
+	return nil
+}
+
+func (m *AuthoredTransactionNotificationsResponse) GetInputs() []*AuthoredTransactionNotificationsResponse_Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *AuthoredTransactionNotificationsResponse) GetDroppedNotifications() uint64 {
+	if m != nil {
+		return m.DroppedNotifications
+	}
+	return 0
+}
+
+type AuthoredTransactionNotificationsResponse_Input struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Index                uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Tree                 int32    `protobuf:"varint,3,opt,name=tree,proto3" json:"tree,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthoredTransactionNotificationsResponse_Input) Reset() {
+	*m = AuthoredTransactionNotificationsResponse_Input{}
+}
+func (m *AuthoredTransactionNotificationsResponse_Input) String() string {
+	return proto.CompactTextString(m)
+}
+func (*AuthoredTransactionNotificationsResponse_Input) ProtoMessage() {}
+func (*AuthoredTransactionNotificationsResponse_Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{162, 0}
+}
+
+func (m *AuthoredTransactionNotificationsResponse_Input) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthoredTransactionNotificationsResponse_Input.Unmarshal(m, b)
+}
+func (m *AuthoredTransactionNotificationsResponse_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthoredTransactionNotificationsResponse_Input.Marshal(b, m, deterministic)
+}
+func (m *AuthoredTransactionNotificationsResponse_Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthoredTransactionNotificationsResponse_Input.Merge(m, src)
+}
+func (m *AuthoredTransactionNotificationsResponse_Input) XXX_Size() int {
+	return xxx_messageInfo_AuthoredTransactionNotificationsResponse_Input.Size(m)
+}
+func (m *AuthoredTransactionNotificationsResponse_Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthoredTransactionNotificationsResponse_Input.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthoredTransactionNotificationsResponse_Input proto.InternalMessageInfo
+
+func (m *AuthoredTransactionNotificationsResponse_Input) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *AuthoredTransactionNotificationsResponse_Input) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *AuthoredTransactionNotificationsResponse_Input) GetTree() int32 {
+	if m != nil {
+		return m.Tree
+	}
+	return 0
+}
+
+func (m *AuthoredTransactionNotificationsResponse_Input) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("walletrpc.SyncNotificationType", SyncNotificationType_name, SyncNotificationType_value)
 	proto.RegisterEnum("walletrpc.TransactionDetails_TransactionType", TransactionDetails_TransactionType_name, TransactionDetails_TransactionType_value)
@@ -9860,547 +10034,552 @@ func init() {
 	proto.RegisterType((*BestBlockResponse)(nil), "walletrpc.BestBlockResponse")
 	proto.RegisterType((*SweepAccountRequest)(nil), "walletrpc.SweepAccountRequest")
 	proto.RegisterType((*SweepAccountResponse)(nil), "walletrpc.SweepAccountResponse")
+	proto.RegisterType((*AuthoredTransactionNotificationsRequest)(nil), "walletrpc.AuthoredTransactionNotificationsRequest")
+	proto.RegisterType((*AuthoredTransactionNotificationsResponse)(nil), "walletrpc.AuthoredTransactionNotificationsResponse")
+	proto.RegisterType((*AuthoredTransactionNotificationsResponse_Input)(nil), "walletrpc.AuthoredTransactionNotificationsResponse.Input")
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 8548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x23, 0x49,
-	0x92, 0xd8, 0x16, 0xa9, 0x07, 0x19, 0x22, 0xa9, 0x62, 0xea, 0x45, 0x51, 0xdd, 0xd3, 0xea, 0xea,
-	0x79, 0xed, 0xcc, 0xb4, 0xa6, 0x57, 0x33, 0xbb, 0x3b, 0xb7, 0x3b, 0xbb, 0x33, 0x6c, 0x89, 0xdd,
-	0xcd, 0x6d, 0x89, 0xd2, 0x15, 0xd9, 0x9a, 0x9e, 0x1d, 0xfb, 0x0a, 0x25, 0x32, 0x25, 0xd5, 0x35,
-	0x59, 0xc5, 0xa9, 0x2a, 0xea, 0xb1, 0xb6, 0xe1, 0xc5, 0x19, 0xf6, 0xdf, 0xc1, 0x0f, 0xc0, 0x1f,
-	0xc6, 0xf9, 0x0e, 0x86, 0x6d, 0xd8, 0x06, 0x0c, 0xbf, 0x60, 0xc3, 0x38, 0x78, 0x0d, 0xc3, 0x67,
-	0xf8, 0xc7, 0x38, 0x18, 0xc6, 0xf9, 0xe7, 0x3e, 0xfc, 0x67, 0xc0, 0x5f, 0x07, 0xf8, 0x00, 0xff,
-	0xfa, 0xc3, 0x46, 0xbe, 0xaa, 0x32, 0xab, 0x8a, 0x94, 0x34, 0x3b, 0x0b, 0x78, 0x17, 0xee, 0x9f,
-	0x66, 0x45, 0x46, 0x46, 0xbe, 0x22, 0x23, 0x23, 0x23, 0x23, 0x42, 0x50, 0xb4, 0x47, 0xce, 0xd6,
-	0xc8, 0xf7, 0x42, 0x0f, 0x15, 0x2f, 0xec, 0xc1, 0x00, 0x87, 0xfe, 0xa8, 0x67, 0xe8, 0x50, 0x39,
-	0xc2, 0x7e, 0xe0, 0x78, 0xae, 0x89, 0xbf, 0x1c, 0xe3, 0x20, 0x34, 0xfe, 0x40, 0x83, 0xc5, 0x08,
-	0x14, 0x8c, 0x3c, 0x37, 0xc0, 0xe8, 0x0d, 0xa8, 0x9c, 0x33, 0x90, 0x15, 0x84, 0xbe, 0xe3, 0x9e,
-	0xd6, 0xb4, 0x4d, 0xed, 0xed, 0xa2, 0x59, 0xe6, 0xd0, 0x0e, 0x05, 0xa2, 0x65, 0x98, 0x1d, 0xda,
-	0xbf, 0xe9, 0xf9, 0xb5, 0xdc, 0xa6, 0xf6, 0x76, 0xd9, 0x64, 0x1f, 0x14, 0xea, 0xb8, 0x9e, 0x5f,
-	0xcb, 0x73, 0x28, 0xf9, 0x20, 0xd0, 0x91, 0x1d, 0xf6, 0xce, 0x6a, 0x33, 0x0c, 0x4a, 0x3f, 0xd0,
-	0x6b, 0x00, 0x23, 0x1f, 0xfb, 0x78, 0x80, 0xed, 0x00, 0xd7, 0x66, 0x69, 0x23, 0x12, 0x84, 0x74,
-	0xe4, 0x78, 0xec, 0x0c, 0xfa, 0xd6, 0x10, 0x87, 0x76, 0xdf, 0x0e, 0xed, 0xda, 0x1c, 0xeb, 0x08,
-	0x85, 0xee, 0x73, 0xa0, 0xf1, 0x5f, 0x66, 0x01, 0x75, 0x7d, 0xdb, 0x0d, 0xec, 0x5e, 0xe8, 0x78,
-	0xee, 0x2e, 0x0e, 0x6d, 0x67, 0x10, 0x20, 0x04, 0x33, 0x67, 0x76, 0x70, 0x46, 0x3b, 0x5f, 0x32,
-	0xe9, 0x6f, 0xb4, 0x09, 0x0b, 0x61, 0x8c, 0x49, 0x7b, 0x5e, 0x32, 0x65, 0x10, 0xfa, 0x3e, 0xcc,
-	0xf5, 0xf1, 0xb1, 0x13, 0x06, 0xb5, 0xfc, 0x66, 0xfe, 0xed, 0x85, 0xed, 0x07, 0x5b, 0xd1, 0xf4,
-	0x6d, 0xa5, 0x1b, 0xd9, 0x6a, 0xb9, 0xa3, 0x71, 0x68, 0xf2, 0x2a, 0xe8, 0x87, 0x30, 0xdf, 0xf3,
-	0x71, 0x9f, 0xd4, 0x9e, 0xa1, 0xb5, 0x5f, 0x9f, 0x5e, 0xfb, 0x60, 0x1c, 0x92, 0xea, 0xa2, 0x12,
-	0xd2, 0x21, 0x7f, 0x82, 0xd9, 0x4c, 0xe4, 0x4d, 0xf2, 0x13, 0xdd, 0x81, 0x62, 0xe8, 0x0c, 0x71,
-	0x10, 0xda, 0xc3, 0x11, 0x1d, 0x7d, 0xde, 0x8c, 0x01, 0xe8, 0x25, 0xe8, 0x52, 0xdf, 0xad, 0xf0,
-	0x6a, 0x84, 0x6b, 0xf3, 0x9b, 0xda, 0xdb, 0x95, 0xed, 0x87, 0xd3, 0x1b, 0x96, 0x40, 0xdd, 0xab,
-	0x11, 0x36, 0x17, 0x43, 0x15, 0x50, 0xff, 0x12, 0x66, 0xe9, 0xd0, 0xc8, 0xca, 0x39, 0x6e, 0x1f,
-	0x5f, 0xd2, 0x69, 0x2c, 0x9b, 0xec, 0x03, 0x7d, 0x13, 0xf4, 0x91, 0x8f, 0xcf, 0x1d, 0x6f, 0x1c,
-	0x58, 0x76, 0xaf, 0xe7, 0x8d, 0xdd, 0x90, 0xb3, 0xc1, 0xa2, 0x80, 0x37, 0x18, 0x18, 0xbd, 0x05,
-	0x8b, 0x31, 0xea, 0x90, 0x62, 0xe6, 0xe9, 0x38, 0x2a, 0x11, 0x26, 0x85, 0xd6, 0xff, 0xb1, 0x06,
-	0x73, 0x6c, 0x42, 0x26, 0x34, 0x5a, 0x83, 0x79, 0xb5, 0x2d, 0xf1, 0x89, 0xea, 0x50, 0x70, 0xdc,
-	0x10, 0xfb, 0xae, 0x3d, 0xa0, 0xc4, 0x0b, 0x66, 0xf4, 0x8d, 0x56, 0x61, 0x8e, 0x37, 0x3b, 0x43,
-	0x9b, 0xe5, 0x5f, 0x94, 0x5a, 0xbf, 0xef, 0xe3, 0x20, 0xe0, 0x9c, 0x27, 0x3e, 0xd1, 0x03, 0x28,
-	0x7b, 0xb4, 0x1f, 0x56, 0xd0, 0xf3, 0x9d, 0x51, 0x48, 0xe7, 0xbd, 0x64, 0x96, 0x18, 0xb0, 0x43,
-	0x61, 0xc6, 0x17, 0xb0, 0x98, 0x98, 0x44, 0xb4, 0x00, 0xf3, 0x66, 0xf3, 0xe9, 0x8b, 0xbd, 0x86,
-	0xa9, 0x7f, 0x03, 0x95, 0xa0, 0xb0, 0x73, 0xd0, 0x6a, 0x3f, 0x6e, 0x74, 0x9a, 0xfa, 0x0c, 0x5a,
-	0x82, 0xc5, 0x6e, 0x6b, 0xe7, 0x79, 0xb3, 0x6b, 0x1d, 0xbe, 0x30, 0x77, 0x9e, 0x11, 0xa0, 0x86,
-	0x0a, 0x30, 0x73, 0x74, 0xd0, 0x6d, 0xea, 0x39, 0x54, 0x01, 0x30, 0x9b, 0x47, 0x07, 0x3b, 0x8d,
-	0x6e, 0xeb, 0xa0, 0xad, 0xe7, 0x8d, 0xff, 0xa8, 0x41, 0xe9, 0xf1, 0xc0, 0xeb, 0xbd, 0x9a, 0xc6,
-	0xcb, 0xab, 0x30, 0x77, 0x86, 0x9d, 0xd3, 0x33, 0x36, 0x1b, 0xb3, 0x26, 0xff, 0x52, 0x59, 0x26,
-	0x9f, 0x64, 0x99, 0xb7, 0x60, 0xd1, 0x1e, 0x8d, 0x7c, 0xef, 0x1c, 0x07, 0xd6, 0xc8, 0xf6, 0xb1,
-	0x1b, 0xd2, 0xe1, 0x17, 0xcc, 0x8a, 0x00, 0x1f, 0x52, 0x28, 0x6a, 0x40, 0x49, 0x62, 0x0a, 0xc1,
-	0xd0, 0x77, 0xa7, 0xf2, 0x95, 0xa9, 0x54, 0x31, 0x0e, 0xa0, 0xc2, 0xb9, 0xe0, 0xb1, 0x3d, 0xb0,
-	0xdd, 0x1e, 0x96, 0x97, 0x50, 0x53, 0x97, 0xf0, 0x01, 0x94, 0x43, 0x2f, 0xb4, 0x07, 0xd6, 0x31,
-	0x43, 0xa5, 0x83, 0xca, 0x9b, 0x25, 0x0a, 0xe4, 0xd5, 0x8d, 0x32, 0x2c, 0x1c, 0x3a, 0xee, 0xa9,
-	0x10, 0x5e, 0x15, 0x28, 0xb1, 0x4f, 0x26, 0xb8, 0x88, 0x78, 0x6b, 0xe3, 0xf0, 0xc2, 0xf3, 0x5f,
-	0x09, 0x8c, 0x8f, 0x60, 0x31, 0x82, 0xc4, 0xd2, 0x8d, 0xf4, 0xef, 0x1c, 0x5b, 0x2e, 0x2b, 0xe1,
-	0x3d, 0x29, 0x33, 0x28, 0x47, 0x37, 0xaa, 0xb0, 0xb8, 0xe3, 0x39, 0x6c, 0x77, 0x70, 0x62, 0xef,
-	0x83, 0x1e, 0x83, 0x38, 0xb5, 0x0d, 0x28, 0xf6, 0x3c, 0x87, 0x6f, 0x3d, 0x46, 0xa8, 0xd0, 0xe3,
-	0x48, 0xc6, 0xaf, 0xc1, 0x32, 0x1f, 0x7f, 0x7b, 0x3c, 0x3c, 0xc6, 0x3e, 0x27, 0x84, 0xee, 0x43,
-	0x89, 0x0f, 0xdb, 0x72, 0xed, 0x21, 0xe6, 0xe2, 0x75, 0x81, 0xc3, 0xda, 0xf6, 0x10, 0x1b, 0x3f,
-	0x84, 0x95, 0x44, 0x55, 0xb9, 0xfb, 0xbc, 0x2e, 0x2d, 0x89, 0xbb, 0x2f, 0xa1, 0x93, 0xee, 0xf3,
-	0xfa, 0x81, 0xe8, 0xfe, 0xef, 0xe7, 0x41, 0x8f, 0x61, 0x9c, 0xdc, 0x27, 0x50, 0xe0, 0x15, 0x83,
-	0x9a, 0x96, 0x12, 0x78, 0x49, 0x74, 0x01, 0x30, 0xa3, 0x4a, 0xe8, 0x3d, 0x40, 0xbd, 0xb1, 0x4f,
-	0x38, 0xc6, 0x3a, 0x26, 0x1c, 0x6b, 0x51, 0x3e, 0x65, 0x82, 0x55, 0xe7, 0x25, 0x94, 0x95, 0x9f,
-	0x11, 0x9e, 0x7d, 0x04, 0xcb, 0x09, 0x6c, 0xc6, 0xc1, 0x79, 0xca, 0xc1, 0x48, 0xc1, 0xa7, 0x25,
-	0xf5, 0xdf, 0xca, 0xc1, 0xbc, 0x10, 0x25, 0x37, 0x1b, 0x7b, 0x6a, 0x7a, 0x73, 0xa9, 0xe9, 0x4d,
-	0x73, 0x5b, 0x3e, 0xcd, 0x6d, 0x64, 0x68, 0xf8, 0x92, 0x49, 0x11, 0xeb, 0x15, 0xbe, 0xb2, 0x7a,
-	0x91, 0x14, 0x29, 0x9b, 0xba, 0x28, 0x79, 0x8e, 0xaf, 0x76, 0x68, 0xe7, 0xde, 0x03, 0x24, 0x64,
-	0x8e, 0x84, 0x3d, 0xcb, 0xb0, 0x45, 0x89, 0x82, 0x3d, 0x1c, 0x79, 0x7e, 0x88, 0xfb, 0x12, 0xf6,
-	0x1c, 0xc7, 0xe6, 0x25, 0x02, 0xdb, 0x78, 0x09, 0xcb, 0x26, 0x26, 0x63, 0x11, 0xf3, 0xcf, 0x19,
-	0xe9, 0x86, 0x13, 0xb2, 0x0e, 0x05, 0x17, 0x5f, 0xc8, 0x93, 0x31, 0xef, 0xe2, 0x0b, 0xca, 0x67,
-	0x6b, 0xb0, 0x92, 0xa0, 0xcc, 0xf7, 0xd2, 0xaf, 0x43, 0xd9, 0xc4, 0x41, 0xcf, 0x76, 0x25, 0xa6,
-	0x3d, 0xc6, 0xa7, 0x8e, 0x2b, 0x96, 0x4c, 0xa3, 0x4b, 0xb6, 0x40, 0x61, 0x6c, 0xad, 0xd0, 0x5d,
-	0x00, 0x8e, 0x12, 0xf3, 0x40, 0x91, 0x21, 0xd8, 0xc1, 0x99, 0xf1, 0x03, 0xa8, 0x08, 0x92, 0x9c,
-	0xfb, 0xde, 0x85, 0xaa, 0x4f, 0x21, 0x2e, 0xee, 0x5b, 0xe1, 0x99, 0xef, 0x8d, 0x4f, 0xcf, 0x38,
-	0x61, 0x3d, 0x2a, 0xe8, 0x32, 0xb8, 0xf1, 0x19, 0xa0, 0x36, 0xbe, 0x0c, 0x13, 0x53, 0x40, 0x74,
-	0x08, 0x3b, 0x08, 0x46, 0x67, 0x3e, 0xd1, 0x21, 0x98, 0x7c, 0x94, 0x20, 0x37, 0x60, 0x06, 0xe3,
-	0x63, 0x58, 0x52, 0x08, 0xdf, 0x6e, 0xa7, 0xfd, 0xe7, 0x1c, 0xef, 0x17, 0x3b, 0x3d, 0x44, 0xbf,
-	0x26, 0x4b, 0xba, 0xef, 0xc0, 0xcc, 0x2b, 0xc7, 0xed, 0xd3, 0x9e, 0x54, 0xb6, 0x0d, 0x69, 0xbb,
-	0xa5, 0xc9, 0x6c, 0x3d, 0x77, 0xdc, 0xbe, 0x49, 0xf1, 0xd1, 0x13, 0x80, 0x53, 0x7b, 0x64, 0x8d,
-	0xbc, 0x81, 0xd3, 0xbb, 0xa2, 0x0c, 0x5b, 0xd9, 0x7e, 0x6b, 0x7a, 0xed, 0xa7, 0xf6, 0xe8, 0x90,
-	0xa2, 0x9b, 0xc5, 0x53, 0xf1, 0xd3, 0xd8, 0x86, 0x19, 0x42, 0x15, 0x2d, 0x83, 0xfe, 0xb8, 0x75,
-	0xf8, 0xe8, 0xd1, 0x87, 0x1f, 0x5a, 0xcd, 0x97, 0xdd, 0xa6, 0xd9, 0x6e, 0xec, 0xe9, 0xdf, 0x90,
-	0xa1, 0xad, 0x36, 0x87, 0x6a, 0x86, 0x03, 0xc5, 0x88, 0x16, 0xaa, 0xc3, 0xea, 0xd3, 0xc6, 0xa1,
-	0x75, 0x78, 0xb0, 0xd7, 0xda, 0xf9, 0xdc, 0x7a, 0xd1, 0xee, 0x1c, 0x36, 0x77, 0x5a, 0x4f, 0x5a,
-	0xcd, 0x5d, 0x56, 0x5d, 0x2a, 0x6b, 0x9a, 0xe6, 0x81, 0xa9, 0x6b, 0x68, 0x05, 0xaa, 0x12, 0xb4,
-	0xf5, 0xb4, 0x7d, 0x60, 0x92, 0x63, 0x6f, 0x09, 0x16, 0x25, 0xf0, 0x67, 0x66, 0xe3, 0x50, 0xcf,
-	0x1b, 0x6d, 0xbe, 0x1a, 0x62, 0x24, 0x7c, 0x35, 0xa4, 0xe3, 0x5a, 0x53, 0x8f, 0xeb, 0xbb, 0x00,
-	0xa3, 0xf1, 0xf1, 0xc0, 0xe9, 0x91, 0x8d, 0xc4, 0xd7, 0xb7, 0xc8, 0x20, 0xcf, 0xf1, 0x95, 0xf1,
-	0xcf, 0x35, 0x58, 0x6b, 0xd1, 0x0d, 0x75, 0xe8, 0x3b, 0xe7, 0x76, 0x88, 0x9f, 0xe3, 0xab, 0x9b,
-	0x32, 0xcf, 0x64, 0x8d, 0xe3, 0x4d, 0xa2, 0xd5, 0x50, 0x72, 0x74, 0xfb, 0x5e, 0x38, 0x27, 0x74,
-	0x45, 0x8a, 0x66, 0x79, 0x14, 0xb5, 0xf2, 0x99, 0x73, 0x42, 0x0e, 0x69, 0xc6, 0xc8, 0x54, 0x6e,
-	0x14, 0x4c, 0xfe, 0x45, 0xce, 0x0d, 0xf2, 0xbf, 0x75, 0xe2, 0x7b, 0x43, 0x2a, 0x24, 0x66, 0xcd,
-	0x02, 0x01, 0x3c, 0xf1, 0xbd, 0xa1, 0x51, 0x87, 0x5a, 0xba, 0xc7, 0x7c, 0x5f, 0xfe, 0x0b, 0x0d,
-	0x96, 0x58, 0x21, 0x53, 0x44, 0x6e, 0x3a, 0x94, 0x55, 0x98, 0xe3, 0xda, 0x0c, 0xdb, 0x97, 0xfc,
-	0x4b, 0xea, 0x60, 0x7e, 0x72, 0x07, 0x67, 0xd4, 0x0e, 0xa2, 0x87, 0x80, 0x7c, 0xfc, 0xe5, 0xd8,
-	0xf1, 0xb1, 0xe5, 0xe3, 0x3e, 0xc6, 0x43, 0xfb, 0x78, 0x80, 0xb9, 0x1e, 0x51, 0xe5, 0x25, 0x66,
-	0x54, 0x60, 0x7c, 0x0e, 0xcb, 0x6a, 0x97, 0xf9, 0x9a, 0xde, 0x87, 0xd2, 0x68, 0x3b, 0x38, 0xb3,
-	0xd4, 0x85, 0x5d, 0x20, 0x30, 0xbe, 0xfc, 0x64, 0x58, 0x52, 0x0b, 0x39, 0xda, 0x82, 0x04, 0x31,
-	0x5c, 0xa8, 0x70, 0x71, 0x7d, 0x4b, 0x99, 0xf8, 0x6d, 0x58, 0xe5, 0x1d, 0xed, 0x5b, 0x3d, 0xcf,
-	0x3d, 0x71, 0xfc, 0xa1, 0xcd, 0x14, 0x1d, 0xa6, 0x4d, 0xad, 0x88, 0xd2, 0x1d, 0xb9, 0xd0, 0xf8,
-	0x7b, 0x39, 0x58, 0x8c, 0x1a, 0xe4, 0xc3, 0x58, 0x86, 0x59, 0x7a, 0x6e, 0xd0, 0x86, 0xf2, 0x26,
-	0xfb, 0x20, 0x6a, 0x58, 0x30, 0xc2, 0x6e, 0x3f, 0xea, 0x78, 0xde, 0x8c, 0x01, 0x44, 0x0d, 0x73,
-	0x86, 0x43, 0x3b, 0x1c, 0xd3, 0x29, 0xbc, 0xb0, 0xfd, 0xbe, 0xd0, 0x8a, 0x05, 0xd8, 0xa4, 0x50,
-	0xf4, 0x3d, 0x58, 0x8f, 0x10, 0x83, 0xd0, 0x7e, 0x85, 0xad, 0x53, 0xec, 0x62, 0x9f, 0x76, 0x87,
-	0x6b, 0xb4, 0x6b, 0x02, 0xa1, 0x43, 0xca, 0x9f, 0x46, 0xc5, 0xe8, 0x1d, 0xa8, 0x92, 0x93, 0x14,
-	0xf7, 0xad, 0xe3, 0x2b, 0x2b, 0x74, 0x7a, 0xaf, 0x70, 0x18, 0xf0, 0xcb, 0xc5, 0x22, 0x2b, 0x78,
-	0x7c, 0xd5, 0x65, 0x60, 0xa2, 0xd1, 0x9f, 0x7b, 0xa1, 0xe3, 0x9e, 0x5a, 0xf6, 0x38, 0x3c, 0xf3,
-	0x7c, 0x27, 0xbc, 0xe2, 0xf7, 0x8d, 0x45, 0x06, 0x6f, 0x08, 0x30, 0xb9, 0x44, 0x8d, 0x5d, 0x3e,
-	0x67, 0xb8, 0x4f, 0x2f, 0x1c, 0x79, 0x53, 0x06, 0x19, 0x8f, 0x61, 0xe5, 0x29, 0x0e, 0x25, 0xfd,
-	0x50, 0x2c, 0xce, 0x37, 0xd5, 0x0b, 0x8b, 0xa4, 0xd3, 0xca, 0x37, 0x10, 0x7a, 0x5a, 0xfc, 0x9e,
-	0x06, 0xab, 0x49, 0x22, 0x91, 0xd2, 0xa2, 0xdc, 0xe2, 0x08, 0x81, 0x6b, 0x35, 0x53, 0xe5, 0x92,
-	0xf7, 0x3a, 0x94, 0xb3, 0xd6, 0x5c, 0x05, 0xd2, 0xe3, 0x2c, 0x56, 0x69, 0xf2, 0xfc, 0x38, 0x13,
-	0xba, 0x8c, 0xf1, 0x5f, 0x73, 0xc9, 0x0e, 0x46, 0xc2, 0x7f, 0x0b, 0x96, 0x82, 0xd0, 0xf6, 0xe9,
-	0x74, 0x4a, 0x24, 0xd8, 0x48, 0xab, 0xa2, 0x28, 0x56, 0x8b, 0xb6, 0x61, 0x25, 0x89, 0x1f, 0x6b,
-	0xf6, 0x55, 0x73, 0x49, 0xad, 0xc1, 0x0e, 0xdb, 0x77, 0xa0, 0x8a, 0xdd, 0x7e, 0xa2, 0x05, 0xd6,
-	0xc9, 0x45, 0x56, 0x10, 0xd3, 0xdf, 0x82, 0x25, 0x15, 0x97, 0x51, 0x67, 0xdb, 0xba, 0x2a, 0x63,
-	0x33, 0xda, 0x3f, 0x84, 0x8d, 0xa1, 0xe3, 0x3a, 0xc3, 0xf1, 0xd0, 0xf2, 0x71, 0x8f, 0x68, 0x6b,
-	0xca, 0x55, 0x80, 0xc9, 0xab, 0x75, 0x8e, 0x62, 0x52, 0x0c, 0x79, 0x1a, 0xd0, 0x47, 0x50, 0x0b,
-	0x6d, 0xff, 0x14, 0x2b, 0xf5, 0x24, 0x1d, 0x67, 0xd6, 0x5c, 0x65, 0xe5, 0x52, 0x2d, 0xa6, 0xe9,
-	0xfc, 0x4b, 0x0d, 0xd6, 0x52, 0x93, 0xca, 0x97, 0xfd, 0x09, 0xa0, 0xa1, 0x43, 0x35, 0x05, 0xb9,
-	0x33, 0x6c, 0xf5, 0xd7, 0xa4, 0xd5, 0x97, 0x6f, 0x4e, 0x66, 0x95, 0x56, 0x51, 0x7a, 0x77, 0x08,
-	0xcb, 0x63, 0x37, 0x83, 0x52, 0xee, 0x26, 0x37, 0x9c, 0x25, 0x5e, 0x55, 0xa6, 0x68, 0x7c, 0x00,
-	0x3a, 0xe9, 0x34, 0xdd, 0x4a, 0x82, 0x07, 0xee, 0xc1, 0x02, 0xdb, 0x72, 0xf2, 0xda, 0x03, 0x03,
-	0x51, 0xfe, 0xf9, 0x4b, 0x39, 0xa8, 0x46, 0xb5, 0x7e, 0x65, 0x58, 0x67, 0x0b, 0x96, 0xc4, 0xd2,
-	0xb3, 0xd1, 0xc7, 0x7a, 0xf0, 0xac, 0x59, 0xe5, 0xab, 0x4e, 0x4b, 0xd8, 0x82, 0xff, 0xa7, 0x19,
-	0x40, 0xf2, 0x2c, 0xf0, 0xb5, 0xde, 0x81, 0x39, 0x56, 0x9f, 0xaf, 0xef, 0xbb, 0xd2, 0xaa, 0xa4,
-	0xd1, 0xb7, 0xd8, 0xb7, 0x58, 0x23, 0x5e, 0x15, 0x7d, 0x0a, 0xb3, 0xb4, 0xd3, 0x74, 0x2e, 0x16,
-	0xb6, 0xdf, 0x99, 0x4e, 0x43, 0x61, 0x1b, 0x56, 0xb1, 0xfe, 0x47, 0x39, 0x28, 0x2b, 0xb4, 0xd1,
-	0xb7, 0x13, 0x1d, 0xbb, 0x86, 0x5d, 0x44, 0x57, 0xbe, 0x0b, 0xf3, 0x54, 0xf8, 0x63, 0x9f, 0x77,
-	0xe6, 0x9a, 0x7a, 0x02, 0x1b, 0xfd, 0x59, 0x28, 0xf3, 0x89, 0x0c, 0x42, 0x3b, 0x1c, 0x07, 0x5c,
-	0xf1, 0xfb, 0xe8, 0x16, 0xf3, 0xc1, 0xbf, 0x3a, 0xb4, 0xbe, 0x59, 0x0a, 0xa5, 0x2f, 0xe3, 0x4b,
-	0x28, 0xc9, 0xa5, 0x68, 0x01, 0xe6, 0x5f, 0xb4, 0x9f, 0xb7, 0x0f, 0x3e, 0x6b, 0xeb, 0xdf, 0x60,
-	0x1f, 0xfb, 0xad, 0x76, 0x73, 0x57, 0xd7, 0x50, 0x09, 0x0a, 0xad, 0xfd, 0xfd, 0x46, 0xf7, 0x05,
-	0x55, 0xdd, 0x0a, 0x30, 0xb3, 0xd7, 0x3a, 0x6a, 0xea, 0x79, 0x54, 0x84, 0xd9, 0xa3, 0x83, 0x6e,
-	0x73, 0x57, 0x9f, 0x41, 0x00, 0x73, 0xfb, 0xad, 0x4e, 0xa7, 0xb9, 0xab, 0xcf, 0x92, 0xba, 0xcd,
-	0x97, 0x87, 0x2d, 0xb3, 0xb9, 0xab, 0xcf, 0x31, 0xcb, 0xc8, 0xd1, 0xc1, 0xf3, 0xe6, 0xae, 0x3e,
-	0x5f, 0x7f, 0xf9, 0x8b, 0xb2, 0x6d, 0x18, 0xcb, 0x80, 0xd8, 0x60, 0x0e, 0x7d, 0x27, 0x52, 0x08,
-	0x8c, 0x43, 0x58, 0x52, 0xa0, 0xb1, 0xf2, 0xc1, 0x27, 0x76, 0x44, 0xe0, 0xfc, 0xf0, 0xe6, 0x7b,
-	0x96, 0xa2, 0x4e, 0xea, 0x85, 0x81, 0x40, 0xa7, 0x47, 0x6d, 0xcb, 0x3d, 0xf1, 0x44, 0x2b, 0x7f,
-	0x94, 0x83, 0xaa, 0x04, 0x8c, 0xcd, 0x03, 0x23, 0xcf, 0x1b, 0x58, 0x81, 0xf3, 0x93, 0xc8, 0x3c,
-	0x40, 0x00, 0x1d, 0xe7, 0x27, 0x98, 0xe8, 0x90, 0xf6, 0x60, 0x60, 0x0d, 0xf1, 0x90, 0xe2, 0x84,
-	0xce, 0x25, 0xd7, 0x32, 0xcb, 0xf6, 0x60, 0xb0, 0xcf, 0xa0, 0x5d, 0xe7, 0x92, 0xe0, 0x79, 0x17,
-	0xae, 0x82, 0xc7, 0x8c, 0xab, 0x65, 0xef, 0xc2, 0x95, 0xf0, 0xea, 0x50, 0x10, 0x9a, 0x00, 0xbf,
-	0xa5, 0x46, 0xdf, 0x64, 0x92, 0x07, 0xce, 0x39, 0xe6, 0xf7, 0x51, 0xfa, 0x9b, 0xe8, 0x2d, 0xe7,
-	0x5e, 0x88, 0xfb, 0xfc, 0xda, 0xc9, 0x3e, 0xc8, 0xa0, 0x87, 0x4e, 0x10, 0xf0, 0x83, 0xbd, 0x6c,
-	0xf2, 0x2f, 0xa2, 0x0b, 0xfb, 0xf8, 0xdc, 0x7b, 0x85, 0xfb, 0xb5, 0x02, 0xd3, 0x85, 0xf9, 0x27,
-	0x29, 0xc1, 0x97, 0x23, 0xa2, 0x2b, 0xd5, 0x8a, 0xac, 0x84, 0x7f, 0xc6, 0xd7, 0xec, 0x60, 0x7c,
-	0x1c, 0x38, 0xfd, 0xab, 0x1a, 0x48, 0xd7, 0xec, 0x0e, 0x83, 0x91, 0xea, 0x63, 0x97, 0xb0, 0x7b,
-	0x58, 0x5b, 0x60, 0xd5, 0xf9, 0xa7, 0xd1, 0x05, 0x9d, 0x72, 0x8a, 0x34, 0xcf, 0x89, 0x43, 0x59,
-	0x4b, 0x1c, 0xca, 0xf4, 0x96, 0x9a, 0x94, 0x82, 0xe4, 0x96, 0x1a, 0x4b, 0x28, 0xe3, 0x6f, 0xe4,
-	0xa0, 0x2a, 0x91, 0xe5, 0x2b, 0xf5, 0x73, 0xd3, 0x4d, 0x2b, 0x15, 0xf9, 0x2c, 0xa5, 0x42, 0xe1,
-	0xe0, 0x99, 0xa4, 0x75, 0x4e, 0x6a, 0xc6, 0x26, 0xb2, 0x62, 0x96, 0x19, 0xa8, 0x79, 0x33, 0x04,
-	0x44, 0xee, 0xcc, 0x4c, 0x0f, 0x74, 0xdc, 0x73, 0x7b, 0xe0, 0xf4, 0x6d, 0xb1, 0x82, 0x05, 0x53,
-	0x0f, 0x18, 0x03, 0x46, 0xf0, 0x2c, 0x6b, 0xdf, 0x7c, 0x96, 0xb5, 0xcf, 0xf8, 0x03, 0x0d, 0xd6,
-	0x76, 0xce, 0x6c, 0xf7, 0x14, 0x1f, 0x46, 0x77, 0x06, 0x31, 0xe5, 0x1f, 0x41, 0x9e, 0xdc, 0xac,
-	0x34, 0x2a, 0x78, 0xde, 0x94, 0x04, 0xcf, 0x84, 0x0a, 0x5b, 0xe4, 0xbe, 0x42, 0xaa, 0x10, 0x5d,
-	0xdc, 0x1b, 0xf4, 0x2d, 0xe9, 0x62, 0xc2, 0x2e, 0x1f, 0x65, 0x6f, 0xd0, 0x8f, 0xab, 0x11, 0x34,
-	0x17, 0x5f, 0xc8, 0x68, 0xec, 0x30, 0x2a, 0xbb, 0xf8, 0x22, 0x46, 0x33, 0x5e, 0x83, 0xfc, 0x73,
-	0x7c, 0x45, 0x84, 0xc9, 0xa1, 0xd9, 0x3a, 0x6a, 0x74, 0x9b, 0xfa, 0x37, 0x88, 0xc8, 0x39, 0x7c,
-	0xf1, 0x78, 0xaf, 0xb5, 0xa3, 0x6b, 0xe4, 0xda, 0x94, 0xee, 0x11, 0xbf, 0x36, 0xfd, 0x34, 0x07,
-	0xab, 0x4f, 0xc6, 0x6e, 0x3f, 0x43, 0x27, 0x9d, 0x6e, 0x93, 0x64, 0x67, 0x19, 0xb7, 0x20, 0x0b,
-	0x9b, 0x24, 0x05, 0x32, 0xb3, 0xf5, 0x94, 0x8b, 0x44, 0x7e, 0xca, 0x45, 0x02, 0x7d, 0x0c, 0x75,
-	0xc7, 0xed, 0x0d, 0xc6, 0x7d, 0x6c, 0x45, 0xfa, 0x7d, 0xcf, 0x73, 0xdc, 0x63, 0x3b, 0xc0, 0x01,
-	0xbf, 0x2c, 0xd6, 0x38, 0x46, 0x8b, 0x23, 0xec, 0x88, 0x72, 0x72, 0xea, 0x8b, 0xda, 0x3d, 0x3a,
-	0x64, 0x61, 0xaa, 0x66, 0x77, 0xb0, 0x25, 0x5e, 0xc8, 0xa6, 0x83, 0x5b, 0xac, 0xff, 0x75, 0x1e,
-	0xd6, 0x52, 0x53, 0xc0, 0xb9, 0xff, 0xcf, 0x80, 0x1e, 0xe0, 0x01, 0xee, 0x85, 0xb8, 0x6f, 0x31,
-	0x33, 0xb7, 0x30, 0x07, 0x7e, 0x4b, 0x5a, 0xef, 0x09, 0xb5, 0xb7, 0x0e, 0xb9, 0x21, 0x9f, 0x3f,
-	0x67, 0x2c, 0x0a, 0x52, 0xec, 0x3b, 0xa0, 0xa2, 0x96, 0x8a, 0x01, 0x65, 0x1a, 0x17, 0x28, 0x8c,
-	0xcf, 0xe2, 0xdb, 0xa0, 0xf3, 0x81, 0x8c, 0x5e, 0x89, 0xb1, 0x30, 0x26, 0xa8, 0x30, 0xf8, 0xe1,
-	0x2b, 0x36, 0x8c, 0xfa, 0x9f, 0x6a, 0x50, 0x51, 0x1b, 0xbc, 0xc5, 0xad, 0x82, 0x74, 0x85, 0xdb,
-	0xf6, 0xd9, 0x03, 0x03, 0x13, 0xb8, 0x0b, 0x0c, 0xd6, 0xa2, 0xcf, 0x0c, 0xf1, 0x83, 0x41, 0x5e,
-	0x79, 0x30, 0x20, 0xb2, 0x3c, 0xea, 0xdb, 0x0c, 0x25, 0x5f, 0x18, 0xf1, 0x5e, 0x11, 0xba, 0x44,
-	0x53, 0x76, 0xce, 0xb1, 0x45, 0x76, 0x33, 0xbf, 0x65, 0x2d, 0x70, 0x58, 0xd7, 0x61, 0x36, 0x47,
-	0x72, 0x99, 0x8e, 0x56, 0x99, 0x6f, 0xda, 0x12, 0x01, 0x8a, 0x95, 0x25, 0x72, 0x3a, 0xf4, 0x31,
-	0x7b, 0xc5, 0x99, 0x35, 0xe9, 0x6f, 0xe3, 0x0f, 0x35, 0x58, 0x79, 0xc1, 0x44, 0x22, 0x9f, 0xd1,
-	0x5f, 0x62, 0xd6, 0x35, 0xfe, 0x66, 0x2e, 0x31, 0x9a, 0x88, 0x09, 0x7f, 0xb5, 0x97, 0x91, 0x9c,
-	0x30, 0xac, 0x0b, 0x56, 0x30, 0x1e, 0xd2, 0x33, 0x34, 0x6f, 0x16, 0x19, 0xa4, 0x33, 0x1e, 0x1a,
-	0x3f, 0x9d, 0x83, 0x8d, 0x1d, 0xcf, 0x0d, 0x42, 0x7f, 0xdc, 0xcb, 0xba, 0x3a, 0xbf, 0x01, 0x95,
-	0xc0, 0x1b, 0xfb, 0x3d, 0x6c, 0xa9, 0x4b, 0x5e, 0x66, 0x50, 0x61, 0x23, 0xff, 0x6a, 0x76, 0x0d,
-	0x74, 0x07, 0xe0, 0x04, 0x63, 0x6b, 0x84, 0x7d, 0xeb, 0xd5, 0x31, 0x5f, 0xfe, 0xc2, 0x09, 0xc6,
-	0x87, 0xd8, 0x7f, 0x7e, 0x8c, 0xfe, 0x02, 0xd4, 0xc5, 0x8b, 0x18, 0xdd, 0xda, 0x64, 0x79, 0xec,
-	0xc1, 0xa9, 0xe7, 0x3b, 0xe1, 0x19, 0xb3, 0x0e, 0x55, 0xb6, 0x3f, 0x91, 0x0f, 0x86, 0xc9, 0xe3,
-	0xe0, 0x6f, 0x9e, 0x1d, 0x41, 0xa7, 0x21, 0xc8, 0x98, 0x35, 0x6f, 0x42, 0x09, 0xfa, 0x02, 0x90,
-	0x4b, 0xee, 0x8f, 0x4c, 0x40, 0x08, 0xf9, 0x34, 0x4b, 0xe5, 0xd3, 0xc3, 0x5b, 0x35, 0x6b, 0xea,
-	0xae, 0xe7, 0x32, 0xa9, 0x28, 0x84, 0xd3, 0x29, 0x20, 0x4e, 0xb8, 0x8f, 0x83, 0xd0, 0x71, 0x99,
-	0x65, 0x65, 0x8e, 0x2a, 0xe9, 0x1f, 0xdd, 0x8a, 0xf8, 0x6e, 0x5c, 0xdf, 0xac, 0x32, 0x9a, 0x12,
-	0xa8, 0x3e, 0x80, 0x6a, 0x0a, 0x6f, 0x8a, 0x59, 0x73, 0x92, 0xc1, 0x8e, 0xf0, 0x01, 0xfd, 0x65,
-	0xf1, 0xe7, 0x78, 0xa1, 0x0c, 0x32, 0x28, 0x7f, 0xcc, 0xaf, 0xff, 0xf9, 0xe8, 0x31, 0xf5, 0xc7,
-	0xb0, 0x20, 0x8f, 0x4c, 0xfb, 0x39, 0x47, 0x26, 0x13, 0x93, 0x36, 0x59, 0x4e, 0xde, 0x64, 0xc6,
-	0x87, 0x50, 0x9b, 0xb4, 0xce, 0x68, 0x11, 0x16, 0x54, 0x9b, 0xf1, 0x3c, 0xe4, 0x1b, 0x7b, 0x7b,
-	0xba, 0x66, 0xfc, 0xad, 0x1c, 0xdc, 0xc9, 0xee, 0x0c, 0x97, 0x10, 0xdf, 0x22, 0x37, 0xf7, 0xc0,
-	0x39, 0x4d, 0x5c, 0xdd, 0xb9, 0x94, 0x58, 0x12, 0x65, 0x52, 0x55, 0xf4, 0x09, 0xdc, 0x61, 0x67,
-	0x4f, 0xf4, 0x08, 0xcd, 0x39, 0x59, 0xe9, 0xf7, 0x3a, 0xc5, 0x51, 0x8f, 0x15, 0x2e, 0x24, 0xc9,
-	0x85, 0x96, 0x12, 0x50, 0xeb, 0x31, 0xa1, 0x52, 0xa5, 0x45, 0x0a, 0xfe, 0x36, 0xac, 0x90, 0x09,
-	0x1a, 0x12, 0xfd, 0xcb, 0xe2, 0x7d, 0xa5, 0xea, 0x3f, 0x53, 0xc9, 0x97, 0xa2, 0xc2, 0x0e, 0x2d,
-	0xa3, 0x37, 0x81, 0xfb, 0x50, 0xe2, 0x3c, 0xc8, 0xc4, 0x19, 0xbb, 0x2d, 0x2f, 0x30, 0x18, 0x15,
-	0x67, 0xc6, 0xff, 0xce, 0xc1, 0x2a, 0xa9, 0x91, 0x21, 0x19, 0xae, 0x33, 0xfd, 0x7e, 0x1b, 0x56,
-	0x03, 0xec, 0x3b, 0xf6, 0xc0, 0xf9, 0x49, 0x62, 0xde, 0x18, 0x67, 0xad, 0xc4, 0xa5, 0xf2, 0xcc,
-	0xd9, 0x80, 0xec, 0x7e, 0xdf, 0x21, 0xbf, 0x89, 0x06, 0x4f, 0xb9, 0x4b, 0x3c, 0x03, 0x6f, 0x4b,
-	0xec, 0x93, 0xdd, 0xab, 0xad, 0x46, 0x54, 0x97, 0x5b, 0x7d, 0xab, 0x76, 0x02, 0x12, 0xd4, 0xff,
-	0xba, 0x06, 0x7a, 0x12, 0xef, 0x6b, 0x3e, 0x06, 0x84, 0x24, 0xce, 0x4b, 0x92, 0x78, 0xda, 0x11,
-	0xf0, 0xa3, 0x99, 0x42, 0x5e, 0x9f, 0x31, 0xcb, 0x8e, 0x1b, 0x91, 0xc5, 0xe4, 0x9a, 0xbc, 0x96,
-	0x1a, 0x26, 0xe7, 0xc9, 0xcd, 0xb4, 0x31, 0x32, 0xe1, 0x52, 0xf2, 0x21, 0xac, 0x46, 0x5c, 0xab,
-	0x90, 0xa5, 0x16, 0xa7, 0xb2, 0x19, 0xf1, 0x34, 0xf5, 0xb8, 0x68, 0xf1, 0x26, 0xff, 0x5b, 0x3e,
-	0xd5, 0x66, 0x70, 0xd3, 0x15, 0xff, 0x71, 0xe2, 0xed, 0x9e, 0x59, 0xb6, 0xbe, 0x33, 0x79, 0xd1,
-	0xa2, 0x17, 0xa3, 0x17, 0xe9, 0x2d, 0xa4, 0x3e, 0xea, 0xa3, 0xe3, 0x4c, 0xb6, 0x60, 0xce, 0x32,
-	0x1f, 0xdc, 0xa0, 0x85, 0x5f, 0x52, 0xbe, 0xa8, 0xef, 0xc1, 0x52, 0xc6, 0xe4, 0x4c, 0xd9, 0x5c,
-	0xda, 0x94, 0xcd, 0x65, 0xfc, 0x77, 0x0d, 0x6a, 0xe9, 0x19, 0xe2, 0x2c, 0xf5, 0x79, 0x62, 0xf9,
-	0x98, 0x26, 0xfe, 0xed, 0xa9, 0x93, 0xcb, 0x55, 0xf1, 0xce, 0xf4, 0xd5, 0xab, 0xbf, 0x82, 0x6a,
-	0x0a, 0xe5, 0x17, 0xc6, 0xc2, 0xff, 0x24, 0x0f, 0xab, 0x3b, 0x3e, 0xb6, 0x43, 0x4c, 0xda, 0xe4,
-	0xaf, 0x1a, 0x37, 0x7f, 0x79, 0xe3, 0xe7, 0x62, 0x4e, 0x3d, 0x17, 0x27, 0x4f, 0x78, 0x7e, 0x9a,
-	0x34, 0xbb, 0x07, 0x0b, 0x52, 0xc7, 0xb9, 0x30, 0x06, 0x27, 0xea, 0x2e, 0xfa, 0x11, 0x14, 0x09,
-	0x4b, 0x31, 0x4f, 0x8e, 0xd9, 0x94, 0x13, 0x55, 0xf6, 0x38, 0xc8, 0x7c, 0x13, 0x8e, 0xa3, 0x3e,
-	0x21, 0x85, 0x33, 0xfe, 0x0b, 0xbd, 0x07, 0x28, 0x3a, 0x6e, 0x62, 0x8e, 0x62, 0x6e, 0x44, 0x91,
-	0xe3, 0x94, 0xb8, 0xd1, 0x18, 0x7f, 0x45, 0x83, 0x05, 0x89, 0x0e, 0x39, 0x20, 0x3b, 0xad, 0xa7,
-	0xcf, 0x1a, 0x9d, 0x67, 0xd6, 0xc1, 0x1e, 0x39, 0x20, 0x25, 0x00, 0x3d, 0x28, 0x91, 0x0e, 0x25,
-	0x01, 0x68, 0x1f, 0xb4, 0x9b, 0x7a, 0x0e, 0x21, 0xa8, 0x08, 0x48, 0xa7, 0xd5, 0x7e, 0xba, 0xd7,
-	0xd4, 0xf3, 0x68, 0x19, 0x74, 0xa9, 0xda, 0x51, 0x63, 0xef, 0x45, 0x53, 0x9f, 0x41, 0xeb, 0xb0,
-	0x1c, 0x41, 0xdb, 0x9f, 0x1f, 0xb4, 0x9b, 0x3b, 0x8d, 0xf6, 0x61, 0xe3, 0x73, 0xfd, 0xa7, 0x9a,
-	0x71, 0x04, 0x6b, 0xa9, 0x61, 0x72, 0x96, 0xbc, 0x03, 0xc5, 0x40, 0x00, 0x85, 0x75, 0x24, 0x02,
-	0x64, 0x3c, 0xc1, 0x96, 0xe4, 0x27, 0xd8, 0x1f, 0xc1, 0xfa, 0x21, 0xf9, 0x08, 0xce, 0x32, 0x4e,
-	0xaf, 0x87, 0x80, 0x26, 0x9e, 0xe8, 0xd5, 0xd4, 0x7e, 0x33, 0x9e, 0x42, 0x3d, 0x8b, 0xd6, 0xad,
-	0xaf, 0x10, 0xc6, 0x03, 0xb8, 0xcf, 0x09, 0xbd, 0x48, 0x5b, 0xf4, 0x85, 0x55, 0xef, 0x75, 0x30,
-	0xa6, 0x21, 0x71, 0xe3, 0xc2, 0xdf, 0xcd, 0xc3, 0xea, 0xe1, 0xd8, 0xef, 0x9d, 0xd9, 0x01, 0x4e,
-	0x98, 0xf3, 0xbf, 0xfa, 0x0b, 0xf3, 0x3d, 0x58, 0xa0, 0x36, 0x60, 0x6b, 0xe0, 0x0c, 0x1d, 0xa1,
-	0x6f, 0x00, 0x05, 0xed, 0x11, 0xc8, 0x14, 0x4d, 0x9f, 0x31, 0xf7, 0x04, 0x4d, 0xff, 0x0d, 0xa8,
-	0x70, 0xbb, 0xa7, 0xea, 0xfe, 0xc6, 0xcd, 0xcc, 0xe2, 0xe1, 0xf5, 0x1e, 0x2c, 0xb8, 0xe3, 0x61,
-	0xf4, 0x6a, 0xc8, 0x4c, 0x84, 0xe0, 0x8e, 0x87, 0xe2, 0xc1, 0xf0, 0x3e, 0x94, 0xa8, 0x39, 0x52,
-	0x50, 0x99, 0xe7, 0x8f, 0xb7, 0x9e, 0x37, 0x10, 0x34, 0x84, 0xf5, 0xf3, 0x04, 0xe3, 0x80, 0x5e,
-	0x78, 0x34, 0x66, 0xfd, 0x7c, 0x82, 0x31, 0xd5, 0x6f, 0xa9, 0x99, 0xf0, 0x8a, 0x1b, 0x0d, 0xf9,
-	0x17, 0x5a, 0x81, 0xb9, 0xf0, 0x92, 0x54, 0xe1, 0xc6, 0xc2, 0xd9, 0xf0, 0xf2, 0x09, 0xbb, 0x3d,
-	0xf1, 0x6e, 0x93, 0xa2, 0x05, 0x61, 0x38, 0x23, 0x10, 0x52, 0xbc, 0x09, 0xa5, 0xbe, 0x47, 0xae,
-	0x56, 0xce, 0xa9, 0x6b, 0x85, 0x97, 0xb5, 0x12, 0x7b, 0x29, 0x26, 0x30, 0x2a, 0x2b, 0x2f, 0x8d,
-	0x00, 0xd6, 0x52, 0x6b, 0xc4, 0xb9, 0xe6, 0x41, 0x64, 0x63, 0x27, 0x0c, 0x83, 0x99, 0xc0, 0x2d,
-	0x09, 0x4b, 0xf9, 0x33, 0x0a, 0x23, 0x2b, 0x25, 0x26, 0x23, 0x47, 0x8b, 0xc5, 0x27, 0x5a, 0x87,
-	0x42, 0x30, 0x1a, 0x38, 0x21, 0x69, 0x97, 0xc9, 0xa0, 0x79, 0xfa, 0xdd, 0xbd, 0x34, 0xbe, 0x03,
-	0xcb, 0x26, 0xb5, 0x92, 0xde, 0x8e, 0x2d, 0x98, 0x5b, 0x8e, 0x52, 0x8f, 0xb3, 0xda, 0x6b, 0x70,
-	0x67, 0xcf, 0xb3, 0xfb, 0x0d, 0xea, 0xab, 0xb6, 0x6b, 0x87, 0xf6, 0x13, 0x67, 0x10, 0x62, 0x3f,
-	0x62, 0xd8, 0x7b, 0x70, 0x77, 0x42, 0x39, 0x27, 0x70, 0x06, 0x88, 0x4c, 0xc8, 0x3e, 0x0e, 0x02,
-	0xfb, 0x14, 0xcb, 0x86, 0x84, 0xec, 0x6b, 0x48, 0x0d, 0xe6, 0x87, 0x0c, 0x57, 0x08, 0x62, 0xfe,
-	0x99, 0x18, 0x43, 0x3e, 0x35, 0x86, 0x0f, 0x60, 0x49, 0x69, 0xe9, 0x26, 0x92, 0xc4, 0xf8, 0x7d,
-	0x4d, 0xa9, 0x75, 0xe3, 0x7d, 0xf4, 0x18, 0x0a, 0xbc, 0x5f, 0x42, 0xdb, 0x79, 0x33, 0x71, 0x5c,
-	0x26, 0x28, 0x6e, 0x89, 0x7e, 0x45, 0xf5, 0xea, 0x3f, 0x80, 0x79, 0x0e, 0xfc, 0x2a, 0xf3, 0x61,
-	0xfc, 0x6d, 0x0d, 0x96, 0xd5, 0x86, 0xa2, 0xb7, 0xac, 0x79, 0x1f, 0x8f, 0x06, 0x0e, 0x16, 0x27,
-	0xf9, 0x37, 0x27, 0x76, 0x4d, 0x3a, 0xc5, 0x4d, 0x3c, 0x1a, 0x5c, 0x99, 0xa2, 0x66, 0xfd, 0x13,
-	0x28, 0x46, 0xd0, 0x6b, 0xa4, 0xf1, 0x32, 0xcc, 0x62, 0xdf, 0xe7, 0x8e, 0xd9, 0x45, 0x93, 0x7d,
-	0x18, 0xf7, 0xe1, 0x9e, 0x24, 0xbc, 0xda, 0x5e, 0xe8, 0x9c, 0x38, 0x3d, 0x5b, 0x91, 0x76, 0xbf,
-	0x9b, 0x83, 0xcd, 0xc9, 0x38, 0x7c, 0x34, 0x9f, 0xc2, 0xa2, 0x1d, 0x86, 0x76, 0xef, 0x0c, 0xf7,
-	0xd9, 0x93, 0xa0, 0x18, 0xd5, 0xc4, 0x27, 0xd8, 0x8a, 0xc0, 0xa7, 0xd0, 0x00, 0xbd, 0x05, 0x8b,
-	0x7d, 0xac, 0x52, 0x60, 0x3b, 0xaa, 0x22, 0xc0, 0x1c, 0x71, 0xd2, 0x43, 0x6d, 0xfe, 0xab, 0x3e,
-	0xd4, 0xa2, 0x8f, 0xa1, 0x9e, 0x41, 0x51, 0x6c, 0xfb, 0x19, 0xda, 0x8b, 0x5a, 0xba, 0x22, 0x13,
-	0x01, 0xc6, 0x5d, 0xd8, 0x10, 0x4e, 0x99, 0x59, 0xd3, 0xf7, 0xbf, 0x34, 0xb8, 0x93, 0x5d, 0x7e,
-	0x2b, 0x8f, 0xb2, 0x9b, 0xf8, 0x2f, 0x66, 0xbb, 0x26, 0xe6, 0x6f, 0xe5, 0x9a, 0x38, 0x73, 0x2b,
-	0xd7, 0xc4, 0xd9, 0x09, 0xae, 0x89, 0xbf, 0x01, 0x9b, 0xf2, 0xf9, 0x92, 0x35, 0x31, 0xe4, 0x1c,
-	0x08, 0x2f, 0x55, 0xd9, 0x5a, 0x08, 0x2f, 0xb9, 0x5c, 0xbd, 0x0b, 0x10, 0x84, 0xde, 0xc8, 0xb2,
-	0x4f, 0x42, 0xfe, 0x38, 0x3a, 0x6b, 0x16, 0x09, 0xa4, 0x41, 0x00, 0xc6, 0x3f, 0xcd, 0xc1, 0xfd,
-	0x29, 0x0d, 0xf0, 0x99, 0x7d, 0x95, 0x7c, 0x7b, 0x61, 0x2c, 0xd9, 0x54, 0xad, 0x1c, 0xd3, 0x89,
-	0x6c, 0x29, 0xce, 0x08, 0x12, 0xb1, 0xc4, 0x13, 0x4e, 0xfd, 0x77, 0x34, 0xa8, 0x4d, 0xc2, 0x45,
-	0x6b, 0x30, 0xcf, 0xc7, 0xca, 0x37, 0xe6, 0x1c, 0x1b, 0xe9, 0xd7, 0xe2, 0x73, 0x92, 0x7a, 0x86,
-	0x9a, 0x49, 0x3f, 0x6f, 0xfd, 0x65, 0x0d, 0x96, 0x98, 0x16, 0xf7, 0x19, 0x1d, 0xbb, 0x58, 0x84,
-	0x77, 0xa1, 0xca, 0x75, 0xb4, 0x94, 0x20, 0xd5, 0x59, 0x81, 0xf4, 0x22, 0xf3, 0x90, 0x28, 0xb0,
-	0xcc, 0xbd, 0x2d, 0xf5, 0x78, 0x53, 0xe5, 0x25, 0x12, 0x3a, 0x82, 0x99, 0x00, 0xe3, 0x3e, 0xef,
-	0x2f, 0xfd, 0x6d, 0xac, 0xc2, 0xb2, 0xda, 0x0d, 0x7e, 0x00, 0x5d, 0xc2, 0x3d, 0x01, 0x0f, 0x7b,
-	0x67, 0x8e, 0x7b, 0x7a, 0xe0, 0x0e, 0xae, 0xd4, 0xae, 0xbe, 0x0d, 0x94, 0x87, 0xdd, 0x3e, 0xee,
-	0x5b, 0xa3, 0xf1, 0xb1, 0x25, 0x5e, 0x9f, 0x8a, 0x66, 0x45, 0xc0, 0x0f, 0xc7, 0xc7, 0xcf, 0xf1,
-	0x55, 0xf6, 0xa0, 0x72, 0xd9, 0x83, 0x32, 0x0c, 0xd8, 0x9c, 0xdc, 0x32, 0xef, 0xdd, 0xa7, 0x50,
-	0x3d, 0x18, 0x61, 0xf7, 0xab, 0x4f, 0x9d, 0xf1, 0x6b, 0x80, 0x64, 0x0a, 0xb1, 0x8a, 0x71, 0xc1,
-	0x5b, 0xb5, 0x3c, 0x77, 0xc0, 0xc6, 0x53, 0x30, 0x4b, 0x17, 0x52, 0x57, 0x8c, 0x65, 0x40, 0x3b,
-	0x03, 0x2f, 0x50, 0x17, 0xce, 0x58, 0x81, 0x25, 0x05, 0xca, 0x7b, 0xba, 0x02, 0x4b, 0x0c, 0xd2,
-	0xbc, 0x74, 0x82, 0xd8, 0xcb, 0x7b, 0x0b, 0x96, 0x55, 0x30, 0xef, 0x00, 0x55, 0xb7, 0x08, 0x84,
-	0xb7, 0xcc, 0xbf, 0x8c, 0xdf, 0x25, 0x17, 0xd1, 0xd0, 0xf6, 0xc3, 0x1d, 0x82, 0xe6, 0x06, 0xe3,
-	0xc0, 0x1c, 0xf5, 0xc4, 0xc0, 0xdf, 0x82, 0x45, 0xee, 0x24, 0x9f, 0xf0, 0xd1, 0xab, 0x70, 0xb0,
-	0xd0, 0xf4, 0xea, 0x50, 0x18, 0x07, 0x44, 0x8c, 0x44, 0xe2, 0x2a, 0xfa, 0x26, 0x65, 0x64, 0xda,
-	0x2e, 0x3c, 0x5f, 0x30, 0x48, 0xf4, 0x4d, 0x6e, 0x9e, 0x3d, 0xec, 0xf3, 0xcd, 0x88, 0xf9, 0x9d,
-	0x5b, 0x06, 0x19, 0x1b, 0xb0, 0x9e, 0xd1, 0x3d, 0x3e, 0x07, 0xff, 0x40, 0x83, 0xda, 0xae, 0x13,
-	0xf4, 0xbc, 0x73, 0xec, 0xf3, 0xae, 0xc4, 0x2a, 0xc3, 0xbb, 0x50, 0xed, 0xf3, 0x32, 0x4b, 0xf2,
-	0x71, 0xa7, 0x0f, 0xa5, 0xa2, 0x40, 0x38, 0xb8, 0xdf, 0x96, 0xe1, 0x27, 0x78, 0xe9, 0xe4, 0x27,
-	0x78, 0xe9, 0x90, 0x51, 0x64, 0xf4, 0x93, 0x8f, 0xe2, 0x2e, 0x6c, 0x3c, 0xc1, 0x61, 0xef, 0x6c,
-	0xdf, 0x09, 0x02, 0xc7, 0x3d, 0xdd, 0x49, 0xa8, 0x74, 0xaf, 0xc1, 0x9d, 0xec, 0x62, 0x5e, 0xfd,
-	0x4d, 0x78, 0xbd, 0x33, 0x3e, 0x26, 0x77, 0xcc, 0x63, 0xdc, 0xf5, 0x68, 0x9b, 0x99, 0xc7, 0xd3,
-	0x5b, 0xf0, 0xc6, 0x35, 0x78, 0x31, 0x67, 0xd1, 0x06, 0xd9, 0x83, 0x73, 0x54, 0xff, 0x1f, 0xe5,
-	0x60, 0x59, 0x85, 0x73, 0xd6, 0xda, 0x86, 0x95, 0x13, 0x02, 0xc7, 0x7d, 0xfe, 0x6c, 0x1d, 0x58,
-	0xf2, 0x03, 0xc5, 0x12, 0x2f, 0xe4, 0xd5, 0xd8, 0x21, 0xf3, 0x3e, 0x2c, 0x9f, 0x38, 0x7e, 0x10,
-	0x5a, 0x2e, 0xbe, 0x48, 0x07, 0x0e, 0x54, 0x69, 0x59, 0x1b, 0x5f, 0xc4, 0x7e, 0x48, 0x1f, 0xc0,
-	0x6a, 0xaa, 0x82, 0x1c, 0x3b, 0xb0, 0xa4, 0x56, 0x61, 0x4f, 0xf2, 0x1f, 0xc1, 0xfa, 0xd0, 0x76,
-	0xe8, 0xcb, 0x81, 0xe3, 0x5a, 0xa1, 0x33, 0x92, 0x9b, 0x62, 0xcc, 0xb6, 0x42, 0x10, 0x76, 0x48,
-	0x79, 0xd7, 0x19, 0xc5, 0xcd, 0x7d, 0x0c, 0x1b, 0xd9, 0x35, 0x59, 0x9b, 0xcc, 0x40, 0xbb, 0x96,
-	0xae, 0xcb, 0x64, 0xf0, 0x25, 0xd4, 0xe4, 0x99, 0x92, 0xa7, 0x79, 0xfa, 0x6c, 0xcd, 0x66, 0xcf,
-	0xd6, 0xdb, 0xa0, 0x0f, 0xec, 0x20, 0xe4, 0x15, 0xd8, 0xd3, 0x14, 0x33, 0x5c, 0x57, 0x08, 0x9c,
-	0xe1, 0x76, 0x9d, 0x21, 0x36, 0xfe, 0xbe, 0x06, 0x9b, 0x59, 0xdc, 0xa2, 0x74, 0xa1, 0x01, 0x77,
-	0x45, 0x17, 0x7a, 0x27, 0xac, 0xdc, 0xa2, 0x3c, 0xab, 0xfa, 0xf6, 0xd7, 0x39, 0xd2, 0x0e, 0xc7,
-	0xa1, 0xfb, 0x90, 0xcf, 0xec, 0x0f, 0x60, 0x23, 0x45, 0x82, 0x5c, 0x56, 0x15, 0xf7, 0x88, 0x5a,
-	0x82, 0x40, 0xd3, 0xed, 0xf3, 0x09, 0x6a, 0x41, 0x9d, 0x85, 0x02, 0x1c, 0xfa, 0xde, 0x29, 0xd9,
-	0x0e, 0x4a, 0xff, 0x6e, 0x15, 0x16, 0xf0, 0x1c, 0xf4, 0x43, 0x8c, 0x7d, 0x85, 0xc0, 0x5d, 0x80,
-	0x11, 0xc6, 0xbe, 0x32, 0xb1, 0x45, 0x02, 0xd9, 0x49, 0x86, 0x7e, 0xa9, 0xc6, 0x25, 0xe3, 0x4f,
-	0x35, 0xa8, 0x98, 0xa3, 0x5e, 0xe7, 0xca, 0xfd, 0x7f, 0x48, 0x06, 0x66, 0x4b, 0xb2, 0xd9, 0x5b,
-	0x49, 0xb2, 0xb9, 0x09, 0x92, 0xcc, 0xf8, 0xb7, 0x79, 0x58, 0x8c, 0x46, 0x1c, 0x9f, 0x15, 0xc1,
-	0x95, 0xdb, 0xc3, 0x7d, 0x71, 0x56, 0xb0, 0x2f, 0xb4, 0x07, 0x55, 0x57, 0x9a, 0x66, 0x66, 0x2a,
-	0x63, 0x61, 0x0c, 0xf7, 0xe4, 0x2b, 0xcd, 0x95, 0xdb, 0x93, 0x97, 0x83, 0x1a, 0xc7, 0x74, 0x37,
-	0x01, 0x41, 0xcf, 0xa0, 0x4c, 0xf9, 0x43, 0x6c, 0x03, 0x3a, 0x31, 0x6a, 0xfc, 0xd1, 0xa4, 0x4d,
-	0x64, 0x96, 0x4e, 0xa4, 0x12, 0x64, 0xc3, 0x2a, 0xa3, 0x34, 0x64, 0x4c, 0x1f, 0xb1, 0x24, 0x9d,
-	0x4c, 0xd5, 0x79, 0xf0, 0xba, 0xcd, 0x61, 0x2e, 0x9f, 0xc8, 0x18, 0x9c, 0x10, 0x6a, 0xc3, 0x22,
-	0xe3, 0x3c, 0x6b, 0xc4, 0x39, 0x96, 0x2e, 0xc0, 0xc2, 0xf6, 0x1b, 0x12, 0xed, 0xc9, 0x2c, 0x6d,
-	0x56, 0x7c, 0xa5, 0x0c, 0x3d, 0x01, 0x9d, 0x72, 0xa8, 0xe3, 0x9e, 0x78, 0x5c, 0xf9, 0xe3, 0x6f,
-	0x8e, 0x1b, 0x12, 0xc1, 0x24, 0x63, 0x9b, 0x8b, 0xa4, 0x52, 0x2b, 0xae, 0x63, 0xfc, 0xb6, 0x06,
-	0x95, 0xce, 0xe8, 0x5c, 0x66, 0xd8, 0x5f, 0xe4, 0xb9, 0x47, 0x8d, 0x52, 0xe7, 0x56, 0xcf, 0x73,
-	0x5d, 0xdc, 0x0b, 0xe9, 0x45, 0xac, 0x68, 0x42, 0x30, 0x3a, 0xdf, 0x61, 0x10, 0xca, 0x4e, 0x51,
-	0x7f, 0xfe, 0x3f, 0x3b, 0xfd, 0xb2, 0xb1, 0xd3, 0x32, 0x20, 0xde, 0xaa, 0xe7, 0x44, 0x31, 0x56,
-	0x46, 0x03, 0x96, 0x14, 0x28, 0x5f, 0xd7, 0x77, 0x84, 0x98, 0xb6, 0x46, 0x04, 0xae, 0x58, 0x5b,
-	0xfd, 0x18, 0x9f, 0x2a, 0x40, 0x1f, 0xc3, 0x3a, 0x0f, 0x4c, 0xc0, 0xa6, 0xed, 0xf6, 0xbd, 0x61,
-	0x07, 0xe3, 0xbe, 0xe4, 0x2a, 0x4d, 0xae, 0x0c, 0xd6, 0x00, 0xbb, 0xa7, 0xe1, 0x19, 0x57, 0x1b,
-	0x80, 0x80, 0xf6, 0x28, 0xc4, 0xf8, 0x73, 0x50, 0xcf, 0xaa, 0x1d, 0xbb, 0xee, 0xd1, 0xea, 0xc7,
-	0x57, 0x21, 0x0e, 0x22, 0x73, 0x08, 0xc6, 0xfd, 0xc7, 0x04, 0x40, 0xcd, 0x73, 0x98, 0x9e, 0xb6,
-	0x97, 0x42, 0xdc, 0x93, 0xef, 0x67, 0xf8, 0x92, 0x68, 0xe5, 0xb4, 0x68, 0xe8, 0xe2, 0xa1, 0xe7,
-	0x3a, 0x3d, 0x1e, 0xc3, 0x53, 0x22, 0xc0, 0x7d, 0x0e, 0x33, 0xb6, 0xa1, 0xba, 0x8b, 0x7b, 0x5e,
-	0x1f, 0xcb, 0x5d, 0xbe, 0x0b, 0x40, 0x84, 0x3b, 0x7b, 0x0c, 0xe1, 0x07, 0x42, 0x91, 0x40, 0xe8,
-	0x03, 0x88, 0xf1, 0x5d, 0x40, 0x72, 0x9d, 0xd8, 0xe5, 0xb4, 0x4f, 0xa1, 0x7d, 0x8b, 0x5e, 0x97,
-	0xf8, 0x43, 0x0b, 0x87, 0x11, 0x54, 0xe3, 0xaf, 0xe6, 0x60, 0xc5, 0x1c, 0xbb, 0xcc, 0xec, 0xf7,
-	0x78, 0x7c, 0x15, 0x07, 0x8d, 0x7e, 0x75, 0x4b, 0xf2, 0x1b, 0x50, 0x11, 0xa1, 0x1d, 0x3d, 0xd9,
-	0x50, 0x50, 0xe6, 0x81, 0x1d, 0x1c, 0x6d, 0x0b, 0x96, 0x78, 0x34, 0xa4, 0x15, 0x7a, 0x16, 0x51,
-	0x6d, 0x42, 0xdb, 0x11, 0x31, 0x26, 0x55, 0x5e, 0xd4, 0xf5, 0xf6, 0x79, 0x81, 0x4c, 0x56, 0x35,
-	0x24, 0x73, 0xb2, 0xfc, 0x58, 0x4c, 0xda, 0x89, 0xe7, 0xae, 0xb1, 0x13, 0xcf, 0xab, 0x76, 0x62,
-	0xa3, 0x06, 0xab, 0xc9, 0x09, 0xe1, 0x7a, 0xea, 0x1f, 0x6b, 0xb4, 0x88, 0xf7, 0x7f, 0xdf, 0xb9,
-	0xbc, 0xf9, 0x64, 0x3d, 0x80, 0xf2, 0xd0, 0xb9, 0xc4, 0xfd, 0x44, 0xf0, 0x7a, 0x89, 0x02, 0xc5,
-	0x84, 0x3c, 0x82, 0x65, 0x05, 0xc9, 0x3a, 0xf6, 0x6d, 0xb7, 0x77, 0xc6, 0x67, 0x0f, 0xc9, 0xb8,
-	0x8f, 0x69, 0x09, 0x99, 0x12, 0xfe, 0x8e, 0x2f, 0xe8, 0x32, 0x23, 0x4b, 0x99, 0x41, 0x1b, 0xb1,
-	0x69, 0xbf, 0x17, 0x8c, 0x46, 0x56, 0x80, 0xfd, 0x73, 0xee, 0xe4, 0x59, 0x34, 0x81, 0x80, 0x3a,
-	0x14, 0x62, 0xac, 0xc3, 0x5a, 0x6a, 0x60, 0x7c, 0xd0, 0xbf, 0x9d, 0x87, 0x15, 0xaa, 0x88, 0x35,
-	0xc6, 0xa1, 0xf7, 0x35, 0x31, 0xc8, 0x84, 0x95, 0xcf, 0x4f, 0x5a, 0xf9, 0x07, 0x50, 0x19, 0xda,
-	0xd4, 0x46, 0x2f, 0x1c, 0x86, 0x18, 0x93, 0x2c, 0x0c, 0xed, 0xcb, 0x27, 0xc2, 0x67, 0xe8, 0x3d,
-	0x40, 0x04, 0x89, 0x3a, 0x57, 0x5b, 0x3e, 0x1e, 0xd8, 0xa1, 0xf0, 0x3f, 0xd6, 0x4c, 0x7d, 0x68,
-	0x5f, 0x72, 0x6f, 0x6c, 0x06, 0x57, 0xb1, 0xed, 0xe3, 0xc0, 0x1b, 0x8c, 0x43, 0xcc, 0x03, 0x90,
-	0x22, 0xec, 0x06, 0x87, 0x67, 0xb0, 0xde, 0xfc, 0x4d, 0x58, 0xaf, 0x70, 0x0d, 0xeb, 0x15, 0x13,
-	0x4f, 0x14, 0x06, 0x94, 0x69, 0xa7, 0xb0, 0xcf, 0xb4, 0x7f, 0xfe, 0x22, 0x41, 0x86, 0x79, 0x88,
-	0x7d, 0xaa, 0xf0, 0x13, 0xf6, 0x4c, 0x2e, 0x07, 0x5f, 0xa9, 0x55, 0x58, 0xee, 0x84, 0xde, 0x28,
-	0xb9, 0x4e, 0xc6, 0x1a, 0x59, 0x40, 0x05, 0xce, 0x2b, 0xd4, 0xa1, 0x26, 0xb1, 0x39, 0x35, 0x2b,
-	0x45, 0xa1, 0xee, 0x7f, 0x6d, 0x0e, 0xd6, 0x33, 0x0a, 0xa5, 0xe0, 0xc8, 0x6c, 0x3f, 0xc0, 0xd7,
-	0xa1, 0x62, 0x9f, 0x9f, 0xf2, 0x79, 0x1d, 0x7a, 0x7d, 0xa1, 0x9a, 0x96, 0xec, 0xf3, 0x53, 0x3a,
-	0xa7, 0xfb, 0x5e, 0x9f, 0x5e, 0x67, 0x23, 0xac, 0xa3, 0xcf, 0x1a, 0x87, 0x56, 0x1f, 0x0f, 0x42,
-	0x5b, 0x30, 0x80, 0x40, 0x25, 0x25, 0xbb, 0xa4, 0xe0, 0xd6, 0xa2, 0xc2, 0x80, 0x32, 0x33, 0xf4,
-	0x12, 0x74, 0xfb, 0xfc, 0x54, 0xf8, 0xd5, 0x31, 0x60, 0xd7, 0x6b, 0x9c, 0x9f, 0xa2, 0x6f, 0xc1,
-	0x0a, 0x7d, 0xc1, 0xb9, 0xb0, 0x9d, 0xd0, 0x3a, 0xf1, 0x7c, 0xe5, 0xe9, 0xa9, 0x60, 0x22, 0x52,
-	0xf8, 0x99, 0xed, 0x84, 0x4f, 0x3c, 0x5f, 0x7a, 0x82, 0x62, 0x8f, 0x46, 0xbc, 0xbf, 0x3c, 0x12,
-	0x8d, 0xc1, 0x58, 0x4f, 0xef, 0x32, 0xbf, 0x36, 0xe6, 0x23, 0xc7, 0x19, 0xa0, 0x78, 0x82, 0x71,
-	0x87, 0x02, 0x08, 0xdb, 0x91, 0x62, 0xee, 0x2a, 0x19, 0xf4, 0xec, 0x81, 0xe3, 0x9e, 0x72, 0x3e,
-	0xd0, 0x4f, 0x30, 0xee, 0xd2, 0x82, 0x0e, 0x83, 0xa3, 0x35, 0x98, 0x1f, 0x3a, 0xae, 0xf4, 0x36,
-	0x35, 0x37, 0x74, 0xdc, 0x27, 0x18, 0xd3, 0x02, 0xb6, 0x21, 0xe8, 0xc3, 0x13, 0x29, 0xa0, 0x3b,
-	0x21, 0xcd, 0x41, 0xe5, 0x14, 0x07, 0x4d, 0x60, 0xfd, 0xca, 0x04, 0xd6, 0xcf, 0xde, 0x56, 0x8b,
-	0x13, 0xb6, 0xd5, 0xeb, 0x6c, 0xa7, 0x3a, 0x51, 0xf4, 0x40, 0xad, 0xca, 0xfc, 0x40, 0x87, 0xf6,
-	0x65, 0x4b, 0xc4, 0x0e, 0xa4, 0xf6, 0x09, 0xba, 0x66, 0x9f, 0x2c, 0x25, 0xf6, 0xc9, 0x77, 0x60,
-	0x2d, 0x18, 0xf9, 0xd8, 0xee, 0x8b, 0x98, 0x9f, 0x11, 0x7f, 0x68, 0x0b, 0x6a, 0xcb, 0x74, 0xf1,
-	0x56, 0x58, 0x31, 0x0f, 0xc3, 0x10, 0x85, 0x19, 0xdb, 0x78, 0x25, 0x6b, 0x1b, 0xc7, 0x2f, 0x82,
-	0xab, 0xd2, 0x8b, 0xa0, 0xf1, 0x10, 0xaa, 0x1d, 0x9c, 0x0c, 0x07, 0x9f, 0xb8, 0x13, 0x88, 0x6a,
-	0x23, 0xa3, 0xf3, 0x3d, 0xb7, 0x0f, 0x1b, 0x1d, 0x2c, 0xd2, 0x53, 0xc4, 0x1c, 0x2b, 0x45, 0x63,
-	0x65, 0x31, 0xba, 0x36, 0x81, 0xd1, 0x8d, 0xd7, 0xe0, 0x4e, 0x36, 0x39, 0xde, 0xdc, 0x77, 0x41,
-	0xef, 0xe0, 0x70, 0x9f, 0x32, 0x87, 0x68, 0x23, 0x2d, 0x4d, 0xb5, 0x94, 0x34, 0x35, 0x96, 0xe8,
-	0x60, 0x45, 0x45, 0x4e, 0xed, 0x47, 0x50, 0x67, 0x40, 0x65, 0xd1, 0x05, 0xdd, 0x6c, 0x4e, 0xd1,
-	0xb2, 0x39, 0xc5, 0xb8, 0x4b, 0x27, 0x22, 0x4d, 0x2b, 0xb3, 0x29, 0xc1, 0x8d, 0x99, 0x4d, 0x45,
-	0x2c, 0xac, 0x65, 0xb3, 0x70, 0xa2, 0xa9, 0x98, 0x56, 0x64, 0x82, 0x5d, 0xeb, 0xe0, 0xf0, 0x48,
-	0x66, 0x01, 0xc9, 0x07, 0x36, 0xc1, 0x30, 0x5a, 0x06, 0xc3, 0x10, 0x41, 0x9a, 0xa6, 0xc0, 0xa9,
-	0x7f, 0x0f, 0x56, 0x3a, 0x38, 0x3c, 0x8c, 0x59, 0x5b, 0xca, 0x6f, 0xa0, 0x6c, 0x02, 0x2d, 0xb5,
-	0x09, 0xa8, 0xac, 0x4f, 0xd4, 0xe5, 0x54, 0xbf, 0x45, 0x99, 0xeb, 0x90, 0x6f, 0x08, 0xe9, 0xdd,
-	0x23, 0xde, 0x34, 0x5a, 0x42, 0xaf, 0x59, 0x81, 0x25, 0xa5, 0x0a, 0xa7, 0xf4, 0x7d, 0xda, 0xbf,
-	0xfd, 0x58, 0x3e, 0x08, 0x62, 0x29, 0x51, 0xa2, 0x65, 0x1f, 0x46, 0x89, 0xca, 0x71, 0x6a, 0x94,
-	0xc6, 0x29, 0x76, 0xfb, 0x76, 0x64, 0xce, 0xfb, 0x59, 0x1e, 0x16, 0x23, 0x50, 0x7c, 0x8e, 0x08,
-	0xa7, 0x52, 0xbe, 0x7b, 0xf8, 0x27, 0xfa, 0x3e, 0xcc, 0xdb, 0x0c, 0x99, 0x3f, 0xaf, 0xde, 0x97,
-	0xd3, 0x84, 0xa8, 0x64, 0xf8, 0xb7, 0x29, 0x6a, 0xd4, 0xff, 0x50, 0x83, 0x39, 0x06, 0x43, 0x15,
-	0xc8, 0x39, 0x7d, 0x3e, 0xb7, 0x39, 0x87, 0x1a, 0x3f, 0xfa, 0x98, 0xf9, 0xc7, 0x08, 0x87, 0xc4,
-	0xa2, 0x29, 0x83, 0x10, 0x82, 0x99, 0xa1, 0x1d, 0xbc, 0xe2, 0x5a, 0x17, 0xfd, 0x4d, 0x7a, 0xd3,
-	0x3b, 0xf3, 0x9c, 0x1e, 0x16, 0xfe, 0x88, 0xd3, 0x7a, 0xb3, 0x43, 0x31, 0x4d, 0x51, 0x83, 0x3d,
-	0x38, 0xd9, 0x7e, 0x28, 0x7b, 0x78, 0x17, 0x29, 0x84, 0xfa, 0x77, 0xdf, 0x03, 0x76, 0x80, 0x70,
-	0x0f, 0x70, 0xa6, 0x82, 0x00, 0x03, 0x11, 0x84, 0xfa, 0x6f, 0x69, 0x30, 0xc7, 0x68, 0x7e, 0xb5,
-	0xd1, 0xf0, 0xe4, 0x52, 0x74, 0x34, 0x34, 0x6b, 0xd4, 0x5d, 0x00, 0x27, 0x20, 0xdb, 0x26, 0x3a,
-	0x44, 0x0b, 0x66, 0xd1, 0x09, 0x1a, 0x0c, 0x80, 0x96, 0x60, 0xd6, 0x09, 0x2c, 0xd7, 0xe3, 0x16,
-	0x9f, 0x19, 0x27, 0x68, 0x7b, 0x44, 0x9a, 0x1d, 0x79, 0x21, 0x66, 0xfd, 0x88, 0xd6, 0xf4, 0x9f,
-	0xe5, 0x60, 0x49, 0x01, 0x5f, 0xbb, 0xae, 0x9f, 0xc4, 0x33, 0xc9, 0xd6, 0x55, 0xbe, 0x80, 0x66,
-	0x90, 0x4a, 0xcd, 0x66, 0x1d, 0x0a, 0xe7, 0x5e, 0x88, 0xa5, 0x41, 0x45, 0xdf, 0xf5, 0xbf, 0x13,
-	0xcf, 0xd4, 0x06, 0x14, 0x19, 0x37, 0x58, 0xd1, 0x84, 0x15, 0x18, 0xa0, 0xd5, 0x47, 0x0f, 0x01,
-	0xf1, 0xc2, 0xf4, 0xec, 0x55, 0x59, 0xc9, 0xae, 0x34, 0x87, 0x1b, 0x50, 0x64, 0xad, 0x13, 0x5a,
-	0xec, 0xc6, 0x56, 0x60, 0x00, 0x46, 0x8b, 0x17, 0xca, 0xb4, 0x66, 0x18, 0x2d, 0x56, 0x22, 0xd1,
-	0x32, 0x7e, 0x4f, 0xa3, 0xfb, 0x2d, 0x3d, 0x97, 0xa8, 0x11, 0xcf, 0x0c, 0x7b, 0x4c, 0x94, 0x73,
-	0x6d, 0x64, 0x56, 0x49, 0xce, 0x4d, 0xfd, 0xf1, 0xcd, 0x86, 0xaf, 0x8c, 0x27, 0xa7, 0x8e, 0xc7,
-	0xf8, 0x90, 0x6e, 0xe9, 0xac, 0x45, 0x95, 0x67, 0x5e, 0x53, 0x67, 0xde, 0x38, 0x83, 0xe5, 0x23,
-	0xec, 0x3b, 0x27, 0x57, 0x5f, 0x83, 0x9f, 0x87, 0xe2, 0x6c, 0x90, 0x4f, 0x3a, 0x6c, 0x3c, 0x84,
-	0x95, 0x44, 0x4b, 0x71, 0x56, 0x04, 0x1a, 0x87, 0xc6, 0x8d, 0x3e, 0xec, 0xc3, 0xf8, 0xe9, 0x82,
-	0xb8, 0x19, 0x2b, 0xde, 0x79, 0xb7, 0xf0, 0xed, 0x94, 0x78, 0x99, 0x59, 0x99, 0x23, 0x5e, 0xde,
-	0x80, 0x22, 0xb5, 0xd1, 0xd3, 0x7d, 0xcb, 0x79, 0x91, 0x00, 0xe8, 0xb6, 0x8e, 0xdd, 0x8d, 0x66,
-	0x14, 0x77, 0xa3, 0xac, 0x14, 0x6a, 0xb3, 0x5f, 0x47, 0x0a, 0x35, 0xf4, 0x7d, 0x98, 0xa3, 0xd6,
-	0x01, 0xa2, 0xc1, 0x26, 0x13, 0x2b, 0xa5, 0xa7, 0x40, 0x64, 0x92, 0x63, 0x55, 0xd0, 0x0f, 0x61,
-	0x5e, 0xc4, 0x39, 0xcc, 0xa7, 0x32, 0xc9, 0x65, 0xd4, 0x16, 0x99, 0xe4, 0x78, 0xa5, 0xfa, 0x1f,
-	0xe7, 0x45, 0x02, 0xb7, 0xef, 0xc1, 0x7a, 0xe4, 0x8b, 0x38, 0x61, 0x8e, 0xd7, 0x04, 0x42, 0xc2,
-	0xe5, 0x01, 0x7d, 0x0c, 0xf5, 0xcc, 0xba, 0xb2, 0x57, 0x6d, 0x2d, 0xa3, 0x32, 0xf3, 0xa8, 0xfc,
-	0x54, 0x72, 0xb1, 0xad, 0x6c, 0xbf, 0x77, 0x83, 0xe1, 0x6f, 0x75, 0x7d, 0x8c, 0xe9, 0x6c, 0x32,
-	0x87, 0xdc, 0x3a, 0x14, 0x02, 0xc2, 0xb9, 0x6e, 0x2f, 0x8a, 0x68, 0x15, 0xdf, 0x74, 0x4b, 0xb1,
-	0x70, 0x1a, 0xc7, 0xe5, 0x52, 0xbc, 0xc0, 0x00, 0x2d, 0x37, 0xf5, 0x4e, 0xce, 0xdc, 0xd7, 0x94,
-	0x70, 0xcd, 0x7b, 0xc0, 0x3e, 0xf9, 0x60, 0x58, 0xb0, 0x2b, 0x7b, 0x7c, 0x6f, 0x89, 0x1c, 0x77,
-	0x11, 0x9b, 0x0b, 0x17, 0xce, 0x02, 0xe3, 0xc9, 0x08, 0xce, 0x5d, 0x93, 0x1f, 0xc1, 0x72, 0x12,
-	0xd5, 0xb2, 0x83, 0x21, 0xbd, 0x48, 0x14, 0x4d, 0x94, 0x40, 0x6f, 0x04, 0x43, 0xe3, 0x23, 0x28,
-	0x88, 0xb1, 0xaa, 0x79, 0xe3, 0x96, 0xe3, 0x00, 0xec, 0xff, 0x23, 0xfe, 0x69, 0xa8, 0x08, 0xb3,
-	0x9d, 0x6e, 0xe3, 0x79, 0x53, 0xd7, 0xea, 0xff, 0x6e, 0x46, 0x4e, 0x93, 0x77, 0x6e, 0x0f, 0xc6,
-	0x42, 0xd3, 0x62, 0x1f, 0x71, 0xf2, 0xbc, 0x5c, 0x22, 0x79, 0x9e, 0x1c, 0x2f, 0x22, 0x6d, 0x9b,
-	0x38, 0xd0, 0x64, 0x46, 0x09, 0x34, 0x21, 0xe7, 0x64, 0x3c, 0x14, 0x66, 0xa4, 0x28, 0x06, 0x62,
-	0x04, 0xe8, 0x7d, 0x58, 0x8a, 0xdc, 0x0f, 0xa3, 0x01, 0x06, 0x3c, 0xbd, 0x83, 0x48, 0x0f, 0xd3,
-	0x8f, 0x3c, 0x49, 0x03, 0xd4, 0x81, 0x12, 0xa7, 0xd7, 0x1b, 0xd8, 0xfc, 0xca, 0x5e, 0xd9, 0x7e,
-	0x74, 0x13, 0xbe, 0xde, 0x62, 0x13, 0xb7, 0x43, 0xea, 0x99, 0x0b, 0x41, 0xfc, 0x41, 0x84, 0x93,
-	0x2d, 0x1e, 0x54, 0x6b, 0x05, 0x6a, 0x8e, 0x8e, 0x01, 0xe8, 0x5d, 0xa8, 0xf6, 0xbc, 0xe1, 0xd0,
-	0x09, 0x87, 0xd8, 0x8d, 0x22, 0x37, 0x8a, 0x4c, 0x2d, 0x8d, 0x0b, 0x58, 0xe0, 0x86, 0xf1, 0x3f,
-	0x34, 0x58, 0x90, 0xda, 0x41, 0x3a, 0x94, 0xda, 0x07, 0x6d, 0xab, 0xd3, 0x6d, 0xb4, 0x77, 0x1b,
-	0xe6, 0x2e, 0x8b, 0x87, 0x3f, 0x7c, 0xf1, 0xd8, 0x7a, 0xde, 0xfc, 0x9c, 0xf9, 0xe0, 0xf2, 0x0f,
-	0xeb, 0x59, 0xa3, 0xf3, 0x4c, 0xcf, 0x51, 0x37, 0xdd, 0x1d, 0xb3, 0x75, 0xd8, 0x65, 0x80, 0x3c,
-	0x2a, 0x43, 0x71, 0xff, 0xc5, 0x5e, 0xb7, 0x65, 0x75, 0x5a, 0x4f, 0xf5, 0x19, 0xf2, 0xd9, 0x7e,
-	0xb1, 0xb7, 0x67, 0xed, 0x36, 0xba, 0x0d, 0x7d, 0x96, 0xba, 0xe7, 0x92, 0x35, 0xb5, 0x3a, 0x2f,
-	0x1e, 0xef, 0xb7, 0x3a, 0x9d, 0xd6, 0x41, 0x5b, 0x9f, 0x23, 0x48, 0x0c, 0xfa, 0xb4, 0xd9, 0xd6,
-	0xe7, 0x63, 0x24, 0x29, 0x3f, 0x60, 0x41, 0xa9, 0x6a, 0xed, 0x3c, 0x6b, 0xb4, 0x9f, 0x36, 0xf5,
-	0x22, 0x69, 0x5f, 0xf4, 0xa8, 0xb1, 0xd7, 0xd5, 0x81, 0xa0, 0xc9, 0x5d, 0xa4, 0xd0, 0x05, 0xa3,
-	0x0b, 0x1b, 0x6c, 0xa2, 0x4d, 0xfb, 0x22, 0xc3, 0x1f, 0xf7, 0x2b, 0x3a, 0xb4, 0x5b, 0x70, 0x27,
-	0x9b, 0xea, 0x4d, 0x73, 0xb6, 0xa4, 0x17, 0x5f, 0x71, 0x41, 0x37, 0xb6, 0x61, 0xf5, 0x88, 0xc7,
-	0x35, 0x67, 0xa4, 0xda, 0xca, 0x3c, 0xd4, 0x8c, 0x9f, 0xcd, 0xc2, 0x5a, 0xaa, 0x12, 0xef, 0xd0,
-	0x3a, 0x14, 0x9c, 0xc0, 0x92, 0x8f, 0xa8, 0x79, 0x27, 0xa0, 0xc8, 0xe4, 0x3a, 0xef, 0x04, 0xd6,
-	0xd0, 0x71, 0x45, 0xc6, 0xa1, 0x39, 0x27, 0xd8, 0x77, 0xdc, 0x2c, 0x07, 0xae, 0x7c, 0x96, 0x03,
-	0xd7, 0x26, 0x94, 0xb8, 0xdb, 0x0a, 0xbd, 0x4d, 0x70, 0xed, 0x03, 0x46, 0xd4, 0x67, 0x85, 0xf4,
-	0x83, 0xb4, 0x20, 0x1c, 0x5b, 0x58, 0x88, 0xf7, 0x1c, 0x2b, 0x24, 0x52, 0xcd, 0x09, 0x64, 0x87,
-	0xf1, 0x82, 0x59, 0x70, 0x02, 0x2e, 0x66, 0xde, 0x84, 0xc5, 0xc8, 0x9b, 0x9c, 0x52, 0x66, 0x87,
-	0x43, 0xd1, 0x2c, 0x8b, 0x28, 0x05, 0x42, 0x3c, 0x40, 0x6d, 0xe0, 0x7b, 0x84, 0x1d, 0x67, 0x85,
-	0xd4, 0x71, 0x36, 0x61, 0x4e, 0xf8, 0x36, 0xa3, 0x02, 0x98, 0xef, 0x7d, 0x2a, 0xa0, 0xde, 0x05,
-	0x34, 0xb2, 0xaf, 0xa8, 0xed, 0xa6, 0xdf, 0xf7, 0x45, 0xef, 0x8a, 0x4c, 0x16, 0x8e, 0xec, 0xab,
-	0xae, 0x47, 0x08, 0xf1, 0x4e, 0x3e, 0x80, 0x72, 0xe0, 0x9c, 0x06, 0x96, 0x90, 0x00, 0xd4, 0x54,
-	0x52, 0x36, 0x4b, 0x04, 0x68, 0x72, 0x18, 0xf5, 0xc6, 0x0f, 0xac, 0x28, 0x67, 0xe7, 0x02, 0xf3,
-	0xd6, 0x75, 0x82, 0x96, 0xc8, 0xda, 0x19, 0x09, 0xb1, 0x92, 0x24, 0xc4, 0x8c, 0x3f, 0xd1, 0x00,
-	0xe2, 0x3e, 0xa2, 0x2a, 0x94, 0xdb, 0x9e, 0xdb, 0x09, 0x6d, 0xb7, 0x6f, 0xfb, 0xfd, 0xee, 0x15,
-	0x4b, 0xbb, 0xc9, 0x5c, 0x83, 0xba, 0x57, 0x7c, 0x8f, 0xd2, 0x2f, 0xe6, 0x5b, 0xaf, 0xe7, 0xa8,
-	0xe7, 0x3c, 0x25, 0xc0, 0x21, 0x79, 0x54, 0x01, 0xd8, 0x1f, 0x0f, 0x42, 0xa7, 0xe3, 0x9c, 0x76,
-	0xaf, 0xf4, 0x19, 0xf2, 0xdd, 0x1e, 0x0f, 0x06, 0xbb, 0x76, 0x68, 0x77, 0xaf, 0xf4, 0x59, 0xb4,
-	0xc2, 0xf3, 0x3a, 0x74, 0xc6, 0xc7, 0xf4, 0x3d, 0x88, 0x9c, 0xee, 0xfa, 0x1c, 0x41, 0x13, 0xe9,
-	0x96, 0xba, 0x57, 0xfa, 0x7c, 0x84, 0x66, 0xe2, 0x73, 0x4f, 0xbc, 0x4a, 0xf1, 0x9d, 0xca, 0x6b,
-	0xb3, 0xb0, 0xc3, 0xee, 0x15, 0xdf, 0xa9, 0xe3, 0xe3, 0x57, 0xf8, 0xaa, 0x31, 0x08, 0xbb, 0x57,
-	0x3a, 0xa0, 0x25, 0x58, 0x64, 0x00, 0xd2, 0x2d, 0x06, 0x5c, 0x30, 0x3e, 0x80, 0xb5, 0x1d, 0x2a,
-	0xa4, 0x42, 0xdc, 0x4f, 0xf8, 0x0f, 0x4b, 0xce, 0xc8, 0x9a, 0xe2, 0x8c, 0x6c, 0x3c, 0x83, 0x7b,
-	0x4f, 0x23, 0x33, 0x47, 0x53, 0xf1, 0x96, 0xba, 0x5d, 0x86, 0x2c, 0xa3, 0x03, 0x9b, 0x93, 0x29,
-	0xf1, 0x4d, 0xf4, 0x3e, 0x2c, 0xdb, 0xbd, 0x9e, 0x35, 0xc1, 0x5b, 0xab, 0x6a, 0xf7, 0x7a, 0x6a,
-	0x45, 0xc3, 0xc9, 0x24, 0xea, 0x3b, 0xe7, 0xb7, 0xee, 0x5f, 0xc2, 0xde, 0x9d, 0x4b, 0xf9, 0x1f,
-	0x1f, 0xc1, 0xfd, 0x29, 0x4d, 0x45, 0x11, 0x85, 0x2b, 0xea, 0x00, 0x7c, 0xe7, 0x5c, 0x1a, 0x01,
-	0x92, 0x47, 0xc0, 0xaa, 0x1a, 0xff, 0x46, 0x83, 0x5a, 0x7a, 0x5d, 0x38, 0xbd, 0x2f, 0x60, 0x51,
-	0xf1, 0xa3, 0xc7, 0x59, 0x71, 0xf4, 0x93, 0x6a, 0xf3, 0x44, 0x2d, 0x62, 0x57, 0x26, 0x29, 0xd5,
-	0x1b, 0x22, 0x19, 0x4d, 0x23, 0x8e, 0x11, 0x95, 0x92, 0xd1, 0x94, 0xa2, 0x6c, 0x33, 0x93, 0x1d,
-	0x1c, 0x10, 0xe8, 0x8f, 0x71, 0x10, 0xca, 0x96, 0x05, 0xe3, 0x13, 0xa8, 0x4a, 0xb0, 0xf8, 0xd1,
-	0x56, 0x72, 0x06, 0x29, 0x47, 0x19, 0x58, 0x44, 0xb6, 0x96, 0x5c, 0x9c, 0xad, 0xc5, 0xf8, 0x0f,
-	0x1a, 0x2c, 0x75, 0x2e, 0x30, 0x1e, 0xa5, 0xd3, 0x53, 0x66, 0x84, 0x2c, 0x17, 0x93, 0x21, 0xcb,
-	0xef, 0xc3, 0x92, 0x14, 0x53, 0x6a, 0xa9, 0x3d, 0x47, 0x52, 0x51, 0x23, 0x0e, 0x01, 0x9a, 0x12,
-	0xb7, 0x5e, 0xbe, 0x59, 0x8c, 0xf3, 0x0c, 0xb3, 0xc7, 0x88, 0x18, 0x67, 0xe3, 0x7f, 0x6a, 0xb0,
-	0xac, 0x0e, 0xe2, 0x57, 0x3b, 0xe8, 0xf4, 0x9d, 0x7f, 0x98, 0x87, 0xe5, 0xac, 0xb7, 0x75, 0x04,
-	0x30, 0xd7, 0xf9, 0xbc, 0xbd, 0x43, 0x63, 0x73, 0x4b, 0x50, 0x78, 0xd1, 0xe6, 0x5f, 0x1a, 0x42,
-	0x50, 0x39, 0x6c, 0x36, 0x4d, 0x6b, 0xe7, 0xa0, 0xdd, 0x6e, 0xee, 0x74, 0x9b, 0xbb, 0x7a, 0x8e,
-	0x08, 0x3e, 0x0a, 0xdb, 0x6d, 0x75, 0x62, 0x70, 0x1e, 0xbd, 0x0e, 0x9b, 0x4f, 0x9a, 0xdd, 0x9d,
-	0x67, 0xcd, 0x5d, 0x8b, 0x2a, 0x37, 0xed, 0xa7, 0xd6, 0xce, 0x93, 0xd6, 0x5e, 0xb7, 0x69, 0x76,
-	0x88, 0x4a, 0x65, 0xb2, 0x8c, 0x41, 0x6f, 0xc0, 0xfd, 0x89, 0x58, 0x87, 0xe6, 0xc1, 0x53, 0xb3,
-	0xd9, 0xe9, 0xe8, 0xb3, 0x53, 0xd1, 0x9e, 0xb4, 0xda, 0xad, 0xce, 0x33, 0x9a, 0x66, 0x68, 0x03,
-	0xd6, 0x04, 0xda, 0xb3, 0x66, 0x63, 0x57, 0x6e, 0x6a, 0x1e, 0xdd, 0x81, 0x5a, 0xb2, 0x30, 0x6a,
-	0xa1, 0x90, 0x55, 0x1a, 0x11, 0x2e, 0xa2, 0xd7, 0xa0, 0x4e, 0x87, 0x77, 0xd4, 0x34, 0xad, 0xc6,
-	0xee, 0x2e, 0xa9, 0xd3, 0x8c, 0x69, 0x03, 0xba, 0x07, 0x1b, 0x19, 0xe5, 0x11, 0x81, 0x05, 0x32,
-	0x71, 0x66, 0xb3, 0xb3, 0xd3, 0x68, 0x47, 0x95, 0x4a, 0x44, 0xe6, 0x73, 0x58, 0xd4, 0x8f, 0xb2,
-	0x04, 0x8c, 0x6a, 0x57, 0xb6, 0xcd, 0x28, 0x6d, 0x7b, 0x07, 0xfb, 0xe7, 0x4e, 0x0f, 0xa3, 0x4f,
-	0x61, 0x9e, 0x43, 0xd0, 0xba, 0x7c, 0xae, 0x2b, 0xc9, 0xdd, 0xeb, 0xf5, 0xac, 0x22, 0xc6, 0xd5,
-	0xdb, 0x7f, 0x72, 0x07, 0xca, 0xcc, 0x51, 0x54, 0xd0, 0xfc, 0x2e, 0xcc, 0x1c, 0x3a, 0xee, 0x29,
-	0x5a, 0x95, 0x3d, 0x06, 0xe2, 0x6c, 0xcb, 0xf5, 0xb5, 0x14, 0x3c, 0x8a, 0x08, 0x98, 0xe7, 0x59,
-	0x93, 0x95, 0xce, 0xa8, 0xa9, 0x98, 0x95, 0xce, 0x24, 0x73, 0x32, 0xef, 0x40, 0x41, 0x64, 0x56,
-	0x46, 0x75, 0x45, 0x50, 0x2a, 0x19, 0x98, 0xeb, 0x1b, 0x99, 0x65, 0x9c, 0x88, 0x09, 0x65, 0x25,
-	0x65, 0x32, 0xba, 0x97, 0xce, 0x64, 0xac, 0xe4, 0x61, 0xae, 0x6f, 0x4e, 0x46, 0x88, 0x3b, 0x16,
-	0xb9, 0xca, 0xd4, 0x33, 0x13, 0x23, 0xa7, 0x3b, 0x96, 0xca, 0xb1, 0xfc, 0x29, 0xcc, 0x8b, 0x94,
-	0xc2, 0xf2, 0xfc, 0xa8, 0x79, 0x2b, 0x95, 0xf9, 0x49, 0x66, 0x98, 0x0c, 0xa0, 0x36, 0xe9, 0x28,
-	0x46, 0x89, 0xac, 0x66, 0xd3, 0x4e, 0xfe, 0xfa, 0xbb, 0x37, 0xc2, 0xe5, 0x8d, 0x9e, 0xc3, 0xfa,
-	0xc4, 0xf3, 0x13, 0x5d, 0x43, 0x49, 0x39, 0xd0, 0xeb, 0xef, 0xdd, 0x0c, 0x99, 0xb7, 0xfb, 0x02,
-	0x2a, 0x6a, 0x06, 0x40, 0xb4, 0x99, 0x48, 0x76, 0x96, 0xba, 0xb4, 0xd4, 0xef, 0x4f, 0xc1, 0xe0,
-	0x64, 0x7f, 0x0c, 0x8b, 0x89, 0xc4, 0x82, 0x68, 0x72, 0xad, 0x68, 0x61, 0x8d, 0x69, 0x28, 0x8c,
-	0xf2, 0x23, 0x0d, 0x3d, 0x85, 0x62, 0x94, 0x84, 0x0d, 0x6d, 0x64, 0xa5, 0x66, 0x13, 0xf4, 0xee,
-	0x4e, 0xcd, 0xdb, 0x86, 0x9e, 0x03, 0xc4, 0x50, 0x74, 0x67, 0x02, 0xf2, 0x4d, 0x48, 0x3d, 0xd2,
-	0xd0, 0x1e, 0x2c, 0x48, 0x89, 0xcf, 0x90, 0x12, 0x2f, 0x93, 0x4a, 0x93, 0x56, 0x7f, 0x6d, 0x52,
-	0x71, 0x94, 0x7d, 0xb1, 0x18, 0xe5, 0x37, 0x53, 0xc6, 0x98, 0x4c, 0x85, 0x56, 0xbf, 0x93, 0x5d,
-	0x18, 0xd3, 0x89, 0xb2, 0x6f, 0x29, 0x74, 0x92, 0xa9, 0xbe, 0x14, 0x3a, 0xe9, 0x84, 0x5d, 0x84,
-	0x8e, 0xd0, 0x5a, 0x54, 0x3a, 0x09, 0xfd, 0x46, 0xa5, 0x93, 0x52, 0x74, 0xc6, 0x4a, 0x30, 0x87,
-	0xe2, 0x11, 0xad, 0xec, 0xad, 0x6b, 0x82, 0xa7, 0x94, 0xbd, 0x75, 0x5d, 0x10, 0xd5, 0x23, 0x0d,
-	0x39, 0x71, 0x6e, 0x78, 0xa5, 0xc9, 0x37, 0x33, 0x64, 0x52, 0x56, 0x73, 0x6f, 0x5d, 0x8b, 0x17,
-	0x35, 0xf5, 0x13, 0x58, 0x9f, 0x18, 0xfc, 0xa2, 0x6c, 0xe4, 0xeb, 0x02, 0x79, 0x94, 0x8d, 0x7c,
-	0x6d, 0x3c, 0xcd, 0xdb, 0xda, 0x23, 0x0d, 0x7d, 0x01, 0x7a, 0x32, 0x27, 0x17, 0x32, 0xae, 0x4f,
-	0x21, 0x56, 0x7f, 0x30, 0x15, 0x27, 0x96, 0xf8, 0x4a, 0xf2, 0x72, 0x45, 0xe2, 0x67, 0x25, 0x4c,
-	0x57, 0x24, 0x7e, 0x66, 0xde, 0x73, 0xf4, 0x09, 0xcc, 0x31, 0x5f, 0x37, 0x54, 0x4b, 0xb9, 0xe2,
-	0x09, 0x2a, 0xeb, 0x19, 0x25, 0xf2, 0xae, 0x93, 0xb2, 0x89, 0x2b, 0xbb, 0x2e, 0x9d, 0xbe, 0x5c,
-	0xd9, 0x75, 0x59, 0x49, 0xc8, 0x05, 0x35, 0x91, 0xeb, 0x7a, 0x6a, 0xbe, 0xef, 0x34, 0xb5, 0x84,
-	0xcd, 0xe3, 0x0b, 0xd0, 0x93, 0x89, 0xa5, 0x95, 0xd5, 0x98, 0x90, 0x27, 0x5b, 0x59, 0x8d, 0x49,
-	0x99, 0xa9, 0xd1, 0x01, 0x94, 0xe4, 0x2c, 0xcf, 0xe8, 0xb5, 0x54, 0x25, 0x25, 0x63, 0x75, 0xfd,
-	0xde, 0xc4, 0x72, 0x4e, 0xf0, 0x25, 0x2c, 0x26, 0x32, 0x8e, 0x29, 0x12, 0x3b, 0x3b, 0x9d, 0x9b,
-	0x22, 0xb1, 0x27, 0xa5, 0x3b, 0x3b, 0x82, 0x8a, 0x9a, 0x50, 0x4b, 0x39, 0x62, 0x32, 0x73, 0x6d,
-	0xd5, 0x27, 0x62, 0x48, 0x6b, 0x7f, 0x0a, 0xcb, 0x59, 0xf9, 0x6b, 0x94, 0x4d, 0x3d, 0x25, 0xdb,
-	0x8e, 0xb2, 0xa9, 0xa7, 0x26, 0xc2, 0x79, 0x09, 0x8b, 0x89, 0x14, 0x10, 0xca, 0xd4, 0x64, 0xa7,
-	0x64, 0x51, 0xa6, 0x66, 0x52, 0x3a, 0x93, 0x2f, 0x40, 0x4f, 0x26, 0x97, 0x40, 0xc6, 0xf5, 0x69,
-	0x3d, 0x14, 0x16, 0x99, 0x98, 0xd8, 0xe2, 0x25, 0x2c, 0x26, 0x12, 0x0c, 0x28, 0xdd, 0xce, 0xce,
-	0xb1, 0xa0, 0x74, 0x7b, 0x52, 0x7e, 0x02, 0x1b, 0x50, 0x3a, 0x2d, 0x00, 0x92, 0x1f, 0x4d, 0x26,
-	0x66, 0x20, 0xa8, 0xbf, 0x71, 0x0d, 0x16, 0x6f, 0xe2, 0x2a, 0xca, 0x3c, 0x90, 0x91, 0x0b, 0x00,
-	0xbd, 0x97, 0x26, 0x32, 0x39, 0xaf, 0x40, 0xfd, 0xe1, 0x0d, 0xb1, 0xe3, 0x79, 0x4b, 0xc4, 0xae,
-	0x2b, 0xf3, 0x96, 0x9d, 0x7b, 0x40, 0x99, 0xb7, 0x49, 0xa1, 0xef, 0x54, 0x84, 0x4a, 0x81, 0xe6,
-	0x09, 0x11, 0x9a, 0x0e, 0x5d, 0x4f, 0x88, 0xd0, 0x8c, 0x18, 0x75, 0xf4, 0x9b, 0xb0, 0x92, 0x19,
-	0x83, 0x8e, 0x64, 0xf6, 0x9e, 0x16, 0xc5, 0x5e, 0x7f, 0xfb, 0x7a, 0xc4, 0x58, 0x3e, 0x4a, 0x11,
-	0xd4, 0x8a, 0x7c, 0x4c, 0x87, 0xb9, 0x2b, 0xf2, 0x31, 0x2b, 0x36, 0xfd, 0x00, 0x4a, 0x72, 0x3c,
-	0x36, 0x7a, 0x6d, 0x7a, 0x0c, 0xb9, 0x22, 0xc2, 0x32, 0x43, 0xbf, 0x5f, 0xc2, 0x62, 0xc2, 0xd6,
-	0xaa, 0x2c, 0x5c, 0xb6, 0x41, 0x5b, 0x59, 0xb8, 0x49, 0xe6, 0x6b, 0x72, 0xb0, 0x26, 0xcc, 0x48,
-	0xea, 0xc1, 0x9a, 0x6d, 0x39, 0x54, 0x0f, 0xd6, 0x49, 0x56, 0x2c, 0x32, 0x0f, 0x92, 0x29, 0x44,
-	0x9d, 0x87, 0xb4, 0xa1, 0x47, 0x9d, 0x87, 0x0c, 0x1b, 0xca, 0xf6, 0xef, 0xcc, 0x8a, 0x68, 0x45,
-	0xb2, 0x9c, 0xd8, 0x17, 0x77, 0xce, 0x03, 0x28, 0xc9, 0xd1, 0x8a, 0x4a, 0x43, 0x19, 0xd1, 0x8d,
-	0x4a, 0x43, 0x99, 0x61, 0x8e, 0x07, 0x50, 0x92, 0xa3, 0x4e, 0x15, 0x82, 0x19, 0x51, 0xb1, 0x0a,
-	0xc1, 0xac, 0x70, 0x55, 0x72, 0xf5, 0x9a, 0x14, 0x34, 0xaa, 0xa8, 0x87, 0xd7, 0xc4, 0xb4, 0x2a,
-	0xea, 0xe1, 0x75, 0x51, 0xa8, 0xa8, 0x05, 0x10, 0xc7, 0x90, 0x2a, 0xd7, 0x80, 0x54, 0x70, 0xaa,
-	0x72, 0x0d, 0xc8, 0x08, 0x3c, 0xdd, 0x83, 0x05, 0x29, 0x7a, 0x54, 0xd9, 0x20, 0xe9, 0x58, 0x53,
-	0x65, 0x83, 0x64, 0x04, 0x9d, 0xa2, 0xc7, 0x30, 0xcf, 0xa3, 0x3b, 0x94, 0xab, 0xac, 0x1a, 0x81,
-	0xa2, 0x5c, 0x65, 0x13, 0xc1, 0x20, 0x8f, 0x34, 0x42, 0x83, 0x07, 0x1c, 0x29, 0x34, 0xd4, 0xb0,
-	0x2b, 0x85, 0x46, 0x22, 0x3e, 0x89, 0x29, 0x59, 0x52, 0x44, 0x82, 0x32, 0xaa, 0x74, 0xfc, 0x82,
-	0x32, 0xaa, 0x8c, 0x40, 0x86, 0xed, 0x2f, 0x61, 0x49, 0xf6, 0xb5, 0x16, 0xcc, 0xf9, 0x63, 0x58,
-	0x4c, 0x78, 0x61, 0x2b, 0x9b, 0x37, 0xdb, 0xf5, 0x5c, 0xd9, 0xbc, 0x13, 0x9c, 0xb8, 0x1f, 0x69,
-	0xdb, 0x1e, 0x2c, 0x4b, 0xee, 0xbc, 0x47, 0xdb, 0xa2, 0xcd, 0xcf, 0xa0, 0xa2, 0x7a, 0xbb, 0x2b,
-	0x9a, 0x49, 0x66, 0x64, 0x40, 0xfd, 0xfe, 0x14, 0x8c, 0xa8, 0xc1, 0x7f, 0x5f, 0x10, 0xc9, 0xb1,
-	0x69, 0x89, 0x68, 0xef, 0x05, 0x54, 0x54, 0xf7, 0x65, 0xa5, 0xbd, 0x4c, 0x47, 0x73, 0xa5, 0xbd,
-	0x6c, 0xdf, 0x67, 0x72, 0xac, 0x28, 0x3e, 0xce, 0xca, 0xb1, 0x92, 0xe5, 0x15, 0x5d, 0xdf, 0x9c,
-	0x8c, 0xc0, 0x69, 0xfe, 0x06, 0x54, 0x53, 0x1e, 0xd0, 0xe8, 0x41, 0xea, 0xd6, 0x9a, 0x76, 0x9e,
-	0xae, 0xbf, 0x3e, 0x1d, 0x29, 0xde, 0x74, 0xb1, 0x83, 0xa8, 0xb2, 0xe9, 0x52, 0x6e, 0xa6, 0xca,
-	0xa6, 0x4b, 0x7b, 0x95, 0x12, 0x3d, 0x30, 0xcb, 0x0d, 0x54, 0xd1, 0x03, 0xa7, 0xb8, 0x9d, 0xd6,
-	0xdf, 0xba, 0x16, 0x4f, 0xba, 0x94, 0x0b, 0xb7, 0x50, 0xf5, 0x52, 0x9e, 0xf0, 0x32, 0xad, 0xdf,
-	0xc9, 0x2e, 0xe4, 0x74, 0xfa, 0xd4, 0x19, 0x31, 0xe9, 0xfd, 0x89, 0xde, 0x48, 0x55, 0xca, 0xf2,
-	0x34, 0xad, 0xbf, 0x79, 0x1d, 0x5a, 0x66, 0x2b, 0xb1, 0x37, 0x7f, 0x76, 0xf5, 0x84, 0x93, 0xe9,
-	0xa4, 0x56, 0x92, 0xfe, 0xa3, 0x54, 0x83, 0x4d, 0x78, 0x7f, 0xaa, 0x1a, 0x6c, 0xb6, 0x73, 0xa9,
-	0xaa, 0xc1, 0x4e, 0x70, 0x1f, 0xa5, 0xfb, 0x45, 0x71, 0x01, 0x55, 0xf7, 0x4b, 0x96, 0x67, 0xa9,
-	0xba, 0x5f, 0x32, 0xfd, 0x47, 0xa9, 0x1a, 0x13, 0x3b, 0x83, 0xa2, 0xbb, 0xe9, 0x1a, 0x92, 0x5f,
-	0xa9, 0xaa, 0xc6, 0xa4, 0x7d, 0x48, 0x79, 0x27, 0x25, 0x37, 0xd0, 0x64, 0x27, 0xd3, 0xee, 0xa5,
-	0xc9, 0x4e, 0x66, 0xf8, 0x90, 0x6e, 0xff, 0x4c, 0x23, 0xbd, 0xc4, 0x7d, 0x21, 0x3b, 0x6c, 0x40,
-	0xe9, 0xa8, 0x2c, 0x45, 0xe7, 0x9e, 0x18, 0xf2, 0xa5, 0xe8, 0xdc, 0x53, 0x42, 0xbb, 0x5a, 0x00,
-	0x71, 0x1c, 0x95, 0xb2, 0x27, 0x53, 0x21, 0x59, 0xf5, 0xbb, 0x13, 0x4a, 0x79, 0xef, 0x7f, 0x1d,
-	0xca, 0xcc, 0x33, 0x54, 0xb2, 0xa1, 0x73, 0x57, 0x51, 0xe5, 0x1c, 0x52, 0xdd, 0x64, 0x95, 0x73,
-	0x28, 0xe1, 0x59, 0xba, 0xfd, 0xaf, 0x34, 0x28, 0x33, 0x36, 0x11, 0x34, 0xf7, 0x60, 0x41, 0x72,
-	0xd5, 0x53, 0xd6, 0x31, 0xed, 0x2f, 0xa8, 0xac, 0x63, 0x96, 0x87, 0x1f, 0x5b, 0x47, 0x99, 0xe0,
-	0xe6, 0x75, 0x3e, 0x88, 0xc9, 0x75, 0xcc, 0x20, 0xbb, 0x3d, 0x82, 0x3a, 0x57, 0x54, 0xa9, 0xe7,
-	0x1e, 0xb7, 0xdb, 0x88, 0x21, 0x98, 0x50, 0x56, 0x1c, 0xfa, 0x14, 0xd1, 0x9d, 0xe5, 0x54, 0xa8,
-	0x88, 0xee, 0x4c, 0x5f, 0xc0, 0xed, 0xbf, 0x08, 0xcb, 0x6c, 0x45, 0x78, 0x81, 0x68, 0xeb, 0x54,
-	0xc0, 0x55, 0xa7, 0x11, 0x45, 0x4e, 0x4e, 0xf1, 0x55, 0x51, 0xe4, 0xe4, 0x34, 0xef, 0x93, 0xe3,
-	0x39, 0xfa, 0xa7, 0x70, 0x3f, 0xf8, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x2b, 0xc2, 0x4b,
-	0x17, 0x77, 0x00, 0x00,
+	// 8590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xed, 0x7d, 0x5b, 0x6c, 0x24, 0x49,
+	0x72, 0x98, 0x9a, 0xcd, 0x47, 0x77, 0xf0, 0xd5, 0x2c, 0x92, 0x43, 0x4e, 0xcf, 0xcc, 0xce, 0x4c,
+	0xed, 0xf3, 0x6e, 0x77, 0xe7, 0xf6, 0xb8, 0xab, 0xbb, 0xbd, 0xe7, 0x6e, 0x0f, 0xc9, 0x99, 0xe9,
+	0x1b, 0xb2, 0xc9, 0xab, 0xe6, 0xcc, 0xee, 0xde, 0xd9, 0x57, 0x68, 0x76, 0x17, 0xc9, 0xd2, 0xf4,
+	0xeb, 0xba, 0xba, 0x39, 0xe4, 0xd9, 0x86, 0x0e, 0x67, 0xd8, 0x7f, 0x07, 0x4b, 0x02, 0xf4, 0x21,
+	0xe8, 0x01, 0x41, 0x12, 0x24, 0x01, 0x82, 0x5e, 0xb0, 0x21, 0x08, 0x3a, 0xc3, 0xb0, 0x05, 0xfd,
+	0x18, 0x82, 0x21, 0xc8, 0x3f, 0xfa, 0xd0, 0x9f, 0x01, 0x7f, 0x19, 0xb0, 0x01, 0xff, 0xea, 0xc3,
+	0x8e, 0xc8, 0x8c, 0xac, 0xca, 0xac, 0x47, 0x93, 0x1c, 0xed, 0x01, 0xbe, 0x83, 0x07, 0x18, 0x4c,
+	0x57, 0x64, 0x64, 0xe4, 0x2b, 0x32, 0x32, 0x32, 0x32, 0x22, 0x06, 0x8a, 0x8d, 0xbe, 0x7f, 0xaf,
+	0x3f, 0xe8, 0x0d, 0x7b, 0x56, 0xf1, 0x79, 0xa3, 0xdd, 0xf6, 0x86, 0x83, 0x7e, 0xd3, 0x2e, 0xc1,
+	0xc2, 0x53, 0x6f, 0x10, 0xf8, 0xbd, 0xae, 0xe3, 0x7d, 0x77, 0xe4, 0x05, 0x43, 0xfb, 0x3f, 0xe5,
+	0x60, 0x31, 0x04, 0x05, 0xfd, 0x5e, 0x37, 0xf0, 0xac, 0x57, 0x61, 0xe1, 0x54, 0x82, 0xdc, 0x60,
+	0x38, 0xf0, 0xbb, 0xc7, 0xeb, 0xb9, 0x3b, 0xb9, 0x37, 0x8a, 0xce, 0x3c, 0x43, 0xeb, 0x02, 0x68,
+	0xad, 0xc0, 0x54, 0xa7, 0xf1, 0x73, 0xbd, 0xc1, 0xfa, 0x04, 0x96, 0xce, 0x3b, 0xf2, 0x43, 0x40,
+	0xfd, 0x2e, 0x42, 0xf3, 0x0c, 0xa5, 0x0f, 0x82, 0xf6, 0x1b, 0xc3, 0xe6, 0xc9, 0xfa, 0xa4, 0x84,
+	0x8a, 0x0f, 0xeb, 0x25, 0x80, 0xfe, 0xc0, 0x1b, 0x78, 0x6d, 0xaf, 0x11, 0x78, 0xeb, 0x53, 0xa2,
+	0x11, 0x0d, 0x42, 0x1d, 0x39, 0x1c, 0xf9, 0xed, 0x96, 0xdb, 0xf1, 0x86, 0x8d, 0x56, 0x63, 0xd8,
+	0x58, 0x9f, 0x96, 0x1d, 0x11, 0xd0, 0x5d, 0x06, 0xda, 0x7f, 0x33, 0x05, 0xd6, 0xc1, 0xa0, 0xd1,
+	0x0d, 0x1a, 0xcd, 0x21, 0x76, 0x6f, 0x0b, 0xe1, 0x7e, 0x3b, 0xb0, 0x2c, 0x98, 0x3c, 0x69, 0x04,
+	0x27, 0xa2, 0xf3, 0x73, 0x8e, 0xf8, 0x6d, 0xdd, 0x81, 0xd9, 0x61, 0x84, 0x29, 0x7a, 0x3e, 0xe7,
+	0xe8, 0x20, 0xeb, 0x2b, 0x30, 0xdd, 0xf2, 0x0e, 0xfd, 0x61, 0x80, 0x03, 0xc8, 0xbf, 0x31, 0xbb,
+	0xf1, 0xf2, 0xbd, 0x70, 0xfa, 0xee, 0x25, 0x1b, 0xb9, 0x57, 0xed, 0xf6, 0x47, 0x43, 0x87, 0xab,
+	0x58, 0x5f, 0x87, 0x99, 0xe6, 0xc0, 0x6b, 0x51, 0xed, 0x49, 0x51, 0xfb, 0x95, 0xf1, 0xb5, 0xf7,
+	0x46, 0x43, 0xaa, 0xae, 0x2a, 0x59, 0x25, 0xc8, 0x1f, 0x79, 0x72, 0x26, 0xf2, 0x0e, 0xfd, 0xb4,
+	0x6e, 0x42, 0x71, 0xe8, 0x77, 0x70, 0xa5, 0x1a, 0x9d, 0xbe, 0x18, 0x7d, 0xde, 0x89, 0x00, 0xd6,
+	0xc7, 0x50, 0xd2, 0xfa, 0xee, 0x0e, 0xcf, 0xfb, 0xde, 0xfa, 0x0c, 0x22, 0x2d, 0x6c, 0xbc, 0x3d,
+	0xbe, 0x61, 0x0d, 0x74, 0x80, 0x95, 0x9c, 0xc5, 0xa1, 0x09, 0x28, 0x7f, 0x17, 0xa6, 0xc4, 0xd0,
+	0x68, 0xe5, 0xfc, 0x6e, 0xcb, 0x3b, 0x13, 0xd3, 0x88, 0x2b, 0x27, 0x3e, 0xac, 0xcf, 0x40, 0x09,
+	0xd7, 0xe9, 0xd4, 0xef, 0x8d, 0x02, 0xb7, 0xd1, 0x6c, 0xf6, 0x46, 0xdd, 0x21, 0xb3, 0xc1, 0xa2,
+	0x82, 0x57, 0x24, 0xd8, 0x7a, 0x1d, 0x16, 0x23, 0xd4, 0x8e, 0xc0, 0xcc, 0x8b, 0x71, 0x2c, 0x84,
+	0x98, 0x02, 0x5a, 0xfe, 0x83, 0x1c, 0x4c, 0xcb, 0x09, 0xc9, 0x68, 0x74, 0x1d, 0x66, 0xcc, 0xb6,
+	0xd4, 0xa7, 0x55, 0x86, 0x82, 0xdf, 0x1d, 0x7a, 0x83, 0x6e, 0xa3, 0x2d, 0x88, 0x17, 0x9c, 0xf0,
+	0xdb, 0xba, 0x06, 0xd3, 0xdc, 0xec, 0xa4, 0x68, 0x96, 0xbf, 0x04, 0xb5, 0x56, 0x6b, 0xe0, 0x05,
+	0x01, 0x73, 0x9e, 0xfa, 0xb4, 0x5e, 0x86, 0xf9, 0x9e, 0xe8, 0x87, 0x1b, 0x34, 0x07, 0x7e, 0x7f,
+	0x28, 0xe6, 0x7d, 0xce, 0x99, 0x93, 0xc0, 0xba, 0x80, 0xd9, 0xdf, 0x86, 0xc5, 0xd8, 0x24, 0x5a,
+	0xb3, 0x30, 0xe3, 0x6c, 0x3f, 0x7c, 0xb2, 0x53, 0x71, 0x4a, 0x3f, 0x63, 0xcd, 0x41, 0x61, 0x73,
+	0xaf, 0x5a, 0xbb, 0x5f, 0xa9, 0x6f, 0x97, 0x26, 0xad, 0x65, 0xc4, 0xae, 0x6e, 0x3e, 0xde, 0x3e,
+	0x70, 0xf7, 0x9f, 0x38, 0x9b, 0x8f, 0x08, 0x98, 0xb3, 0x0a, 0x30, 0xf9, 0x74, 0xef, 0x60, 0xbb,
+	0x34, 0x61, 0x2d, 0x00, 0x38, 0xdb, 0x4f, 0xf7, 0x36, 0x2b, 0x07, 0xd5, 0xbd, 0x5a, 0x29, 0x6f,
+	0xff, 0x55, 0x0e, 0xe6, 0xee, 0xb7, 0x7b, 0xcd, 0x67, 0xe3, 0x78, 0x19, 0x07, 0x76, 0xe2, 0xf9,
+	0xc7, 0x27, 0x72, 0x36, 0xa6, 0x1c, 0xfe, 0x32, 0x59, 0x26, 0x1f, 0x67, 0x19, 0x5c, 0x8e, 0x46,
+	0x1f, 0x05, 0xc3, 0xa9, 0x17, 0xb8, 0xfd, 0xc6, 0xc0, 0xc3, 0x79, 0x99, 0x12, 0x33, 0xb6, 0xa0,
+	0xc0, 0xfb, 0x02, 0x6a, 0x55, 0x60, 0x4e, 0x63, 0x0a, 0xc5, 0xd0, 0xb7, 0xc6, 0xf2, 0x95, 0x63,
+	0x54, 0xb1, 0xf7, 0x60, 0x81, 0xb9, 0xe0, 0x7e, 0xa3, 0xdd, 0xe8, 0x36, 0x3d, 0x7d, 0x09, 0x73,
+	0xe6, 0x12, 0xe2, 0xa4, 0x0f, 0x7b, 0xc3, 0x46, 0xdb, 0x3d, 0x94, 0xa8, 0x62, 0x50, 0x79, 0x24,
+	0x48, 0x40, 0xae, 0x6e, 0xcf, 0xc3, 0xec, 0x3e, 0x8a, 0x1e, 0x25, 0xbc, 0x16, 0x60, 0x4e, 0x7e,
+	0x4a, 0xc1, 0x45, 0xe2, 0xad, 0xe6, 0x0d, 0x9f, 0xf7, 0x06, 0xcf, 0x14, 0xc6, 0xfb, 0xb0, 0x18,
+	0x42, 0x22, 0xe9, 0x46, 0xfd, 0x3b, 0xf5, 0xdc, 0xae, 0x2c, 0xe1, 0x9e, 0xcc, 0x4b, 0x28, 0xa3,
+	0xdb, 0x4b, 0xb0, 0xb8, 0xd9, 0xf3, 0xe5, 0xee, 0x60, 0x62, 0x9f, 0x83, 0x52, 0x04, 0x62, 0x6a,
+	0x37, 0xa0, 0xd8, 0x44, 0x98, 0xdc, 0x7a, 0x92, 0x50, 0xa1, 0xc9, 0x48, 0xf6, 0x97, 0x60, 0x85,
+	0xc7, 0x5f, 0x1b, 0x75, 0x0e, 0xbd, 0x01, 0x13, 0xb2, 0xee, 0xc2, 0x1c, 0x0f, 0xdb, 0xed, 0x36,
+	0x3a, 0x1e, 0x8b, 0xd7, 0x59, 0x86, 0xd5, 0x10, 0x64, 0x7f, 0x1d, 0x56, 0x63, 0x55, 0xf5, 0xee,
+	0x73, 0x5d, 0x51, 0x12, 0x75, 0x5f, 0x43, 0xa7, 0xee, 0x73, 0xfd, 0x40, 0x75, 0xff, 0xcf, 0xf3,
+	0x50, 0x8a, 0x60, 0x4c, 0xee, 0x03, 0x28, 0x70, 0xc5, 0x00, 0x09, 0xc5, 0x05, 0x5e, 0x1c, 0x5d,
+	0x01, 0x9c, 0xb0, 0x92, 0xf5, 0x16, 0x58, 0xcd, 0xd1, 0x80, 0x38, 0xc6, 0x3d, 0x24, 0x8e, 0x75,
+	0x05, 0x9f, 0x4a, 0xc1, 0x5a, 0xe2, 0x12, 0xc1, 0xca, 0x8f, 0x88, 0x67, 0xdf, 0x81, 0x95, 0x18,
+	0xb6, 0xe4, 0xe0, 0xbc, 0xe0, 0x60, 0xcb, 0xc0, 0x17, 0x25, 0xe5, 0x1f, 0x4c, 0xc0, 0x8c, 0x12,
+	0x25, 0x97, 0x1b, 0x7b, 0x62, 0x7a, 0x27, 0x12, 0xd3, 0x9b, 0xe4, 0xb6, 0x7c, 0x92, 0xdb, 0x68,
+	0x68, 0xde, 0x99, 0x94, 0x22, 0xee, 0x33, 0xef, 0xdc, 0x6d, 0x86, 0x52, 0x64, 0xde, 0x29, 0xa9,
+	0x92, 0xc7, 0xde, 0xf9, 0xa6, 0xe8, 0x1c, 0x62, 0x2b, 0x99, 0xa3, 0x61, 0x4f, 0x49, 0x6c, 0x55,
+	0x62, 0x60, 0x77, 0xfa, 0xbd, 0xc1, 0xd0, 0x6b, 0x69, 0xd8, 0xd3, 0x8c, 0xcd, 0x25, 0x0a, 0xdb,
+	0xfe, 0x18, 0x56, 0x1c, 0x8f, 0xc6, 0xa2, 0xe6, 0x9f, 0x19, 0xe9, 0x92, 0x13, 0x72, 0x1d, 0x0a,
+	0x5d, 0xef, 0xb9, 0x3e, 0x19, 0x33, 0xf8, 0x2d, 0xf8, 0x6c, 0x0d, 0x56, 0x63, 0x94, 0x79, 0x2f,
+	0x7d, 0x13, 0xe6, 0xf1, 0x77, 0xb3, 0xd1, 0xd5, 0x98, 0xf6, 0xd0, 0x3b, 0x46, 0x56, 0xe7, 0x25,
+	0xcb, 0x89, 0x25, 0x9b, 0x15, 0x30, 0xb9, 0x56, 0xd6, 0x2d, 0x00, 0x46, 0x89, 0x78, 0xa0, 0x28,
+	0x11, 0x10, 0x60, 0x7f, 0x0d, 0x16, 0x14, 0x49, 0xe6, 0xbe, 0x37, 0x61, 0x69, 0x20, 0x20, 0x5d,
+	0x9c, 0x86, 0xe1, 0xc9, 0xa0, 0x37, 0x3a, 0x3e, 0x61, 0xc2, 0xa5, 0xb0, 0xe0, 0x40, 0xc2, 0xed,
+	0x8f, 0xc0, 0xaa, 0xe1, 0xac, 0xc7, 0xa6, 0x80, 0x74, 0x88, 0x46, 0x10, 0xf4, 0x4f, 0x06, 0xa4,
+	0x43, 0x48, 0xf9, 0xa8, 0x41, 0x2e, 0xc1, 0x0c, 0xf6, 0x57, 0x61, 0xd9, 0x20, 0x7c, 0xb5, 0x9d,
+	0xf6, 0x5f, 0x26, 0xb8, 0x5f, 0xf2, 0xf4, 0x50, 0xfd, 0xca, 0x96, 0x74, 0x5f, 0x80, 0xc9, 0x67,
+	0x78, 0xa0, 0x89, 0x9e, 0x2c, 0x6c, 0xd8, 0xda, 0x76, 0x4b, 0x92, 0xb9, 0xf7, 0x18, 0x31, 0x1d,
+	0x81, 0x6f, 0x3d, 0x00, 0x38, 0x6e, 0xf4, 0xdd, 0x7e, 0xaf, 0xed, 0x37, 0xcf, 0x05, 0xc3, 0x2e,
+	0x6c, 0xbc, 0x3e, 0xbe, 0xf6, 0xc3, 0x46, 0x7f, 0x5f, 0xa0, 0x3b, 0xc5, 0x63, 0xf5, 0xd3, 0xde,
+	0x80, 0x49, 0xa2, 0x8a, 0x87, 0x6c, 0xe9, 0x7e, 0x75, 0xff, 0x9d, 0x77, 0xde, 0x7b, 0xcf, 0xdd,
+	0xfe, 0xf8, 0x60, 0xdb, 0xa9, 0x55, 0x76, 0xf0, 0xdc, 0xd2, 0xa0, 0xd5, 0x1a, 0x43, 0x73, 0xb6,
+	0x0f, 0xc5, 0x90, 0x16, 0x9e, 0xb6, 0xd7, 0x1e, 0x56, 0xf6, 0xdd, 0xfd, 0xbd, 0x9d, 0xea, 0xe6,
+	0x27, 0xee, 0x93, 0x5a, 0x7d, 0x7f, 0x7b, 0xb3, 0xfa, 0xa0, 0xba, 0xbd, 0x25, 0xab, 0x6b, 0x65,
+	0xdb, 0x8e, 0xb3, 0xe7, 0xe0, 0x49, 0xb7, 0x0a, 0x4b, 0x1a, 0xb4, 0xfa, 0xb0, 0xb6, 0xe7, 0xd0,
+	0xb1, 0x87, 0xa7, 0xa2, 0x06, 0xfe, 0xc8, 0xa9, 0xec, 0xe3, 0xd9, 0x57, 0xe3, 0xd5, 0x50, 0x23,
+	0xe1, 0xd5, 0xd0, 0x8e, 0xeb, 0x9c, 0x79, 0x5c, 0x23, 0xd7, 0xf5, 0x47, 0x87, 0xd8, 0x33, 0xda,
+	0x48, 0xbc, 0xbe, 0x45, 0x09, 0xc1, 0x0d, 0x64, 0xff, 0x49, 0x0e, 0xd6, 0xaa, 0x62, 0x43, 0xed,
+	0x0f, 0xfc, 0xd3, 0xc6, 0xd0, 0x43, 0xe0, 0x65, 0x99, 0x27, 0x5b, 0xe3, 0x78, 0x8d, 0xb4, 0x1a,
+	0x41, 0x4e, 0x6c, 0xdf, 0xe7, 0xfe, 0x91, 0x58, 0x11, 0xd4, 0x4d, 0xfb, 0x61, 0x2b, 0x1f, 0xf9,
+	0x47, 0x74, 0x48, 0x4b, 0x46, 0x16, 0x72, 0xa3, 0xe0, 0xf0, 0x17, 0x9d, 0x1b, 0xf4, 0xaf, 0x7b,
+	0x34, 0xe8, 0x75, 0x84, 0x90, 0x98, 0x72, 0x0a, 0x04, 0x78, 0x80, 0xdf, 0x76, 0x19, 0xd6, 0x93,
+	0x3d, 0xe6, 0x7d, 0xf9, 0xa7, 0x39, 0x58, 0x96, 0x85, 0x52, 0x11, 0xb9, 0xec, 0x50, 0xb0, 0x23,
+	0xac, 0xcd, 0xc8, 0x7d, 0xc9, 0x5f, 0x5a, 0x07, 0xf3, 0xd9, 0x1d, 0x9c, 0x34, 0x3b, 0x68, 0xbd,
+	0x0d, 0xd6, 0x00, 0xdb, 0xf5, 0x07, 0x9e, 0x8b, 0x9a, 0xab, 0xe7, 0x75, 0x1a, 0x87, 0x6d, 0x8f,
+	0xf5, 0x88, 0x25, 0x2e, 0x71, 0xc2, 0x02, 0xfb, 0x13, 0x58, 0x31, 0xbb, 0xcc, 0x6b, 0x8a, 0x7b,
+	0xb3, 0xbf, 0x11, 0x9c, 0xb8, 0xe6, 0xc2, 0xce, 0x12, 0x8c, 0x97, 0x9f, 0x86, 0xa5, 0xb5, 0x30,
+	0x21, 0x5a, 0xd0, 0x20, 0x76, 0x17, 0x16, 0x58, 0x5c, 0x5f, 0x51, 0x26, 0xfe, 0x2c, 0x5c, 0xe3,
+	0x8e, 0xb6, 0x50, 0xf8, 0x76, 0x8f, 0xfc, 0x41, 0xa7, 0x21, 0x15, 0x1d, 0xa9, 0x4d, 0xad, 0xaa,
+	0xd2, 0x4d, 0xbd, 0xd0, 0xfe, 0xed, 0x09, 0x58, 0x0c, 0x1b, 0xe4, 0x61, 0xa0, 0xb6, 0x2a, 0xce,
+	0x0d, 0xd1, 0x50, 0xde, 0x91, 0x1f, 0xa4, 0x86, 0x05, 0x7d, 0xaf, 0xdb, 0x0a, 0x3b, 0x8e, 0x6a,
+	0x58, 0x08, 0x20, 0x35, 0xcc, 0xef, 0x20, 0xd1, 0x91, 0x98, 0xc2, 0xe7, 0x8d, 0x41, 0x4b, 0x69,
+	0xc5, 0x0a, 0xec, 0x08, 0xa8, 0xf5, 0x65, 0xb8, 0x1e, 0x22, 0xa2, 0x06, 0xf7, 0xcc, 0x73, 0x8f,
+	0xbd, 0xae, 0x37, 0x10, 0xdd, 0x61, 0x8d, 0x76, 0x4d, 0x21, 0xd4, 0xa9, 0xfc, 0x61, 0x58, 0x6c,
+	0x7d, 0x16, 0x96, 0xe8, 0x24, 0xc5, 0x11, 0x1e, 0x9e, 0xbb, 0x43, 0x1f, 0x7f, 0x0d, 0x03, 0xbe,
+	0x5c, 0x2c, 0xca, 0x82, 0xfb, 0xe7, 0x07, 0x12, 0x4c, 0x1a, 0xfd, 0x69, 0x6f, 0x88, 0xda, 0x94,
+	0xdb, 0x18, 0x0d, 0x4f, 0x7a, 0x03, 0x7f, 0x78, 0xce, 0xf7, 0x8d, 0x45, 0x09, 0xaf, 0x28, 0x30,
+	0x5d, 0xa2, 0x46, 0x5d, 0x9e, 0x33, 0xaf, 0x25, 0x2e, 0x1c, 0x79, 0x47, 0x07, 0xd9, 0xf7, 0x61,
+	0xf5, 0xa1, 0x37, 0xd4, 0xf4, 0x43, 0xb5, 0x38, 0x9f, 0x31, 0x2f, 0x2c, 0x9a, 0x4e, 0xab, 0xdf,
+	0x40, 0xc4, 0x69, 0xf1, 0x1b, 0x39, 0x14, 0x33, 0x31, 0x22, 0xa1, 0xd2, 0x62, 0xdc, 0xe2, 0x88,
+	0xc0, 0x85, 0x9a, 0xa9, 0x71, 0xc9, 0x7b, 0x05, 0xe6, 0xd3, 0xd6, 0xdc, 0x04, 0x8a, 0xe3, 0x2c,
+	0x52, 0x69, 0xf2, 0x7c, 0x9c, 0x29, 0x5d, 0xc6, 0xfe, 0xaf, 0x13, 0xf1, 0x0e, 0x86, 0xc2, 0xff,
+	0x1e, 0x2c, 0xe3, 0x5a, 0x0d, 0xc4, 0x74, 0x6a, 0x24, 0xe4, 0x48, 0x97, 0x54, 0x51, 0xa4, 0x16,
+	0x6d, 0xc0, 0x6a, 0x1c, 0x3f, 0xd2, 0xec, 0x97, 0x9c, 0x65, 0xb3, 0x86, 0x3c, 0x6c, 0x71, 0x71,
+	0x91, 0x99, 0x62, 0x2d, 0xc8, 0x4e, 0x2e, 0xca, 0x82, 0x88, 0x3e, 0xf6, 0xc7, 0xc4, 0x95, 0xd4,
+	0xe5, 0xb6, 0x5e, 0xd2, 0xb1, 0x25, 0xed, 0xaf, 0xc3, 0x0d, 0xbc, 0xb7, 0xfb, 0x9d, 0x51, 0x07,
+	0x99, 0xb3, 0x49, 0xda, 0x9a, 0x71, 0x15, 0x90, 0xf2, 0xea, 0x3a, 0xa3, 0x38, 0x02, 0x43, 0x9f,
+	0x06, 0xeb, 0x7d, 0x58, 0xc7, 0x1e, 0x1f, 0x7b, 0x46, 0x3d, 0x4d, 0xc7, 0x99, 0x72, 0xae, 0xc9,
+	0x72, 0xad, 0x96, 0xd4, 0x74, 0xfe, 0x2d, 0x4a, 0xeb, 0xc4, 0xa4, 0xf2, 0xb2, 0x3f, 0x00, 0x0b,
+	0x9b, 0x24, 0x4d, 0x41, 0xef, 0x8c, 0x5c, 0xfd, 0x35, 0x6d, 0xf5, 0xf5, 0x9b, 0x93, 0xb3, 0x24,
+	0xaa, 0x18, 0xbd, 0xdb, 0x87, 0x95, 0x51, 0x37, 0x85, 0xd2, 0xc4, 0x65, 0x6e, 0x38, 0xcb, 0x5c,
+	0x55, 0xa7, 0x68, 0xbf, 0x8b, 0xa7, 0x1e, 0x76, 0x5a, 0x6c, 0x25, 0xc5, 0x03, 0xb7, 0x91, 0x49,
+	0x05, 0x40, 0x5f, 0x7b, 0x90, 0x20, 0xc1, 0x3f, 0xff, 0x72, 0x02, 0x4f, 0x45, 0x55, 0xeb, 0xa7,
+	0x86, 0x75, 0x10, 0x5f, 0x2d, 0xbd, 0x1c, 0x7d, 0xa4, 0x07, 0x23, 0x3e, 0xaf, 0xba, 0x28, 0x91,
+	0x0b, 0xfe, 0x9f, 0x27, 0xc1, 0xd2, 0x67, 0x81, 0xd7, 0x7a, 0x13, 0xa6, 0x65, 0x7d, 0x5e, 0xdf,
+	0x37, 0xb5, 0x55, 0x49, 0xa2, 0xdf, 0x93, 0xdf, 0x6a, 0x8d, 0xb8, 0xaa, 0xf5, 0x21, 0x4c, 0x89,
+	0x4e, 0x8b, 0xb9, 0x98, 0xdd, 0xf8, 0xec, 0x78, 0x1a, 0x06, 0xdb, 0xc8, 0x8a, 0xe5, 0xbf, 0x9d,
+	0x80, 0x79, 0x83, 0x36, 0x9e, 0x1b, 0x66, 0xc7, 0x2e, 0x60, 0x17, 0xd5, 0x95, 0x2f, 0xc2, 0x8c,
+	0x10, 0xfe, 0xde, 0x80, 0x3b, 0x73, 0x41, 0x3d, 0x85, 0x6d, 0xfd, 0x53, 0xbc, 0xa9, 0xc8, 0x89,
+	0xc4, 0x95, 0x1c, 0x8e, 0x02, 0x56, 0xfc, 0xde, 0xbf, 0xc2, 0x7c, 0xf0, 0x57, 0x5d, 0xd4, 0xc7,
+	0x3b, 0x8e, 0xf6, 0x65, 0x7f, 0x17, 0xe6, 0xf4, 0x52, 0xb2, 0x61, 0x3c, 0xa9, 0x3d, 0xae, 0xed,
+	0x7d, 0x54, 0x43, 0x65, 0x4e, 0x7c, 0xec, 0x56, 0x6b, 0xa8, 0xd9, 0xe5, 0xc8, 0xa0, 0x51, 0xdd,
+	0xdd, 0xad, 0x1c, 0x3c, 0x11, 0xaa, 0x5b, 0x01, 0x26, 0x77, 0xaa, 0x4f, 0xb7, 0x4b, 0x79, 0xab,
+	0x08, 0x53, 0x64, 0xc5, 0xd8, 0x2a, 0x4d, 0x5a, 0x00, 0xd3, 0xbb, 0xd5, 0x7a, 0x1d, 0x7f, 0x4f,
+	0x51, 0xdd, 0xed, 0x8f, 0xf7, 0xab, 0x0e, 0x7e, 0x4c, 0x4b, 0xcb, 0xc8, 0xd3, 0xbd, 0xc7, 0xf8,
+	0x31, 0x53, 0xfe, 0xf8, 0xc7, 0x65, 0xdb, 0xb0, 0x57, 0xc0, 0x92, 0x83, 0x41, 0xbd, 0x29, 0x54,
+	0x08, 0xec, 0x7d, 0x58, 0x36, 0xa0, 0x91, 0xf2, 0xc1, 0x13, 0xdb, 0x27, 0x38, 0x1f, 0xde, 0xbc,
+	0x67, 0x05, 0x6a, 0x56, 0x2f, 0x6c, 0x0b, 0x4a, 0xe2, 0xa8, 0xad, 0x76, 0x8f, 0x7a, 0xaa, 0x15,
+	0xe4, 0x94, 0x25, 0x0d, 0x18, 0x99, 0x07, 0xfa, 0xbd, 0x5e, 0xdb, 0x0d, 0xfc, 0xef, 0x85, 0xe6,
+	0x01, 0x02, 0xd4, 0xf1, 0x9b, 0x74, 0x48, 0x5c, 0x43, 0xb7, 0xe3, 0x75, 0x04, 0xce, 0xd0, 0x3f,
+	0x63, 0x2d, 0x73, 0x1e, 0xc1, 0xbb, 0x12, 0x7a, 0xe0, 0x9f, 0x11, 0x5e, 0xef, 0x79, 0xd7, 0xc0,
+	0x93, 0xc6, 0xd5, 0x79, 0x04, 0x6b, 0x78, 0x64, 0x05, 0x63, 0x4d, 0x80, 0x6f, 0xa9, 0xe1, 0x37,
+	0x4d, 0x72, 0xdb, 0x3f, 0xf5, 0xf8, 0x3e, 0x2a, 0x7e, 0x93, 0xde, 0x82, 0x47, 0x3b, 0x9e, 0xe0,
+	0xf2, 0xda, 0x29, 0x3f, 0x68, 0xd0, 0x1d, 0x3f, 0x08, 0xf8, 0x60, 0x9f, 0x77, 0xf8, 0x8b, 0x74,
+	0xe1, 0x81, 0x77, 0xda, 0x43, 0xa5, 0x61, 0xbd, 0x20, 0x75, 0x61, 0xfe, 0xa4, 0x12, 0xef, 0xac,
+	0x4f, 0xba, 0xd2, 0x7a, 0x51, 0x96, 0xf0, 0x67, 0x74, 0xcd, 0x0e, 0x46, 0x87, 0x81, 0xdf, 0x3a,
+	0x5f, 0x07, 0xed, 0x9a, 0x5d, 0x97, 0x30, 0xaa, 0x3e, 0xea, 0x12, 0xbb, 0x0f, 0xd7, 0x67, 0x65,
+	0x75, 0xfe, 0xb4, 0x0f, 0xf0, 0x2e, 0x42, 0x9c, 0xa2, 0xcd, 0x73, 0xec, 0x50, 0xce, 0xc5, 0x0e,
+	0x65, 0x71, 0x4b, 0x8d, 0x4b, 0x41, 0xba, 0xa5, 0x46, 0x12, 0xca, 0xfe, 0x25, 0x5c, 0x29, 0x8d,
+	0x2c, 0xaf, 0xd4, 0x3f, 0x9a, 0x6e, 0x52, 0xa9, 0xc8, 0xa7, 0x29, 0x15, 0x06, 0x07, 0x4f, 0xc6,
+	0xad, 0x73, 0x5a, 0x33, 0x0d, 0x92, 0x15, 0x53, 0xd2, 0x40, 0xcd, 0xcd, 0x10, 0x88, 0xee, 0xcc,
+	0x52, 0x0f, 0xf4, 0xbb, 0xa7, 0x8d, 0xb6, 0xdf, 0x6a, 0xa8, 0x15, 0x2c, 0x38, 0xa5, 0x40, 0x32,
+	0x60, 0x08, 0x4f, 0xb3, 0xf6, 0xcd, 0xa4, 0x59, 0xfb, 0xe8, 0x1d, 0x60, 0x6d, 0xf3, 0xa4, 0xd1,
+	0x3d, 0xf6, 0xf6, 0xc3, 0x3b, 0x83, 0x9a, 0xf2, 0xf7, 0x21, 0x4f, 0x37, 0xab, 0x9c, 0x10, 0x3c,
+	0xaf, 0x69, 0x82, 0x27, 0xa3, 0xc2, 0x3d, 0xba, 0xaf, 0x50, 0x15, 0xd2, 0xc5, 0x7b, 0xed, 0x96,
+	0xab, 0x5d, 0x4c, 0xe4, 0xe5, 0x63, 0x1e, 0xa1, 0x51, 0x35, 0x42, 0x23, 0xfb, 0x84, 0x86, 0x26,
+	0x0f, 0xa3, 0x79, 0x84, 0x46, 0x68, 0xf6, 0x4b, 0x90, 0x47, 0xca, 0x24, 0x4c, 0xf6, 0x9d, 0xea,
+	0xd3, 0xca, 0xc1, 0x36, 0x8a, 0x28, 0x14, 0x39, 0xfb, 0x4f, 0xee, 0xe3, 0xfd, 0x11, 0x2f, 0xa9,
+	0x78, 0x6d, 0x4a, 0xf6, 0x88, 0xaf, 0x4d, 0xdf, 0x47, 0x65, 0xed, 0xc1, 0xa8, 0xdb, 0x4a, 0xd1,
+	0x49, 0xc7, 0xdb, 0x24, 0xe5, 0x59, 0xc6, 0x16, 0x64, 0x65, 0x93, 0x14, 0x40, 0x69, 0xb6, 0x1e,
+	0x73, 0x91, 0xc8, 0x8f, 0xb9, 0x48, 0x58, 0x5f, 0x85, 0xb2, 0xdf, 0x6d, 0xb6, 0x47, 0x2d, 0x5c,
+	0x48, 0xa5, 0xdf, 0x93, 0xe1, 0xf0, 0x10, 0x7b, 0x1d, 0xf0, 0x65, 0x71, 0x9d, 0x31, 0xaa, 0x8c,
+	0xb0, 0xa9, 0xca, 0xe9, 0xd4, 0x57, 0xb5, 0x9b, 0x62, 0xc8, 0xca, 0x54, 0x2d, 0xef, 0x60, 0xcb,
+	0x5c, 0x28, 0xa7, 0x83, 0x2d, 0xd6, 0x7f, 0x96, 0x87, 0xb5, 0xc4, 0x14, 0x30, 0xf7, 0xff, 0x13,
+	0x28, 0x05, 0x5e, 0xdb, 0x6b, 0x92, 0x39, 0x4a, 0x9a, 0xb9, 0x95, 0x39, 0xf0, 0xf3, 0xda, 0x7a,
+	0x67, 0xd4, 0xbe, 0xb7, 0xcf, 0x86, 0x7c, 0x7e, 0xce, 0x58, 0x54, 0xa4, 0xe4, 0x77, 0x20, 0x44,
+	0xad, 0x10, 0x03, 0xc6, 0x34, 0xce, 0x0a, 0x18, 0xcf, 0xe2, 0x1b, 0x50, 0xe2, 0x81, 0xf4, 0x9f,
+	0xa9, 0xb1, 0x48, 0x26, 0x58, 0x90, 0xf0, 0xfd, 0x67, 0x72, 0x18, 0xe5, 0xff, 0x95, 0x83, 0x05,
+	0xb3, 0xc1, 0x2b, 0xdc, 0x2a, 0xa8, 0x2b, 0x6c, 0xdb, 0x97, 0x0f, 0x0c, 0x52, 0xe0, 0xce, 0x4a,
+	0x58, 0x55, 0x3c, 0x33, 0x44, 0x0f, 0x06, 0x79, 0xe3, 0xc1, 0x80, 0x64, 0x79, 0xd8, 0xb7, 0x49,
+	0x41, 0xbe, 0xd0, 0xe7, 0x5e, 0x11, 0x5d, 0xd2, 0x94, 0xc9, 0xac, 0x4c, 0xbb, 0x99, 0x6f, 0x59,
+	0xb3, 0x0c, 0x3b, 0xf0, 0xa5, 0xcd, 0x91, 0x2e, 0xd3, 0xe1, 0x2a, 0xf3, 0xa6, 0x9d, 0x23, 0xa0,
+	0x5a, 0x59, 0x92, 0xd3, 0xc3, 0x81, 0x27, 0x5f, 0x71, 0xa6, 0x1c, 0xf1, 0xdb, 0xfe, 0xeb, 0x1c,
+	0xac, 0x3e, 0x91, 0x22, 0x91, 0x67, 0xf4, 0x27, 0x98, 0x75, 0xed, 0x5f, 0x9e, 0x88, 0x8d, 0x26,
+	0x64, 0xc2, 0x9f, 0xee, 0x65, 0xa4, 0x13, 0x46, 0x76, 0x01, 0x4f, 0xc3, 0x8e, 0x38, 0x43, 0x51,
+	0xf4, 0x4b, 0x48, 0x7d, 0xd4, 0xb1, 0xbf, 0x3f, 0x0d, 0x37, 0x70, 0x9e, 0x83, 0xe1, 0x60, 0xd4,
+	0x4c, 0xbb, 0x3a, 0xa3, 0x90, 0x0c, 0x7a, 0xa3, 0x41, 0xd3, 0x73, 0xcd, 0x25, 0x9f, 0x97, 0x50,
+	0x65, 0x23, 0x7f, 0x31, 0xbb, 0x06, 0x1e, 0x4b, 0x70, 0xe4, 0xe1, 0xe6, 0xf3, 0x06, 0xee, 0xb3,
+	0x43, 0x5e, 0xfe, 0x02, 0x42, 0xf6, 0xbd, 0xc1, 0xe3, 0x43, 0xeb, 0x5f, 0x40, 0x59, 0xbd, 0x88,
+	0x89, 0xad, 0x4d, 0xcb, 0xd3, 0x68, 0x1f, 0x93, 0x39, 0xe0, 0x44, 0x5a, 0x87, 0x16, 0x36, 0x3e,
+	0xd0, 0x0f, 0x86, 0xec, 0x71, 0xf0, 0x9b, 0x67, 0x5d, 0xd1, 0xa9, 0x28, 0x32, 0xce, 0x7a, 0x2f,
+	0xa3, 0xc4, 0xfa, 0x36, 0x58, 0x5d, 0xba, 0x3f, 0x4a, 0x01, 0xa1, 0xe4, 0xd3, 0x94, 0x90, 0x4f,
+	0x6f, 0x5f, 0xa9, 0x59, 0xa7, 0x84, 0x84, 0xa4, 0x54, 0x54, 0xc2, 0xe9, 0x18, 0x2c, 0x26, 0xdc,
+	0x42, 0x3c, 0xbf, 0x2b, 0x2d, 0x2b, 0xd3, 0x42, 0x49, 0x7f, 0xff, 0x4a, 0xc4, 0xb7, 0xa2, 0xfa,
+	0xce, 0x92, 0xa4, 0xa9, 0x81, 0xca, 0x6d, 0x58, 0x4a, 0xe0, 0x8d, 0x31, 0x6b, 0x66, 0x19, 0xec,
+	0x88, 0x0f, 0xc4, 0x2f, 0x97, 0x9f, 0xe3, 0x95, 0x32, 0x28, 0xa1, 0xfc, 0x98, 0x5f, 0xfe, 0xe7,
+	0xe1, 0x63, 0xea, 0xb7, 0x60, 0x56, 0x1f, 0x59, 0xee, 0x1f, 0x39, 0x32, 0x9d, 0x98, 0xb6, 0xc9,
+	0x26, 0xf4, 0x4d, 0x66, 0xbf, 0x07, 0xeb, 0x59, 0xeb, 0x6c, 0x2d, 0xc2, 0xac, 0x69, 0x33, 0x9e,
+	0x81, 0x7c, 0x65, 0x87, 0xac, 0xcc, 0xbf, 0x32, 0x01, 0x37, 0xd3, 0x3b, 0xc3, 0x12, 0xe2, 0xf3,
+	0x74, 0x73, 0x0f, 0xfc, 0xe3, 0xd8, 0xd5, 0x9d, 0xa5, 0xc4, 0xb2, 0x2a, 0xd3, 0xaa, 0x5a, 0x1f,
+	0xc0, 0x4d, 0x79, 0xf6, 0x84, 0x8f, 0xd0, 0xcc, 0xc9, 0x46, 0xbf, 0xaf, 0x0b, 0x1c, 0xf3, 0x58,
+	0x61, 0x21, 0x49, 0x17, 0x5a, 0x41, 0xc0, 0xac, 0x27, 0x85, 0xca, 0x92, 0x28, 0x32, 0xf0, 0xf1,
+	0x68, 0xa6, 0x09, 0xea, 0x90, 0xfe, 0xe5, 0x72, 0x5f, 0x85, 0xfa, 0x2f, 0x55, 0xf2, 0xe5, 0xb0,
+	0xb0, 0x2e, 0xca, 0xc4, 0x4d, 0x00, 0xc5, 0x0e, 0xf3, 0xa0, 0x14, 0x67, 0xf2, 0xb6, 0x3c, 0x2b,
+	0x61, 0x42, 0x9c, 0xd9, 0xff, 0x80, 0x0a, 0x0c, 0xd5, 0x48, 0x91, 0x0c, 0x17, 0x99, 0x7e, 0x51,
+	0x24, 0x04, 0xde, 0xc0, 0x47, 0xa5, 0xf0, 0x7b, 0xb1, 0x79, 0x93, 0x9c, 0xb5, 0x1a, 0x95, 0xea,
+	0x33, 0xd7, 0x00, 0x0b, 0x79, 0xd1, 0xa7, 0xdf, 0xa4, 0xc1, 0x0b, 0xee, 0x52, 0xcf, 0xc0, 0x1b,
+	0x1a, 0xfb, 0xa4, 0xf7, 0xea, 0x5e, 0x25, 0xac, 0xcb, 0x56, 0xdf, 0xa5, 0x46, 0x0c, 0x12, 0x94,
+	0x7f, 0x31, 0x07, 0xa5, 0x38, 0xde, 0xa7, 0x7c, 0x0c, 0x28, 0x49, 0x9c, 0xd7, 0x24, 0xf1, 0xb8,
+	0x23, 0xe0, 0x1b, 0x93, 0x85, 0x7c, 0x69, 0xd2, 0x99, 0xf7, 0xbb, 0x21, 0x59, 0x8f, 0xae, 0xc9,
+	0x6b, 0x89, 0x61, 0x32, 0x4f, 0xde, 0x49, 0x1a, 0x23, 0x63, 0x2e, 0x25, 0xef, 0xc1, 0xb5, 0x90,
+	0x6b, 0x0d, 0xb2, 0xc2, 0xe2, 0x34, 0xef, 0x84, 0x3c, 0x2d, 0x3c, 0x2e, 0xaa, 0xdc, 0xe4, 0xdf,
+	0xe7, 0x13, 0x6d, 0x06, 0x97, 0x5d, 0xf1, 0x6f, 0xc5, 0xde, 0xee, 0xa5, 0x65, 0xeb, 0x0b, 0xd9,
+	0x8b, 0x16, 0xbe, 0x18, 0x3d, 0x49, 0x6e, 0x21, 0xf3, 0x51, 0xdf, 0x3a, 0x4c, 0x65, 0x0b, 0xe9,
+	0x2c, 0xf3, 0xee, 0x25, 0x5a, 0xf8, 0x09, 0xe5, 0x8b, 0xf2, 0x0e, 0x2c, 0xa7, 0x4c, 0xce, 0x98,
+	0xcd, 0x95, 0x1b, 0xb3, 0xb9, 0xec, 0xff, 0x96, 0x83, 0xf5, 0xe4, 0x0c, 0x31, 0x4b, 0x7d, 0x12,
+	0x5b, 0x3e, 0xa9, 0x89, 0xff, 0xec, 0xd8, 0xc9, 0x65, 0x55, 0xbc, 0x3e, 0x7e, 0xf5, 0xca, 0xcf,
+	0x60, 0x29, 0x81, 0xf2, 0x63, 0x63, 0xe1, 0x3f, 0xcc, 0xc3, 0xb5, 0xcd, 0x81, 0x87, 0xc2, 0x8e,
+	0xda, 0xe4, 0x57, 0x8d, 0xcb, 0xbf, 0xbc, 0xf1, 0xb9, 0x38, 0x61, 0x9e, 0x8b, 0xd9, 0x13, 0x9e,
+	0x1f, 0x27, 0xcd, 0x6e, 0xc3, 0xac, 0xd6, 0x71, 0x16, 0xc6, 0xe0, 0x87, 0xdd, 0xb5, 0xbe, 0x01,
+	0x45, 0x62, 0x29, 0xe9, 0xc9, 0x31, 0x95, 0x70, 0xa2, 0x4a, 0x1f, 0x07, 0xcd, 0x37, 0x71, 0x9c,
+	0xf0, 0x09, 0x29, 0x9c, 0xf0, 0x2f, 0x7a, 0xdd, 0x0f, 0x8f, 0x9b, 0x88, 0xa3, 0xa4, 0x1b, 0x51,
+	0xe8, 0x38, 0xa5, 0x6e, 0x34, 0xf6, 0xbf, 0xce, 0xc1, 0xac, 0x46, 0x87, 0x0e, 0xc8, 0x7a, 0xf5,
+	0xe1, 0xa3, 0x4a, 0xfd, 0x91, 0xbb, 0xb7, 0x43, 0x07, 0xa4, 0x06, 0x10, 0x07, 0xa5, 0x55, 0x82,
+	0x39, 0x05, 0xa8, 0xed, 0xd5, 0xc8, 0x1e, 0x67, 0xc1, 0x82, 0x82, 0xd4, 0xab, 0xb5, 0x87, 0x3b,
+	0x64, 0x99, 0x5b, 0x81, 0x92, 0x56, 0xed, 0x69, 0x65, 0xe7, 0x09, 0xb9, 0x22, 0x5d, 0x87, 0x95,
+	0x10, 0x5a, 0xfb, 0x04, 0x6b, 0x6f, 0x56, 0x6a, 0xfb, 0x95, 0x4f, 0x4a, 0xdf, 0xcf, 0xd9, 0x4f,
+	0x61, 0x2d, 0x31, 0x4c, 0x66, 0x49, 0x7a, 0xcd, 0x52, 0x40, 0x65, 0x1d, 0x09, 0x01, 0x29, 0x4f,
+	0xb0, 0x73, 0xfa, 0x13, 0xec, 0x37, 0xe0, 0xfa, 0x3e, 0x7d, 0xe0, 0xf8, 0x92, 0xa7, 0xd7, 0xdb,
+	0x60, 0x65, 0x9e, 0xe8, 0x4b, 0x89, 0xfd, 0x66, 0x3f, 0x84, 0x72, 0x1a, 0xad, 0x2b, 0x5f, 0x21,
+	0xec, 0x97, 0xe1, 0x2e, 0x13, 0x7a, 0x92, 0xb4, 0xe8, 0x2b, 0xab, 0xde, 0x2b, 0x60, 0x8f, 0x43,
+	0x62, 0xe3, 0xc2, 0x6f, 0x21, 0x9f, 0xef, 0xa3, 0x12, 0x8e, 0xcd, 0x79, 0x31, 0x73, 0xfe, 0x8b,
+	0xbf, 0x30, 0x23, 0xc3, 0x0a, 0x1b, 0xb0, 0xdb, 0xf6, 0x3b, 0xbe, 0xd2, 0x37, 0x40, 0x80, 0x76,
+	0x08, 0x32, 0x46, 0xd3, 0x97, 0xcc, 0x9d, 0xa1, 0xe9, 0xa3, 0xfe, 0xc8, 0x76, 0x4f, 0xd3, 0xfd,
+	0x8d, 0xcd, 0xcc, 0xea, 0xe1, 0x15, 0x9b, 0xef, 0x8e, 0x3a, 0xe1, 0xab, 0xa1, 0x34, 0x11, 0x02,
+	0x82, 0xd4, 0x83, 0x21, 0x3d, 0xde, 0x92, 0x39, 0x52, 0x51, 0x99, 0xe1, 0xc7, 0x5b, 0x84, 0x29,
+	0x1a, 0xca, 0xfa, 0x89, 0xf7, 0x88, 0x40, 0x5c, 0x78, 0x72, 0xd2, 0xfa, 0xf9, 0x00, 0xbf, 0x49,
+	0x75, 0x14, 0x66, 0xc2, 0x73, 0x36, 0x1a, 0xf2, 0x97, 0xb5, 0x0a, 0xd3, 0xc3, 0x33, 0xaa, 0xc2,
+	0xc6, 0xc2, 0xa9, 0xe1, 0xd9, 0x03, 0x79, 0x7b, 0xe2, 0x6e, 0x53, 0xd1, 0xac, 0x32, 0x9c, 0x11,
+	0x84, 0x8a, 0xef, 0xc0, 0x5c, 0xab, 0x47, 0x57, 0x2b, 0x64, 0x18, 0x77, 0x78, 0xb6, 0x3e, 0x27,
+	0x5f, 0x8a, 0x09, 0x26, 0x64, 0xe5, 0x99, 0x1d, 0xc0, 0x5a, 0x62, 0x8d, 0x98, 0x6b, 0x5e, 0x0e,
+	0x6d, 0xec, 0xc4, 0x30, 0x9e, 0x14, 0xb8, 0x73, 0xca, 0x52, 0xfe, 0x48, 0xc0, 0x68, 0xa5, 0xd4,
+	0x64, 0x4c, 0x88, 0x62, 0xf5, 0x49, 0xee, 0x35, 0x41, 0xbf, 0xed, 0x0f, 0xa9, 0x5d, 0x29, 0x83,
+	0x66, 0xc4, 0x37, 0x36, 0xfa, 0x05, 0x72, 0xdc, 0x21, 0x2b, 0xe9, 0xd5, 0xd8, 0x42, 0xba, 0xe5,
+	0x18, 0xf5, 0x98, 0xd5, 0x5e, 0x82, 0x9b, 0x3b, 0xbd, 0x46, 0xab, 0x22, 0x7c, 0xd5, 0xb6, 0x1a,
+	0xc3, 0xc6, 0x03, 0xbf, 0x3d, 0x44, 0x95, 0x5f, 0x31, 0xec, 0x6d, 0xb8, 0x95, 0x51, 0xce, 0x04,
+	0x4e, 0xc0, 0xa2, 0x09, 0xd9, 0xc5, 0xf5, 0x69, 0x1c, 0x7b, 0xba, 0x21, 0x21, 0xfd, 0x1a, 0x82,
+	0x25, 0x1d, 0x89, 0xab, 0x04, 0x31, 0x7f, 0xc6, 0xc6, 0x90, 0x4f, 0x8c, 0xe1, 0x5d, 0x58, 0x36,
+	0x5a, 0xba, 0x8c, 0x24, 0xb1, 0xff, 0x3c, 0x67, 0xd4, 0xba, 0xf4, 0x3e, 0xba, 0x0f, 0x05, 0xee,
+	0x97, 0xd2, 0x76, 0x5e, 0x8b, 0x1d, 0x97, 0x31, 0x8a, 0xf7, 0x54, 0xbf, 0xc2, 0x7a, 0xe5, 0xaf,
+	0xc1, 0x0c, 0x03, 0x5f, 0x64, 0x3e, 0xec, 0x5f, 0xcb, 0xa1, 0x64, 0x35, 0x1a, 0x0a, 0xdf, 0xb2,
+	0x66, 0x06, 0x1e, 0x32, 0x84, 0xa7, 0x4e, 0xf2, 0xcf, 0x64, 0x76, 0x4d, 0x3b, 0xc5, 0x1d, 0xac,
+	0x72, 0xee, 0xa8, 0x9a, 0xe5, 0x0f, 0xa0, 0x18, 0x42, 0x2f, 0x90, 0xc6, 0x2b, 0x30, 0xe5, 0x0d,
+	0x06, 0xec, 0x98, 0x5d, 0x74, 0xe4, 0x87, 0x7d, 0x17, 0x6e, 0x6b, 0xc2, 0xab, 0xd6, 0x1b, 0xfa,
+	0x47, 0x7e, 0xb3, 0x61, 0x48, 0xbb, 0x5f, 0x9f, 0x80, 0x3b, 0xd9, 0x38, 0x3c, 0x9a, 0x0f, 0x61,
+	0xb1, 0x31, 0x1c, 0x36, 0x9a, 0x27, 0xe4, 0x56, 0x40, 0x76, 0x69, 0x35, 0xaa, 0xcc, 0x27, 0xd8,
+	0x05, 0x85, 0x2f, 0xa0, 0x01, 0x19, 0xa5, 0x5b, 0x9e, 0x49, 0x41, 0xee, 0xa8, 0x05, 0x05, 0x66,
+	0xc4, 0xac, 0x87, 0xda, 0xfc, 0x8b, 0x3e, 0xd4, 0x92, 0xe9, 0x2a, 0x85, 0xa2, 0xda, 0xf6, 0x93,
+	0xa2, 0x17, 0xeb, 0xc9, 0x8a, 0x52, 0x04, 0xd8, 0xb7, 0xe0, 0x86, 0x72, 0xca, 0x4c, 0x9b, 0xbe,
+	0xff, 0x9d, 0x83, 0x9b, 0xe9, 0xe5, 0x57, 0xf2, 0x28, 0xbb, 0x8c, 0xff, 0x62, 0xba, 0x6b, 0x62,
+	0xfe, 0x4a, 0xae, 0x89, 0x93, 0x57, 0x72, 0x4d, 0x9c, 0xca, 0x70, 0x4d, 0xfc, 0x0e, 0xdc, 0xd1,
+	0xcf, 0x97, 0xb4, 0x89, 0xa1, 0x73, 0x00, 0x45, 0xba, 0x21, 0x5b, 0x0b, 0xc3, 0x33, 0x96, 0xab,
+	0x28, 0xd8, 0x83, 0x61, 0xaf, 0xef, 0x36, 0x8e, 0x86, 0xfc, 0x38, 0x3a, 0x85, 0xcc, 0x8c, 0x90,
+	0x0a, 0x01, 0xec, 0x3f, 0x9a, 0x80, 0xbb, 0x63, 0x1a, 0xe0, 0x99, 0x7d, 0x16, 0x7f, 0x7b, 0x91,
+	0x2c, 0xb9, 0x6d, 0x5a, 0x39, 0xc6, 0x13, 0xb9, 0x67, 0x38, 0x23, 0x68, 0xc4, 0x62, 0x4f, 0x38,
+	0xe5, 0x5f, 0x45, 0xdd, 0x3d, 0x0b, 0xd7, 0x5a, 0xc3, 0x63, 0xe2, 0x4c, 0x57, 0x3c, 0xa6, 0xe5,
+	0x48, 0x3f, 0x15, 0x9f, 0x93, 0xc4, 0x33, 0xd4, 0x64, 0xf2, 0x79, 0xeb, 0x5f, 0xa1, 0x04, 0x95,
+	0x5a, 0xdc, 0x47, 0x62, 0xec, 0x6a, 0x11, 0xde, 0x84, 0x25, 0xd6, 0xd1, 0x12, 0x82, 0xb4, 0x24,
+	0x0b, 0xb4, 0x17, 0x99, 0xb7, 0x49, 0x81, 0x95, 0xee, 0x6d, 0x89, 0xc7, 0x9b, 0x25, 0x2e, 0xd1,
+	0xd0, 0xf1, 0x32, 0x15, 0x78, 0x5e, 0x8b, 0xfb, 0x2b, 0x7e, 0xdb, 0xd7, 0x60, 0xc5, 0xec, 0x06,
+	0x1f, 0x40, 0x67, 0x70, 0x5b, 0xc1, 0x87, 0xcd, 0x13, 0xbf, 0x7b, 0xbc, 0xd7, 0x6d, 0x9f, 0x9b,
+	0x5d, 0x7d, 0x03, 0x04, 0x0f, 0xa3, 0x5a, 0xde, 0x72, 0xb1, 0x6b, 0xae, 0x7a, 0x7d, 0x2a, 0x3a,
+	0x0b, 0x0a, 0x8e, 0x5a, 0x19, 0xbd, 0x05, 0xa5, 0x0e, 0x6a, 0x22, 0x7d, 0x50, 0xb6, 0x8d, 0xac,
+	0x9a, 0xd9, 0x32, 0xf7, 0xee, 0x43, 0x58, 0xda, 0x43, 0x15, 0xeb, 0xc5, 0xa7, 0xce, 0xfe, 0x12,
+	0x58, 0x3a, 0x85, 0x48, 0xc5, 0x78, 0xce, 0xad, 0xba, 0x3d, 0x6c, 0x56, 0x54, 0x2f, 0x38, 0x73,
+	0xcf, 0xb5, 0xae, 0xd0, 0xfb, 0xf5, 0x66, 0xbb, 0x17, 0x98, 0x0b, 0x67, 0xaf, 0xe2, 0x7a, 0xea,
+	0x50, 0xee, 0x29, 0x82, 0x25, 0x64, 0xfb, 0xcc, 0x0f, 0x22, 0x2f, 0xef, 0x7b, 0xb0, 0x62, 0x82,
+	0xb9, 0x03, 0x42, 0xdd, 0x22, 0x08, 0xb7, 0xcc, 0x5f, 0xf6, 0xaf, 0xd3, 0x45, 0x94, 0x7c, 0x44,
+	0xc8, 0xf0, 0xe6, 0x75, 0x83, 0x51, 0xe0, 0xf4, 0x9b, 0x6a, 0xe0, 0x28, 0xa9, 0xd9, 0x49, 0x3e,
+	0xe6, 0xa3, 0xb7, 0xc0, 0x60, 0xa5, 0xe9, 0x95, 0xa1, 0x30, 0x0a, 0x48, 0x8c, 0x84, 0xe2, 0x2a,
+	0xfc, 0xa6, 0x32, 0x9a, 0x36, 0x44, 0x57, 0x0c, 0x12, 0x7e, 0xd3, 0xcd, 0xb3, 0xe9, 0x0d, 0x78,
+	0x33, 0x7a, 0x7c, 0xe7, 0xd6, 0x41, 0xf6, 0x0d, 0xb8, 0x9e, 0xd2, 0x3d, 0x9e, 0x83, 0xdf, 0xc5,
+	0xce, 0x6f, 0xf9, 0x41, 0xb3, 0x77, 0xea, 0x0d, 0xb8, 0x2b, 0x91, 0xca, 0x80, 0xab, 0xd6, 0xe2,
+	0x32, 0x57, 0xf3, 0x71, 0x17, 0x0f, 0xa5, 0xaa, 0x40, 0x39, 0xb8, 0x5f, 0x95, 0xe1, 0x33, 0xbc,
+	0x74, 0xf2, 0x19, 0x5e, 0x3a, 0x34, 0x8a, 0x94, 0x7e, 0xf2, 0x28, 0xf0, 0x58, 0x79, 0xe0, 0x21,
+	0x1b, 0xec, 0xfa, 0x41, 0x80, 0xb5, 0x36, 0x63, 0x2a, 0x1d, 0xaa, 0x7c, 0xe9, 0xc5, 0x5c, 0xfd,
+	0x35, 0x78, 0x85, 0x9e, 0xd2, 0xf1, 0x2e, 0x79, 0xe8, 0x1d, 0xf4, 0x44, 0x9b, 0xa9, 0xc7, 0xd3,
+	0xeb, 0xf0, 0xea, 0x05, 0x78, 0x11, 0x67, 0x89, 0x06, 0xe5, 0x83, 0x73, 0x58, 0xff, 0xf7, 0x27,
+	0x60, 0xc5, 0x84, 0x33, 0x6b, 0x6d, 0xc0, 0xea, 0x11, 0xc1, 0x71, 0xb7, 0xca, 0x67, 0xeb, 0xc0,
+	0xd5, 0x1f, 0x28, 0x96, 0xb9, 0x90, 0xab, 0xc9, 0x43, 0xe6, 0x73, 0xb0, 0x82, 0x52, 0x2f, 0xc0,
+	0x13, 0xce, 0x7b, 0x9e, 0x0c, 0x1c, 0x58, 0x12, 0x65, 0x35, 0xef, 0x79, 0xe4, 0x87, 0xf4, 0x2e,
+	0x5c, 0x4b, 0x54, 0xd0, 0x63, 0x07, 0x96, 0xcd, 0x2a, 0xf2, 0x49, 0xfe, 0x7d, 0xb8, 0xde, 0x69,
+	0xf8, 0xe2, 0xe5, 0x80, 0x62, 0x34, 0xfc, 0xbe, 0xde, 0x94, 0x64, 0xb6, 0x55, 0x42, 0xd8, 0xa4,
+	0xf2, 0x03, 0xbf, 0x1f, 0x35, 0xf7, 0x55, 0xb8, 0x91, 0x5e, 0x53, 0xb6, 0x29, 0x0d, 0xb4, 0x6b,
+	0xc9, 0xba, 0x52, 0x06, 0x9f, 0xc1, 0xba, 0x3e, 0x53, 0xfa, 0x34, 0x8f, 0x9f, 0xad, 0xa9, 0xf4,
+	0xd9, 0x42, 0x81, 0xd8, 0x6e, 0xe0, 0xd8, 0x65, 0x05, 0xf9, 0x34, 0x25, 0x0d, 0xd7, 0x0b, 0x04,
+	0x97, 0xb8, 0xf4, 0x3a, 0x65, 0xff, 0x4e, 0x0e, 0xee, 0xa4, 0x71, 0x8b, 0xd1, 0x85, 0x0a, 0xdc,
+	0x52, 0x5d, 0x68, 0x1e, 0xc9, 0x72, 0x57, 0xf0, 0xac, 0xe9, 0xdb, 0x5f, 0x66, 0xa4, 0x4d, 0xc6,
+	0x11, 0xfb, 0x90, 0x67, 0xf6, 0x6b, 0x70, 0x23, 0x41, 0x82, 0x2e, 0xab, 0x86, 0x7b, 0xc4, 0x7a,
+	0x8c, 0xc0, 0x76, 0xb7, 0xc5, 0x13, 0x54, 0x85, 0xb2, 0x0c, 0x05, 0xd8, 0x1f, 0xf4, 0x8e, 0x69,
+	0x3b, 0x18, 0xfd, 0xbb, 0x52, 0x58, 0xc0, 0x63, 0x28, 0xed, 0x7b, 0xde, 0xc0, 0x20, 0x40, 0xf6,
+	0x08, 0x84, 0x19, 0x13, 0x5b, 0x24, 0xc8, 0x66, 0x3c, 0xf4, 0xcb, 0x34, 0x2e, 0xd9, 0xf4, 0xb8,
+	0x8c, 0x02, 0xa6, 0x7e, 0xde, 0xfd, 0x7f, 0x48, 0x06, 0xa6, 0x4b, 0xb2, 0xa9, 0x2b, 0x49, 0xb2,
+	0xe9, 0x0c, 0x49, 0x66, 0xff, 0xfb, 0x3c, 0x2c, 0x86, 0x23, 0x8e, 0xce, 0x8a, 0x00, 0xbf, 0xf1,
+	0x40, 0xe7, 0xb3, 0x42, 0x7e, 0x59, 0x3b, 0xb0, 0xd4, 0xd5, 0xa6, 0x59, 0x9a, 0xca, 0x64, 0x18,
+	0xc3, 0x6d, 0xfd, 0x4a, 0x83, 0xd8, 0xfa, 0x72, 0x08, 0xe3, 0x58, 0xa9, 0x1b, 0x83, 0x58, 0x8f,
+	0x60, 0x5e, 0xf0, 0x87, 0xda, 0x06, 0x62, 0x62, 0xcc, 0xf8, 0xa3, 0xac, 0x4d, 0xe4, 0xcc, 0x1d,
+	0x69, 0x25, 0x56, 0x03, 0x65, 0x83, 0xa0, 0xd4, 0x91, 0x4c, 0x1f, 0xb2, 0xa4, 0x98, 0x4c, 0xd3,
+	0x79, 0xf0, 0xa2, 0xcd, 0xe1, 0xac, 0x1c, 0xe9, 0x18, 0x4c, 0xc8, 0xaa, 0xc1, 0xa2, 0xe4, 0x3c,
+	0xb7, 0xcf, 0x1c, 0x2b, 0x16, 0x60, 0x76, 0xe3, 0x55, 0x8d, 0x76, 0x36, 0x4b, 0x3b, 0x0b, 0x03,
+	0xa3, 0xcc, 0x7a, 0x00, 0x25, 0xc1, 0xa1, 0x7e, 0xf7, 0xa8, 0xc7, 0xca, 0x1f, 0xbf, 0x39, 0xde,
+	0xd0, 0x08, 0xc6, 0x19, 0xdb, 0x59, 0xa4, 0x4a, 0xd5, 0xa8, 0x8e, 0xfd, 0x43, 0x64, 0xd8, 0x7a,
+	0xff, 0x54, 0x67, 0xd8, 0x1f, 0xe7, 0xb9, 0x27, 0x8c, 0x52, 0xa7, 0x64, 0x6e, 0xea, 0x7a, 0xcd,
+	0xa1, 0xb8, 0x88, 0x15, 0xc9, 0x28, 0x75, 0xba, 0x29, 0x21, 0x82, 0x9d, 0xc2, 0xfe, 0xfc, 0x7f,
+	0x76, 0xfa, 0x49, 0x63, 0x27, 0xd4, 0x40, 0xb9, 0xd5, 0x9e, 0x1f, 0xc6, 0x58, 0xd9, 0x15, 0x58,
+	0x36, 0xa0, 0xbc, 0xae, 0x9f, 0x55, 0x62, 0xda, 0xed, 0x13, 0xdc, 0xb0, 0xb6, 0x0e, 0x22, 0x7c,
+	0xa1, 0x00, 0x7d, 0x15, 0xae, 0x73, 0x60, 0x82, 0xe7, 0x34, 0xba, 0xad, 0x5e, 0xa7, 0x8e, 0x77,
+	0x04, 0xcd, 0x55, 0x9a, 0xae, 0x0c, 0x6e, 0xdb, 0xeb, 0x1e, 0x0f, 0x4f, 0x58, 0x6d, 0x00, 0x02,
+	0xed, 0x08, 0x88, 0xfd, 0xcf, 0xa0, 0x9c, 0x56, 0x3b, 0x72, 0xdd, 0x13, 0xd5, 0x0f, 0xcf, 0x87,
+	0x5e, 0x10, 0x9a, 0x43, 0x3c, 0x8a, 0x6e, 0x40, 0x80, 0x30, 0xcf, 0x79, 0xe2, 0xb4, 0x3d, 0x53,
+	0xe2, 0x9e, 0xbe, 0x1f, 0x79, 0x67, 0xa4, 0x95, 0x8b, 0xa2, 0x4e, 0xd7, 0xeb, 0xf4, 0xba, 0x7e,
+	0x93, 0x63, 0x78, 0xe6, 0x08, 0xb8, 0xcb, 0x30, 0x7b, 0x03, 0x96, 0xb6, 0xbc, 0x66, 0xaf, 0xe5,
+	0xe9, 0x5d, 0xc6, 0x36, 0x49, 0xb8, 0xcb, 0xc7, 0x10, 0x3e, 0x10, 0x8a, 0x04, 0x11, 0x0f, 0x20,
+	0xf6, 0x17, 0xc1, 0xd2, 0xeb, 0x44, 0x2e, 0xa7, 0x2d, 0x01, 0x6d, 0xb9, 0xe2, 0xba, 0xc4, 0x0f,
+	0x2d, 0x0c, 0x23, 0x54, 0xfb, 0xdf, 0x4c, 0xc0, 0xaa, 0x33, 0xea, 0x4a, 0xb3, 0xdf, 0xfd, 0xd1,
+	0x79, 0x14, 0x34, 0xfa, 0xe2, 0x96, 0x64, 0x8a, 0xe7, 0xe7, 0xd0, 0x8e, 0xa6, 0x6e, 0x28, 0x98,
+	0xe7, 0xc0, 0x0e, 0x46, 0x43, 0x9d, 0x96, 0xa3, 0x21, 0xdd, 0x61, 0xcf, 0x25, 0xd5, 0x66, 0x88,
+	0x7f, 0xd9, 0x47, 0x71, 0x89, 0x8b, 0x0e, 0x7a, 0xbb, 0x5c, 0xa0, 0x93, 0x35, 0x0d, 0xc9, 0x4c,
+	0x96, 0x8f, 0xc5, 0xb8, 0x9d, 0x78, 0xfa, 0x02, 0x3b, 0xf1, 0x8c, 0x69, 0x27, 0xb6, 0xd7, 0xe1,
+	0x5a, 0x7c, 0x42, 0x58, 0x4f, 0xfd, 0xbb, 0x9c, 0x28, 0xe2, 0xfe, 0xef, 0xfa, 0x67, 0x97, 0x9f,
+	0x2c, 0x5c, 0xf8, 0x0e, 0xe2, 0xb7, 0x62, 0xc1, 0xeb, 0x73, 0x02, 0xa8, 0x26, 0xe4, 0x1d, 0x58,
+	0x31, 0x90, 0xdc, 0x43, 0xbc, 0xf4, 0x37, 0x4f, 0x78, 0xf6, 0x2c, 0x1d, 0xf7, 0xbe, 0x28, 0xa1,
+	0x29, 0xe1, 0x77, 0x7c, 0x45, 0x57, 0x1a, 0x59, 0xe6, 0x25, 0xb4, 0x12, 0x99, 0xf6, 0x9b, 0x41,
+	0xbf, 0x8f, 0x4c, 0x30, 0x38, 0x65, 0x27, 0x4f, 0x94, 0xa2, 0x04, 0xaa, 0x0b, 0x88, 0x7d, 0x1d,
+	0xd6, 0x12, 0x03, 0xe3, 0x41, 0xff, 0x30, 0x0f, 0xab, 0x42, 0x11, 0xab, 0x8c, 0x86, 0xbd, 0x4f,
+	0x89, 0x41, 0x32, 0x56, 0x3e, 0x9f, 0xb5, 0xf2, 0x2f, 0xc3, 0x42, 0xa7, 0x21, 0x6c, 0xf4, 0xca,
+	0x61, 0x48, 0x32, 0xc9, 0x2c, 0x42, 0x1f, 0x28, 0x9f, 0xa1, 0xb7, 0xc0, 0x22, 0x24, 0xe1, 0x5c,
+	0xed, 0x0e, 0xbc, 0x76, 0x63, 0xa8, 0xfc, 0x8f, 0x73, 0x4e, 0x09, 0x4b, 0xd8, 0x1b, 0x5b, 0xc2,
+	0x4d, 0xec, 0xc6, 0x61, 0xd0, 0x6b, 0x8f, 0x86, 0x1e, 0x07, 0x20, 0x85, 0xd8, 0x15, 0x86, 0xa7,
+	0xb0, 0xde, 0xcc, 0x65, 0x58, 0xaf, 0x70, 0x01, 0xeb, 0x15, 0x63, 0x4f, 0x14, 0x36, 0x72, 0x09,
+	0x75, 0x0a, 0xc7, 0x28, 0xe3, 0x08, 0x20, 0x1c, 0x26, 0x8e, 0x51, 0x28, 0xfc, 0xc4, 0x9e, 0xf1,
+	0xe5, 0xe0, 0x95, 0xba, 0x06, 0x2b, 0x75, 0x32, 0x63, 0xc5, 0xd6, 0x89, 0x6c, 0xfb, 0x31, 0x38,
+	0x57, 0x28, 0xc3, 0xba, 0xc6, 0xe6, 0xc2, 0xac, 0x14, 0x86, 0xba, 0xff, 0xc2, 0x34, 0x5c, 0x4f,
+	0x29, 0xd4, 0x82, 0x23, 0xd3, 0xfd, 0x00, 0x5f, 0x81, 0x85, 0xc6, 0xe9, 0x31, 0xcf, 0x6b, 0x07,
+	0xc5, 0x0c, 0x8b, 0xc0, 0x39, 0x84, 0x8a, 0x39, 0xdd, 0x45, 0x18, 0x31, 0x40, 0x88, 0xf5, 0xf4,
+	0xa3, 0xca, 0xbe, 0xdb, 0xf2, 0xda, 0xc3, 0x86, 0x62, 0x00, 0x85, 0x4a, 0x25, 0x5b, 0x54, 0x70,
+	0x65, 0x51, 0x81, 0x13, 0x29, 0x0d, 0xbd, 0x84, 0x8e, 0xe4, 0x94, 0x5f, 0x9d, 0x04, 0x1e, 0xf4,
+	0x2a, 0xa7, 0xc7, 0xd6, 0xe7, 0x61, 0x55, 0xbc, 0xe0, 0x3c, 0x6f, 0xf8, 0x43, 0x17, 0x4f, 0x24,
+	0xe3, 0xe9, 0xa9, 0xe0, 0x58, 0x54, 0xf8, 0x11, 0x96, 0x3d, 0xe8, 0x0d, 0xb4, 0x27, 0x28, 0xf9,
+	0x68, 0xc4, 0xfd, 0xe5, 0x48, 0x34, 0x09, 0x93, 0x3d, 0xbd, 0x25, 0xfd, 0xda, 0xa4, 0x8f, 0x1c,
+	0x33, 0x40, 0x11, 0x21, 0x75, 0x01, 0x20, 0xb6, 0xa3, 0x62, 0x76, 0x95, 0xc4, 0x23, 0xab, 0x4d,
+	0xe9, 0x4e, 0x24, 0x1f, 0x94, 0xb0, 0xe4, 0x40, 0x14, 0xd4, 0x25, 0x9c, 0x6c, 0x7b, 0x1d, 0xbc,
+	0x0d, 0x46, 0x6f, 0x53, 0xd3, 0xf8, 0x49, 0xaf, 0x4f, 0x54, 0x20, 0x37, 0x84, 0x78, 0x78, 0xa2,
+	0x02, 0xb1, 0x13, 0x92, 0x1c, 0x34, 0x9f, 0xe0, 0xa0, 0x0c, 0xd6, 0x5f, 0xc8, 0x60, 0xfd, 0xf4,
+	0x6d, 0xb5, 0x98, 0xb1, 0xad, 0x5e, 0x91, 0x3b, 0xd5, 0x0f, 0xa3, 0x07, 0xd6, 0x97, 0xa4, 0x1f,
+	0x28, 0x42, 0xab, 0x2a, 0x76, 0x20, 0xb1, 0x4f, 0xac, 0x0b, 0xf6, 0xc9, 0x72, 0x6c, 0x9f, 0x7c,
+	0x01, 0xd6, 0x82, 0xfe, 0x00, 0xb5, 0x27, 0x15, 0xf3, 0xd3, 0xe7, 0x87, 0xb6, 0x60, 0x7d, 0x45,
+	0x2c, 0xde, 0xaa, 0x2c, 0xe6, 0x30, 0x0c, 0x55, 0x98, 0xb2, 0x8d, 0x57, 0xd3, 0xb6, 0x71, 0xf4,
+	0x22, 0x78, 0x4d, 0x7b, 0x11, 0xb4, 0xdf, 0x86, 0xa5, 0xba, 0x17, 0x0f, 0x07, 0xcf, 0xdc, 0x09,
+	0xa4, 0xda, 0xe8, 0xe8, 0xbc, 0xe7, 0x76, 0xe1, 0x06, 0x42, 0xef, 0xc7, 0x39, 0x56, 0x8b, 0xc6,
+	0x4a, 0x63, 0xf4, 0x5c, 0x06, 0xa3, 0x93, 0xad, 0x26, 0x9d, 0x1c, 0x37, 0xf7, 0x45, 0x28, 0x61,
+	0xf9, 0xae, 0x60, 0x0e, 0xd5, 0x46, 0x52, 0x9a, 0xe6, 0x12, 0xd2, 0xd4, 0x5e, 0x16, 0x83, 0x55,
+	0x15, 0x99, 0xda, 0x37, 0xa0, 0x2c, 0x81, 0xc6, 0xa2, 0x2b, 0xba, 0xe9, 0x9c, 0x92, 0x4b, 0xe7,
+	0x14, 0x32, 0x42, 0xa5, 0xd2, 0x4a, 0x6d, 0x4a, 0x71, 0x63, 0x6a, 0x53, 0x21, 0x0b, 0xe7, 0xd2,
+	0x59, 0x38, 0xd6, 0x54, 0x44, 0x2b, 0x34, 0xc1, 0xae, 0x61, 0xf1, 0x53, 0x9d, 0x05, 0x34, 0x1f,
+	0xd8, 0x18, 0xc3, 0xe4, 0x52, 0x18, 0x86, 0x04, 0x69, 0x92, 0x02, 0x53, 0xff, 0x32, 0x4a, 0x5f,
+	0xe4, 0xc1, 0x88, 0xb5, 0xb5, 0xfc, 0x06, 0xc6, 0x26, 0xc8, 0x25, 0x36, 0x81, 0x90, 0xf5, 0xb1,
+	0xba, 0x4c, 0xf5, 0xf3, 0x82, 0xb9, 0xf6, 0x79, 0x43, 0x68, 0xef, 0x1e, 0xd1, 0xa6, 0xc9, 0xc5,
+	0xf4, 0x9a, 0x55, 0x58, 0x36, 0xaa, 0x30, 0xa5, 0xaf, 0x88, 0xfe, 0xed, 0x46, 0xf2, 0x41, 0x11,
+	0x4b, 0x88, 0x92, 0x5c, 0xfa, 0x61, 0x14, 0xab, 0x1c, 0xa5, 0x46, 0xa9, 0x1c, 0x53, 0xf0, 0x71,
+	0x68, 0xce, 0xfb, 0x11, 0xde, 0xd4, 0x42, 0x50, 0x74, 0x8e, 0x28, 0xa7, 0x52, 0xde, 0x3d, 0xfc,
+	0x69, 0x7d, 0x05, 0xf7, 0x95, 0x44, 0xe6, 0xe7, 0xd5, 0xbb, 0x7a, 0x9a, 0x10, 0x93, 0x0c, 0x7f,
+	0x3b, 0xaa, 0x46, 0xf9, 0xaf, 0x73, 0x30, 0x2d, 0x61, 0xd6, 0x02, 0x4c, 0xf8, 0x2d, 0x9e, 0x5b,
+	0xfc, 0x45, 0xc6, 0x8f, 0x96, 0x27, 0xfd, 0x63, 0x94, 0x43, 0x62, 0xd1, 0xd1, 0x41, 0xf4, 0xb6,
+	0xd0, 0x69, 0x04, 0xcf, 0x58, 0xeb, 0x12, 0xbf, 0xa9, 0x37, 0xcd, 0x93, 0x1e, 0x32, 0x8f, 0xf2,
+	0x47, 0x1c, 0xd7, 0x9b, 0x4d, 0x81, 0xe9, 0xa8, 0x1a, 0xf2, 0xc1, 0x89, 0x8c, 0x5d, 0x9a, 0x87,
+	0x77, 0x51, 0x40, 0x84, 0x7f, 0x37, 0x2a, 0x67, 0x32, 0x7c, 0x49, 0x96, 0x4b, 0x15, 0x04, 0x24,
+	0x88, 0x10, 0xca, 0x3f, 0xc0, 0xd1, 0x48, 0x9a, 0x2f, 0x36, 0x1a, 0x4e, 0x2e, 0x25, 0x46, 0x23,
+	0xb2, 0x46, 0x61, 0x87, 0xfc, 0x80, 0xb6, 0x4d, 0x78, 0x88, 0x16, 0x9c, 0xa2, 0x1f, 0x54, 0x24,
+	0xc0, 0x5a, 0x86, 0x29, 0x2c, 0xee, 0xf6, 0xd8, 0xe2, 0x33, 0xe9, 0xe3, 0x55, 0x90, 0xa4, 0x19,
+	0xf2, 0xb7, 0x27, 0xfb, 0x11, 0xae, 0xe9, 0x1f, 0x4f, 0xc0, 0xb2, 0x01, 0xbe, 0x70, 0x5d, 0x3f,
+	0x88, 0x66, 0x52, 0xae, 0xab, 0x7e, 0x01, 0x4d, 0x21, 0x95, 0x98, 0xcd, 0x32, 0x14, 0x28, 0x6e,
+	0x4c, 0x1b, 0x54, 0xf8, 0x5d, 0xfe, 0xcd, 0x68, 0xa6, 0x70, 0x2b, 0x48, 0x6e, 0x70, 0xc3, 0x09,
+	0x2b, 0x48, 0x40, 0xb5, 0x45, 0x46, 0x08, 0x2e, 0x4c, 0xce, 0xde, 0x92, 0x2c, 0xd9, 0xd2, 0xe6,
+	0x90, 0x72, 0xee, 0x08, 0xaa, 0x44, 0x4b, 0xde, 0xd8, 0x0a, 0x12, 0x20, 0x69, 0x71, 0xa1, 0x4e,
+	0x6b, 0x52, 0xd2, 0x92, 0x25, 0x1a, 0x2d, 0x8a, 0x32, 0x5f, 0x95, 0xb2, 0x22, 0x36, 0x97, 0x56,
+	0x25, 0x9a, 0x19, 0xf9, 0x98, 0xa8, 0xe7, 0xda, 0x48, 0xad, 0x12, 0x9f, 0x9b, 0xf2, 0xfd, 0xcb,
+	0x0d, 0xdf, 0x18, 0xcf, 0x84, 0x39, 0x1e, 0xfb, 0x3d, 0xb1, 0xa5, 0xd3, 0x16, 0x55, 0x9f, 0xf9,
+	0x9c, 0x39, 0xf3, 0xf6, 0x09, 0xac, 0x3c, 0xf5, 0x06, 0xfe, 0xd1, 0xf9, 0xa7, 0xe0, 0xe7, 0x61,
+	0x38, 0x1b, 0xe4, 0xe3, 0x0e, 0x1b, 0x6f, 0xc3, 0x6a, 0xac, 0xa5, 0x28, 0x2b, 0x82, 0x88, 0x43,
+	0x63, 0xa3, 0x8f, 0xfc, 0xb0, 0xbf, 0x3f, 0xab, 0x6e, 0xc6, 0x86, 0x77, 0xde, 0x15, 0x7c, 0x3b,
+	0x35, 0x5e, 0x96, 0x56, 0xe6, 0x90, 0x97, 0x71, 0x1e, 0x85, 0x8d, 0x5e, 0xec, 0x5b, 0xe6, 0x45,
+	0x02, 0x88, 0x6d, 0x1d, 0xb9, 0x1b, 0x4d, 0x1a, 0xee, 0x46, 0x69, 0x29, 0xd4, 0xa6, 0x3e, 0x8d,
+	0x14, 0x6a, 0x94, 0x49, 0x4e, 0x58, 0x07, 0x48, 0x83, 0x8d, 0x27, 0x56, 0x4a, 0x4e, 0x81, 0xca,
+	0x24, 0x27, 0xab, 0x50, 0x26, 0x39, 0x15, 0xe7, 0x30, 0x93, 0xc8, 0x24, 0x97, 0x52, 0x5b, 0x65,
+	0x92, 0xe3, 0x4a, 0xe5, 0xbf, 0xcb, 0xab, 0x04, 0x6e, 0x5f, 0x86, 0xeb, 0xa1, 0x2f, 0x62, 0xc6,
+	0x1c, 0xaf, 0x29, 0x84, 0x98, 0xcb, 0x03, 0xb9, 0x4b, 0xa4, 0xd6, 0xd5, 0xbd, 0x6a, 0xd7, 0x53,
+	0x2a, 0x4b, 0x8f, 0xca, 0x0f, 0x35, 0x17, 0xdb, 0x85, 0x8d, 0xb7, 0x2e, 0x31, 0x7c, 0x9c, 0x54,
+	0xd4, 0xb9, 0x69, 0x36, 0xa5, 0x43, 0x6e, 0x99, 0x4c, 0x37, 0xc8, 0xb9, 0x94, 0xa1, 0x89, 0x23,
+	0x5a, 0xd5, 0xb7, 0xd8, 0x52, 0x32, 0x9c, 0x06, 0x85, 0xa6, 0x94, 0xe2, 0x05, 0x09, 0xa8, 0x76,
+	0x13, 0xef, 0xe4, 0xd2, 0x7d, 0xcd, 0x08, 0xd7, 0x44, 0x39, 0x2f, 0x51, 0xe4, 0x60, 0x64, 0xb0,
+	0xab, 0x7c, 0x7c, 0xaf, 0xaa, 0x1c, 0x77, 0x21, 0x9b, 0x2b, 0x17, 0xce, 0x82, 0xe4, 0xc9, 0x10,
+	0xce, 0xae, 0xc9, 0xef, 0xc0, 0x4a, 0x1c, 0xd5, 0x6d, 0x04, 0x1d, 0x71, 0x91, 0x28, 0x3a, 0x56,
+	0x0c, 0xbd, 0x12, 0x74, 0xec, 0xf7, 0xa1, 0xa0, 0xc6, 0x6a, 0xe6, 0x8d, 0x5b, 0x89, 0x02, 0xb0,
+	0xff, 0x8f, 0xfa, 0x93, 0xa3, 0x20, 0xeb, 0xfa, 0x41, 0xe5, 0xf1, 0x76, 0x29, 0x57, 0xfe, 0x0f,
+	0x93, 0x7a, 0x9a, 0x3c, 0xdc, 0x55, 0x23, 0xa5, 0x69, 0xc9, 0x8f, 0x28, 0x79, 0xde, 0x44, 0x2c,
+	0x79, 0x9e, 0x1e, 0x2f, 0xa2, 0x6d, 0x9b, 0x28, 0xd0, 0x64, 0xd2, 0x08, 0x34, 0xa1, 0x73, 0x32,
+	0x1a, 0x8a, 0x34, 0x52, 0x14, 0x03, 0x35, 0x02, 0xeb, 0x73, 0xb0, 0x1c, 0xba, 0x1f, 0x86, 0x03,
+	0x0c, 0x38, 0xbd, 0x83, 0x4a, 0x0f, 0xd3, 0x0a, 0x3d, 0x49, 0x03, 0xab, 0x0e, 0x73, 0x4c, 0xaf,
+	0xd9, 0x6e, 0xf0, 0x95, 0x7d, 0x61, 0xe3, 0x9d, 0xcb, 0xf0, 0xf5, 0x3d, 0x39, 0x71, 0x9b, 0x54,
+	0xcf, 0x99, 0x0d, 0xa2, 0x0f, 0x12, 0x4e, 0x0d, 0xf5, 0xa0, 0x8a, 0xab, 0x43, 0xe6, 0xe8, 0x08,
+	0x40, 0xa6, 0xf0, 0x66, 0xaf, 0xd3, 0xf1, 0x87, 0x1d, 0xca, 0x61, 0xc1, 0x91, 0x1b, 0x45, 0xa9,
+	0x96, 0x46, 0x05, 0x32, 0x70, 0xc3, 0xfe, 0xef, 0xe4, 0x86, 0xab, 0x91, 0x2e, 0xc1, 0x5c, 0x6d,
+	0xaf, 0xe6, 0xe2, 0xbc, 0xd7, 0xb6, 0x2a, 0xce, 0x96, 0x8c, 0x87, 0xdf, 0x7f, 0x72, 0xdf, 0x7d,
+	0xbc, 0xfd, 0x89, 0xf4, 0xc1, 0xe5, 0x0f, 0x97, 0x9c, 0x69, 0x4b, 0x13, 0xc2, 0x4d, 0x77, 0xd3,
+	0xa9, 0xee, 0x1f, 0x48, 0x40, 0xde, 0x9a, 0x87, 0xe2, 0xee, 0x93, 0x9d, 0x83, 0xaa, 0x5b, 0xaf,
+	0x3e, 0x2c, 0x4d, 0xd2, 0x67, 0xed, 0xc9, 0xce, 0x8e, 0xbb, 0x55, 0x39, 0xa8, 0x94, 0xa6, 0x84,
+	0x7b, 0x2e, 0xad, 0xa9, 0x5b, 0x7f, 0x72, 0x9f, 0xc2, 0xe6, 0x29, 0xf5, 0xdf, 0x34, 0x21, 0x49,
+	0xe8, 0xc3, 0xed, 0x5a, 0x69, 0x26, 0x42, 0xd2, 0xf2, 0x03, 0x16, 0x8c, 0xaa, 0xee, 0xe6, 0xa3,
+	0x4a, 0xed, 0xe1, 0x76, 0xa9, 0x48, 0xed, 0xab, 0x1e, 0x55, 0x76, 0x0e, 0x4a, 0x40, 0x68, 0x7a,
+	0x17, 0x05, 0x74, 0xd6, 0x3e, 0x80, 0x1b, 0x72, 0xa2, 0x9d, 0xc6, 0xf3, 0x14, 0x7f, 0xdc, 0x17,
+	0x74, 0x68, 0x77, 0xe1, 0x66, 0x3a, 0xd5, 0xcb, 0xe6, 0x6c, 0x49, 0x2e, 0xbe, 0xe1, 0x82, 0x6e,
+	0x6f, 0xc0, 0xb5, 0xa7, 0x1c, 0xd7, 0x9c, 0x92, 0x6a, 0x2b, 0xf5, 0x50, 0xb3, 0x7f, 0x34, 0x05,
+	0x6b, 0x89, 0x4a, 0xdc, 0xa1, 0xeb, 0x50, 0x40, 0xb5, 0x4a, 0x3f, 0xa2, 0x66, 0xfc, 0x40, 0x20,
+	0xd3, 0x75, 0x1e, 0x8b, 0xc8, 0x09, 0x8c, 0x33, 0x0e, 0x4d, 0xfb, 0xc1, 0x2e, 0x7e, 0xa5, 0x38,
+	0x70, 0xe5, 0xd3, 0x1c, 0xb8, 0xee, 0xe0, 0x55, 0x42, 0xba, 0xad, 0x88, 0xdb, 0x04, 0x6b, 0x1f,
+	0xe4, 0x22, 0xfd, 0xd8, 0x3b, 0xa7, 0x7e, 0x50, 0x0b, 0xca, 0xb1, 0x45, 0x86, 0x78, 0x4f, 0xcb,
+	0x42, 0x92, 0x6a, 0xd8, 0xb4, 0xe6, 0x30, 0x4e, 0xa9, 0x2c, 0x03, 0x16, 0x33, 0x94, 0x74, 0xea,
+	0x59, 0x28, 0x5f, 0x90, 0x8e, 0x3c, 0x1c, 0x28, 0xe9, 0x14, 0xfb, 0x92, 0x13, 0x71, 0x7a, 0x5d,
+	0xe0, 0x3d, 0x22, 0x8f, 0xb3, 0x42, 0xe2, 0x38, 0xcb, 0x98, 0x13, 0xde, 0x66, 0x42, 0x00, 0xf3,
+	0xde, 0x17, 0x02, 0xea, 0x4d, 0xb0, 0xfa, 0x8d, 0x73, 0x61, 0xbb, 0xc1, 0x0a, 0xaa, 0x77, 0x45,
+	0x29, 0x0b, 0xb1, 0xe4, 0xa0, 0x47, 0x84, 0xb8, 0x93, 0x64, 0x53, 0xf7, 0x8f, 0x03, 0x57, 0x49,
+	0x00, 0x61, 0x2a, 0x99, 0x77, 0xe6, 0x08, 0xe8, 0x30, 0x4c, 0x78, 0xe3, 0x07, 0x6e, 0x98, 0xb3,
+	0x73, 0x56, 0x7a, 0xeb, 0xfa, 0x41, 0x55, 0x65, 0xed, 0x0c, 0x85, 0xd8, 0x9c, 0x26, 0xc4, 0xec,
+	0xff, 0x91, 0x03, 0x88, 0xfa, 0x68, 0x2d, 0xc1, 0x7c, 0x8d, 0xd2, 0xd1, 0x36, 0x50, 0x8f, 0x1a,
+	0xb4, 0x0e, 0xce, 0x65, 0xda, 0x4d, 0xe9, 0x1a, 0x84, 0x5f, 0x72, 0x8f, 0x8a, 0x2f, 0xe9, 0x5b,
+	0x8f, 0x7b, 0x94, 0x3c, 0xe7, 0x05, 0x01, 0x86, 0xe4, 0x29, 0xf7, 0xe6, 0xee, 0xa8, 0x3d, 0xf4,
+	0x51, 0x52, 0xe1, 0xf7, 0x24, 0x7d, 0xd7, 0x46, 0xed, 0x36, 0xf9, 0xce, 0xe2, 0xf7, 0x14, 0xe5,
+	0x2e, 0x13, 0x79, 0x1d, 0xea, 0xa3, 0x43, 0xf1, 0x1e, 0x44, 0xa7, 0x3b, 0xee, 0x53, 0x44, 0x53,
+	0xe9, 0x96, 0xf0, 0x7b, 0x26, 0x44, 0x23, 0xb7, 0x5d, 0xf5, 0x2a, 0xc5, 0x3b, 0x95, 0x6b, 0xcb,
+	0xb0, 0x43, 0x84, 0xca, 0x9d, 0x3a, 0x3a, 0xc4, 0xd5, 0xaf, 0xb4, 0x71, 0x24, 0xb8, 0x53, 0x97,
+	0x61, 0x51, 0x02, 0xa8, 0x5b, 0x12, 0x38, 0x6b, 0xbf, 0x0b, 0x6b, 0x9b, 0x42, 0x48, 0x0d, 0xbd,
+	0x56, 0xcc, 0x7f, 0x58, 0x73, 0x46, 0xce, 0x19, 0xce, 0xc8, 0xf6, 0x23, 0xb8, 0xfd, 0x30, 0x34,
+	0x73, 0x6c, 0x1b, 0xde, 0x52, 0x57, 0xcb, 0x90, 0x65, 0xd7, 0xe1, 0x4e, 0x36, 0x25, 0xde, 0x44,
+	0x9f, 0x83, 0x15, 0xac, 0xe4, 0x66, 0x78, 0x6b, 0x2d, 0x61, 0x99, 0x59, 0xd1, 0xf6, 0x53, 0x89,
+	0x0e, 0xfc, 0xd3, 0x2b, 0xf7, 0x2f, 0x66, 0xef, 0x9e, 0x48, 0xf8, 0x1f, 0x3f, 0x85, 0xbb, 0x63,
+	0x9a, 0x0a, 0x23, 0x0a, 0x57, 0xcd, 0x01, 0x60, 0xb9, 0x36, 0x02, 0x4b, 0x1f, 0x81, 0xac, 0x6a,
+	0xff, 0x45, 0x0e, 0xd6, 0x93, 0xeb, 0xc2, 0xf4, 0xbe, 0x0d, 0x8b, 0x86, 0x1f, 0xbd, 0x97, 0x16,
+	0x47, 0x9f, 0x55, 0x9b, 0x13, 0xb5, 0xa8, 0x5d, 0x19, 0xa7, 0x54, 0xae, 0xa8, 0x64, 0x34, 0x95,
+	0x28, 0x46, 0x54, 0x4b, 0x46, 0x33, 0x17, 0x66, 0x9b, 0xc9, 0x76, 0x70, 0xb0, 0xa0, 0x74, 0x1f,
+	0xe7, 0x58, 0xb7, 0x2c, 0xd8, 0x1f, 0xc0, 0x92, 0x06, 0x8b, 0x1e, 0x6d, 0x35, 0x67, 0x90, 0xf9,
+	0x30, 0x03, 0x8b, 0xca, 0xd6, 0x32, 0x11, 0x65, 0x6b, 0xb1, 0xff, 0x92, 0x9c, 0xb6, 0x9f, 0x7b,
+	0x5e, 0x3f, 0x99, 0x9e, 0x32, 0x25, 0x64, 0xb9, 0x18, 0x0f, 0x59, 0x46, 0x4d, 0x42, 0x8b, 0x29,
+	0x75, 0xcd, 0x9e, 0x5b, 0x5a, 0x51, 0x25, 0x0a, 0x01, 0x1a, 0x13, 0xb7, 0x3e, 0x7f, 0xb9, 0x18,
+	0xe7, 0x49, 0x69, 0x8f, 0x51, 0x31, 0xce, 0xf6, 0xff, 0x24, 0xf7, 0x6d, 0x63, 0x10, 0x3f, 0xdd,
+	0x41, 0xa7, 0xf6, 0x77, 0xe0, 0x75, 0x99, 0xd3, 0xcd, 0xe8, 0x7b, 0xaa, 0x03, 0x2f, 0x2e, 0x7a,
+	0x6b, 0xd0, 0xeb, 0xf3, 0x39, 0x29, 0x7e, 0x0b, 0xfd, 0x79, 0x74, 0x74, 0x84, 0x93, 0x29, 0x1a,
+	0x9a, 0x60, 0xfd, 0x59, 0x80, 0x04, 0xfd, 0xbf, 0x9f, 0x80, 0x37, 0x2e, 0x6e, 0xe0, 0xc5, 0x27,
+	0xf9, 0x9b, 0xe1, 0xfd, 0x4a, 0x5a, 0x2e, 0xbe, 0xa4, 0xdb, 0x80, 0x2e, 0xd9, 0x6e, 0xec, 0xd6,
+	0xf5, 0x2e, 0xac, 0xd2, 0xd8, 0xfa, 0xd8, 0x09, 0xdd, 0xbf, 0x40, 0xf2, 0xd5, 0xa4, 0xb3, 0xc2,
+	0x85, 0x06, 0xa9, 0xf2, 0x50, 0xdd, 0xb4, 0xae, 0x70, 0x89, 0x4d, 0xd7, 0xd1, 0xd3, 0x62, 0x12,
+	0x33, 0xd2, 0x57, 0x7f, 0xf6, 0xf7, 0xf2, 0xc8, 0xae, 0x29, 0x9e, 0x11, 0x94, 0x11, 0xa5, 0xfe,
+	0x49, 0x6d, 0x53, 0x44, 0x56, 0xe3, 0x69, 0xf8, 0xa4, 0xc6, 0x5f, 0x39, 0x8a, 0x11, 0xdb, 0xdf,
+	0xde, 0x76, 0xdc, 0xcd, 0xbd, 0x5a, 0x6d, 0x7b, 0x93, 0x52, 0x36, 0x4d, 0xd0, 0xb1, 0x25, 0x60,
+	0x5b, 0xd5, 0x7a, 0x04, 0xce, 0x5b, 0xaf, 0xc0, 0x9d, 0x07, 0xdb, 0x07, 0x9b, 0x8f, 0xb6, 0xb7,
+	0x5c, 0xa1, 0x9a, 0xd6, 0x1e, 0xba, 0x9b, 0x0f, 0xaa, 0x3b, 0x07, 0xdb, 0x4e, 0x9d, 0x14, 0x62,
+	0x47, 0xe6, 0x7b, 0x7a, 0x15, 0xee, 0x66, 0x62, 0xed, 0x3b, 0x7b, 0x0f, 0x9d, 0xed, 0x7a, 0x1d,
+	0x4f, 0xd0, 0x71, 0x68, 0x0f, 0xaa, 0xb5, 0x6a, 0xfd, 0x91, 0x48, 0x12, 0x75, 0x03, 0xd6, 0x14,
+	0xda, 0xa3, 0xed, 0xca, 0x96, 0xde, 0xd4, 0x0c, 0xee, 0xdd, 0xf5, 0x78, 0x61, 0xd8, 0x42, 0x21,
+	0xad, 0x34, 0x24, 0x5c, 0xc4, 0x83, 0xa2, 0x2c, 0x86, 0xf7, 0x14, 0xc7, 0x59, 0xd9, 0xda, 0xa2,
+	0x3a, 0xdb, 0x11, 0x6d, 0x40, 0x4e, 0xbe, 0x91, 0x52, 0x1e, 0x12, 0x98, 0xa5, 0x89, 0x43, 0xe0,
+	0x66, 0xa5, 0x16, 0x56, 0x9a, 0xa3, 0x13, 0x9b, 0x61, 0x61, 0x3f, 0xe6, 0x35, 0x60, 0x58, 0x7b,
+	0x61, 0xc3, 0x09, 0x93, 0xee, 0xd3, 0xeb, 0x2e, 0x59, 0x83, 0x3e, 0x84, 0x19, 0x86, 0x58, 0xd7,
+	0x75, 0xad, 0xcc, 0x48, 0xcd, 0x5f, 0x2e, 0xa7, 0x15, 0x49, 0xb6, 0xdd, 0xf8, 0x87, 0x5b, 0x30,
+	0x2f, 0xdd, 0x7c, 0x15, 0xcd, 0x2f, 0xc2, 0x24, 0xe5, 0xc2, 0xb6, 0xae, 0xe9, 0xfe, 0x1e, 0x51,
+	0xae, 0xec, 0xf2, 0x5a, 0x02, 0x1e, 0xc6, 0x73, 0xcc, 0x70, 0xce, 0x6b, 0xa3, 0x33, 0x66, 0x22,
+	0x6d, 0xa3, 0x33, 0xf1, 0x8c, 0xda, 0x9b, 0x50, 0x50, 0x79, 0xb1, 0xad, 0xb2, 0x71, 0xcc, 0x19,
+	0xf9, 0xb3, 0xcb, 0x37, 0x52, 0xcb, 0x98, 0x88, 0x03, 0xf3, 0x46, 0xc2, 0x6b, 0xeb, 0x76, 0x32,
+	0x0f, 0xb5, 0x91, 0x45, 0xbb, 0x7c, 0x27, 0x1b, 0x21, 0xea, 0x58, 0xe8, 0xe8, 0x54, 0x4e, 0x4d,
+	0x6b, 0x9d, 0xec, 0x58, 0x22, 0x43, 0x36, 0xce, 0x8f, 0x4a, 0x08, 0xad, 0xcf, 0x8f, 0x99, 0x75,
+	0xd4, 0x98, 0x9f, 0x78, 0x7e, 0xd0, 0x00, 0xd6, 0xb3, 0x14, 0x29, 0x2b, 0x96, 0x93, 0x6e, 0x9c,
+	0xde, 0x56, 0x7e, 0xf3, 0x52, 0xb8, 0xdc, 0xe8, 0x29, 0x39, 0xdc, 0x64, 0x68, 0x3f, 0xd6, 0x05,
+	0x94, 0x0c, 0x75, 0xac, 0xfc, 0xd6, 0xe5, 0x90, 0xb9, 0xdd, 0x27, 0xb0, 0x60, 0xe6, 0x6f, 0xb4,
+	0xee, 0xc4, 0x52, 0xd5, 0x25, 0xae, 0x9c, 0xe5, 0xbb, 0x63, 0x30, 0x98, 0xec, 0xb7, 0x60, 0x31,
+	0x96, 0x16, 0xd2, 0xca, 0xae, 0x15, 0x2e, 0xac, 0x3d, 0x0e, 0x45, 0x52, 0x7e, 0x27, 0x67, 0x3d,
+	0x84, 0x62, 0x98, 0x42, 0xcf, 0xba, 0x91, 0x96, 0x58, 0x4f, 0xd1, 0xbb, 0x35, 0x36, 0xeb, 0x9e,
+	0xf5, 0x18, 0x20, 0x82, 0x5a, 0x37, 0x33, 0x90, 0x2f, 0x43, 0x0a, 0x7b, 0xb5, 0x03, 0xb3, 0x5a,
+	0xda, 0x3a, 0xcb, 0x88, 0x76, 0x4a, 0x24, 0xb9, 0x2b, 0xbf, 0x94, 0x55, 0x1c, 0xe6, 0xce, 0x2c,
+	0x86, 0xd9, 0xe9, 0x8c, 0x31, 0xc6, 0x13, 0xd9, 0x95, 0x6f, 0xa6, 0x17, 0x46, 0x74, 0xc2, 0xdc,
+	0x69, 0x06, 0x9d, 0x78, 0xa2, 0x36, 0x83, 0x4e, 0x32, 0xdd, 0x1a, 0xd1, 0x51, 0x3a, 0xa7, 0x49,
+	0x27, 0xa6, 0x9d, 0x9a, 0x74, 0x12, 0x6a, 0xea, 0xc8, 0x08, 0xc5, 0x31, 0x0e, 0x66, 0x63, 0x6f,
+	0x5d, 0xa0, 0xe1, 0x18, 0x7b, 0xeb, 0x22, 0xa5, 0x01, 0x17, 0xc7, 0x8f, 0x32, 0xfb, 0x1b, 0x4d,
+	0xbe, 0x96, 0x22, 0x93, 0xd2, 0x9a, 0x7b, 0xfd, 0x42, 0xbc, 0xb0, 0xa9, 0xef, 0xc1, 0xf5, 0xcc,
+	0xd0, 0x25, 0x63, 0x23, 0x5f, 0x14, 0x86, 0x65, 0x6c, 0xe4, 0x0b, 0xa3, 0xa1, 0xde, 0xc8, 0x61,
+	0xdb, 0x3f, 0xcc, 0xc1, 0x9d, 0x8b, 0x54, 0x29, 0x6b, 0xe3, 0x4a, 0x7a, 0x97, 0xec, 0xca, 0xbb,
+	0x2f, 0xa0, 0xab, 0x61, 0x7f, 0xbe, 0x0d, 0xa5, 0x78, 0x86, 0x37, 0xcb, 0xbe, 0x38, 0x21, 0x5d,
+	0xf9, 0xe5, 0xb1, 0x38, 0xd1, 0x09, 0x64, 0xa4, 0xc2, 0x37, 0x4e, 0xa0, 0xb4, 0xf4, 0xfb, 0xc6,
+	0x09, 0x94, 0x9a, 0x45, 0x1f, 0x2f, 0x02, 0xd3, 0xd2, 0x73, 0xd2, 0x5a, 0x4f, 0x38, 0x76, 0x2a,
+	0x2a, 0xd7, 0x53, 0x4a, 0x74, 0x29, 0xa0, 0xe5, 0xa6, 0x37, 0xa4, 0x40, 0x32, 0x19, 0xbe, 0x21,
+	0x05, 0xd2, 0x52, 0xda, 0x2b, 0x6a, 0x2a, 0x73, 0xfa, 0xd8, 0xec, 0xf1, 0x49, 0x6a, 0x31, 0x0b,
+	0x1a, 0xae, 0x46, 0x3c, 0x4d, 0xb9, 0xb1, 0x1a, 0x19, 0x59, 0xd7, 0x8d, 0xd5, 0xc8, 0xca, 0x73,
+	0x6e, 0xed, 0xc1, 0x9c, 0x9e, 0x33, 0xdc, 0x7a, 0x29, 0x51, 0xc9, 0xc8, 0x7f, 0x5e, 0xbe, 0x9d,
+	0x59, 0xce, 0x04, 0x3f, 0x86, 0xc5, 0x58, 0xfe, 0x3a, 0xe3, 0x04, 0x49, 0x4f, 0x0e, 0x68, 0x9c,
+	0x20, 0x59, 0xc9, 0xf3, 0x9e, 0xc2, 0x82, 0x99, 0x9e, 0xcd, 0x38, 0xf2, 0x52, 0x33, 0xb7, 0x95,
+	0x33, 0x31, 0xb4, 0xb5, 0x3f, 0x86, 0x95, 0xb4, 0x6c, 0x48, 0x86, 0x90, 0x19, 0x93, 0xbb, 0xc9,
+	0x10, 0x32, 0x63, 0xd3, 0x2a, 0xe1, 0xd4, 0xc4, 0x12, 0x8a, 0x18, 0x53, 0x93, 0x9e, 0xe0, 0xc7,
+	0x98, 0x9a, 0xac, 0xe4, 0x38, 0xc8, 0x22, 0xf1, 0x54, 0x25, 0x96, 0x7d, 0x71, 0x92, 0x18, 0x83,
+	0x45, 0x32, 0xd3, 0xa4, 0x60, 0xb7, 0x63, 0xe9, 0x2a, 0x8c, 0x6e, 0xa7, 0x67, 0xec, 0x30, 0xba,
+	0x9d, 0x95, 0xed, 0xa2, 0x01, 0x56, 0x32, 0xc9, 0x84, 0xa5, 0x3f, 0xc1, 0x65, 0xe6, 0xb3, 0x28,
+	0xbf, 0x7a, 0x01, 0x16, 0x37, 0x71, 0x1e, 0xe6, 0xb1, 0x48, 0xc9, 0x2c, 0x61, 0xbd, 0x95, 0x24,
+	0x92, 0x9d, 0xa5, 0xa2, 0xfc, 0xf6, 0x25, 0xb1, 0xa3, 0x79, 0x8b, 0x65, 0x42, 0x30, 0xe6, 0x2d,
+	0x3d, 0x93, 0x85, 0x31, 0x6f, 0x59, 0x89, 0x14, 0x84, 0x08, 0xd5, 0xd2, 0x16, 0xc4, 0x44, 0x68,
+	0x32, 0x11, 0x42, 0x4c, 0x84, 0xa6, 0x64, 0x3c, 0xb0, 0x7e, 0x0e, 0x56, 0x53, 0x33, 0x1a, 0x58,
+	0x3a, 0x7b, 0x8f, 0xcb, 0x89, 0x50, 0x7e, 0xe3, 0x62, 0xc4, 0x48, 0x3e, 0x6a, 0xf1, 0xf8, 0x86,
+	0x7c, 0x4c, 0x26, 0x4d, 0x30, 0xe4, 0x63, 0x5a, 0xa6, 0x03, 0x14, 0x61, 0x7a, 0x74, 0xbf, 0xf5,
+	0xd2, 0xf8, 0x8c, 0x04, 0x86, 0x08, 0x4b, 0x4d, 0x24, 0x80, 0x0b, 0x17, 0xb3, 0xdc, 0x1b, 0x0b,
+	0x97, 0xfe, 0x3c, 0x62, 0x2c, 0x5c, 0xd6, 0x63, 0x08, 0x1d, 0xac, 0x31, 0xa3, 0xa4, 0x79, 0xb0,
+	0xa6, 0xdb, 0xa1, 0xcd, 0x83, 0x35, 0xcb, 0x26, 0x4a, 0xf3, 0xa0, 0x19, 0xd6, 0xcc, 0x79, 0x48,
+	0x9a, 0x0d, 0xcd, 0x79, 0x48, 0xb1, 0xc8, 0x6d, 0xfc, 0xea, 0x94, 0x8a, 0x7d, 0xa5, 0xe5, 0xf4,
+	0x06, 0xea, 0x0e, 0x8c, 0x0d, 0xe9, 0xb1, 0xaf, 0x46, 0x43, 0x29, 0xb1, 0xb2, 0x46, 0x43, 0xa9,
+	0x41, 0xb3, 0x48, 0x50, 0x8f, 0x61, 0x36, 0x08, 0xa6, 0xc4, 0x58, 0x1b, 0x04, 0xd3, 0x82, 0x9f,
+	0xe9, 0x2a, 0x98, 0x15, 0x82, 0x6c, 0xa8, 0xab, 0x17, 0x44, 0x48, 0x1b, 0xea, 0xea, 0x45, 0x31,
+	0xcd, 0x56, 0x15, 0x20, 0x8a, 0x48, 0x36, 0xae, 0x25, 0x89, 0x50, 0x67, 0xe3, 0x5a, 0x92, 0x12,
+	0xc6, 0x8c, 0x1b, 0x44, 0x8b, 0x45, 0x36, 0x36, 0x48, 0x32, 0x72, 0xd9, 0xd8, 0x20, 0x29, 0x21,
+	0xcc, 0xd6, 0x7d, 0x98, 0xe1, 0x58, 0x21, 0xe3, 0x6a, 0x6d, 0xc6, 0x33, 0x19, 0x57, 0xeb, 0x58,
+	0x68, 0x11, 0x1e, 0x92, 0x48, 0x83, 0xc3, 0xd7, 0x0c, 0x1a, 0x66, 0x10, 0x9f, 0x41, 0x23, 0x16,
+	0xed, 0x26, 0x95, 0x2c, 0x2d, 0xbe, 0xc5, 0x18, 0x55, 0x32, 0x1a, 0xc6, 0x18, 0x55, 0x4a, 0x58,
+	0xcc, 0xc6, 0x77, 0x61, 0x59, 0xf7, 0xdc, 0x57, 0xcc, 0x89, 0x37, 0xd8, 0x98, 0x4f, 0xbf, 0xb1,
+	0x79, 0xd3, 0x03, 0x19, 0x8c, 0xcd, 0x9b, 0x11, 0x12, 0xf0, 0x4e, 0x6e, 0xa3, 0x07, 0x2b, 0x9a,
+	0x73, 0xf8, 0xd3, 0x0d, 0xd5, 0xe6, 0x47, 0xb0, 0x60, 0xc6, 0x4e, 0x18, 0x9a, 0x49, 0x6a, 0x9c,
+	0x49, 0xf9, 0xee, 0x18, 0x8c, 0xb0, 0xc1, 0xff, 0x58, 0x50, 0xa9, 0xd6, 0x45, 0x89, 0x6a, 0x0f,
+	0x2f, 0xff, 0xa6, 0x33, 0xbc, 0xd1, 0x5e, 0x6a, 0xd8, 0x82, 0xd1, 0x5e, 0xba, 0x27, 0x3d, 0x1d,
+	0x2b, 0x86, 0xc7, 0xbc, 0x71, 0xac, 0xa4, 0xf9, 0xd8, 0x97, 0xef, 0x64, 0x23, 0x30, 0xcd, 0xef,
+	0xc0, 0x52, 0xc2, 0x9f, 0xde, 0x7a, 0x39, 0x71, 0x8b, 0x4e, 0xba, 0xe2, 0x97, 0x5f, 0x19, 0x8f,
+	0x14, 0x6d, 0xba, 0xc8, 0xdd, 0xd8, 0xd8, 0x74, 0x09, 0xa7, 0x65, 0x63, 0xd3, 0x25, 0x7d, 0x94,
+	0x49, 0x0f, 0x4c, 0x73, 0x2a, 0x36, 0xf4, 0xc0, 0x31, 0x4e, 0xcc, 0xe5, 0xd7, 0x2f, 0xc4, 0xd3,
+	0x8c, 0x04, 0xca, 0xc9, 0xd8, 0x34, 0x12, 0xc4, 0x7c, 0x96, 0xcb, 0x37, 0xd3, 0x0b, 0x99, 0x4e,
+	0x4b, 0xb8, 0xb6, 0xc6, 0x7d, 0x89, 0xad, 0x57, 0x13, 0x95, 0xd2, 0xfc, 0x96, 0xcb, 0xaf, 0x5d,
+	0x84, 0x96, 0xda, 0x4a, 0x14, 0x1b, 0x92, 0x5e, 0x3d, 0xe6, 0xb2, 0x9c, 0xd5, 0x4a, 0xdc, 0x1b,
+	0x59, 0x68, 0xb0, 0x31, 0x5f, 0x62, 0x53, 0x83, 0x4d, 0x77, 0x55, 0x36, 0x35, 0xd8, 0x0c, 0x67,
+	0x64, 0xb1, 0x5f, 0x0c, 0x87, 0x62, 0x73, 0xbf, 0xa4, 0xf9, 0x29, 0x9b, 0xfb, 0x25, 0xd5, 0x1b,
+	0x59, 0xa8, 0x31, 0x91, 0x6b, 0xb1, 0x75, 0x2b, 0x59, 0x43, 0xf3, 0x52, 0x36, 0xd5, 0x98, 0xa4,
+	0x47, 0x32, 0x77, 0x52, 0x73, 0x2a, 0x8e, 0x77, 0x32, 0xe9, 0xac, 0x1c, 0xef, 0x64, 0x8a, 0x47,
+	0xf2, 0xc6, 0x8f, 0xc8, 0xdd, 0xc6, 0xa3, 0xb0, 0x37, 0x29, 0x3b, 0x1a, 0xf4, 0xff, 0x80, 0xc4,
+	0x63, 0xfc, 0x0c, 0x9d, 0x3b, 0x33, 0x80, 0xd0, 0xd0, 0xb9, 0xc7, 0x04, 0x0a, 0xe2, 0x9e, 0x8c,
+	0xa2, 0xf2, 0x8c, 0x3d, 0x99, 0x08, 0xf0, 0x2b, 0xdf, 0xca, 0x28, 0xe5, 0xde, 0x7f, 0x13, 0xe6,
+	0xa5, 0x9f, 0xb1, 0x66, 0xd3, 0x67, 0xc7, 0x63, 0xe3, 0x1c, 0x32, 0x9d, 0xae, 0x8d, 0x73, 0x28,
+	0xe6, 0xa7, 0xbc, 0xf1, 0xef, 0x72, 0x30, 0x2f, 0xd9, 0x44, 0xd1, 0xc4, 0x75, 0xd4, 0x1c, 0x3f,
+	0x8d, 0x75, 0x4c, 0x7a, 0x9f, 0x1a, 0xeb, 0x98, 0xe6, 0x2f, 0x2a, 0xd7, 0x51, 0x27, 0x78, 0xe7,
+	0x22, 0x8f, 0xd6, 0xf8, 0x3a, 0xa6, 0x90, 0xdd, 0xe8, 0x43, 0x99, 0x15, 0x55, 0xe1, 0x07, 0xca,
+	0x56, 0x1b, 0x35, 0x04, 0x14, 0xdd, 0x86, 0x7b, 0xa8, 0x21, 0xba, 0xd3, 0x5c, 0x54, 0x0d, 0xd1,
+	0x9d, 0xea, 0x59, 0xba, 0xf1, 0xf3, 0xb0, 0x22, 0x57, 0x84, 0x0b, 0x54, 0x5b, 0xc7, 0x0a, 0x6e,
+	0xba, 0x20, 0x19, 0x72, 0x72, 0x8c, 0xe7, 0x93, 0x21, 0x27, 0xc7, 0xf9, 0x32, 0x1d, 0x4e, 0x8b,
+	0xff, 0x58, 0xf9, 0xdd, 0xff, 0x0b, 0xc0, 0xc6, 0xe5, 0x96, 0x65, 0x79, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -10508,6 +10687,7 @@ type WalletServiceClient interface {
 	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error)
 	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error)
 	ConfirmationNotifications(ctx context.Context, opts ...grpc.CallOption) (WalletService_ConfirmationNotificationsClient, error)
+	AuthoredTransactionNotifications(ctx context.Context, in *AuthoredTransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_AuthoredTransactionNotificationsClient, error)
 	// Control
 	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
 	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
@@ -10827,6 +11007,38 @@ func (x *walletServiceConfirmationNotificationsClient) Recv() (*ConfirmationNoti
 	return m, nil
 }
 
+func (c *walletServiceClient) AuthoredTransactionNotifications(ctx context.Context, in *AuthoredTransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_AuthoredTransactionNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[5], "/walletrpc.WalletService/AuthoredTransactionNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceAuthoredTransactionNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_AuthoredTransactionNotificationsClient interface {
+	Recv() (*AuthoredTransactionNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceAuthoredTransactionNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceAuthoredTransactionNotificationsClient) Recv() (*AuthoredTransactionNotificationsResponse, error) {
+	m := new(AuthoredTransactionNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
 	out := new(ChangePassphraseResponse)
 	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ChangePassphrase", in, out, opts...)
@@ -10846,7 +11058,7 @@ func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccou
 }
 
 func (c *walletServiceClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (WalletService_RescanClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[5], "/walletrpc.WalletService/Rescan", opts...)
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[6], "/walletrpc.WalletService/Rescan", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10923,7 +11135,7 @@ func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTrans
 }
 
 func (c *walletServiceClient) UnspentOutputs(ctx context.Context, in *UnspentOutputsRequest, opts ...grpc.CallOption) (WalletService_UnspentOutputsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[6], "/walletrpc.WalletService/UnspentOutputs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[7], "/walletrpc.WalletService/UnspentOutputs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -11103,6 +11315,7 @@ type WalletServiceServer interface {
 	TransactionNotifications(*TransactionNotificationsRequest, WalletService_TransactionNotificationsServer) error
 	AccountNotifications(*AccountNotificationsRequest, WalletService_AccountNotificationsServer) error
 	ConfirmationNotifications(WalletService_ConfirmationNotificationsServer) error
+	AuthoredTransactionNotifications(*AuthoredTransactionNotificationsRequest, WalletService_AuthoredTransactionNotificationsServer) error
 	// Control
 	ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error)
 	RenameAccount(context.Context, *RenameAccountRequest) (*RenameAccountResponse, error)
@@ -11190,6 +11403,9 @@ func (*UnimplementedWalletServiceServer) AccountNotifications(req *AccountNotifi
 func (*UnimplementedWalletServiceServer) ConfirmationNotifications(srv WalletService_ConfirmationNotificationsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ConfirmationNotifications not implemented")
 }
+func (*UnimplementedWalletServiceServer) AuthoredTransactionNotifications(req *AuthoredTransactionNotificationsRequest, srv WalletService_AuthoredTransactionNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AuthoredTransactionNotifications not implemented")
+}
 func (*UnimplementedWalletServiceServer) ChangePassphrase(ctx context.Context, req *ChangePassphraseRequest) (*ChangePassphraseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassphrase not implemented")
 }
@@ -11626,6 +11842,27 @@ func (x *walletServiceConfirmationNotificationsServer) Recv() (*ConfirmationNoti
 	return m, nil
 }
 
+func _WalletService_AuthoredTransactionNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AuthoredTransactionNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).AuthoredTransactionNotifications(m, &walletServiceAuthoredTransactionNotificationsServer{stream})
+}
+
+type WalletService_AuthoredTransactionNotificationsServer interface {
+	Send(*AuthoredTransactionNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceAuthoredTransactionNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceAuthoredTransactionNotificationsServer) Send(m *AuthoredTransactionNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangePassphraseRequest)
 	if err := dec(in); err != nil {
@@ -12218,6 +12455,11 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "AuthoredTransactionNotifications",
+			Handler:       _WalletService_AuthoredTransactionNotifications_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Rescan",
 			Handler:       _WalletService_Rescan_Handler,
@@ -122,9 +122,22 @@ func NewSyncer(w *wallet.Wallet, lp *p2p.LocalPeer) *Syncer {
 }
 
 // SetPersistentPeers sets each peer as a persistent peer and disables DNS
-// seeding and peer discovery.
+// seeding and peer discovery.  Connections are maintained to exactly these
+// peers and are reestablished when a peer disconnects.  Peers which misbehave,
+// such as by serving an invalid header chain, are banned and reconnections
+// are backed off.  DNS seeding and peer discovery are only enabled when no
+// persistent peers are set.
 func (s *Syncer) SetPersistentPeers(peers []string) {
-	s.persistentPeers = peers
+	persistent := make([]string, 0, len(peers))
+	seen := make(map[string]struct{}, len(peers))
+	for _, p := range peers {
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		persistent = append(persistent, p)
+	}
+	s.persistentPeers = persistent
 }
 
 // SetNotifications sets the possible various callbacks that are used
@@ -285,7 +298,7 @@ func (s *Syncer) Run(ctx context.Context) error {
 
 	// Seed peers over DNS when not disabled by persistent peers.
 	if len(s.persistentPeers) == 0 {
-		s.lp.SeedPeers(ctx, wire.SFNodeNetwork | wire.SFNodeCF)
+		s.lp.SeedPeers(ctx, wire.SFNodeNetwork|wire.SFNodeCF)
 	}
 
 	// Start background handlers to read received messages from remote peers
@@ -347,56 +360,125 @@ func (s *Syncer) peerCandidate(svcs wire.ServiceFlag) (*wire.NetAddress, error)
 	return nil, errors.New("no addresses")
 }
 
-func (s *Syncer) connectToPersistent(ctx context.Context, raddr string) error {
-	for {
-		func() {
-			ctx, cancel := context.WithCancel(ctx)
-			defer cancel()
+// Persistent peers are reconnected to after persistentReconnectDelay.  Peers
+// which are disconnected for misbehaving, such as by serving an invalid header
+// chain, are banned and reconnection is backed off, beginning at
+// persistentBanDelay and doubling for each consecutive ban up to
+// persistentMaxBanDelay.
+const (
+	persistentReconnectDelay = 5 * time.Second
+	persistentBanDelay       = time.Minute
+	persistentMaxBanDelay    = time.Hour
+)
 
-			rp, err := s.lp.ConnectOutbound(ctx, raddr, reqSvcs)
-			if err != nil {
-				if ctx.Err() == nil {
-					log.Errorf("Peering attempt failed: %v", err)
-				}
-				return
-			}
-			log.Infof("New peer %v %v %v", raddr, rp.UA(), rp.Services())
+// isBanReason returns whether a peer disconnected with err misbehaved and
+// should be banned.
+func isBanReason(err error) bool {
+	return errors.Is(err, errors.Protocol) || errors.Is(err, errors.Consensus)
+}
 
-			k := addrmgr.NetAddressKey(rp.NA())
-			s.remotesMu.Lock()
-			s.remotes[k] = rp
-			n := len(s.remotes)
-			s.remotesMu.Unlock()
-			s.peerConnected(n, k)
+// banDelay returns the reconnection delay after a persistent peer has been
+// banned bans consecutive times.
+func banDelay(bans int) time.Duration {
+	d := persistentBanDelay
+	for i := 1; i < bans && d < persistentMaxBanDelay; i++ {
+		d *= 2
+	}
+	if d > persistentMaxBanDelay {
+		d = persistentMaxBanDelay
+	}
+	return d
+}
 
-			wait := make(chan struct{})
-			go func() {
-				err := s.startupSync(ctx, rp)
-				if err != nil {
-					rp.Disconnect(err)
-				}
-				wait <- struct{}{}
-			}()
+// sleep blocks for the duration d or until the context is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
 
-			err = rp.Err()
-			s.remotesMu.Lock()
-			delete(s.remotes, k)
-			n = len(s.remotes)
-			s.remotesMu.Unlock()
-			s.peerDisconnected(n, k)
-			<-wait
-			if ctx.Err() != nil {
-				return
-			}
+// peerSession connects to a remote peer and runs a session with it until the
+// peer disconnects.  connected reports whether the connection was established,
+// and err describes why the connection failed or the peer disconnected.
+type peerSession func(ctx context.Context, raddr string) (connected bool, err error)
+
+// maintainPersistent maintains a connection to the persistent peer raddr by
+// running sessions until the context is cancelled.  The delay before each
+// reconnection is waited for using the sleep function.
+func maintainPersistent(ctx context.Context, raddr string, session peerSession,
+	sleep func(context.Context, time.Duration) error) error {
+
+	bans := 0
+	for {
+		connected, err := session(ctx, raddr)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		delay := persistentReconnectDelay
+		switch {
+		case isBanReason(err):
+			bans++
+			delay = banDelay(bans)
+			log.Warnf("Banning persistent peer %v for %v: %v", raddr, delay, err)
+		case connected:
+			bans = 0
 			log.Warnf("Lost peer %v: %v", raddr, err)
-		}()
+		default:
+			log.Errorf("Peering attempt failed: %v", err)
+		}
 
-		if err := ctx.Err(); err != nil {
+		if err := sleep(ctx, delay); err != nil {
 			return err
 		}
+	}
+}
+
+func (s *Syncer) connectToPersistent(ctx context.Context, raddr string) error {
+	return maintainPersistent(ctx, raddr, s.persistentSession, sleep)
+}
+
+// persistentSession implements peerSession for persistent peers.  The peer is
+// synced from until it disconnects.
+func (s *Syncer) persistentSession(ctx context.Context, raddr string) (connected bool, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-		time.Sleep(5 * time.Second)
+	rp, err := s.lp.ConnectOutbound(ctx, raddr, reqSvcs)
+	if err != nil {
+		return false, err
 	}
+	log.Infof("New peer %v %v %v", raddr, rp.UA(), rp.Services())
+
+	k := addrmgr.NetAddressKey(rp.NA())
+	s.remotesMu.Lock()
+	s.remotes[k] = rp
+	n := len(s.remotes)
+	s.remotesMu.Unlock()
+	s.peerConnected(n, k)
+
+	wait := make(chan struct{})
+	go func() {
+		err := s.startupSync(ctx, rp)
+		if err != nil {
+			rp.Disconnect(err)
+		}
+		wait <- struct{}{}
+	}()
+
+	err = rp.Err()
+	s.remotesMu.Lock()
+	delete(s.remotes, k)
+	n = len(s.remotes)
+	s.remotesMu.Unlock()
+	s.peerDisconnected(n, k)
+	<-wait
+	return true, err
 }
 
 func (s *Syncer) connectToCandidates(ctx context.Context) error {
@@ -0,0 +1,135 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package spv
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/errors"
+)
+
+type sessionResult struct {
+	connected bool
+	err       error
+}
+
+// mockPeers records the dialed persistent peers and the reconnection delays,
+// and ends each session with the next scripted result.  The context is
+// cancelled once all results have been returned.
+type mockPeers struct {
+	results []sessionResult
+	cancel  func()
+	dials   []string
+	delays  []time.Duration
+}
+
+func (m *mockPeers) session(ctx context.Context, raddr string) (bool, error) {
+	m.dials = append(m.dials, raddr)
+	if len(m.results) == 0 {
+		m.cancel()
+		return false, ctx.Err()
+	}
+	r := m.results[0]
+	m.results = m.results[1:]
+	return r.connected, r.err
+}
+
+func (m *mockPeers) sleep(ctx context.Context, d time.Duration) error {
+	m.delays = append(m.delays, d)
+	return ctx.Err()
+}
+
+func TestMaintainPersistent(t *testing.T) {
+	badHeader := errors.E(errors.Consensus, "invalid header")
+	lost := errors.E(errors.IO, "connection reset")
+
+	tests := []struct {
+		name    string
+		results []sessionResult
+		delays  []time.Duration
+	}{{
+		name: "reconnect",
+		results: []sessionResult{
+			{false, lost}, // dial failure
+			{true, lost},
+			{true, lost},
+		},
+		delays: []time.Duration{
+			persistentReconnectDelay,
+			persistentReconnectDelay,
+			persistentReconnectDelay,
+		},
+	}, {
+		name: "ban",
+		results: []sessionResult{
+			{true, badHeader},
+			{true, badHeader},
+			{false, lost}, // dial failures do not reset bans
+			{true, errors.E(errors.Protocol, "ban score reached")},
+			{true, lost}, // well behaved sessions reset bans
+			{true, badHeader},
+		},
+		delays: []time.Duration{
+			persistentBanDelay,
+			2 * persistentBanDelay,
+			persistentReconnectDelay,
+			4 * persistentBanDelay,
+			persistentReconnectDelay,
+			persistentBanDelay,
+		},
+	}}
+	for _, test := range tests {
+		ctx, cancel := context.WithCancel(context.Background())
+		m := &mockPeers{results: test.results, cancel: cancel}
+		const raddr = "127.0.0.1:9108"
+		err := maintainPersistent(ctx, raddr, m.session, m.sleep)
+		cancel()
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("%s: expected context.Canceled, got %v", test.name, err)
+		}
+		if len(m.dials) != len(test.results)+1 {
+			t.Errorf("%s: dialed %d times, expected %d", test.name,
+				len(m.dials), len(test.results)+1)
+		}
+		for _, d := range m.dials {
+			if d != raddr {
+				t.Errorf("%s: dialed %q, expected %q", test.name, d, raddr)
+			}
+		}
+		if !reflect.DeepEqual(m.delays, test.delays) {
+			t.Errorf("%s: reconnect delays %v, expected %v", test.name,
+				m.delays, test.delays)
+		}
+	}
+}
+
+func TestBanDelay(t *testing.T) {
+	prev := time.Duration(0)
+	for bans := 1; bans <= 20; bans++ {
+		d := banDelay(bans)
+		if d < prev {
+			t.Errorf("delay for %d bans %v is less than previous %v", bans, d, prev)
+		}
+		if d > persistentMaxBanDelay {
+			t.Errorf("delay for %d bans %v exceeds maximum", bans, d)
+		}
+		prev = d
+	}
+	if prev != persistentMaxBanDelay {
+		t.Errorf("delay %v did not reach maximum %v", prev, persistentMaxBanDelay)
+	}
+}
+
+func TestSetPersistentPeers(t *testing.T) {
+	s := new(Syncer)
+	s.SetPersistentPeers([]string{"a:9108", "b:9108", "a:9108"})
+	want := []string{"a:9108", "b:9108"}
+	if !reflect.DeepEqual(s.persistentPeers, want) {
+		t.Errorf("persistent peers %v, expected %v", s.persistentPeers, want)
+	}
+}
@@ -12,6 +12,8 @@ import (
 
 	"decred.org/dcrwallet/errors"
 	"decred.org/dcrwallet/wallet"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
 	"github.com/decred/dcrd/dcrutil/v3"
 	"github.com/decred/dcrd/wire"
 )
@@ -28,7 +30,9 @@ type Config struct {
 	// Account to derive voting addresses from; overridden by VotingAddr
 	VotingAccount uint32
 
-	// Minimum amount to maintain in purchasing account
+	// Minimum amount to maintain in purchasing account.  Only the spendable
+	// balance above this amount, after ticket and split transaction fees,
+	// is used to buy tickets.
 	Maintain dcrutil.Amount
 
 	// Address to assign voting rights; overrides VotingAccount
@@ -234,27 +238,25 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 	if err != nil {
 		return err
 	}
-	spendable := bal.Spendable
-	if spendable < maintain {
-		log.Debugf("Skipping purchase: low available balance")
-		return nil
-	}
-	spendable -= maintain
 	sdiff, err := w.NextStakeDifficultyAfterHeader(ctx, tip)
 	if err != nil {
 		return err
 	}
-	buy := int(spendable / sdiff)
+	inputs, err := splitInputs(ctx, w, account)
+	if err != nil {
+		return err
+	}
+	costs := estimatePurchaseCosts(sdiff, w.TicketFeeIncrement(), w.RelayFee(),
+		inputs, poolFeeAddr != nil)
+	maxTickets := int(w.ChainParams().MaxFreshStakePerBlock)
+	if limit > 0 && limit < maxTickets {
+		maxTickets = limit
+	}
+	buy := costs.count(bal.Spendable, maintain, maxTickets)
 	if buy == 0 {
 		log.Debugf("Skipping purchase: low available balance")
 		return nil
 	}
-	if max := int(w.ChainParams().MaxFreshStakePerBlock); buy > max {
-		buy = max
-	}
-	if limit > 0 && buy > limit {
-		buy = limit
-	}
 
 	tix, err := w.PurchaseTicketsContext(ctx, n, &wallet.PurchaseTicketsRequest{
 		Count:         buy,
@@ -282,6 +284,122 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 	return err
 }
 
+// splitInputs returns the values of the unlocked outputs of account which may
+// be spent by a split transaction, in the order they are selected as inputs.
+func splitInputs(ctx context.Context, w *wallet.Wallet, account uint32) ([]dcrutil.Amount, error) {
+	var outputs []*wallet.TransactionOutput
+	err := w.ForEachUnspentOutput(ctx, account, minconf, func(output *wallet.TransactionOutput) error {
+		outputs = append(outputs, output)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs := make([]dcrutil.Amount, 0, len(outputs))
+	for _, output := range outputs {
+		if w.LockedOutpoint(output.OutPoint) {
+			continue
+		}
+		inputs = append(inputs, dcrutil.Amount(output.Output.Value))
+	}
+	return inputs, nil
+}
+
+// purchaseCosts describes the amounts spent from the purchasing account to buy
+// tickets.
+type purchaseCosts struct {
+	ticketPrice  dcrutil.Amount   // stake difficulty
+	ticketFee    dcrutil.Amount   // fee of each ticket
+	splitFeeRate dcrutil.Amount   // split transaction fee rate
+	splitOutputs int              // split transaction outputs for each ticket
+	inputs       []dcrutil.Amount // outputs which may be spent by the split transaction
+}
+
+// estimatePurchaseCosts returns estimates of the costs of buying tickets at a
+// ticket price.  Ticket fees are estimated using ticketFeeRate, and split
+// transaction fees using splitFeeRate.  inputs are the values of the P2PKH
+// outputs which may be spent by the split transaction, in the order they are
+// selected.
+func estimatePurchaseCosts(ticketPrice, ticketFeeRate, splitFeeRate dcrutil.Amount,
+	inputs []dcrutil.Amount, vsp bool) purchaseCosts {
+
+	// Solo tickets spend a single split output and pay to a single
+	// commitment and change output.  VSP tickets additionally commit to the
+	// VSP fee, which is paid by a second split transaction output.
+	splitOutputs := 1
+	if vsp {
+		splitOutputs = 2
+	}
+	var ticketIns, ticketOuts []int
+	for i := 0; i < splitOutputs; i++ {
+		ticketIns = append(ticketIns, txsizes.RedeemP2PKHSigScriptSize)
+		ticketOuts = append(ticketOuts, txsizes.TicketCommitmentScriptSize,
+			txsizes.TicketChangeScriptSize)
+	}
+	ticketOuts = append(ticketOuts, txsizes.TicketChangeScriptSize)
+	ticketSize := txsizes.EstimateSerializeSizeFromScriptSizes(ticketIns,
+		ticketOuts, 0)
+
+	return purchaseCosts{
+		ticketPrice:  ticketPrice,
+		ticketFee:    txrules.FeeForSerializeSize(ticketFeeRate, ticketSize),
+		splitFeeRate: splitFeeRate,
+		splitOutputs: splitOutputs,
+		inputs:       inputs,
+	}
+}
+
+// splitFee returns the fee of the split transaction funding count tickets, and
+// whether the inputs can pay for it.  As when the transaction is authored,
+// inputs are selected in order until they pay for the split outputs and the
+// fee of a transaction spending each selected input and paying P2PKH change.
+func (c *purchaseCosts) splitFee(count int) (dcrutil.Amount, bool) {
+	outputSizes := make([]int, count*c.splitOutputs)
+	for i := range outputSizes {
+		outputSizes[i] = txsizes.P2PKHPkScriptSize
+	}
+	// The size of the transaction without inputs is extended by the
+	// size of each input and the larger input count encodings.
+	baseSize := txsizes.EstimateSerializeSizeFromScriptSizes(nil, outputSizes,
+		txsizes.P2PKHPkScriptSize)
+	inputSize := txsizes.EstimateInputSize(txsizes.RedeemP2PKHSigScriptSize)
+	target := dcrutil.Amount(count) * (c.ticketPrice + c.ticketFee)
+	var total dcrutil.Amount
+	for i, input := range c.inputs {
+		total += input
+		n := i + 1
+		size := baseSize + n*inputSize +
+			2*(wire.VarIntSerializeSize(uint64(n))-wire.VarIntSerializeSize(0))
+		fee := txrules.FeeForSerializeSize(c.splitFeeRate, size)
+		if total >= target+fee {
+			return fee, true
+		}
+	}
+	return 0, false
+}
+
+// total returns the amount spent to buy count tickets, and whether the inputs
+// can pay for them.
+func (c *purchaseCosts) total(count int) (dcrutil.Amount, bool) {
+	if count == 0 {
+		return 0, true
+	}
+	splitFee, ok := c.splitFee(count)
+	return dcrutil.Amount(count)*(c.ticketPrice+c.ticketFee) + splitFee, ok
+}
+
+// count returns the number of tickets, no more than maxTickets, which may be
+// bought without reducing the spendable balance below maintain.
+func (c *purchaseCosts) count(spendable, maintain dcrutil.Amount, maxTickets int) int {
+	for n := maxTickets; n > 0; n-- {
+		total, ok := c.total(n)
+		if ok && spendable-total >= maintain {
+			return n
+		}
+	}
+	return 0
+}
+
 // AccessConfig runs f with the current config passed as a parameter.  The
 // config is protected by a mutex and this function is safe for concurrent
 // access to read or modify the config.  It is unsafe to leak a pointer to the
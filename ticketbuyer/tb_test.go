@@ -0,0 +1,109 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ticketbuyer
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/dcrutil/v3"
+)
+
+// splitAmounts returns n inputs of equal value totalling amount.
+func splitAmounts(amount dcrutil.Amount, n int) []dcrutil.Amount {
+	inputs := make([]dcrutil.Amount, n)
+	for i := range inputs {
+		inputs[i] = amount / dcrutil.Amount(n)
+	}
+	return inputs
+}
+
+func TestPurchaseCount(t *testing.T) {
+	const feeRate dcrutil.Amount = 1e4
+	tests := []struct {
+		name        string
+		spendable   dcrutil.Amount
+		inputs      int
+		maintain    dcrutil.Amount
+		ticketPrice dcrutil.Amount
+		vsp         bool
+		max         int
+	}{
+		{"no floor", 100e8, 1, 0, 10e8, false, 20},
+		{"floor", 100e8, 1, 25e8, 10e8, false, 20},
+		{"vsp", 100e8, 1, 25e8, 10e8, true, 20},
+		{"many inputs", 100e8, 500, 25e8, 10e8, false, 20},
+		{"floor above balance", 10e8, 1, 25e8, 1e8, false, 20},
+		{"floor equals balance", 25e8, 1, 25e8, 1e8, false, 20},
+		{"surplus is one ticket price", 35e8, 1, 25e8, 10e8, false, 20},
+		{"surplus covers fees", 35e8 + 1e6, 1, 25e8, 10e8, false, 20},
+		{"limited", 1000e8, 1, 1e8, 1e8, false, 5},
+		{"cheap tickets", 3e8, 3, 1e8, 1e6, true, 200},
+	}
+	for _, test := range tests {
+		inputs := splitAmounts(test.spendable, test.inputs)
+		costs := estimatePurchaseCosts(test.ticketPrice, feeRate, feeRate,
+			inputs, test.vsp)
+		if costs.ticketFee <= 0 {
+			t.Fatalf("%s: ticket fee not estimated: %+v", test.name, costs)
+		}
+		n := costs.count(test.spendable, test.maintain, test.max)
+		if n < 0 || n > test.max {
+			t.Errorf("%s: bought %d tickets with maximum %d", test.name, n, test.max)
+			continue
+		}
+		total, ok := costs.total(n)
+		if !ok {
+			t.Errorf("%s: inputs can not pay for %d tickets", test.name, n)
+		}
+		if remaining := test.spendable - total; remaining < test.maintain {
+			t.Errorf("%s: buying %d tickets leaves %v below floor %v",
+				test.name, n, remaining, test.maintain)
+		}
+		// One more ticket must exceed the maximum, the inputs or the
+		// floor.
+		if n < test.max {
+			total, ok := costs.total(n + 1)
+			if remaining := test.spendable - total; ok && remaining >= test.maintain {
+				t.Errorf("%s: bought %d tickets but %d leave %v above floor %v",
+					test.name, n, n+1, remaining, test.maintain)
+			}
+		}
+	}
+
+	// Fees prevent spending a surplus of exactly the ticket price.
+	costs := estimatePurchaseCosts(10e8, feeRate, feeRate, splitAmounts(35e8, 1), false)
+	if n := costs.count(35e8, 25e8, 20); n != 0 {
+		t.Errorf("bought %d tickets with surplus of exactly the ticket price", n)
+	}
+	costs = estimatePurchaseCosts(10e8, feeRate, feeRate, splitAmounts(35e8+1e6, 1), false)
+	if n := costs.count(35e8+1e6, 25e8, 20); n != 1 {
+		t.Errorf("bought %d tickets with surplus covering fees, expected 1", n)
+	}
+}
+
+func TestSplitFee(t *testing.T) {
+	const feeRate dcrutil.Amount = 1e4
+	costs := estimatePurchaseCosts(1e8, feeRate, feeRate, splitAmounts(10e8, 10), false)
+
+	// Each input pays for about one ticket, so funding more tickets spends more
+	// inputs and pays a higher fee.
+	var prev dcrutil.Amount
+	for count := 1; count <= 9; count++ {
+		fee, ok := costs.splitFee(count)
+		if !ok {
+			t.Fatalf("inputs can not pay for %d tickets", count)
+		}
+		if fee <= prev {
+			t.Errorf("split fee %v for %d tickets is not above fee %v for %d",
+				fee, count, prev, count-1)
+		}
+		prev = fee
+	}
+
+	// The inputs can not pay for the tickets and fee.
+	if _, ok := costs.splitFee(10); ok {
+		t.Errorf("inputs paid for 10 tickets and the split fee")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/binary"
 	"runtime/trace"
+	"sort"
 
 	"decred.org/dcrwallet/errors"
 	"decred.org/dcrwallet/wallet/internal/compat"
@@ -333,6 +334,119 @@ type bip0044AccountData struct {
 	xpub        *hdkeychain.ExtendedKey
 	albExternal addressBuffer
 	albInternal addressBuffer
+	gapLimit    uint32 // zero when the wallet gap limit is used
+}
+
+// bufferGapLimit returns the unused address gap limit of an account's address
+// buffers.  The wallet gap limit is used when the account does not set one.
+func (w *Wallet) bufferGapLimit(ad *bip0044AccountData) uint32 {
+	if ad.gapLimit != 0 {
+		return ad.gapLimit
+	}
+	return uint32(w.gapLimit)
+}
+
+// accountGapLimit returns the unused address gap limit recorded for an
+// account, or the wallet gap limit when the account does not set one.
+func (w *Wallet) accountGapLimit(ns walletdb.ReadBucket, account uint32) (uint32, error) {
+	limit, err := w.Manager.AccountGapLimit(ns, account)
+	if err != nil {
+		return 0, err
+	}
+	if limit == 0 {
+		limit = uint32(w.gapLimit)
+	}
+	return limit, nil
+}
+
+// AccountGapLimit returns the unused address gap limit of an account.  This is
+// the wallet's gap limit unless a different limit was set for the account by
+// SetAccountGapLimit.
+func (w *Wallet) AccountGapLimit(ctx context.Context, account uint32) (uint32, error) {
+	const op errors.Op = "wallet.AccountGapLimit"
+	var limit uint32
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		limit, err = w.accountGapLimit(ns, account)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return limit, nil
+}
+
+// SetAccountGapLimit sets the unused address gap limit of an account.  The
+// account limit is used instead of the wallet's gap limit when discovering
+// used addresses and when returning new addresses of the account.  Setting a
+// zero limit restores the wallet's gap limit for the account.
+func (w *Wallet) SetAccountGapLimit(ctx context.Context, account, limit uint32) error {
+	const op errors.Op = "wallet.SetAccountGapLimit"
+	if limit >= hdkeychain.HardenedKeyStart {
+		return errors.E(op, errors.Invalid, "gap limit too large")
+	}
+
+	defer w.addressBuffersMu.Unlock()
+	w.addressBuffersMu.Lock()
+
+	var props *udb.AccountProperties
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		err := w.Manager.SetAccountGapLimit(ns, account, limit)
+		if err != nil {
+			return err
+		}
+		if limit == 0 {
+			limit = uint32(w.gapLimit)
+		}
+		props, err = w.Manager.AccountProperties(ns, account)
+		if err != nil {
+			return err
+		}
+
+		// Record all addresses within the new gap limit.
+		err = w.Manager.SyncAccountToAddrIndex(ns, account,
+			minUint32(hdkeychain.HardenedKeyStart-1, props.LastUsedExternalIndex+limit),
+			udb.ExternalBranch)
+		if err != nil {
+			return err
+		}
+		return w.Manager.SyncAccountToAddrIndex(ns, account,
+			minUint32(hdkeychain.HardenedKeyStart-1, props.LastUsedInternalIndex+limit),
+			udb.InternalBranch)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	ad, ok := w.addressBuffers[account]
+	if !ok {
+		return nil
+	}
+	prevLimit := w.bufferGapLimit(ad)
+	ad.gapLimit = limit
+	if limit <= prevLimit {
+		return nil
+	}
+
+	// Watch the addresses which are newly within the gap limit.
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil
+	}
+	for _, alb := range []*addressBuffer{&ad.albExternal, &ad.albInternal} {
+		addrs, err := deriveChildAddresses(alb.branchXpub,
+			alb.lastUsed+1+prevLimit, limit-prevLimit, w.chainParams)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		err = n.LoadTxFilter(ctx, false, addrs, nil)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	return nil
 }
 
 // persistReturnedChildFunc is the function used by nextAddress to update the
@@ -410,19 +524,32 @@ func (w *Wallet) deferPersistReturnedChild(ctx context.Context, updates *[]func(
 func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistReturnedChildFunc, account, branch uint32,
 	callOpts ...NextAddressCallOption) (dcrutil.Address, error) {
 
+	addrs, err := w.nextAddresses(ctx, op, persist, account, branch, 1, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return addrs[0], nil
+}
+
+// nextAddresses returns the next count addresses of an account branch.  The
+// returned child index is persisted once, for the last returned child, and the
+// branch cursor is only advanced if all addresses are derived and the
+// index is persisted.
+func (w *Wallet) nextAddresses(ctx context.Context, op errors.Op, persist persistReturnedChildFunc, account, branch uint32,
+	count int, callOpts ...NextAddressCallOption) ([]dcrutil.Address, error) {
+
 	var opts nextAddressCallOptions // TODO: zero values for now, add to wallet config later.
 	for _, c := range callOpts {
 		c(&opts)
 	}
 
-	gapLimit := uint32(w.gapLimit)
-
 	defer w.addressBuffersMu.Unlock()
 	w.addressBuffersMu.Lock()
 	ad, ok := w.addressBuffers[account]
 	if !ok {
 		return nil, errors.E(op, errors.NotExist, errors.Errorf("account %d", account))
 	}
+	gapLimit := w.bufferGapLimit(ad)
 
 	var alb *addressBuffer
 	switch branch {
@@ -434,8 +561,11 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 		return nil, errors.E(op, errors.Invalid, "branch must be external (0) or internal (1)")
 	}
 
-	for {
-		if alb.cursor >= gapLimit {
+	addrs := make([]dcrutil.Address, 0, count)
+	cursor := alb.cursor
+	var lastChild uint32
+	for len(addrs) < count {
+		if cursor >= gapLimit {
 			switch opts.policy {
 			case gapPolicyError:
 				return nil, errors.E(op, errors.Policy,
@@ -447,36 +577,36 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 				// connected to a consensus RPC server.  Watch addresses in
 				// batches of the gap limit at a time to avoid introducing many
 				// RPCs from repeated new address calls.
-				if alb.cursor%uint32(w.gapLimit) != 0 {
+				if cursor%gapLimit != 0 {
 					break
 				}
 				n, err := w.NetworkBackend()
 				if err != nil {
 					break
 				}
-				addrs, err := deriveChildAddresses(alb.branchXpub,
-					alb.lastUsed+1+alb.cursor, gapLimit, w.chainParams)
+				watch, err := deriveChildAddresses(alb.branchXpub,
+					alb.lastUsed+1+cursor, gapLimit, w.chainParams)
 				if err != nil {
 					return nil, errors.E(op, err)
 				}
-				err = n.LoadTxFilter(ctx, false, addrs, nil)
+				err = n.LoadTxFilter(ctx, false, watch, nil)
 				if err != nil {
 					return nil, err
 				}
 
 			case gapPolicyWrap:
-				alb.cursor = 0
+				cursor = 0
 			}
 		}
 
-		childIndex := alb.lastUsed + 1 + alb.cursor
+		childIndex := alb.lastUsed + 1 + cursor
 		if childIndex >= hdkeychain.HardenedKeyStart {
 			return nil, errors.E(op, errors.Errorf("account %d branch %d exhausted",
 				account, branch))
 		}
 		child, err := alb.branchXpub.Child(childIndex)
 		if errors.Is(err, hdkeychain.ErrInvalidChild) {
-			alb.cursor++
+			cursor++
 			continue
 		}
 		if err != nil {
@@ -486,21 +616,27 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 		if err != nil {
 			return nil, errors.E(op, err)
 		}
-		// Write the returned child index to the database.
-		err = persist(account, branch, childIndex)
-		if err != nil {
-			return nil, err
-		}
-		alb.cursor++
-		addr := &xpubAddress{
+		cursor++
+		lastChild = childIndex
+		addrs = append(addrs, &xpubAddress{
 			AddressPubKeyHash: apkh,
 			xpub:              ad.xpub,
 			branch:            branch,
 			child:             childIndex,
-		}
-		log.Infof("Returning address (account=%v branch=%v child=%v)", account, branch, childIndex)
-		return addr, nil
+		})
+	}
+
+	// Write the returned child index to the database.
+	err := persist(account, branch, lastChild)
+	if err != nil {
+		return nil, err
+	}
+	alb.cursor = cursor
+	for _, addr := range addrs {
+		log.Infof("Returning address (account=%v branch=%v child=%v)", account,
+			branch, addr.(*xpubAddress).child)
 	}
+	return addrs, nil
 }
 
 func (w *Wallet) nextImportedXpubAddress(ctx context.Context, op errors.Op, maybeDBTX walletdb.ReadWriteTx,
@@ -605,8 +741,12 @@ func (w *Wallet) markUsedAddress(op errors.Op, dbtx walletdb.ReadWriteTx, addr u
 		lastUsed = props.LastUsedInternalIndex
 		branch = udb.InternalBranch
 	}
+	gapLimit, err := w.accountGapLimit(ns, account)
+	if err != nil {
+		return errors.E(op, err)
+	}
 	err = w.Manager.SyncAccountToAddrIndex(ns, account,
-		minUint32(hdkeychain.HardenedKeyStart-1, lastUsed+uint32(w.gapLimit)),
+		minUint32(hdkeychain.HardenedKeyStart-1, lastUsed+gapLimit),
 		branch)
 	if err != nil {
 		return errors.E(op, err)
@@ -620,6 +760,24 @@ func (w *Wallet) NewExternalAddress(ctx context.Context, account uint32, callOpt
 	return w.nextAddress(ctx, op, w.persistReturnedChild(ctx, nil), account, udb.ExternalBranch, callOpts...)
 }
 
+// NewExternalAddresses returns count new external addresses of an account.
+// The addresses are identical to those returned by count calls to
+// NewExternalAddress, but the returned child index is recorded by a single
+// database update.  Either all addresses are returned and recorded, or an
+// error is returned and no addresses are recorded.  With the default gap
+// policy, an error with kind errors.Policy is returned if any of the addresses
+// would violate the unused address gap limit.
+func (w *Wallet) NewExternalAddresses(ctx context.Context, account uint32, count int,
+	callOpts ...NextAddressCallOption) ([]dcrutil.Address, error) {
+
+	const op errors.Op = "wallet.NewExternalAddresses"
+	if count < 1 {
+		return nil, errors.E(op, errors.Invalid, "address count must be positive")
+	}
+	return w.nextAddresses(ctx, op, w.persistReturnedChild(ctx, nil), account,
+		udb.ExternalBranch, count, callOpts...)
+}
+
 // NewInternalAddress returns an internal address.
 func (w *Wallet) NewInternalAddress(ctx context.Context, account uint32, callOpts ...NextAddressCallOption) (dcrutil.Address, error) {
 	const op errors.Op = "wallet.NewExternalAddress"
@@ -672,6 +830,7 @@ func (w *Wallet) SyncLastReturnedAddress(ctx context.Context, account, branch, c
 	var (
 		branchXpub *hdkeychain.ExtendedKey
 		lastUsed   uint32
+		gapLimit   uint32
 	)
 	err := func() error {
 		defer w.addressBuffersMu.Unlock()
@@ -693,6 +852,7 @@ func (w *Wallet) SyncLastReturnedAddress(ctx context.Context, account, branch, c
 
 		branchXpub = alb.branchXpub
 		lastUsed = alb.lastUsed
+		gapLimit = w.bufferGapLimit(acctData)
 		if lastUsed != ^uint32(0) && child > lastUsed {
 			alb.cursor = child - lastUsed
 		}
@@ -715,7 +875,6 @@ func (w *Wallet) SyncLastReturnedAddress(ctx context.Context, account, branch, c
 	}
 
 	if n, err := w.NetworkBackend(); err == nil {
-		gapLimit := uint32(w.gapLimit)
 		lastWatched := lastUsed + gapLimit
 		if child <= lastWatched {
 			// No need to derive anything more.
@@ -772,6 +931,71 @@ func (w *Wallet) AccountBranchAddressRange(account, branch, start, end uint32) (
 	return addrs, nil
 }
 
+// AddressPath describes a BIP0044 address and its derivation path relative to
+// the wallet's coin type key.
+type AddressPath struct {
+	Address dcrutil.Address
+	Account uint32
+	Branch  uint32
+	Index   uint32
+}
+
+// AccountAddressPaths returns every address of a BIP0044 account recorded by
+// the wallet, both used addresses and unused addresses within the gap limit,
+// paired with their derivation paths.  Paths are read from the address
+// manager, and addresses are derived from the cached branch xpubs of the
+// account.  Results are sorted by branch and then child index.
+func (w *Wallet) AccountAddressPaths(ctx context.Context, account uint32) ([]AddressPath, error) {
+	const op errors.Op = "wallet.AccountAddressPaths"
+
+	var paths []AddressPath
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.Manager.ForEachAccountAddressPath(ns, account, func(branch, index uint32) error {
+			paths = append(paths, AddressPath{
+				Account: account,
+				Branch:  branch,
+				Index:   index,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if paths[i].Branch != paths[j].Branch {
+			return paths[i].Branch < paths[j].Branch
+		}
+		return paths[i].Index < paths[j].Index
+	})
+
+	w.addressBuffersMu.Lock()
+	ad, ok := w.addressBuffers[account]
+	w.addressBuffersMu.Unlock()
+	if !ok {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("account %d", account))
+	}
+	for i := range paths {
+		p := &paths[i]
+		var branchXpub *hdkeychain.ExtendedKey
+		switch p.Branch {
+		case udb.ExternalBranch:
+			branchXpub = ad.albExternal.branchXpub
+		case udb.InternalBranch:
+			branchXpub = ad.albInternal.branchXpub
+		default:
+			return nil, errors.E(op, errors.Bug, errors.Errorf("account %d "+
+				"records address of unknown branch %d", account, p.Branch))
+		}
+		p.Address, err = deriveChildAddress(branchXpub, p.Index, w.chainParams)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	return paths, nil
+}
+
 type p2PKHChangeSource struct {
 	persist   persistReturnedChildFunc
 	account   uint32
@@ -781,7 +1005,7 @@ type p2PKHChangeSource struct {
 }
 
 func (src *p2PKHChangeSource) Script() ([]byte, uint16, error) {
-	changeAddress, err := src.wallet.newChangeAddress(src.ctx, "", src.persist, src.account, src.gapPolicy)
+	changeAddress, err := src.wallet.changeAddress(src.ctx, "", src.persist, src.account, src.gapPolicy)
 	if err != nil {
 		return nil, 0, err
 	}
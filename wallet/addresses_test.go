@@ -13,6 +13,7 @@ import (
 	"strings"
 	"testing"
 
+	"decred.org/dcrwallet/errors"
 	"decred.org/dcrwallet/wallet/walletdb"
 	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/dcrutil/v3"
@@ -147,7 +148,8 @@ func setupWallet(t *testing.T, cfg *Config) (*Wallet, walletdb.DB, func()) {
 		t.Fatal(err)
 	}
 	ctx := context.Background()
-	err = Create(ctx, opaqueDB{db}, pubPassphrase, privPassphrase, seed, cfg.Params)
+	err = Create(ctx, opaqueDB{db}, pubPassphrase, privPassphrase, seed, cfg.Params,
+		cfg.Params.SLIP0044CoinType)
 	if err != nil {
 		db.Close()
 		os.Remove(f.Name())
@@ -418,3 +420,152 @@ func useAddress(child uint32) func(t *testing.T, w *Wallet) {
 		watchFutureAddresses(t, w)
 	}
 }
+
+func TestAccountAddressPaths(t *testing.T) {
+	cfg := walletConfig
+	w, _, teardown := setupWallet(t, &cfg)
+	defer teardown()
+
+	ctx := context.Background()
+	for range expectedExternalAddrs {
+		if _, err := w.NewExternalAddress(ctx, defaultAccount); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for range expectedInternalAddrs {
+		if _, err := w.NewInternalAddress(ctx, defaultAccount); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	paths, err := w.AccountAddressPaths(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [2][]expectedAddr{expectedExternalAddrs, expectedInternalAddrs}
+	var counts [2]int
+	for _, p := range paths {
+		if p.Account != defaultAccount {
+			t.Fatalf("path %d/%d/%d has account %d", p.Account, p.Branch,
+				p.Index, p.Account)
+		}
+		if p.Branch > 1 {
+			t.Fatalf("path has unknown branch %d", p.Branch)
+		}
+		// Paths are sorted and every child index of a branch is
+		// recorded.
+		if p.Index != uint32(counts[p.Branch]) {
+			t.Fatalf("branch %d: path with index %d, expected %d",
+				p.Branch, p.Index, counts[p.Branch])
+		}
+		counts[p.Branch]++
+		if p.Index < uint32(len(expected[p.Branch])) {
+			want := expected[p.Branch][p.Index].address
+			if p.Address.String() != want {
+				t.Errorf("path %d/%d/%d: address %v, expected %v",
+					p.Account, p.Branch, p.Index, p.Address, want)
+			}
+		}
+	}
+	for branch := range counts {
+		if counts[branch] < len(expected[branch]) {
+			t.Errorf("branch %d: listed %d addresses, expected at least %d",
+				branch, counts[branch], len(expected[branch]))
+		}
+	}
+}
+
+func TestNewExternalAddresses(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	xpub, err := w.MasterPubKey(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seqCfg := basicWalletConfig
+	seq, teardown := testWatchingOnlyWallet(t, &seqCfg, xpub.String())
+	defer teardown()
+
+	// A batch exceeding the gap limit records no addresses.
+	_, err = w.NewExternalAddresses(ctx, 0, 1000)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("expected Policy error, got %v", err)
+	}
+	ext, _, err := w.BIP0044BranchNextIndexes(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ext != 0 {
+		t.Fatalf("failed batch advanced next external index to %d", ext)
+	}
+
+	// Batches return the same addresses as sequential derivation.
+	var batch []dcrutil.Address
+	for _, count := range []int{1, 10, 39} {
+		addrs, err := w.NewExternalAddresses(ctx, 0, count, WithGapPolicyIgnore())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(addrs) != count {
+			t.Fatalf("returned %d addresses, expected %d", len(addrs), count)
+		}
+		batch = append(batch, addrs...)
+	}
+	for i := range batch {
+		addr, err := seq.NewExternalAddress(ctx, 0, WithGapPolicyIgnore())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if batch[i].Address() != addr.Address() {
+			t.Errorf("batch address %d is %v, sequential address is %v", i,
+				batch[i], addr)
+		}
+	}
+	for _, w := range []*Wallet{w, seq} {
+		ext, _, err := w.BIP0044BranchNextIndexes(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ext != uint32(len(batch)) {
+			t.Errorf("next external index %d, expected %d", ext, len(batch))
+		}
+	}
+
+	_, err = w.NewExternalAddresses(ctx, 0, 0)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero count: expected Invalid, got %v", err)
+	}
+}
+
+func BenchmarkNewExternalAddresses(b *testing.B) {
+	const count = 100
+	ctx := context.Background()
+	b.Run("batch", func(b *testing.B) {
+		cfg := basicWalletConfig
+		w, teardown := testWallet(b, &cfg)
+		defer teardown()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, err := w.NewExternalAddresses(ctx, 0, count, WithGapPolicyIgnore())
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("sequential", func(b *testing.B) {
+		cfg := basicWalletConfig
+		w, teardown := testWallet(b, &cfg)
+		defer teardown()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < count; j++ {
+				_, err := w.NewExternalAddress(ctx, 0, WithGapPolicyIgnore())
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v3"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestAgendaChoices(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	_, deployments := CurrentAgendas(cfg.Params)
+	if len(deployments) == 0 {
+		t.Skip("no agendas for the current stake version")
+	}
+	agenda := &deployments[0].Vote
+	var choice *chaincfg.Choice
+	for i := range agenda.Choices {
+		if !agenda.Choices[i].IsAbstain {
+			choice = &agenda.Choices[i]
+			break
+		}
+	}
+	if choice == nil {
+		t.Fatalf("agenda %q has no non-abstain choice", agenda.Id)
+	}
+
+	// All agendas default to abstaining.
+	choices, voteBits, err := w.AgendaChoices(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(choices) != len(deployments) {
+		t.Fatalf("%d choices for %d agendas", len(choices), len(deployments))
+	}
+	for _, c := range choices {
+		if c.ChoiceID != "abstain" {
+			t.Errorf("agenda %q defaults to choice %q", c.AgendaID, c.ChoiceID)
+		}
+	}
+	if voteBits != 1 || w.VoteBits().Bits != 1 {
+		t.Errorf("default vote bits %#x, wallet vote bits %#x", voteBits, w.VoteBits().Bits)
+	}
+
+	// Unknown agendas and choices are rejected.
+	_, err = w.SetAgendaChoices(ctx, AgendaChoice{AgendaID: "unknown", ChoiceID: choice.Id})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown agenda: expected Invalid, got %v", err)
+	}
+	_, err = w.SetAgendaChoices(ctx, AgendaChoice{AgendaID: agenda.Id, ChoiceID: "unknown"})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown choice: expected Invalid, got %v", err)
+	}
+
+	wantBits := 1 | choice.Bits
+	voteBits, err = w.SetAgendaChoices(ctx, AgendaChoice{AgendaID: agenda.Id, ChoiceID: choice.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if voteBits != wantBits {
+		t.Errorf("set vote bits %#x, expected %#x", voteBits, wantBits)
+	}
+	choices, voteBits, err = w.AgendaChoices(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if choices[0].AgendaID != agenda.Id || choices[0].ChoiceID != choice.Id {
+		t.Errorf("read choice %+v, expected %q for agenda %q", choices[0],
+			choice.Id, agenda.Id)
+	}
+	if voteBits != wantBits {
+		t.Errorf("read vote bits %#x, expected %#x", voteBits, wantBits)
+	}
+
+	// The choice is persisted and reloaded by an opened wallet.
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		if vb := w.readDBVoteBits(dbtx); vb.Bits != wantBits {
+			t.Errorf("database vote bits %#x, expected %#x", vb.Bits, wantBits)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Votes are created with the vote bits of the choice.
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustScript := func(script []byte, err error) []byte {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+	ticket := wire.NewMsgTx()
+	ticket.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 1e8, nil))
+	ticket.AddTxOut(wire.NewTxOut(1e8, mustScript(txscript.PayToSStx(addr))))
+	ticket.AddTxOut(wire.NewTxOut(0, mustScript(txscript.GenerateSStxAddrPush(addr, 1e8, 0x0058))))
+	ticket.AddTxOut(wire.NewTxOut(0, mustScript(txscript.PayToSStxChange(addr))))
+	ticketHash := ticket.TxHash()
+	vote, err := createUnsignedVote(&ticketHash, ticket, 1000, &chainhash.Hash{2},
+		w.VoteBits(), w.subsidyCache, cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bits := stake.SSGenVoteBits(vote); bits != wantBits {
+		t.Errorf("vote has vote bits %#x, expected %#x", bits, wantBits)
+	}
+
+	// Setting the abstain choice clears the agenda's bits.
+	_, err = w.SetAgendaChoices(ctx, AgendaChoice{AgendaID: agenda.Id, ChoiceID: "abstain"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bits := w.VoteBits().Bits; bits != 1 {
+		t.Errorf("vote bits %#x after abstaining, expected 1", bits)
+	}
+}
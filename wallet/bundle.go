@@ -0,0 +1,68 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txauthor"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// SignBundle signs the inputs of a transaction bundle serialized by
+// txauthor.AuthoredTx.MarshalBinary, such as one authored by a watching-only
+// wallet and moved to an offline signer.  The previous output scripts recorded
+// by the bundle are used to sign each input, so the previous transactions need
+// not be known to the signing wallet.
+//
+// A locked wallet is unlocked with passphrase for the duration of the call.
+// Inputs of P2SH multisig outputs may be returned partially signed, and the
+// signatures of multiple signers may be combined with
+// txauthor.MergeSignatures.  An error with kind errors.ScriptFailure is returned
+// if any other input can not be signed.
+func (w *Wallet) SignBundle(ctx context.Context, bundle, passphrase []byte) (*wire.MsgTx, error) {
+	const op errors.Op = "wallet.SignBundle"
+
+	var atx txauthor.AuthoredTx
+	err := atx.UnmarshalBinary(bundle)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(atx.PrevScripts) != len(atx.Tx.TxIn) {
+		return nil, errors.E(op, errors.Invalid, "bundle is missing previous output scripts")
+	}
+
+	if w.Locked() {
+		err = w.Unlock(ctx, passphrase, nil)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		defer w.Lock()
+	} else {
+		w.passphraseUsedMu.RLock()
+		err = w.Manager.UnlockedWithPassphrase(passphrase)
+		w.passphraseUsedMu.RUnlock()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	prevScripts := make(map[wire.OutPoint][]byte, len(atx.PrevScripts))
+	for i, in := range atx.Tx.TxIn {
+		prevScripts[in.PreviousOutPoint] = atx.PrevScripts[i]
+	}
+	sigErrs, err := w.SignTransaction(ctx, atx.Tx, txscript.SigHashAll,
+		prevScripts, nil, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(sigErrs) != 0 {
+		return nil, errors.E(op, errors.ScriptFailure, errors.Errorf(
+			"input %d: %v", sigErrs[0].InputIndex, sigErrs[0].Error))
+	}
+	return atx.Tx, nil
+}
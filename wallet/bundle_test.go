@@ -0,0 +1,116 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/wallet/udb"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestSignBundle(t *testing.T) {
+	ctx := context.Background()
+
+	fullCfg := basicWalletConfig
+	full, teardown := testWallet(t, &fullCfg)
+	defer teardown()
+	xpub, err := full.MasterPubKey(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watchCfg := basicWalletConfig
+	watchOnly, teardown := testWatchingOnlyWallet(t, &watchCfg, xpub.String())
+	defer teardown()
+
+	addr, err := watchOnly.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fullAddr, err := full.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr.String() != fullAddr.String() {
+		t.Fatalf("watching-only address %v differs from %v", addr, fullAddr)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Fund the watching-only wallet with two unmined outputs.
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 3e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	funding.AddTxOut(wire.NewTxOut(2e8, pkScript))
+	rec, err := udb.NewTxRecordFromMsgTx(funding, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, watchOnly.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := watchOnly.TxStore.InsertMemPoolTx(ns, rec)
+		if err != nil {
+			return err
+		}
+		for i := range funding.TxOut {
+			err = watchOnly.TxStore.AddCredit(ns, rec, nil, uint32(i), false, 0)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputs := []*wire.TxOut{wire.NewTxOut(2.5e8, pkScript)}
+	atx, err := watchOnly.NewUnsignedTransaction(ctx, outputs, dcrutil.Amount(1e4),
+		0, 0, OutputSelectionAlgorithmDefault, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle, err := atx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The watching-only wallet can not sign the bundle.
+	if _, err := watchOnly.SignBundle(ctx, bundle, []byte("private")); err == nil {
+		t.Fatal("watching-only wallet signed bundle")
+	}
+	if _, err := full.SignBundle(ctx, bundle, []byte("wrong")); err == nil {
+		t.Fatal("signed bundle with incorrect passphrase")
+	}
+
+	signed, err := full.SignBundle(ctx, bundle, []byte("private"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signed.TxHash() != atx.Tx.TxHash() {
+		t.Errorf("signed transaction %v differs from authored %v",
+			signed.TxHash(), atx.Tx.TxHash())
+	}
+	if !full.Locked() {
+		t.Errorf("wallet was left unlocked")
+	}
+	for i := range signed.TxIn {
+		vm, err := txscript.NewEngine(atx.PrevScripts[i], signed, i,
+			sanityVerifyFlags, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Errorf("input %d: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/gcs"
+)
+
+// MatchFilter returns whether any of the output scripts may be committed to by
+// the regular compact filter of a block.  The key must be the filter key of the
+// block, as returned by blockcf.Key.  As filters are probabilistic, a match
+// may be a false positive, but a script committed to by the filter always
+// matches.
+//
+// MatchFilter does not require a wallet and does not modify the filter or
+// scripts, and is safe for concurrent use.
+func MatchFilter(filter *gcs.Filter, key [gcs.KeySize]byte, scripts [][]byte) (bool, error) {
+	const op errors.Op = "wallet.MatchFilter"
+	if filter == nil {
+		return false, errors.E(op, errors.Invalid, "nil filter")
+	}
+	return matchFilter(filter, key, scripts), nil
+}
+
+func matchFilter(filter *gcs.Filter, key [gcs.KeySize]byte, scripts [][]byte) bool {
+	// Empty filters commit to no data and can not be matched against.
+	if filter.N() == 0 || len(scripts) == 0 {
+		return false
+	}
+	return filter.MatchAny(key, scripts)
+}
+
+// MatchFilters matches output scripts against the regular compact filters of
+// many blocks, returning the indexes of the filters which matched in
+// increasing order.  keys[i] must be the filter key of the block of
+// filters[i].  See MatchFilter for more details.
+func MatchFilters(filters []*gcs.Filter, keys [][gcs.KeySize]byte, scripts [][]byte) ([]int, error) {
+	const op errors.Op = "wallet.MatchFilters"
+	if len(filters) != len(keys) {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("%d filters "+
+			"with %d keys", len(filters), len(keys)))
+	}
+	var matches []int
+	for i, f := range filters {
+		if f == nil {
+			return nil, errors.E(op, errors.Invalid,
+				errors.Errorf("nil filter at index %d", i))
+		}
+		if matchFilter(f, keys[i], scripts) {
+			matches = append(matches, i)
+		}
+	}
+	return matches, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/gcs"
+	"github.com/decred/dcrd/gcs/blockcf"
+)
+
+// testScript returns a unique P2PKH-like script for n.
+func testScript(n byte) []byte {
+	script := make([]byte, 25)
+	script[0] = 0x76
+	script[1] = 0xa9
+	script[2] = 0x14
+	for i := 3; i < 23; i++ {
+		script[i] = n
+	}
+	script[23] = 0x88
+	script[24] = 0xac
+	return script
+}
+
+// testFilter creates a regular compact filter committing to scripts for the
+// block with hash h.
+func testFilter(t *testing.T, h *chainhash.Hash, scripts ...[]byte) *gcs.Filter {
+	t.Helper()
+	f, err := gcs.NewFilter(blockcf.P, blockcf.Key(h), scripts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestMatchFilter(t *testing.T) {
+	h := chainhash.HashH([]byte("block"))
+	key := blockcf.Key(&h)
+	f := testFilter(t, &h, testScript(1), testScript(2))
+
+	tests := []struct {
+		name    string
+		filter  *gcs.Filter
+		key     [gcs.KeySize]byte
+		scripts [][]byte
+		match   bool
+	}{
+		{"committed script", f, key, [][]byte{testScript(2)}, true},
+		{"one of many", f, key, [][]byte{testScript(3), testScript(1)}, true},
+		{"uncommitted script", f, key, [][]byte{testScript(3)}, false},
+		{"no scripts", f, key, nil, false},
+		{"empty filter", testFilter(t, &h), key, [][]byte{testScript(1)}, false},
+	}
+	for _, test := range tests {
+		match, err := MatchFilter(test.filter, test.key, test.scripts)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if match != test.match {
+			t.Errorf("%s: match %v, expected %v", test.name, match, test.match)
+		}
+	}
+
+	_, err := MatchFilter(nil, key, [][]byte{testScript(1)})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for nil filter, got %v", err)
+	}
+
+	// Matching is safe for concurrent use.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			match, err := MatchFilter(f, key, [][]byte{testScript(1)})
+			if err != nil || !match {
+				t.Errorf("concurrent match %v, err %v", match, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMatchFilters(t *testing.T) {
+	var filters []*gcs.Filter
+	var keys [][gcs.KeySize]byte
+	for i, scripts := range [][][]byte{
+		{testScript(1)},
+		{testScript(2), testScript(3)},
+		{testScript(4), testScript(1)},
+		{},
+	} {
+		h := chainhash.HashH([]byte{byte(i)})
+		filters = append(filters, testFilter(t, &h, scripts...))
+		keys = append(keys, blockcf.Key(&h))
+	}
+
+	matches, err := MatchFilters(filters, keys, [][]byte{testScript(1), testScript(5)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 2}; !reflect.DeepEqual(matches, want) {
+		t.Errorf("matched filters %v, expected %v", matches, want)
+	}
+
+	matches, err = MatchFilters(filters, keys, [][]byte{testScript(6)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 0 {
+		t.Errorf("matched filters %v, expected none", matches)
+	}
+
+	_, err = MatchFilters(filters, keys[1:], [][]byte{testScript(1)})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for mismatched keys, got %v", err)
+	}
+	_, err = MatchFilters([]*gcs.Filter{nil}, keys[:1], [][]byte{testScript(1)})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for nil filter, got %v", err)
+	}
+}
@@ -615,8 +615,15 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 	// wallet key.  If so, mark the output as a credit and mark
 	// outpoints to watch.
 	for i, output := range rec.MsgTx.TxOut {
+		// Treasury spend outputs are tagged by OP_TGEN, which is not
+		// recognized by txscript.  Addresses are extracted from the
+		// untagged script.
+		pkScript, isTreasuryGen := udb.TreasuryGenScript(output.PkScript)
+		if !isTreasuryGen {
+			pkScript = output.PkScript
+		}
 		class, addrs, _, err := txscript.ExtractPkScriptAddrs(output.Version,
-			output.PkScript, w.chainParams)
+			pkScript, w.chainParams)
 		if err != nil {
 			// Non-standard outputs are skipped.
 			continue
@@ -655,7 +662,7 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 		}
 
 		var tree int8
-		if isStakeType {
+		if isStakeType || isTreasuryGen {
 			tree = 1
 		}
 		outpoint := wire.OutPoint{Hash: rec.Hash, Tree: tree}
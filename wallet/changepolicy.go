@@ -0,0 +1,107 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v3"
+)
+
+type changePolicyMode int
+
+const (
+	changePolicyFresh changePolicyMode = iota
+	changePolicyStatic
+	changePolicyRoundRobin
+)
+
+// ChangeAddressPolicy describes how the change addresses of transactions
+// created by the wallet are chosen.  The zero value is FreshPerTx.
+type ChangeAddressPolicy struct {
+	mode changePolicyMode
+	addr dcrutil.Address
+	n    uint32
+}
+
+// FreshPerTx pays each change output to a new internal address of the
+// account, advancing the account's internal branch for every change output.
+var FreshPerTx = ChangeAddressPolicy{}
+
+// StaticAddress pays all change to a single P2PKH address.  No internal branch
+// addresses are returned for change, so the internal branch is never advanced
+// when creating transactions.
+func StaticAddress(addr dcrutil.Address) ChangeAddressPolicy {
+	return ChangeAddressPolicy{mode: changePolicyStatic, addr: addr}
+}
+
+// RoundRobin pays change to n internal addresses of each account in turn.
+// The first n change outputs of an account are paid to new internal addresses,
+// advancing the internal branch, and later change outputs reuse these
+// addresses in the order they were returned.  The addresses are remembered
+// only for the lifetime of the opened wallet.  An n of zero is equivalent to
+// FreshPerTx.
+func RoundRobin(n uint32) ChangeAddressPolicy {
+	if n == 0 {
+		return FreshPerTx
+	}
+	return ChangeAddressPolicy{mode: changePolicyRoundRobin, n: n}
+}
+
+// check returns an error if the policy can not be used to pay change.
+func (p *ChangeAddressPolicy) check() error {
+	if p.mode != changePolicyStatic {
+		return nil
+	}
+	addr, ok := p.addr.(*dcrutil.AddressPubKeyHash)
+	if !ok || addr.DSA() != dcrec.STEcdsaSecp256k1 {
+		return errors.E(errors.Invalid, "static change address must be P2PKH")
+	}
+	return nil
+}
+
+// roundRobinChange records the change addresses of an account which are reused
+// by the RoundRobin policy.
+type roundRobinChange struct {
+	addrs []dcrutil.Address
+	next  int
+}
+
+// changeAddress returns the address to pay change to for an account according
+// to the wallet's change address policy.  New internal addresses are returned
+// by newChangeAddress.
+func (w *Wallet) changeAddress(ctx context.Context, op errors.Op, persist persistReturnedChildFunc,
+	account uint32, gap gapPolicy) (dcrutil.Address, error) {
+
+	switch w.changePolicy.mode {
+	case changePolicyStatic:
+		return w.changePolicy.addr, nil
+
+	case changePolicyRoundRobin:
+		defer w.changeRoundRobinMu.Unlock()
+		w.changeRoundRobinMu.Lock()
+
+		rr := w.changeRoundRobin[account]
+		if rr == nil {
+			rr = new(roundRobinChange)
+			w.changeRoundRobin[account] = rr
+		}
+		if uint32(len(rr.addrs)) < w.changePolicy.n {
+			addr, err := w.newChangeAddress(ctx, op, persist, account, gap)
+			if err != nil {
+				return nil, err
+			}
+			rr.addrs = append(rr.addrs, addr)
+			return addr, nil
+		}
+		addr := rr.addrs[rr.next]
+		rr.next = (rr.next + 1) % len(rr.addrs)
+		return addr, nil
+	}
+
+	return w.newChangeAddress(ctx, op, persist, account, gap)
+}
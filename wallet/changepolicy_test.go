@@ -0,0 +1,123 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestChangeAddressPolicy(t *testing.T) {
+	ctx := context.Background()
+	params := basicWalletConfig.Params
+	static, err := dcrutil.NewAddressPubKeyHash(make([]byte, 20), params,
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const txs = 5
+	tests := []struct {
+		name     string
+		policy   ChangeAddressPolicy
+		consumed uint32 // new internal addresses after authoring txs
+		changes  []int  // index of the distinct change address of each tx
+	}{
+		{"fresh", FreshPerTx, txs, []int{0, 1, 2, 3, 4}},
+		{"static", StaticAddress(static), 0, []int{0, 0, 0, 0, 0}},
+		{"round robin", RoundRobin(2), 2, []int{0, 1, 0, 1, 0}},
+		{"round robin zero", RoundRobin(0), txs, []int{0, 1, 2, 3, 4}},
+	}
+	for _, test := range tests {
+		cfg := basicWalletConfig
+		cfg.ChangeAddressPolicy = test.policy
+		w, teardown := testWallet(t, &cfg)
+
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		funding := wire.NewMsgTx()
+		funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 1e8, nil))
+		funding.AddTxOut(wire.NewTxOut(1e8, pkScript))
+		err = w.AcceptMempoolTx(ctx, funding)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, intBefore, err := w.BIP0044BranchNextIndexes(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var distinct []string
+		for i := 0; i < txs; i++ {
+			outputs := []*wire.TxOut{wire.NewTxOut(1e7, make([]byte, 25))}
+			atx, err := w.NewUnsignedTransaction(ctx, outputs, 1e4, 0, 0,
+				OutputSelectionAlgorithmDefault, nil)
+			if err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+			change, ok := atx.ChangeOutput()
+			if !ok {
+				t.Fatalf("%s: tx %d has no change output", test.name, i)
+			}
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(change.Version,
+				change.PkScript, params)
+			if err != nil || len(addrs) != 1 {
+				t.Fatalf("%s: change script: %v", test.name, err)
+			}
+			changeAddr := addrs[0].Address()
+			index := len(distinct)
+			for j, a := range distinct {
+				if a == changeAddr {
+					index = j
+					break
+				}
+			}
+			if index == len(distinct) {
+				distinct = append(distinct, changeAddr)
+			}
+			if index != test.changes[i] {
+				t.Errorf("%s: tx %d paid change to address %d, expected %d",
+					test.name, i, index, test.changes[i])
+			}
+		}
+		_, intAfter, err := w.BIP0044BranchNextIndexes(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if consumed := intAfter - intBefore; consumed != test.consumed {
+			t.Errorf("%s: consumed %d internal addresses, expected %d",
+				test.name, consumed, test.consumed)
+		}
+		if test.policy.mode == changePolicyStatic && distinct[0] != static.Address() {
+			t.Errorf("%s: change paid to %v, expected %v", test.name,
+				distinct[0], static)
+		}
+
+		teardown()
+	}
+
+	// Static change addresses must be P2PKH.
+	p2sh, err := dcrutil.NewAddressScriptHashFromHash(make([]byte, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := StaticAddress(p2sh)
+	if err := policy.check(); !errors.Is(err, errors.Invalid) {
+		t.Errorf("P2SH static change address: expected Invalid, got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/udb"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v3"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// BumpFeeCPFP creates and signs a child transaction which accelerates the
+// confirmation of an unmined parent transaction.  The child spends the largest
+// unspent and unlocked wallet output of the parent and pays a fee large enough
+// for the combined parent and child package to pay targetFeeRate.  All
+// remaining value is returned to a change address of the spent output's
+// account.
+//
+// The parent's fee is calculated from the input values recorded by the
+// parent.  If the parent has no wallet output which may be spent, an error with
+// kind errors.Invalid is returned, and if the output can not pay the child's
+// fee and a non-dust change output, an error with kind
+// errors.InsufficientBalance is returned.
+//
+// The child transaction is not recorded or published by the wallet.
+func (w *Wallet) BumpFeeCPFP(ctx context.Context, parentTx *chainhash.Hash,
+	targetFeeRate dcrutil.Amount) (*txauthor.AuthoredTx, error) {
+
+	const op errors.Op = "wallet.BumpFeeCPFP"
+
+	var atx *txauthor.AuthoredTx
+	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		details, err := w.TxStore.TxDetails(txmgrNs, parentTx)
+		if err != nil {
+			return err
+		}
+		if details.Block.Height != -1 {
+			return errors.E(errors.Invalid, errors.Errorf("parent "+
+				"transaction %v is already mined", parentTx))
+		}
+		if details.TxType != stake.TxTypeRegular {
+			return errors.E(errors.Invalid, errors.Errorf("parent "+
+				"transaction %v is not a regular transaction", parentTx))
+		}
+		parent := &details.MsgTx
+
+		// The parent fee is the difference of the input and output values.
+		// A parent which does not record its input values is assumed to
+		// pay no fee.
+		var parentFee int64
+		for _, in := range parent.TxIn {
+			parentFee += in.ValueIn
+		}
+		for _, out := range parent.TxOut {
+			parentFee -= out.Value
+		}
+		if parentFee < 0 {
+			parentFee = 0
+		}
+		parentSize := parent.SerializeSize()
+		parentFeeRate := dcrutil.Amount(parentFee * 1000 / int64(parentSize))
+
+		// Select the largest wallet output of the parent which has not been
+		// spent or locked.
+		var credit *udb.CreditRecord
+		for i := range details.Credits {
+			c := &details.Credits[i]
+			out := wire.OutPoint{Hash: *parentTx, Index: c.Index, Tree: wire.TxTreeRegular}
+			if c.Spent || w.LockedOutpoint(out) {
+				continue
+			}
+			if credit == nil || c.Amount > credit.Amount {
+				credit = c
+			}
+		}
+		if credit == nil {
+			return errors.E(errors.Invalid, errors.Errorf("parent "+
+				"transaction %v has no spendable wallet outputs", parentTx))
+		}
+		output := parent.TxOut[credit.Index]
+
+		// Return change to the account of the spent output.  Change from
+		// imported addresses is returned to the default account.
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(output.Version,
+			output.PkScript, w.chainParams)
+		if err != nil {
+			return err
+		}
+		if len(addrs) != 1 {
+			return errors.E(errors.Invalid, "parent output does not pay "+
+				"a single address")
+		}
+		account, err := w.Manager.AddrAccount(addrmgrNs, addrs[0])
+		if err != nil {
+			return err
+		}
+		switch {
+		case account == udb.ImportedAddrAccount:
+			account = udb.DefaultAccountNum
+		case account > udb.ImportedAddrAccount:
+			return errors.E(errors.WatchingOnly, errors.Errorf("account "+
+				"%d does not record private keys", account))
+		}
+
+		outpoints := []wire.OutPoint{{Hash: *parentTx, Index: credit.Index,
+			Tree: wire.TxTreeRegular}}
+		lookup := func(wire.OutPoint) (*wire.TxOut, []byte, error) {
+			return output, nil, nil
+		}
+		inputSource := txauthor.ConstrainedInputSource(outpoints, lookup)
+		changeSource := &p2PKHChangeSource{
+			persist: w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
+			account: account,
+			wallet:  w,
+			ctx:     ctx,
+		}
+		atx, _, err = txauthor.NewChildPaysForParentTx(op, parent,
+			parentFeeRate, targetFeeRate, inputSource, changeSource)
+		if err != nil {
+			return err
+		}
+		w.NtfnServer.notifyAuthoredTx(atx.Tx)
+
+		secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
+		err = atx.AddAllInputScripts(secrets, dcrec.STEcdsaSecp256k1)
+		for _, done := range secrets.doneFuncs {
+			done()
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Ensure valid signatures were created.
+	err = validateMsgTx(op, atx.Tx, atx.PrevScripts)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if len(changeSourceUpdates) != 0 {
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			for _, up := range changeSourceUpdates {
+				err := up(dbtx)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	return atx, nil
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/udb"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestBumpFeeCPFP(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The parent pays two outputs to the wallet and a fee of one atom per
+	// byte.
+	parent := wire.NewMsgTx()
+	parent.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 0, nil))
+	parent.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	parent.AddTxOut(wire.NewTxOut(5e7, pkScript))
+	parentSize := parent.SerializeSize()
+	parentFee := dcrutil.Amount(parentSize)
+	parent.TxIn[0].ValueIn = 15e7 + int64(parentFee)
+
+	// unrelated has no wallet outputs.
+	unrelated := wire.NewMsgTx()
+	unrelated.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 1e8, nil))
+	unrelated.AddTxOut(wire.NewTxOut(1e8, make([]byte, 25)))
+
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for _, tx := range []*wire.MsgTx{parent, unrelated} {
+			rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+			if err != nil {
+				return err
+			}
+			err = w.TxStore.InsertMemPoolTx(ns, rec)
+			if err != nil {
+				return err
+			}
+			if tx != parent {
+				continue
+			}
+			for i := range tx.TxOut {
+				err = w.TxStore.AddCredit(ns, rec, nil, uint32(i), false, 0)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parentHash := parent.TxHash()
+
+	const targetFeeRate dcrutil.Amount = 1e5
+	child, err := w.BumpFeeCPFP(ctx, &parentHash, targetFeeRate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The child spends the largest parent output.
+	wantPrevOut := wire.OutPoint{Hash: parentHash, Index: 0, Tree: wire.TxTreeRegular}
+	if len(child.Tx.TxIn) != 1 || child.Tx.TxIn[0].PreviousOutPoint != wantPrevOut {
+		t.Fatalf("child does not only spend %v", &wantPrevOut)
+	}
+	if len(child.Tx.TxOut) != 1 || child.ChangeIndex != 0 {
+		t.Fatalf("child created %d outputs with change index %d, expected "+
+			"a single change output", len(child.Tx.TxOut), child.ChangeIndex)
+	}
+	if size := child.Tx.SerializeSize(); size > child.EstimatedSignedSerializeSize {
+		t.Errorf("signed child size %d exceeds estimate %d", size,
+			child.EstimatedSignedSerializeSize)
+	}
+
+	// The child pays the package fee at the target rate, less the parent's
+	// fee.
+	childSize := child.EstimatedSignedSerializeSize
+	wantFee := txrules.FeeForSerializeSize(targetFeeRate, parentSize+childSize) - parentFee
+	childFee := child.TotalInput - dcrutil.Amount(child.Tx.TxOut[0].Value)
+	if childFee != wantFee {
+		t.Errorf("child fee %v, expected %v", childFee, wantFee)
+	}
+	if child.TotalInput != 1e8 {
+		t.Errorf("child input amount %v, expected %v", child.TotalInput,
+			dcrutil.Amount(1e8))
+	}
+
+	tests := []struct {
+		name    string
+		parent  chainhash.Hash
+		feeRate dcrutil.Amount
+		lock    bool
+		kind    errors.Kind
+	}{
+		{"unknown parent", chainhash.Hash{1}, targetFeeRate, false, errors.NotExist},
+		{"no wallet outputs", unrelated.TxHash(), targetFeeRate, false, errors.Invalid},
+		{"insufficient output", parentHash, 1e11, false, errors.InsufficientBalance},
+		{"locked outputs", parentHash, targetFeeRate, true, errors.Invalid},
+	}
+	for _, test := range tests {
+		if test.lock {
+			for i := range parent.TxOut {
+				op := wire.OutPoint{Hash: parentHash, Index: uint32(i)}
+				if err := w.LockOutpoint(ctx, op, false); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		_, err := w.BumpFeeCPFP(ctx, &test.parent, test.feeRate)
+		if !errors.Is(err, test.kind) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.kind, err)
+		}
+	}
+}
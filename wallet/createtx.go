@@ -77,6 +77,13 @@ const (
 	// OutputSelectionAlgorithmAll describes the output selection algorithm of
 	// picking every possible available output.  This is useful for sweeping.
 	OutputSelectionAlgorithmAll
+
+	// OutputSelectionAlgorithmEffectiveValue describes the output selection
+	// algorithm of picking outputs with the largest effective value first,
+	// that is, the output value less the fee of spending it.  Outputs which
+	// cost more to spend than they are worth are only picked when the
+	// target can not be met without them.
+	OutputSelectionAlgorithmEffectiveValue
 )
 
 // NewUnsignedTransaction constructs an unsigned transaction using unspent
@@ -85,12 +92,81 @@ const (
 // The changeSource parameter is optional and can be nil.  When nil, and if a
 // change output should be added, an internal change address is created for the
 // account.
+//
+// If the outputs can not be paid without exceeding the maximum transaction
+// size, an error with kind errors.TooManyInputs is returned and callers may
+// split the outputs across multiple transactions.  If the fee would exceed
+// the wallet's MaxFee, an error with kind errors.FeeExceedsLimit is returned.
+//
+// Every output is checked against the wallet's dust policy before any inputs
+// are selected.  If any output is dust, an error with kind errors.DustOutput
+// wrapping a *txauthor.DustOutputError naming the first dust output is
+// returned.
+//
+// Clients of AuthoredTxNotifications are notified of the created transaction.
 func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxOut,
 	relayFeePerKb dcrutil.Amount, account uint32, minConf int32,
 	algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource) (*txauthor.AuthoredTx, error) {
 
+	authoredTx, err := w.newUnsignedTransaction(ctx, outputs, relayFeePerKb,
+		account, minConf, algo, changeSource, false)
+	if err != nil {
+		return nil, err
+	}
+	w.NtfnServer.notifyAuthoredTx(authoredTx.Tx)
+	return authoredTx, nil
+}
+
+// NewUnsignedTransactionWithData constructs an unsigned transaction in the
+// same manner as NewUnsignedTransaction, with an additional zero-value null
+// data (OP_RETURN) output carrying each data payload following the provided
+// outputs.  The data outputs are included in the size estimate used to
+// calculate the fee and are never considered dust.
+//
+// An error with kind errors.Invalid is returned if any payload exceeds the
+// maximum standard null data payload size.
+func (w *Wallet) NewUnsignedTransactionWithData(ctx context.Context, outputs []*wire.TxOut,
+	data [][]byte, relayFeePerKb dcrutil.Amount, account uint32, minConf int32,
+	algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource) (*txauthor.AuthoredTx, error) {
+
+	const op errors.Op = "wallet.NewUnsignedTransactionWithData"
+
+	withData := make([]*wire.TxOut, 0, len(outputs)+len(data))
+	withData = append(withData, outputs...)
+	for _, d := range data {
+		if len(d) > txscript.MaxDataCarrierSize {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("data "+
+				"length %d exceeds maximum %d", len(d), txscript.MaxDataCarrierSize))
+		}
+		script, err := txscript.GenerateProvablyPruneableOut(d)
+		if err != nil {
+			return nil, errors.E(op, errors.Invalid, err)
+		}
+		withData = append(withData, &wire.TxOut{Value: 0, Version: 0, PkScript: script})
+	}
+	return w.NewUnsignedTransaction(ctx, withData, relayFeePerKb, account,
+		minConf, algo, changeSource)
+}
+
+// newUnsignedTransaction implements NewUnsignedTransaction without notifying
+// clients of the created transaction, and is used directly when transactions
+// are only created to determine whether outputs can be funded.  If holdLocks is
+// true, the selected inputs remain locked in memory when a transaction is
+// created, and the caller is responsible for unlocking them.
+func (w *Wallet) newUnsignedTransaction(ctx context.Context, outputs []*wire.TxOut,
+	relayFeePerKb dcrutil.Amount, account uint32, minConf int32,
+	algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource,
+	holdLocks bool) (*txauthor.AuthoredTx, error) {
+
 	const op errors.Op = "wallet.NewUnsignedTransaction"
 
+	// Reject dust payment outputs before the database is read or any inputs
+	// are selected.  Change which would be dust is still added to the fee.
+	err := txauthor.CheckDustOutputs(w.DustPolicy, outputs, relayFeePerKb)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	var unlockOutpoints []*wire.OutPoint
 	defer func() {
 		if len(unlockOutpoints) != 0 {
@@ -108,7 +184,7 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 
 	var authoredTx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
-	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
@@ -128,7 +204,8 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 		var inputSource txauthor.InputSource
 		switch algo {
 		case OutputSelectionAlgorithmDefault:
-			inputSource = sourceImpl.SelectInputs
+			inputSource = w.selectorInputSource(txmgrNs, addrmgrNs, account,
+				minConf, tipHeight, ignoreInput, relayFeePerKb).SelectInputs
 		case OutputSelectionAlgorithmAll:
 			// Wrap the source with one that always fetches the max amount
 			// available and ignores insufficient balance issues.
@@ -139,6 +216,9 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 				}
 				return inputDetail, err
 			}
+		case OutputSelectionAlgorithmEffectiveValue:
+			inputSource = txauthor.EffectiveValueInputSource(
+				sourceImpl.SelectInputs, relayFeePerKb)
 		default:
 			return errors.E(errors.Invalid,
 				errors.Errorf("unknown output selection algorithm %v", algo))
@@ -153,18 +233,250 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 			}
 		}
 
+		reserve, err := w.reserveOption(txmgrNs, addrmgrNs, minConf, account)
+		if err != nil {
+			return err
+		}
+
 		defer w.lockedOutpointMu.Unlock()
 		w.lockedOutpointMu.Lock()
 
-		var err error
 		authoredTx, err = txauthor.NewUnsignedTransaction(outputs, relayFeePerKb,
-			inputSource, changeSource, w.chainParams.MaxTxSize)
+			inputSource, changeSource, w.chainParams.MaxTxSize,
+			w.authorOptions(reserve)...)
 		if err != nil {
 			return err
 		}
-		for _, in := range authoredTx.Tx.TxIn {
-			w.lockedOutpoints[in.PreviousOutPoint] = struct{}{}
-			unlockOutpoints = append(unlockOutpoints, &in.PreviousOutPoint)
+		for i := range authoredTx.PrevOutpoints {
+			prevOut := &authoredTx.PrevOutpoints[i]
+			w.lockedOutpoints[*prevOut] = struct{}{}
+			unlockOutpoints = append(unlockOutpoints, prevOut)
+		}
+		if w.CheckAddressReuse {
+			authoredTx.ReusedAddresses, err = w.reusedAddresses(addrmgrNs, outputs)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(changeSourceUpdates) != 0 {
+		err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
+			for _, up := range changeSourceUpdates {
+				err := up(tx)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	if holdLocks {
+		unlockOutpoints = nil
+	}
+	return authoredTx, nil
+}
+
+// reusedAddresses returns the wallet addresses paid by outputs which have
+// already been used.  Only BIP0044 account addresses are checked, using the
+// last used child index of their account branch, as usage of imported and
+// other addresses is not recorded.  Addresses which do not belong to the wallet
+// are not checked.
+func (w *Wallet) reusedAddresses(addrmgrNs walletdb.ReadBucket, outputs []*wire.TxOut) ([]dcrutil.Address, error) {
+	var reused []dcrutil.Address
+	props := make(map[uint32]*udb.AccountProperties)
+	for _, out := range outputs {
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(out.Version,
+			out.PkScript, w.chainParams)
+		if err != nil {
+			// Non-standard outputs are skipped.
+			continue
+		}
+		for _, addr := range addrs {
+			ma, err := w.Manager.Address(addrmgrNs, addr)
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			pkma, ok := ma.(udb.ManagedPubKeyAddress)
+			if !ok || ma.Imported() {
+				continue
+			}
+			p, ok := props[ma.Account()]
+			if !ok {
+				p, err = w.Manager.AccountProperties(addrmgrNs, ma.Account())
+				if err != nil {
+					return nil, err
+				}
+				props[ma.Account()] = p
+			}
+			lastUsed := p.LastUsedExternalIndex
+			if ma.Internal() {
+				lastUsed = p.LastUsedInternalIndex
+			}
+			// The addition allows the comparison to work when no
+			// addresses are used and lastUsed is ^uint32(0).
+			if pkma.Index()+1 <= lastUsed+1 {
+				reused = append(reused, addr)
+			}
+		}
+	}
+	return reused, nil
+}
+
+// reserveOption returns the option preventing a transaction spending from
+// accounts from spending into the wallet's reserve of their total spendable
+// balance.
+func (w *Wallet) reserveOption(txmgrNs, addrmgrNs walletdb.ReadBucket, minConf int32,
+	accounts ...uint32) (txauthor.Option, error) {
+
+	if w.Reserve == 0 {
+		return txauthor.WithReserve(0, 0), nil
+	}
+	balances, err := w.TxStore.AccountBalances(txmgrNs, addrmgrNs, minConf)
+	if err != nil {
+		return nil, err
+	}
+	var balance dcrutil.Amount
+	for _, account := range accounts {
+		if b, ok := balances[account]; ok {
+			balance += b.Spendable
+		}
+	}
+	return txauthor.WithReserve(balance, w.Reserve), nil
+}
+
+// authorOptions returns the txauthor options for transactions created by the
+// wallet, configured by the wallet's transaction creation fields and keeping
+// the spendable balance reserve described by reserve.
+func (w *Wallet) authorOptions(reserve txauthor.Option) []txauthor.Option {
+	opts := []txauthor.Option{
+		txauthor.WithDustPolicy(w.DustPolicy),
+		txauthor.WithEconomicChange(w.EconomicChange),
+		txauthor.WithMaxFee(w.MaxFee),
+		reserve,
+	}
+	if w.CeilFee {
+		opts = append(opts, txauthor.WithCeilFee())
+	}
+	// A fee schedule replaces the fee calculation of WithCeilFee.
+	return append(opts, txauthor.WithFeeSchedule(w.FeeSchedule))
+}
+
+// selectorInputSource returns the input source used by the default output
+// selection algorithm to spend outputs of account.  Outputs are chosen by
+// w.CoinSelector when set, and otherwise by udb.DefaultCoinSelector.
+func (w *Wallet) selectorInputSource(txmgrNs, addrmgrNs walletdb.ReadBucket, account uint32,
+	minConf, tipHeight int32, ignore func(*wire.OutPoint) bool,
+	feeRate dcrutil.Amount) udb.InputSource {
+
+	var selector udb.CoinSelector = udb.DefaultCoinSelector{}
+	if w.CoinSelector != nil {
+		selector = w.CoinSelector
+	}
+	return w.TxStore.CoinSelectorInputSource(txmgrNs, addrmgrNs, account,
+		minConf, tipHeight, ignore, selector, feeRate)
+}
+
+// NewUnsignedTransactionMultiAccount constructs an unsigned transaction using
+// unspent outputs of several accounts, returning any change to changeAccount.
+// Accounts without any outputs with at least minConf confirmations are
+// skipped, and accounts holding more dust outputs are spent from first.  The
+// PrevAccounts of the authored transaction record the account of each input.
+// Inputs are signed using the keys of their previous output addresses, so the
+// transaction may be signed with SignTransaction regardless of the accounts
+// spent from.
+//
+// Clients of AuthoredTxNotifications are notified of the created transaction.
+func (w *Wallet) NewUnsignedTransactionMultiAccount(ctx context.Context, outputs []*wire.TxOut,
+	relayFeePerKb dcrutil.Amount, accounts []uint32, changeAccount uint32,
+	minConf int32) (*txauthor.AuthoredTx, error) {
+
+	const op errors.Op = "wallet.NewUnsignedTransactionMultiAccount"
+
+	if len(accounts) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no accounts to spend from")
+	}
+
+	var unlockOutpoints []*wire.OutPoint
+	defer func() {
+		if len(unlockOutpoints) != 0 {
+			w.lockedOutpointMu.Lock()
+			for _, op := range unlockOutpoints {
+				delete(w.lockedOutpoints, *op)
+			}
+			w.lockedOutpointMu.Unlock()
+		}
+	}()
+	ignoreInput := func(op *wire.OutPoint) bool {
+		_, ok := w.lockedOutpoints[*op]
+		return ok
+	}
+
+	var authoredTx *txauthor.AuthoredTx
+	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+
+		lastAcct, err := w.Manager.LastAccount(addrmgrNs)
+		if err != nil {
+			return err
+		}
+		missing := func(account uint32) bool {
+			return account != udb.ImportedAddrAccount && account > lastAcct
+		}
+		for _, account := range accounts {
+			if missing(account) {
+				return errors.E(errors.NotExist, "missing account")
+			}
+		}
+		if missing(changeAccount) {
+			return errors.E(errors.NotExist, "missing change account")
+		}
+
+		inputSource := w.TxStore.MultiAccountInputSource(txmgrNs, addrmgrNs,
+			accounts, minConf, tipHeight, ignoreInput)
+		changeSource := &p2PKHChangeSource{
+			persist: w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
+			account: changeAccount,
+			wallet:  w,
+			ctx:     ctx,
+		}
+
+		reserve, err := w.reserveOption(txmgrNs, addrmgrNs, minConf, accounts...)
+		if err != nil {
+			return err
+		}
+
+		defer w.lockedOutpointMu.Unlock()
+		w.lockedOutpointMu.Lock()
+
+		authoredTx, err = txauthor.NewUnsignedTransaction(outputs, relayFeePerKb,
+			inputSource.SelectInputs, changeSource, w.chainParams.MaxTxSize,
+			w.authorOptions(reserve)...)
+		if err != nil {
+			return err
+		}
+		for i := range authoredTx.PrevOutpoints {
+			prevOut := &authoredTx.PrevOutpoints[i]
+			w.lockedOutpoints[*prevOut] = struct{}{}
+			unlockOutpoints = append(unlockOutpoints, prevOut)
+		}
+		if w.CheckAddressReuse {
+			authoredTx.ReusedAddresses, err = w.reusedAddresses(addrmgrNs, outputs)
+			if err != nil {
+				return err
+			}
 		}
 		return nil
 	})
@@ -185,6 +497,7 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 			return nil, errors.E(op, err)
 		}
 	}
+	w.NtfnServer.notifyAuthoredTx(authoredTx.Tx)
 	return authoredTx, nil
 }
 
@@ -322,6 +635,12 @@ func (w *Wallet) checkHighFees(totalInput dcrutil.Amount, tx *wire.MsgTx) error
 func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32,
 	n NetworkBackend, randomizeChangeIdx bool, txFee dcrutil.Amount, dontSignTx bool) (*txauthor.AuthoredTx, error) {
 
+	// Imported xpub accounts do not record private keys to sign with.
+	if !dontSignTx && account > udb.ImportedAddrAccount {
+		return nil, errors.E(op, errors.WatchingOnly,
+			errors.Errorf("account %d does not record private keys", account))
+	}
+
 	if n == nil {
 		var err error
 		n, err = w.NetworkBackend()
@@ -351,23 +670,28 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 
+		reserve, err := w.reserveOption(txmgrNs, addrmgrNs, minconf, account)
+		if err != nil {
+			return err
+		}
+
 		var once sync.Once
 		defer once.Do(w.lockedOutpointMu.Unlock)
 		w.lockedOutpointMu.Lock()
 
 		// Create the unsigned transaction.
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
-		inputSource := w.TxStore.MakeIgnoredInputSource(txmgrNs, addrmgrNs, account,
-			minconf, tipHeight, ignoreInput)
+		inputSource := w.selectorInputSource(txmgrNs, addrmgrNs, account,
+			minconf, tipHeight, ignoreInput, txFee)
 		changeSource := &p2PKHChangeSource{
 			persist: w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
 			account: changeAccount,
 			wallet:  w,
 			ctx:     ctx,
 		}
-		var err error
 		atx, err = txauthor.NewUnsignedTransaction(outputs, txFee,
-			inputSource.SelectInputs, changeSource, w.chainParams.MaxTxSize)
+			inputSource.SelectInputs, changeSource, w.chainParams.MaxTxSize,
+			w.authorOptions(reserve)...)
 		if err != nil {
 			return err
 		}
@@ -383,11 +707,12 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 		if atx.ChangeIndex >= 0 && randomizeChangeIdx {
 			atx.RandomizeChangePosition()
 		}
+		w.NtfnServer.notifyAuthoredTx(atx.Tx)
 
 		if !dontSignTx {
 			// Sign the transaction.
 			secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
-			err = atx.AddAllInputScripts(secrets)
+			err = atx.AddAllInputScripts(secrets, dcrec.STEcdsaSecp256k1)
 			for _, done := range secrets.doneFuncs {
 				done()
 			}
@@ -400,8 +725,8 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 
 	// Warn when spending UTXOs controlled by imported keys created change for
 	// the default account.
-	if atx.ChangeIndex >= 0 && account == udb.ImportedAddrAccount {
-		changeAmount := dcrutil.Amount(atx.Tx.TxOut[atx.ChangeIndex].Value)
+	if change, ok := atx.ChangeOutput(); ok && account == udb.ImportedAddrAccount {
+		changeAmount := dcrutil.Amount(change.Value)
 		log.Warnf("Spend from imported account produced change: moving"+
 			" %v from imported account into default account.", changeAmount)
 	}
@@ -1010,15 +1335,13 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 	if err != nil {
 		return
 	}
+	w.NtfnServer.notifyAuthoredTx(atx.Tx)
 	for _, in := range atx.Tx.TxIn {
 		log.Infof("selected input %v (%v) for ticket purchase split transaction",
 			in.PreviousOutPoint, dcrutil.Amount(in.ValueIn))
 	}
 
-	var change *wire.TxOut
-	if atx.ChangeIndex >= 0 {
-		change = atx.Tx.TxOut[atx.ChangeIndex]
-	}
+	change, _ := atx.ChangeOutput()
 	const (
 		txVersion = 1
 		locktime  = 0
@@ -1149,6 +1472,119 @@ func (w *Wallet) vspSplit(ctx context.Context, req *PurchaseTicketsRequest, need
 	return
 }
 
+// affordableTickets returns the largest number of tickets, no more than
+// req.Count, whose split transaction can be funded by the source account.
+// Inputs are selected once, for the split transaction of every requested
+// ticket.  When they can not fund it, all eligible outputs have been selected,
+// and the count is reduced until they pay for the split outputs and the fee of
+// spending every selected input.  As the split transaction of fewer tickets may
+// spend fewer inputs, this fee is an upper bound.  The spendable balance kept
+// by the wallet's Reserve is not available to the split transaction.
+func (w *Wallet) affordableTickets(ctx context.Context, req *PurchaseTicketsRequest,
+	neededPerTicket, vspFee dcrutil.Amount) (int, error) {
+
+	txFeeIncrement := req.txFee
+	if txFeeIncrement == 0 {
+		txFeeIncrement = w.RelayFee()
+	}
+	feeForSize := func(size int) dcrutil.Amount {
+		switch {
+		case len(w.FeeSchedule) != 0:
+			return txrules.FeeForSerializeSizeSchedule(w.FeeSchedule, size)
+		case w.CeilFee:
+			return txrules.FeeForSerializeSizeCeil(txFeeIncrement, size)
+		}
+		return txrules.FeeForSerializeSize(txFeeIncrement, size)
+	}
+
+	// splitOutputs returns P2PKH outputs in the amounts of the split
+	// transaction for count tickets and their total value.
+	splitPkScript := make([]byte, txsizes.P2PKHPkScriptSize)
+	splitOutputs := func(count int) ([]*wire.TxOut, dcrutil.Amount) {
+		var outputs []*wire.TxOut
+		for i := 0; i < count; i++ {
+			if req.VSPAddress != nil {
+				outputs = append(outputs, wire.NewTxOut(int64(vspFee), splitPkScript))
+				outputs = append(outputs, wire.NewTxOut(int64(neededPerTicket-vspFee), splitPkScript))
+				continue
+			}
+			outputs = append(outputs, wire.NewTxOut(int64(neededPerTicket), splitPkScript))
+		}
+		return outputs, neededPerTicket * dcrutil.Amount(count)
+	}
+	splitSize := func(inputs *txauthor.InputDetail, outputs []*wire.TxOut) int {
+		return txsizes.EstimateSerializeSize(inputs.RedeemScriptSizes, outputs,
+			txsizes.P2PKHPkScriptSize)
+	}
+
+	var inputs *txauthor.InputDetail
+	limit := dcrutil.MaxAmount
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+
+		if req.SourceAccount != udb.ImportedAddrAccount {
+			lastAcct, err := w.Manager.LastAccount(addrmgrNs)
+			if err != nil {
+				return err
+			}
+			if req.SourceAccount > lastAcct {
+				return errors.E(errors.NotExist, "missing account")
+			}
+		}
+		if w.Reserve != 0 {
+			balances, err := w.TxStore.AccountBalances(txmgrNs, addrmgrNs, req.MinConf)
+			if err != nil {
+				return err
+			}
+			limit = -w.Reserve
+			if b, ok := balances[req.SourceAccount]; ok {
+				limit += b.Spendable
+			}
+		}
+
+		defer w.lockedOutpointMu.Unlock()
+		w.lockedOutpointMu.Lock()
+		ignoreInput := func(op *wire.OutPoint) bool {
+			_, ok := w.lockedOutpoints[*op]
+			return ok
+		}
+		source := w.selectorInputSource(txmgrNs, addrmgrNs, req.SourceAccount,
+			req.MinConf, tipHeight, ignoreInput, txFeeIncrement)
+
+		// Increase the target with the fee of the selected inputs until
+		// it is met or no more inputs are available.
+		outputs, outputTotal := splitOutputs(req.Count)
+		target := outputTotal
+		for {
+			var err error
+			inputs, err = source.SelectInputs(target)
+			if err != nil {
+				return err
+			}
+			needed := outputTotal + feeForSize(splitSize(inputs, outputs))
+			if inputs.Amount >= needed || inputs.Amount < target {
+				return nil
+			}
+			target = needed
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for count := req.Count; count > 0; count-- {
+		outputs, outputTotal := splitOutputs(count)
+		size := splitSize(inputs, outputs)
+		spent := outputTotal + feeForSize(size)
+		if spent <= inputs.Amount && spent <= limit && size <= w.chainParams.MaxTxSize {
+			return count, nil
+		}
+	}
+	return 0, nil
+}
+
 // purchaseTickets indicates to the wallet that a ticket should be purchased
 // using all currently available funds.  The ticket address parameter in the
 // request can be nil in which case the ticket address associated with the
@@ -1279,7 +1715,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		//   The network supports both P2PKH and P2SH change addresses however.
 		inSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
 		outSizes := []int{stakeSubmissionPkScriptSize,
-			txsizes.TicketCommitmentScriptSize, txsizes.P2PKHPkScriptSize + 1}
+			txsizes.TicketCommitmentScriptSize, txsizes.TicketChangeScriptSize}
 		estSize = txsizes.EstimateSerializeSizeFromScriptSizes(inSizes,
 			outSizes, 0)
 	} else {
@@ -1295,7 +1731,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 			txsizes.RedeemP2PKHSigScriptSize}
 		outSizes := []int{stakeSubmissionPkScriptSize,
 			txsizes.TicketCommitmentScriptSize, txsizes.TicketCommitmentScriptSize,
-			txsizes.P2PKHPkScriptSize + 1, txsizes.P2PKHPkScriptSize + 1}
+			txsizes.TicketChangeScriptSize, txsizes.TicketChangeScriptSize}
 		estSize = txsizes.EstimateSerializeSizeFromScriptSizes(inSizes,
 			outSizes, 0)
 	}
@@ -1350,6 +1786,23 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		}
 	}()
 
+	// Reduce the number of tickets to those which can be funded when
+	// partial purchases are allowed.
+	if req.allowPartial && req.CSPPServer == "" {
+		count, err := w.affordableTickets(ctx, req, neededPerTicket, vspFee)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if count == 0 {
+			return nil, errors.E(op, errors.InsufficientBalance,
+				"insufficient balance to purchase any tickets")
+		}
+		if count < req.Count {
+			log.Infof("Purchasing %d of %d requested tickets", count, req.Count)
+			req.Count = count
+		}
+	}
+
 	purchaseTicketsResponse := &PurchaseTicketsResponse{}
 	var splitTx *wire.MsgTx
 	var splitOutputIndexes []int
@@ -1788,7 +2241,7 @@ func (w *Wallet) signVoteOrRevocation(addrmgrNs walletdb.ReadBucket, ticketPurch
 
 	// Sign the input.
 	redeemTicketScript := ticketPurchase.TxOut[0].PkScript
-	signedScript, err := txscript.SignTxOutput(w.chainParams, tx, inputToSign,
+	signedScript, err := signInputDeterministic(w.chainParams, tx, inputToSign,
 		redeemTicketScript, txscript.SigHashAll, getKey, getScript,
 		tx.TxIn[inputToSign].SignatureScript)
 	if err != nil {
@@ -1944,5 +2397,19 @@ func createUnsignedRevocation(ticketHash *chainhash.Hash, ticketPurchase *wire.M
 			}
 		}
 	}
+
+	// Revocations are not subject to dust rules.  When no output is large
+	// enough to pay the fee without creating dust, pay the fee from the
+	// largest output instead.
+	var largest *wire.TxOut
+	for _, output := range revocation.TxOut {
+		if largest == nil || output.Value > largest.Value {
+			largest = output
+		}
+	}
+	if largest != nil && dcrutil.Amount(largest.Value) > feeEstimate {
+		largest.Value -= int64(feeEstimate)
+		return revocation, nil
+	}
 	return nil, errors.New("missing suitable revocation output to pay relay fee")
 }
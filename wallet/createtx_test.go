@@ -0,0 +1,476 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"decred.org/dcrwallet/wallet/udb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestAffordableTickets(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The account can fund three tickets and the split transaction fee,
+	// but not four.
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 3.2e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1.5e8, pkScript))
+	funding.AddTxOut(wire.NewTxOut(1.5e8, pkScript))
+	funding.AddTxOut(wire.NewTxOut(0.2e8, pkScript))
+	err = w.AcceptMempoolTx(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const neededPerTicket dcrutil.Amount = 1e8
+	tests := []struct {
+		name    string
+		count   int
+		vspFee  dcrutil.Amount
+		reserve dcrutil.Amount
+		want    int
+	}{
+		{"all funded", 2, 0, 0, 2},
+		{"partially funded", 5, 0, 0, 3},
+		{"partially funded vsp", 5, 1e6, 0, 3},
+		{"reserve", 5, 0, 1.5e8, 1},
+		{"none funded", 5, 0, 3e8, 0},
+	}
+	for _, test := range tests {
+		w.Reserve = test.reserve
+		req := &PurchaseTicketsRequest{Count: test.count}
+		if test.vspFee != 0 {
+			req.VSPAddress = addr
+		}
+		count, err := w.affordableTickets(ctx, req, neededPerTicket, test.vspFee)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if count != test.want {
+			t.Errorf("%s: funded %d of %d tickets, expected %d", test.name,
+				count, test.count, test.want)
+		}
+	}
+	w.Reserve = 0
+
+	// The partially funded batch can be authored.
+	outputs := make([]*wire.TxOut, 3)
+	for i := range outputs {
+		outputs[i] = wire.NewTxOut(int64(neededPerTicket), pkScript)
+	}
+	_, err = w.NewUnsignedTransaction(ctx, outputs, w.RelayFee(), 0, 0,
+		OutputSelectionAlgorithmDefault, nil)
+	if err != nil {
+		t.Errorf("authoring split transaction of funded tickets: %v", err)
+	}
+
+	// Locked outputs do not fund tickets.
+	err = w.LockOutpoint(ctx, wire.OutPoint{Hash: funding.TxHash(), Index: 0}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, err := w.affordableTickets(ctx, &PurchaseTicketsRequest{Count: 5},
+		neededPerTicket, 0)
+	if err != nil || count != 1 {
+		t.Errorf("locked output: funded %d tickets with error %v, expected 1",
+			count, err)
+	}
+
+	// Errors selecting inputs are returned.
+	_, err = w.affordableTickets(ctx, &PurchaseTicketsRequest{Count: 5, SourceAccount: 100},
+		neededPerTicket, 0)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("missing account: expected NotExist, got %v", err)
+	}
+}
+
+func TestNewUnsignedTransactionWithData(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 1e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	err = w.AcceptMempoolTx(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const relayFee dcrutil.Amount = 1e4
+	outputs := []*wire.TxOut{wire.NewTxOut(1e7, make([]byte, txsizes.P2PKHPkScriptSize))}
+	inputSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+	withoutData, err := w.NewUnsignedTransaction(ctx, outputs, relayFee, 0, 0,
+		OutputSelectionAlgorithmDefault, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, n := range []int{40, 80} {
+		data := bytes.Repeat([]byte{0xab}, n)
+		atx, err := w.NewUnsignedTransactionWithData(ctx, outputs, [][]byte{data},
+			relayFee, 0, 0, OutputSelectionAlgorithmDefault, nil)
+		if err != nil {
+			t.Fatalf("%d byte payload: %v", n, err)
+		}
+		if len(atx.Tx.TxOut) != 3 || atx.ChangeIndex < 0 {
+			t.Fatalf("%d byte payload: created %d outputs with change index %d",
+				n, len(atx.Tx.TxOut), atx.ChangeIndex)
+		}
+
+		// The data output is zero-value and is sized as estimated.
+		dataScript, err := txscript.GenerateProvablyPruneableOut(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dataIndex := -1
+		for i, out := range atx.Tx.TxOut {
+			if bytes.Equal(out.PkScript, dataScript) {
+				dataIndex = i
+			}
+		}
+		if dataIndex == -1 || atx.Tx.TxOut[dataIndex].Value != 0 {
+			t.Fatalf("%d byte payload: missing zero-value data output", n)
+		}
+		if size := atx.Tx.TxOut[dataIndex].SerializeSize(); size != txsizes.EstimateNullDataOutputSize(n) {
+			t.Errorf("%d byte payload: data output size %d, estimated %d", n,
+				size, txsizes.EstimateNullDataOutputSize(n))
+		}
+
+		// The fee pays for the estimated size including the data output.
+		size := txsizes.EstimateSerializeSize(inputSizes, atx.Tx.TxOut, 0)
+		if atx.EstimatedSignedSerializeSize != size {
+			t.Errorf("%d byte payload: estimated size %d, expected %d", n,
+				atx.EstimatedSignedSerializeSize, size)
+		}
+		if diff := size - withoutData.EstimatedSignedSerializeSize; diff != txsizes.EstimateNullDataOutputSize(n) {
+			t.Errorf("%d byte payload: data output adds %d bytes, expected %d",
+				n, diff, txsizes.EstimateNullDataOutputSize(n))
+		}
+		var out int64
+		for _, o := range atx.Tx.TxOut {
+			out += o.Value
+		}
+		fee := dcrutil.Amount(atx.TotalInput) - dcrutil.Amount(out)
+		if want := txrules.FeeForSerializeSize(relayFee, size); fee != want {
+			t.Errorf("%d byte payload: fee %v, expected %v", n, fee, want)
+		}
+	}
+
+	// Payloads exceeding the standard null data limit are rejected.
+	_, err = w.NewUnsignedTransactionWithData(ctx, outputs,
+		[][]byte{make([]byte, txscript.MaxDataCarrierSize+1)}, relayFee, 0, 0,
+		OutputSelectionAlgorithmDefault, nil)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for over-limit data, got %v", err)
+	}
+}
+
+func TestCeilFee(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 1e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	err = w.AcceptMempoolTx(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A relay fee which does not charge whole atoms for every size.
+	const relayFee dcrutil.Amount = 1234
+	outputs := []*wire.TxOut{wire.NewTxOut(1e7, make([]byte, txsizes.P2PKHPkScriptSize))}
+	fee := func() (dcrutil.Amount, int) {
+		atx, err := w.NewUnsignedTransaction(ctx, outputs, relayFee, 0, 0,
+			OutputSelectionAlgorithmDefault, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out dcrutil.Amount
+		for _, o := range atx.Tx.TxOut {
+			out += dcrutil.Amount(o.Value)
+		}
+		return atx.TotalInput - out, atx.EstimatedSignedSerializeSize
+	}
+
+	// Fees are truncated unless rounding up is enabled.
+	if fee, size := fee(); fee != txrules.FeeForSerializeSize(relayFee, size) {
+		t.Errorf("fee %v, expected truncated fee %v", fee,
+			txrules.FeeForSerializeSize(relayFee, size))
+	}
+	w.CeilFee = true
+	if fee, size := fee(); fee != txrules.FeeForSerializeSizeCeil(relayFee, size) {
+		t.Errorf("fee %v, expected rounded up fee %v", fee,
+			txrules.FeeForSerializeSizeCeil(relayFee, size))
+	}
+}
+
+func TestNewUnsignedTransactionMaxFee(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 1e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	err = w.AcceptMempoolTx(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A small output paying an absurd fee rate.
+	const relayFee dcrutil.Amount = 1e6
+	outputs := []*wire.TxOut{wire.NewTxOut(1e6, make([]byte, txsizes.P2PKHPkScriptSize))}
+	author := func() (*txauthor.AuthoredTx, error) {
+		return w.NewUnsignedTransaction(ctx, outputs, relayFee, 0, 0,
+			OutputSelectionAlgorithmDefault, nil)
+	}
+	atx, err := author()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var outputValue dcrutil.Amount
+	for _, out := range atx.Tx.TxOut {
+		outputValue += dcrutil.Amount(out.Value)
+	}
+	fee := atx.TotalInput - outputValue
+
+	w.MaxFee = fee - 1
+	_, err = author()
+	if !errors.Is(err, errors.FeeExceedsLimit) {
+		t.Errorf("fee over limit: expected FeeExceedsLimit, got %v", err)
+	}
+	w.MaxFee = fee
+	_, err = author()
+	if err != nil {
+		t.Errorf("fee at limit: %v", err)
+	}
+}
+
+func TestReusedAddresses(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	pkScript := func(addr dcrutil.Address) []byte {
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+	change, err := w.NewInternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fresh, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Paying the change address marks it used.
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 1e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript(change)))
+	err = w.AcceptMempoolTx(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const relayFee dcrutil.Amount = 1e4
+	outputs := []*wire.TxOut{
+		wire.NewTxOut(1e6, pkScript(fresh)),
+		wire.NewTxOut(1e6, pkScript(change)),
+		wire.NewTxOut(1e6, make([]byte, txsizes.P2PKHPkScriptSize)),
+	}
+	author := func() *txauthor.AuthoredTx {
+		atx, err := w.NewUnsignedTransaction(ctx, outputs, relayFee, 0, 0,
+			OutputSelectionAlgorithmDefault, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return atx
+	}
+
+	// Address reuse is only checked when enabled.
+	if atx := author(); atx.ReusedAddresses != nil {
+		t.Errorf("reused addresses %v recorded without checking", atx.ReusedAddresses)
+	}
+
+	// Only the used change address is flagged.  The unused external address
+	// and the output not paying the wallet are not.
+	w.CheckAddressReuse = true
+	atx := author()
+	if len(atx.ReusedAddresses) != 1 ||
+		atx.ReusedAddresses[0].Address() != change.Address() {
+		t.Errorf("reused addresses %v, expected only %v", atx.ReusedAddresses, change)
+	}
+}
+
+func TestNewUnsignedTransactionDustOutputs(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	const relayFee dcrutil.Amount = 1e4
+	pkScript := make([]byte, txsizes.P2PKHPkScriptSize)
+	outputs := []*wire.TxOut{
+		wire.NewTxOut(1e7, pkScript),
+		wire.NewTxOut(2e7, pkScript),
+		wire.NewTxOut(100, pkScript),
+		wire.NewTxOut(1, pkScript),
+	}
+
+	// The unfunded wallet reports the first dust output rather than its
+	// insufficient balance, as outputs are checked before input selection.
+	_, err := w.NewUnsignedTransaction(ctx, outputs, relayFee, 0, 0,
+		OutputSelectionAlgorithmDefault, nil)
+	if !errors.Is(err, errors.DustOutput) {
+		t.Fatalf("expected DustOutput, got %v", err)
+	}
+	var e *txauthor.DustOutputError
+	if !errors.As(err, &e) {
+		t.Fatalf("error %v does not wrap a DustOutputError", err)
+	}
+	if e.Index != 2 || e.Value != 100 {
+		t.Errorf("dust output %d with value %v, expected output 2 with value 100",
+			e.Index, e.Value)
+	}
+
+	// Without the dust outputs, the balance is checked.
+	_, err = w.NewUnsignedTransaction(ctx, outputs[:2], relayFee, 0, 0,
+		OutputSelectionAlgorithmDefault, nil)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("expected InsufficientBalance, got %v", err)
+	}
+}
+
+// largestFirstSelector selects the single largest output.
+type largestFirstSelector struct{}
+
+func (largestFirstSelector) Select(target, feeRate dcrutil.Amount, utxos []*udb.Credit) ([]*udb.Credit, error) {
+	var largest *udb.Credit
+	for _, c := range utxos {
+		if largest == nil || c.Amount > largest.Amount {
+			largest = c
+		}
+	}
+	if largest == nil {
+		return nil, nil
+	}
+	return []*udb.Credit{largest}, nil
+}
+
+func TestCoinSelector(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 6e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	funding.AddTxOut(wire.NewTxOut(3e8, pkScript))
+	funding.AddTxOut(wire.NewTxOut(2e8, pkScript))
+	err = w.AcceptMempoolTx(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const relayFee dcrutil.Amount = 1e4
+	outputs := []*wire.TxOut{
+		wire.NewTxOut(5e7, make([]byte, txsizes.P2PKHPkScriptSize)),
+	}
+	spentIndex := func() uint32 {
+		atx, err := w.NewUnsignedTransaction(ctx, outputs, relayFee, 0, 0,
+			OutputSelectionAlgorithmDefault, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(atx.Tx.TxIn) != 1 {
+			t.Fatalf("spent %d inputs, expected 1", len(atx.Tx.TxIn))
+		}
+		return atx.Tx.TxIn[0].PreviousOutPoint.Index
+	}
+
+	// Without a selector, the first output meeting the target is spent.
+	if index := spentIndex(); index != 0 {
+		t.Errorf("default selection spent output %d, expected 0", index)
+	}
+
+	// The default selector reproduces the greedy selection.
+	w.CoinSelector = udb.DefaultCoinSelector{}
+	if index := spentIndex(); index != 0 {
+		t.Errorf("default selector spent output %d, expected 0", index)
+	}
+
+	w.CoinSelector = largestFirstSelector{}
+	if index := spentIndex(); index != 1 {
+		t.Errorf("largest first selector spent output %d, expected 1", index)
+	}
+}
@@ -93,6 +93,8 @@ type accountUsage struct {
 	intLastUsed    uint32
 	extlo, intlo   uint32
 	exthi, inthi   uint32 // Set to lo - 1 when finished, be cautious of unsigned underflow
+	gaplimit       uint32
+	segments       uint32
 }
 
 type scriptPath struct {
@@ -101,8 +103,6 @@ type scriptPath struct {
 
 type addrFinder struct {
 	w           *Wallet
-	gaplimit    uint32
-	segments    uint32
 	usage       []accountUsage
 	commitments blockCommitmentCache
 	mu          sync.RWMutex
@@ -111,8 +111,6 @@ type addrFinder struct {
 func newAddrFinder(ctx context.Context, w *Wallet) (*addrFinder, error) {
 	a := &addrFinder{
 		w:           w,
-		gaplimit:    uint32(w.gapLimit),
-		segments:    hd.HardenedKeyStart / uint32(w.gapLimit),
 		commitments: make(blockCommitmentCache),
 	}
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -139,12 +137,17 @@ func newAddrFinder(ctx context.Context, w *Wallet) (*addrFinder, error) {
 			if err != nil {
 				return err
 			}
+			gaplimit, err := w.accountGapLimit(ns, acct)
+			if err != nil {
+				return err
+			}
+			segments := hd.HardenedKeyStart / gaplimit
 			var extlo, intlo uint32
 			if props.LastUsedExternalIndex != ^uint32(0) {
-				extlo = props.LastUsedExternalIndex / a.gaplimit
+				extlo = props.LastUsedExternalIndex / gaplimit
 			}
 			if props.LastUsedInternalIndex != ^uint32(0) {
-				intlo = props.LastUsedInternalIndex / a.gaplimit
+				intlo = props.LastUsedInternalIndex / gaplimit
 			}
 			a.usage = append(a.usage, accountUsage{
 				account:     acct,
@@ -153,9 +156,11 @@ func newAddrFinder(ctx context.Context, w *Wallet) (*addrFinder, error) {
 				extLastUsed: props.LastUsedExternalIndex,
 				intLastUsed: props.LastUsedInternalIndex,
 				extlo:       extlo,
-				exthi:       a.segments - 1,
+				exthi:       segments - 1,
 				intlo:       intlo,
-				inthi:       a.segments - 1,
+				inthi:       segments - 1,
+				gaplimit:    gaplimit,
+				segments:    segments,
 			})
 			return nil
 		}
@@ -201,13 +206,13 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, p Peer) er
 		// Map address scripts to their HD path.
 		var data [][]byte
 		scrPaths := make(map[string]scriptPath)
-		addBranch := func(branchPub *hd.ExtendedKey, acct, branch, lo, hi uint32) error {
-			if lo > hi || hi >= a.segments { // Terminating condition
+		addBranch := func(u *accountUsage, branchPub *hd.ExtendedKey, branch, lo, hi uint32) error {
+			if lo > hi || hi >= u.segments { // Terminating condition
 				return nil
 			}
 			mid := (hi + lo) / 2
-			begin := mid * a.gaplimit
-			addrs, err := deriveChildAddresses(branchPub, begin, a.gaplimit, a.w.chainParams)
+			begin := mid * u.gaplimit
+			addrs, err := deriveChildAddresses(branchPub, begin, u.gaplimit, a.w.chainParams)
 			if err != nil {
 				return err
 			}
@@ -219,27 +224,27 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, p Peer) er
 				}
 				data = append(data, scr)
 				scrPaths[string(scr)] = scriptPath{
-					account: acct,
+					account: u.account,
 					branch:  branch,
-					index:   mid*a.gaplimit + uint32(i),
+					index:   mid*u.gaplimit + uint32(i),
 				}
 			}
 			return nil
 		}
 		for i := range a.usage {
 			u := &a.usage[i]
-			err = addBranch(u.extkey, u.account, 0, u.extlo, u.exthi)
+			err = addBranch(u, u.extkey, 0, u.extlo, u.exthi)
 			if err != nil {
 				return err
 			}
-			err = addBranch(u.intkey, u.account, 1, u.intlo, u.inthi)
+			err = addBranch(u, u.intkey, 1, u.intlo, u.inthi)
 			if err != nil {
 				return err
 			}
 		}
 
 		if len(data) == 0 {
-			return nil
+			break
 		}
 
 		// Record committed scripts of matching filters.
@@ -288,8 +293,8 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, p Peer) er
 				mid := (u.exthi + u.extlo) / 2
 				// When the last used index is in this segment's index half open
 				// range [begin,end) then an address was found in this segment.
-				begin := mid * a.gaplimit
-				end := begin + a.gaplimit
+				begin := mid * u.gaplimit
+				end := begin + u.gaplimit
 				if u.extLastUsed >= begin && u.extLastUsed < end {
 					u.extlo = mid + 1
 				} else {
@@ -298,8 +303,8 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, p Peer) er
 			}
 			if u.intlo <= u.inthi {
 				mid := (u.inthi + u.intlo) / 2
-				begin := mid * a.gaplimit
-				end := begin + a.gaplimit
+				begin := mid * u.gaplimit
+				end := begin + u.gaplimit
 				if u.intLastUsed >= begin && u.intLastUsed < end {
 					u.intlo = mid + 1
 				} else {
@@ -308,6 +313,125 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, p Peer) er
 			}
 		}
 	}
+
+	if !a.w.incrementalDiscovery {
+		return nil
+	}
+	return a.extend(ctx, fs, p, a.w.maxDiscoveryIndex())
+}
+
+// extend extends the last used child index of every account branch by
+// searching the gap of unused addresses following it.  Each branch is searched
+// independently.
+func (a *addrFinder) extend(ctx context.Context, fs []*udb.BlockCFilter, p Peer, maxIndex uint32) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for i := range a.usage {
+		u := &a.usage[i]
+		g.Go(func() error {
+			lastUsed, err := extendLastUsed(ctx, u.extLastUsed, u.gaplimit, maxIndex,
+				func(ctx context.Context, start, count uint32) (uint32, error) {
+					return a.lastUsedInRange(ctx, fs, p, u.extkey, start, count)
+				})
+			u.extLastUsed = lastUsed
+			return err
+		})
+		g.Go(func() error {
+			lastUsed, err := extendLastUsed(ctx, u.intLastUsed, u.gaplimit, maxIndex,
+				func(ctx context.Context, start, count uint32) (uint32, error) {
+					return a.lastUsedInRange(ctx, fs, p, u.intkey, start, count)
+				})
+			u.intLastUsed = lastUsed
+			return err
+		})
+	}
+	return g.Wait()
+}
+
+// lastUsedInRange returns the last child index in the range [start,
+// start+count) of the branch key whose address script is committed by a block
+// of fs, or ^uint32(0) if none of the addresses are used.
+func (a *addrFinder) lastUsedInRange(ctx context.Context, fs []*udb.BlockCFilter, p Peer,
+	branchPub *hd.ExtendedKey, start, count uint32) (uint32, error) {
+
+	addrs, err := deriveChildAddresses(branchPub, start, count, a.w.chainParams)
+	if err != nil {
+		return 0, err
+	}
+	data := make([][]byte, 0, len(addrs))
+	indexes := make(map[string]uint32, len(addrs))
+	for i, addr := range addrs {
+		scr, _, err := addressScript(addr)
+		if err != nil {
+			log.Errorf("addressScript(%v): %v", addr, err)
+			continue
+		}
+		data = append(data, scr)
+		indexes[string(scr)] = start + uint32(i)
+	}
+	err = a.filter(ctx, fs, data, p)
+	if err != nil {
+		return 0, err
+	}
+
+	lastUsed := ^uint32(0)
+	a.mu.RLock()
+	for _, commitments := range a.commitments {
+		for scr, index := range indexes {
+			if _, ok := commitments[scr]; !ok {
+				continue
+			}
+			if lastUsed == ^uint32(0) || index > lastUsed {
+				lastUsed = index
+			}
+		}
+	}
+	a.mu.RUnlock()
+	return lastUsed, nil
+}
+
+// defaultMaxDiscoveryIndex is the child index at which incremental discovery
+// stops extending a branch when the wallet is not configured with a limit.
+const defaultMaxDiscoveryIndex = 10000
+
+func (w *Wallet) maxDiscoveryIndex() uint32 {
+	if w.discoveryMaxIndex == 0 {
+		return defaultMaxDiscoveryIndex
+	}
+	return w.discoveryMaxIndex
+}
+
+// extendLastUsed searches the gap of gapLimit child indexes following the last
+// used child index of a branch, or following index zero when lastUsed is
+// ^uint32(0) and no child is known to be used.  If any child in the gap is
+// used, the search is repeated following the newly found last used child,
+// until a full gap of unused children is found or the search reaches
+// maxIndex.  lastUsedInRange must return the last used child index in the
+// range [start, start+count), or ^uint32(0) when none are used.
+func extendLastUsed(ctx context.Context, lastUsed, gapLimit, maxIndex uint32,
+	lastUsedInRange func(ctx context.Context, start, count uint32) (uint32, error)) (uint32, error) {
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return lastUsed, err
+		}
+		start := lastUsed + 1 // Wraps to zero when no child is used
+		if start >= maxIndex {
+			return lastUsed, nil
+		}
+		count := gapLimit
+		if maxIndex-start < count {
+			count = maxIndex - start
+		}
+		used, err := lastUsedInRange(ctx, start, count)
+		if err != nil {
+			return lastUsed, err
+		}
+		if used == ^uint32(0) {
+			return lastUsed, nil
+		}
+		log.Debugf("Found used child %d within gap; extending discovery", used)
+		lastUsed = used
+	}
 }
 
 func (a *addrFinder) filter(ctx context.Context, fs []*udb.BlockCFilter, data blockcf.Entries, p Peer) error {
@@ -666,12 +790,12 @@ func (f *existsAddrIndexFinder) branchUsed(ctx context.Context, branchXpub *hd.E
 }
 
 // findLastUsedAddress returns the child index of the last used child address
-// derived from a branch key.  If no addresses are found, ^uint32(0) is
-// returned.
-func (f *existsAddrIndexFinder) findLastUsedAddress(ctx context.Context, xpub *hd.ExtendedKey) (uint32, error) {
+// derived from a branch key, searching segments of gapLimit addresses.  If no
+// addresses are found, ^uint32(0) is returned.
+func (f *existsAddrIndexFinder) findLastUsedAddress(ctx context.Context, xpub *hd.ExtendedKey, gapLimit uint32) (uint32, error) {
 	var (
 		lastUsed        = ^uint32(0)
-		scanLen         = uint32(f.wallet.gapLimit)
+		scanLen         = gapLimit
 		segments        = hd.HardenedKeyStart / scanLen
 		lo, hi   uint32 = 0, segments - 1
 	)
@@ -701,9 +825,29 @@ Bsearch:
 	return lastUsed, nil
 }
 
+// lastUsedInRange returns the last used child index in the range [start,
+// start+count) of a branch key, or ^uint32(0) if none of the addresses are
+// used.
+func (f *existsAddrIndexFinder) lastUsedInRange(ctx context.Context, xpub *hd.ExtendedKey, start, count uint32) (uint32, error) {
+	addrs, err := deriveChildAddresses(xpub, start, count, f.wallet.chainParams)
+	if err != nil {
+		return 0, err
+	}
+	existsBits, err := f.rpc.UsedAddresses(ctx, addrs)
+	if err != nil {
+		return 0, err
+	}
+	for i := len(addrs) - 1; i >= 0; i-- {
+		if existsBits.Get(i) {
+			return start + uint32(i), nil
+		}
+	}
+	return ^uint32(0), nil
+}
+
 func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) error {
 	var g errgroup.Group
-	lastUsed := func(acct, branch uint32, index *uint32) error {
+	lastUsed := func(acct, branch, gapLimit uint32, index *uint32) error {
 		var k *hd.ExtendedKey
 		err := walletdb.View(ctx, f.wallet.db, func(tx walletdb.ReadTx) error {
 			var err error
@@ -713,18 +857,28 @@ func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) er
 		if err != nil {
 			return err
 		}
-		lastUsed, err := f.findLastUsedAddress(ctx, k)
+		lastUsed, err := f.findLastUsedAddress(ctx, k, gapLimit)
 		if err != nil {
 			return err
 		}
+		if f.wallet.incrementalDiscovery {
+			lastUsed, err = extendLastUsed(ctx, lastUsed, gapLimit,
+				f.wallet.maxDiscoveryIndex(),
+				func(ctx context.Context, start, count uint32) (uint32, error) {
+					return f.lastUsedInRange(ctx, k, start, count)
+				})
+			if err != nil {
+				return err
+			}
+		}
 		*index = lastUsed
 		return nil
 	}
 	for i := range finder.usage {
 		u := &finder.usage[i]
-		acct := u.account
-		g.Go(func() error { return lastUsed(acct, 0, &u.extLastUsed) })
-		g.Go(func() error { return lastUsed(acct, 1, &u.intLastUsed) })
+		acct, gapLimit := u.account, u.gaplimit
+		g.Go(func() error { return lastUsed(acct, 0, gapLimit, &u.extLastUsed) })
+		g.Go(func() error { return lastUsed(acct, 1, gapLimit, &u.intLastUsed) })
 	}
 	return g.Wait()
 }
@@ -749,10 +903,15 @@ func rpcFromPeer(p Peer) (*dcrd.RPC, bool) {
 // again.
 func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, p Peer, startBlock *chainhash.Hash, discoverAccts bool) error {
 	const op errors.Op = "wallet.DiscoverActiveAddresses"
-	_, slip0044CoinType := udb.CoinTypes(w.chainParams)
+	legacyCoinType, slip0044CoinType := udb.CoinTypes(w.chainParams)
 	var activeCoinType uint32
 	var coinTypeKnown, isSLIP0044CoinType bool
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		// Watching-only wallets can not be upgraded to another coin type,
+		// even when the coin type is recorded.
+		if w.Manager.WatchingOnly() {
+			return nil
+		}
 		var err error
 		activeCoinType, err = w.Manager.CoinType(dbtx)
 		if errors.Is(err, errors.WatchingOnly) {
@@ -762,7 +921,9 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, p Peer, startBlock
 			return err
 		}
 		coinTypeKnown = true
-		isSLIP0044CoinType = activeCoinType == slip0044CoinType
+		// Wallets created for a coin type other than those of the network
+		// only use that coin type.
+		isSLIP0044CoinType = activeCoinType != legacyCoinType
 		log.Debugf("DiscoverActiveAddresses: activeCoinType=%d", activeCoinType)
 		return nil
 	})
@@ -882,10 +1043,10 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, p Peer, startBlock
 	// addresses that may be used by other wallets sharing the same seed.
 	// Multiple updates are used to allow cancellation.
 	log.Infof("Updating DB with discovered addresses...")
-	gapLimit := uint32(w.gapLimit)
 	for i := range finder.usage {
 		u := &finder.usage[i]
 		acct := u.account
+		gapLimit := u.gaplimit
 
 		const N = 256
 		max := u.extLastUsed + gapLimit
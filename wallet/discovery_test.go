@@ -6,9 +6,12 @@ package wallet
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"decred.org/dcrwallet/errors"
 	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/hdkeychain/v3"
 )
 
 // TestDiscoveryCursorPos tests that the account cursor index is not reset
@@ -100,3 +103,223 @@ func TestDiscoveryCursorPos(t *testing.T) {
 			lastUsed, wasLastUsed, cursor, wasCursor)
 	}
 }
+
+// TestDiscoveryAccountGapLimit tests that address discovery records unused
+// addresses through the gap limit of each account.
+func TestDiscoveryAccountGapLimit(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	cfg.DisableCoinTypeUpgrades = true
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gapLimits := make(map[uint32]uint32)
+	for i, limit := range []uint32{30, 50} {
+		account, err := w.NextAccount(ctx, fmt.Sprintf("account-%d", i+1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Record the gap limit without syncing addresses so that only
+		// discovery records the addresses within the gap.
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+			return w.Manager.SetAccountGapLimit(ns, account, limit)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		gapLimits[account] = limit
+	}
+	for account, limit := range gapLimits {
+		got, err := w.AccountGapLimit(ctx, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != limit {
+			t.Errorf("account %d: gap limit %d, expected %d", account, got, limit)
+		}
+	}
+	got, err := w.AccountGapLimit(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != uint32(cfg.GapLimit) {
+		t.Errorf("account 0: gap limit %d, expected wallet gap limit %d",
+			got, cfg.GapLimit)
+	}
+
+	peer := &peerFuncs{}
+	err = w.DiscoverActiveAddresses(ctx, peer, &w.chainParams.GenesisHash, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each branch must record every unused address within the account gap
+	// limit, and no more.
+	for account, limit := range gapLimits {
+		w.addressBuffersMu.Lock()
+		ad := w.addressBuffers[account]
+		w.addressBuffersMu.Unlock()
+		for branch, xpub := range []*hdkeychain.ExtendedKey{
+			ad.albExternal.branchXpub, ad.albInternal.branchXpub} {
+
+			last, err := deriveChildAddress(xpub, limit-1, w.chainParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+			next, err := deriveChildAddress(xpub, limit, w.chainParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+				ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+				if _, err := w.Manager.Address(ns, last); err != nil {
+					t.Errorf("account %d branch %d: child %d not recorded: %v",
+						account, branch, limit-1, err)
+				}
+				if _, err := w.Manager.Address(ns, next); !errors.Is(err, errors.NotExist) {
+					t.Errorf("account %d branch %d: child %d beyond gap limit recorded",
+						account, branch, limit)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+// TestNewAddressAccountGapLimit tests that new addresses of an account are
+// limited by the account gap limit.
+func TestNewAddressAccountGapLimit(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	const limit = 3
+	err := w.SetAccountGapLimit(ctx, 0, limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < limit; i++ {
+		if _, err := w.NewExternalAddress(ctx, 0); err != nil {
+			t.Fatalf("address %d: %v", i, err)
+		}
+		if _, err := w.NewChangeAddress(ctx, 0); err != nil {
+			t.Fatalf("change address %d: %v", i, err)
+		}
+	}
+	if _, err := w.NewExternalAddress(ctx, 0); !errors.Is(err, errors.Policy) {
+		t.Errorf("expected gap limit policy error, got %v", err)
+	}
+	if _, err := w.NewChangeAddress(ctx, 0); err != nil {
+		t.Errorf("change addresses must wrap within the gap limit: %v", err)
+	}
+
+	// Removing the account gap limit restores the wallet gap limit.
+	err = w.SetAccountGapLimit(ctx, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.NewExternalAddress(ctx, 0); err != nil {
+		t.Errorf("address within wallet gap limit: %v", err)
+	}
+}
+
+// TestExtendLastUsed tests that incremental discovery extends the search past
+// used children found within the gap limit, up to the maximum child index.
+func TestExtendLastUsed(t *testing.T) {
+	const none = ^uint32(0)
+	tests := []struct {
+		name     string
+		used     []uint32
+		lastUsed uint32
+		gapLimit uint32
+		maxIndex uint32
+		want     uint32
+		searched [][2]uint32 // start and count of each searched range
+	}{{
+		name:     "no usage",
+		lastUsed: none,
+		gapLimit: 20,
+		maxIndex: 1000,
+		want:     none,
+		searched: [][2]uint32{{0, 20}},
+	}, {
+		name:     "usage at edge of gap",
+		used:     []uint32{19},
+		lastUsed: none,
+		gapLimit: 20,
+		maxIndex: 1000,
+		want:     19,
+		searched: [][2]uint32{{0, 20}, {20, 20}},
+	}, {
+		name:     "sparse usage beyond gap",
+		used:     []uint32{19, 38, 57},
+		lastUsed: 19,
+		gapLimit: 20,
+		maxIndex: 1000,
+		want:     57,
+		searched: [][2]uint32{{20, 20}, {39, 20}, {58, 20}},
+	}, {
+		name:     "usage beyond clean gap",
+		used:     []uint32{19, 40},
+		lastUsed: 19,
+		gapLimit: 20,
+		maxIndex: 1000,
+		want:     19,
+		searched: [][2]uint32{{20, 20}},
+	}, {
+		name:     "bounded by max index",
+		used:     []uint32{19, 38, 57},
+		lastUsed: 19,
+		gapLimit: 20,
+		maxIndex: 50,
+		want:     38,
+		searched: [][2]uint32{{20, 20}, {39, 11}},
+	}, {
+		name:     "last used at max index",
+		used:     []uint32{49},
+		lastUsed: 49,
+		gapLimit: 20,
+		maxIndex: 50,
+		want:     49,
+	}}
+	for _, test := range tests {
+		used := make(map[uint32]bool)
+		for _, i := range test.used {
+			used[i] = true
+		}
+		var searched [][2]uint32
+		lastUsedInRange := func(ctx context.Context, start, count uint32) (uint32, error) {
+			searched = append(searched, [2]uint32{start, count})
+			last := none
+			for i := start; i < start+count; i++ {
+				if used[i] {
+					last = i
+				}
+			}
+			return last, nil
+		}
+		got, err := extendLastUsed(context.Background(), test.lastUsed,
+			test.gapLimit, test.maxIndex, lastUsedInRange)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if got != test.want {
+			t.Errorf("%s: last used %d, expected %d", test.name, got, test.want)
+		}
+		if fmt.Sprint(searched) != fmt.Sprint(test.searched) {
+			t.Errorf("%s: searched ranges %v, expected %v", test.name,
+				searched, test.searched)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// FeeEstimator estimates the fee rate (per kB of serialized transaction)
+// required for a transaction to be mined within confTarget blocks.
+type FeeEstimator interface {
+	EstimateFeePerKb(confTarget int) (dcrutil.Amount, error)
+}
+
+// FeeEstimateMode describes how aggressively a FeeEstimator prices fee rates.
+type FeeEstimateMode int
+
+// Fee estimation modes.
+const (
+	// FeeEstimateEconomical estimates fee rates from a short history of
+	// recent blocks and responds quickly to falling fee rates.
+	FeeEstimateEconomical FeeEstimateMode = iota
+
+	// FeeEstimateConservative estimates fee rates from a longer history of
+	// recent blocks and prices at a higher percentile of observed fee
+	// rates, making it less likely a transaction is underpriced.
+	FeeEstimateConservative
+)
+
+const (
+	// feeEstimateMinBlocks is the fewest blocks sampled by an economical
+	// estimate.  Conservative estimates sample twice as many blocks.
+	feeEstimateMinBlocks = 6
+
+	// feeEstimateMaxPercentile caps the fee rate percentile used for
+	// the shortest confirmation targets.
+	feeEstimateMaxPercentile = 95
+)
+
+// BlockFeeEstimator is a FeeEstimator which samples the fee rates of regular
+// transactions mined in recent blocks.
+//
+// Shorter confirmation targets are priced at higher percentiles of the sampled
+// fee rates.  Estimates are never less than MinFeePerKb.
+type BlockFeeEstimator struct {
+	// RecentBlocks returns up to count of the most recent main chain
+	// blocks.
+	RecentBlocks func(count int) ([]*wire.MsgBlock, error)
+
+	Mode        FeeEstimateMode
+	MinFeePerKb dcrutil.Amount
+}
+
+// EstimateFeePerKb estimates the fee rate required for a transaction to be
+// mined within confTarget blocks.  An error with kind errors.NotExist is
+// returned when no fee rates could be sampled from recent blocks.
+func (e *BlockFeeEstimator) EstimateFeePerKb(confTarget int) (dcrutil.Amount, error) {
+	const op errors.Op = "wallet.EstimateFeePerKb"
+	if confTarget < 1 {
+		return 0, errors.E(op, errors.Invalid, "confirmation target must be positive")
+	}
+
+	count := feeEstimateMinBlocks
+	if confTarget > count {
+		count = confTarget
+	}
+	percentile := 50 + 40/confTarget
+	if e.Mode == FeeEstimateConservative {
+		count *= 2
+		percentile += 10
+	}
+	if percentile > feeEstimateMaxPercentile {
+		percentile = feeEstimateMaxPercentile
+	}
+
+	blocks, err := e.RecentBlocks(count)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	var rates []dcrutil.Amount
+	for _, b := range blocks {
+		rates = appendBlockFeeRates(rates, b)
+	}
+	if len(rates) == 0 {
+		return 0, errors.E(op, errors.NotExist, "no fee rates to sample")
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i] < rates[j] })
+
+	fee := rates[(len(rates)-1)*percentile/100]
+	if fee < e.MinFeePerKb {
+		fee = e.MinFeePerKb
+	}
+	return fee, nil
+}
+
+// appendBlockFeeRates appends the fee rates of the regular tree transactions
+// of a block, excluding the coinbase, to rates.
+func appendBlockFeeRates(rates []dcrutil.Amount, b *wire.MsgBlock) []dcrutil.Amount {
+	for i, tx := range b.Transactions {
+		if i == 0 {
+			// Coinbase
+			continue
+		}
+		var fee int64
+		for _, in := range tx.TxIn {
+			fee += in.ValueIn
+		}
+		for _, out := range tx.TxOut {
+			fee -= out.Value
+		}
+		if fee < 0 {
+			continue
+		}
+		rates = append(rates, dcrutil.Amount(fee*1000/int64(tx.SerializeSize())))
+	}
+	return rates
+}
+
+// NewBlockFeeEstimator returns a BlockFeeEstimator which samples blocks of the
+// wallet's main chain.  Blocks are fetched from the wallet's network backend.
+// Estimates are never less than the wallet's relay fee.
+func (w *Wallet) NewBlockFeeEstimator(ctx context.Context, mode FeeEstimateMode) *BlockFeeEstimator {
+	recentBlocks := func(count int) ([]*wire.MsgBlock, error) {
+		n, err := w.NetworkBackend()
+		if err != nil {
+			return nil, err
+		}
+		var hashes []*chainhash.Hash
+		err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			_, tipHeight := w.TxStore.MainChainTip(ns)
+			for height := tipHeight; height > 0 && len(hashes) < count; height-- {
+				hash, err := w.TxStore.GetMainChainBlockHashForHeight(ns, height)
+				if err != nil {
+					return err
+				}
+				hashes = append(hashes, &hash)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(hashes) == 0 {
+			return nil, nil
+		}
+		return n.Blocks(ctx, hashes)
+	}
+	return &BlockFeeEstimator{
+		RecentBlocks: recentBlocks,
+		Mode:         mode,
+		MinFeePerKb:  w.RelayFee(),
+	}
+}
+
+// EstimateRelayFee returns the fee rate estimated by est for a transaction to
+// be mined within confTarget blocks.  The wallet's relay fee is returned when
+// est is nil or estimation is unavailable, and estimates less than the relay
+// fee are increased to it.
+func (w *Wallet) EstimateRelayFee(est FeeEstimator, confTarget int) dcrutil.Amount {
+	relayFee := w.RelayFee()
+	if est == nil {
+		return relayFee
+	}
+	fee, err := est.EstimateFeePerKb(confTarget)
+	if err != nil {
+		log.Debugf("Fee estimation unavailable, using relay fee %v: %v",
+			relayFee, err)
+		return relayFee
+	}
+	if fee < relayFee {
+		return relayFee
+	}
+	return fee
+}
+
+// NewUnsignedTransactionEstimatedFee constructs an unsigned transaction using
+// unspent account outputs, paying a fee rate estimated for the transaction to
+// be mined within confTarget blocks.  See NewUnsignedTransaction and
+// EstimateRelayFee for details.
+func (w *Wallet) NewUnsignedTransactionEstimatedFee(ctx context.Context, outputs []*wire.TxOut,
+	est FeeEstimator, confTarget int, account uint32, minConf int32,
+	algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource) (*txauthor.AuthoredTx, error) {
+
+	relayFeePerKb := w.EstimateRelayFee(est, confTarget)
+	return w.NewUnsignedTransaction(ctx, outputs, relayFeePerKb, account,
+		minConf, algo, changeSource)
+}
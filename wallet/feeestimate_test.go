@@ -0,0 +1,124 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// feeRateTx creates a transaction paying approximately feeRate per kB.
+func feeRateTx(feeRate dcrutil.Amount) *wire.MsgTx {
+	const value = 1e8
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, value, make([]byte, 108)))
+	tx.AddTxOut(wire.NewTxOut(value, make([]byte, 25)))
+	fee := (int64(feeRate)*int64(tx.SerializeSize()) + 999) / 1000
+	tx.TxOut[0].Value -= fee
+	return tx
+}
+
+func syntheticBlocks(count int, feeRates ...dcrutil.Amount) []*wire.MsgBlock {
+	blocks := make([]*wire.MsgBlock, count)
+	for i := range blocks {
+		coinbase := wire.NewMsgTx()
+		coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 0, nil))
+		coinbase.AddTxOut(wire.NewTxOut(1e8, make([]byte, 25)))
+		b := &wire.MsgBlock{Transactions: []*wire.MsgTx{coinbase}}
+		for _, r := range feeRates {
+			b.Transactions = append(b.Transactions, feeRateTx(r))
+		}
+		blocks[i] = b
+	}
+	return blocks
+}
+
+func TestBlockFeeEstimator(t *testing.T) {
+	t.Parallel()
+	var feeRates []dcrutil.Amount
+	for i := 1; i <= 10; i++ {
+		feeRates = append(feeRates, dcrutil.Amount(i)*1e4)
+	}
+	blocks := syntheticBlocks(20, feeRates...)
+	recentBlocks := func(count int) ([]*wire.MsgBlock, error) {
+		if count > len(blocks) {
+			count = len(blocks)
+		}
+		return blocks[:count], nil
+	}
+
+	tests := []struct {
+		mode       FeeEstimateMode
+		confTarget int
+		minFee     dcrutil.Amount
+		want       dcrutil.Amount
+	}{
+		{FeeEstimateEconomical, 1, 1e4, 9e4},
+		{FeeEstimateEconomical, 10, 1e4, 6e4},
+		{FeeEstimateConservative, 1, 1e4, 10e4},
+		{FeeEstimateConservative, 10, 1e4, 7e4},
+		{FeeEstimateEconomical, 10, 2e5, 2e5},
+	}
+	for i, test := range tests {
+		est := &BlockFeeEstimator{
+			RecentBlocks: recentBlocks,
+			Mode:         test.mode,
+			MinFeePerKb:  test.minFee,
+		}
+		fee, err := est.EstimateFeePerKb(test.confTarget)
+		if err != nil {
+			t.Errorf("test %d: %v", i, err)
+			continue
+		}
+		// Fees are rounded by the transaction size and may slightly
+		// exceed the synthetic fee rates.
+		if fee < test.want || fee > test.want+10 {
+			t.Errorf("test %d: estimated %v, expected %v", i, fee, test.want)
+		}
+	}
+
+	est := &BlockFeeEstimator{
+		RecentBlocks: func(int) ([]*wire.MsgBlock, error) { return nil, nil },
+	}
+	_, err := est.EstimateFeePerKb(1)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("expected NotExist without blocks, got %v", err)
+	}
+	_, err = est.EstimateFeePerKb(0)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for zero confirmation target, got %v", err)
+	}
+}
+
+type mockFeeEstimator struct {
+	fee dcrutil.Amount
+	err error
+}
+
+func (e mockFeeEstimator) EstimateFeePerKb(int) (dcrutil.Amount, error) {
+	return e.fee, e.err
+}
+
+func TestEstimateRelayFee(t *testing.T) {
+	t.Parallel()
+	w := &Wallet{relayFee: 1e4}
+	tests := []struct {
+		est  FeeEstimator
+		want dcrutil.Amount
+	}{
+		{nil, 1e4},
+		{mockFeeEstimator{err: errors.E(errors.NotExist)}, 1e4},
+		{mockFeeEstimator{fee: 5e3}, 1e4},
+		{mockFeeEstimator{fee: 3e4}, 3e4},
+	}
+	for i, test := range tests {
+		if fee := w.EstimateRelayFee(test.est, 2); fee != test.want {
+			t.Errorf("test %d: fee %v, expected %v", i, fee, test.want)
+		}
+	}
+}
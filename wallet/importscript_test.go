@@ -0,0 +1,137 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestImportScript(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// multisig returns a 2-of-3 multisig script of the pubkeys.
+	multisig := func(pubKeys ...*secp256k1.PublicKey) []byte {
+		addrs := make([]*dcrutil.AddressSecpPubKey, len(pubKeys))
+		for i, pubKey := range pubKeys {
+			addrs[i], err = dcrutil.NewAddressSecpPubKey(
+				pubKey.SerializeCompressed(), cfg.Params)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		script, err := txscript.MultiSigScript(addrs, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+	otherKey := func(b byte) *secp256k1.PublicKey {
+		keyBytes := make([]byte, 32)
+		keyBytes[31] = b
+		return secp256k1.PrivKeyFromBytes(keyBytes).PubKey()
+	}
+	walletAddr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	walletKey, err := w.PubKeyForAddress(ctx, walletAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rescanning requires a network backend.
+	_, err = w.ImportScript(ctx, multisig(otherKey(1), otherKey(2), otherKey(3)), true)
+	if !errors.Is(err, errors.NoPeers) {
+		t.Errorf("import with rescan: expected NoPeers, got %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		script    []byte
+		keysHeld  bool
+		prevIndex byte
+	}{
+		{"one key held", multisig(walletKey, otherKey(1), otherKey(2)), true, 1},
+		{"watched", multisig(otherKey(3), otherKey(4), otherKey(5)), false, 2},
+	}
+	for _, test := range tests {
+		addr, err := w.ImportScript(ctx, test.script, false)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		wantAddr, err := dcrutil.NewAddressScriptHash(test.script, cfg.Params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if addr.Address() != wantAddr.Address() {
+			t.Errorf("%s: P2SH address %v, expected %v", test.name, addr, wantAddr)
+		}
+		if _, err := w.ImportScript(ctx, test.script, false); err != nil {
+			t.Errorf("%s: reimporting script: %v", test.name, err)
+		}
+
+		// Outputs paying to the P2SH address are wallet outputs.
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := wire.NewMsgTx()
+		prev := wire.NewOutPoint(&chainhash.Hash{test.prevIndex}, 0, wire.TxTreeRegular)
+		tx.AddTxIn(wire.NewTxIn(prev, 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8, pkScript))
+		err = w.AcceptMempoolTx(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		unspent, err := w.ListUnspent(ctx, 0, math.MaxInt32,
+			map[string]struct{}{addr.Address(): {}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		txHash := tx.TxHash()
+		if len(unspent) != 1 || unspent[0].TxID != txHash.String() ||
+			unspent[0].Vout != 0 || unspent[0].Amount != 1 {
+			t.Fatalf("%s: unspent outputs of %v: %+v", test.name, addr, unspent)
+		}
+
+		// Spending the output is only possible when the wallet holds
+		// keys of the script.
+		spend := wire.NewMsgTx()
+		outPoint := wire.NewOutPoint(&txHash, 0, wire.TxTreeRegular)
+		spend.AddTxIn(wire.NewTxIn(outPoint, 1e8, nil))
+		spend.AddTxOut(wire.NewTxOut(1e8-1e5, pkScript))
+		signErrs, err := w.SignTransaction(ctx, spend, txscript.SigHashAll,
+			map[wire.OutPoint][]byte{*outPoint: pkScript}, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		watchingOnly := false
+		for _, e := range signErrs {
+			if errors.Is(e.Error, errors.WatchingOnly) {
+				watchingOnly = true
+			}
+		}
+		if watchingOnly == test.keysHeld {
+			t.Errorf("%s: signing errors %v", test.name, signErrs)
+		}
+	}
+}
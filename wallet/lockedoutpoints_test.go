@@ -0,0 +1,225 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/rpc/jsonrpc/types"
+	"decred.org/dcrwallet/wallet/udb"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestLockedOutpoints(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Fund the wallet with two unmined outputs.
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 3e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	funding.AddTxOut(wire.NewTxOut(2e8, pkScript))
+	rec, err := udb.NewTxRecordFromMsgTx(funding, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := w.TxStore.InsertMemPoolTx(ns, rec)
+		if err != nil {
+			return err
+		}
+		for i := range funding.TxOut {
+			err = w.TxStore.AddCredit(ns, rec, nil, uint32(i), false, 0)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fundingHash := funding.TxHash()
+	outpoints := []wire.OutPoint{
+		{Hash: fundingHash, Index: 0, Tree: wire.TxTreeRegular},
+		{Hash: fundingHash, Index: 1, Tree: wire.TxTreeRegular},
+	}
+	results := []types.ListLockUnspentResult{
+		{TxID: fundingHash.String(), Vout: 0, Amount: 1, Account: "default"},
+		{TxID: fundingHash.String(), Vout: 1, Amount: 2, Account: "default"},
+	}
+	checkLocked := func(want []types.ListLockUnspentResult) {
+		t.Helper()
+		locked, err := w.LockedOutpoints(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(locked) == 0 && len(want) == 0 {
+			return
+		}
+		if !reflect.DeepEqual(locked, want) {
+			t.Errorf("locked outpoints %+v, expected %+v", locked, want)
+		}
+	}
+	spend := func(amount dcrutil.Amount) ([]wire.OutPoint, error) {
+		outputs := []*wire.TxOut{wire.NewTxOut(int64(amount), pkScript)}
+		atx, err := w.NewUnsignedTransaction(ctx, outputs, dcrutil.Amount(1e4),
+			0, 0, OutputSelectionAlgorithmDefault, nil)
+		if err != nil {
+			return nil, err
+		}
+		return atx.PrevOutpoints, nil
+	}
+
+	checkLocked(nil)
+
+	// Lock both outputs in reverse order.  Results are sorted by outpoint.
+	for _, op := range []wire.OutPoint{outpoints[1], outpoints[0]} {
+		if err := w.LockOutpoint(ctx, op, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkLocked(results)
+	_, err = spend(5e7)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("expected InsufficientBalance spending locked outputs, got %v", err)
+	}
+
+	// Unlock the second output, which is the only output the coin selector
+	// may then spend.
+	if err := w.UnlockOutpoint(ctx, outpoints[1]); err != nil {
+		t.Fatal(err)
+	}
+	checkLocked(results[:1])
+	prevOuts, err := spend(5e7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(prevOuts, outpoints[1:]) {
+		t.Errorf("spent outpoints %v, expected %v", prevOuts, outpoints[1:])
+	}
+
+	// Outpoints unknown to the wallet are listed without amounts and
+	// accounts.
+	unknown := wire.OutPoint{Hash: chainhash.HashH([]byte("unknown")), Index: 2,
+		Tree: wire.TxTreeStake}
+	if err := w.LockOutpoint(ctx, unknown, false); err != nil {
+		t.Fatal(err)
+	}
+	locked, err := w.LockedOutpoints(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, l := range locked {
+		if l.TxID != unknown.Hash.String() {
+			continue
+		}
+		found = true
+		want := types.ListLockUnspentResult{TxID: unknown.Hash.String(),
+			Vout: 2, Tree: wire.TxTreeStake}
+		if l != want {
+			t.Errorf("unknown locked outpoint %+v, expected %+v", l, want)
+		}
+	}
+	if !found || len(locked) != 2 {
+		t.Errorf("locked outpoints %+v, expected unknown outpoint and %+v",
+			locked, results[0])
+	}
+
+	if err := w.ResetLockedOutpoints(ctx); err != nil {
+		t.Fatal(err)
+	}
+	checkLocked(nil)
+}
+
+func TestPersistentLockedOutpoints(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	persistent := []wire.OutPoint{
+		{Hash: chainhash.HashH([]byte("a")), Index: 0, Tree: wire.TxTreeRegular},
+		{Hash: chainhash.HashH([]byte("b")), Index: 1, Tree: wire.TxTreeStake},
+	}
+	inMemory := wire.OutPoint{Hash: chainhash.HashH([]byte("c")), Index: 2}
+	for i := range persistent {
+		if err := w.LockOutpoint(ctx, persistent[i], true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.LockOutpoint(ctx, inMemory, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// reopen opens the wallet database again, as if the wallet was
+	// restarted.
+	reopen := func() *Wallet {
+		t.Helper()
+		w, err := Open(ctx, &cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return w
+	}
+
+	// Only persistent locks are reloaded.
+	w = reopen()
+	for i := range persistent {
+		if !w.LockedOutpoint(persistent[i]) {
+			t.Errorf("persistent lock on %v was not reloaded", &persistent[i])
+		}
+	}
+	if w.LockedOutpoint(inMemory) {
+		t.Errorf("in-memory lock on %v was reloaded", &inMemory)
+	}
+
+	// Unlocking removes the persistent lock.
+	if err := w.UnlockOutpoint(ctx, persistent[0]); err != nil {
+		t.Fatal(err)
+	}
+	w = reopen()
+	if w.LockedOutpoint(persistent[0]) {
+		t.Errorf("unlocked outpoint %v was reloaded", &persistent[0])
+	}
+	if !w.LockedOutpoint(persistent[1]) {
+		t.Errorf("persistent lock on %v was not reloaded", &persistent[1])
+	}
+
+	// Resetting the locked outpoints removes all persistent locks.
+	if err := w.ResetLockedOutpoints(ctx); err != nil {
+		t.Fatal(err)
+	}
+	w = reopen()
+	if w.LockedOutpoint(persistent[1]) {
+		t.Errorf("reset outpoint %v was reloaded", &persistent[1])
+	}
+}
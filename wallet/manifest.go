@@ -0,0 +1,487 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/udb"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+// manifestVersion is the version of the backup manifest format written by
+// ExportManifest.  ImportManifest rejects manifests of any other version.
+const manifestVersion = 1
+
+// manifest is the JSON encoding of a wallet backup manifest.  It records the
+// public data needed to recreate a watching-only wallet and must never include
+// private keys.
+type manifest struct {
+	Version  uint32            `json:"version"`
+	Network  string            `json:"network"`
+	Accounts []manifestAccount `json:"accounts"`
+
+	// ImportedAddresses lists the addresses of imported private keys.
+	// These are recorded so the keys can be located and imported again,
+	// but are not restored by ImportManifest.
+	ImportedAddresses []string `json:"importedaddresses"`
+
+	// ImportedScripts lists the hex encoded imported redeem scripts.
+	ImportedScripts []string `json:"importedscripts"`
+
+	Labels []manifestLabel `json:"labels"`
+
+	// SigningAddress is the first external address of the default
+	// account, and Signature is its base64 encoded message signature of
+	// the manifest.  See (*manifest).signedMessage.
+	SigningAddress string `json:"signingaddress"`
+	Signature      string `json:"signature,omitempty"`
+}
+
+type manifestAccount struct {
+	Number   uint32 `json:"number"`
+	Name     string `json:"name"`
+	Xpub     string `json:"xpub"`
+	GapLimit uint32 `json:"gaplimit,omitempty"` // zero for the wallet gap limit
+}
+
+type manifestLabel struct {
+	TxHash string `json:"txhash"`
+	Label  string `json:"label"`
+}
+
+// signedMessage returns the message signed by the manifest signature.  This
+// is the hex encoded SHA-256 hash of the JSON encoding of the manifest without
+// its signature.
+func (m *manifest) signedMessage() (string, error) {
+	unsigned := *m
+	unsigned.Signature = ""
+	b, err := json.Marshal(&unsigned)
+	if err != nil {
+		return "", errors.E(errors.Encoding, err)
+	}
+	hash := sha256.Sum256(b)
+	return "dcrwallet backup manifest: " + hex.EncodeToString(hash[:]), nil
+}
+
+// manifestSigningAddress returns the address which signs a manifest.  This is
+// the first external address of the default account.
+func manifestSigningAddress(xpub *hdkeychain.ExtendedKey, params *chaincfg.Params) (dcrutil.Address, error) {
+	extKey, _, err := deriveBranches(xpub)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := deriveChildAddresses(extKey, 0, 1, params)
+	if err != nil {
+		return nil, err
+	}
+	return addrs[0], nil
+}
+
+// ExportManifest writes a signed backup manifest of the wallet to out.  The
+// manifest is a versioned JSON document listing every account with its name,
+// extended public key and gap limit, the addresses of imported keys, imported
+// redeem scripts, and transaction labels.  Private keys are never written.
+//
+// The manifest is signed by the key of the default account's first external
+// address, so the wallet must be unlocked.  A watching-only wallet can not
+// export a manifest.
+func (w *Wallet) ExportManifest(ctx context.Context, out io.Writer) error {
+	const op errors.Op = "wallet.ExportManifest"
+	m := &manifest{
+		Version:           manifestVersion,
+		Network:           w.chainParams.Name,
+		ImportedAddresses: []string{},
+		ImportedScripts:   []string{},
+		Labels:            []manifestLabel{},
+	}
+	var defaultXpub *hdkeychain.ExtendedKey
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		err := w.Manager.ForEachAccount(addrmgrNs, func(account uint32) error {
+			if account == udb.ImportedAddrAccount {
+				return nil
+			}
+			name, err := w.Manager.AccountName(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			xpub, err := w.Manager.AccountExtendedPubKey(dbtx, account)
+			if err != nil {
+				return err
+			}
+			gapLimit, err := w.Manager.AccountGapLimit(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			if account == udb.DefaultAccountNum {
+				defaultXpub = xpub
+			}
+			m.Accounts = append(m.Accounts, manifestAccount{
+				Number:   account,
+				Name:     name,
+				Xpub:     xpub.String(),
+				GapLimit: gapLimit,
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		err = w.Manager.ForEachAccountAddress(addrmgrNs, udb.ImportedAddrAccount,
+			func(maddr udb.ManagedAddress) error {
+				if _, ok := maddr.(udb.ManagedScriptAddress); !ok {
+					m.ImportedAddresses = append(m.ImportedAddresses,
+						maddr.Address().Address())
+					return nil
+				}
+				script, err := w.TxStore.GetTxScript(txmgrNs, maddr.AddrHash())
+				if err != nil {
+					return err
+				}
+				m.ImportedScripts = append(m.ImportedScripts,
+					hex.EncodeToString(script))
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+
+		return w.TxStore.ForEachTxLabel(txmgrNs, func(txHash *chainhash.Hash, label string) error {
+			m.Labels = append(m.Labels, manifestLabel{
+				TxHash: txHash.String(),
+				Label:  label,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if defaultXpub == nil {
+		return errors.E(op, errors.Bug, "missing default account")
+	}
+	sort.Slice(m.Accounts, func(i, j int) bool {
+		return m.Accounts[i].Number < m.Accounts[j].Number
+	})
+	sort.Strings(m.ImportedAddresses)
+	sort.Strings(m.ImportedScripts)
+
+	signingAddr, err := manifestSigningAddress(defaultXpub, w.chainParams)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	m.SigningAddress = signingAddr.Address()
+	msg, err := m.signedMessage()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	sig, err := w.SignMessage(ctx, msg, signingAddr)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	m.Signature = base64.StdEncoding.EncodeToString(sig)
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	err = enc.Encode(m)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ImportManifest restores the accounts, imported scripts, and transaction
+// labels recorded by a backup manifest written by ExportManifest, and then
+// rescans the main chain for the transactions of the restored accounts.
+//
+// The manifest must be signed by the first external address of this wallet's
+// default account, such as a watching-only wallet created from the default
+// account xpub of the exporting wallet.  The signature is verified, and all
+// records are imported, in a single database transaction, so the wallet is
+// not modified unless the entire manifest is imported.
+//
+// Accounts are restored as watching-only accounts of their extended public
+// keys.  An account which already exists with the same name and extended
+// public key, such as the default account, is not recreated.  If an account
+// exists with the same name but a different key, an error with kind
+// errors.Exist is returned.  Imported private keys are not restored.
+//
+// If the wallet has no network backend, the rescan is not performed and must
+// be started once the wallet is synced.
+func (w *Wallet) ImportManifest(ctx context.Context, r io.Reader) error {
+	const op errors.Op = "wallet.ImportManifest"
+	var m manifest
+	err := json.NewDecoder(r).Decode(&m)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	if m.Version != manifestVersion {
+		return errors.E(op, errors.Invalid, errors.Errorf("unsupported "+
+			"manifest version %d", m.Version))
+	}
+	if m.Network != w.chainParams.Name {
+		return errors.E(op, errors.Invalid, errors.Errorf("manifest is "+
+			"for network %q", m.Network))
+	}
+
+	xpubs := make([]*hdkeychain.ExtendedKey, len(m.Accounts))
+	for i := range m.Accounts {
+		a := &m.Accounts[i]
+		xpub, err := hdkeychain.NewKeyFromString(a.Xpub, w.chainParams)
+		if err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+		if xpub.IsPrivate() {
+			return errors.E(op, errors.Invalid, "manifest contains a private key")
+		}
+		if a.GapLimit >= hdkeychain.HardenedKeyStart {
+			return errors.E(op, errors.Invalid, "gap limit too large")
+		}
+		xpubs[i] = xpub
+	}
+	scripts := make([][]byte, len(m.ImportedScripts))
+	for i, s := range m.ImportedScripts {
+		scripts[i], err = hex.DecodeString(s)
+		if err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+	}
+	labelHashes := make([]*chainhash.Hash, len(m.Labels))
+	for i := range m.Labels {
+		labelHashes[i], err = chainhash.NewHashFromStr(m.Labels[i].TxHash)
+		if err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+	}
+	sig, err := base64.StdEncoding.DecodeString(m.Signature)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	msg, err := m.signedMessage()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	// New accounts are recorded so their address buffers may be created
+	// once the import is committed.
+	type importedAccount struct {
+		account  uint32
+		xpub     *hdkeychain.ExtendedKey
+		gapLimit uint32
+	}
+	var imported []importedAccount
+	gapLimits := make(map[uint32]uint32)
+
+	defer w.addressBuffersMu.Unlock()
+	w.addressBuffersMu.Lock()
+
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		// Verify the signature by the first address of the wallet's
+		// default account.
+		defaultXpub, err := w.Manager.AccountExtendedPubKey(dbtx, udb.DefaultAccountNum)
+		if err != nil {
+			return err
+		}
+		signingAddr, err := manifestSigningAddress(defaultXpub, w.chainParams)
+		if err != nil {
+			return err
+		}
+		if m.SigningAddress != signingAddr.Address() {
+			return errors.E(errors.Invalid, "manifest is not signed by "+
+				"the wallet's default account")
+		}
+		ok, err := VerifyMessage(msg, signingAddr, sig, w.chainParams)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.E(errors.Invalid, "invalid manifest signature")
+		}
+
+		for i := range m.Accounts {
+			a := &m.Accounts[i]
+			account, exists, err := w.manifestAccount(dbtx, a)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				err = w.Manager.ImportXpubAccount(addrmgrNs, a.Name, xpubs[i])
+				if err != nil {
+					return err
+				}
+				account, err = w.Manager.LookupAccount(addrmgrNs, a.Name)
+				if err != nil {
+					return err
+				}
+				imported = append(imported, importedAccount{account, xpubs[i], a.GapLimit})
+			}
+			if a.GapLimit == 0 {
+				continue
+			}
+			err = w.Manager.SetAccountGapLimit(addrmgrNs, account, a.GapLimit)
+			if err != nil {
+				return err
+			}
+			props, err := w.Manager.AccountProperties(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
+				minUint32(hdkeychain.HardenedKeyStart-1, props.LastUsedExternalIndex+a.GapLimit),
+				udb.ExternalBranch)
+			if err != nil {
+				return err
+			}
+			err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
+				minUint32(hdkeychain.HardenedKeyStart-1, props.LastUsedInternalIndex+a.GapLimit),
+				udb.InternalBranch)
+			if err != nil {
+				return err
+			}
+			gapLimits[account] = a.GapLimit
+		}
+
+		for _, script := range scripts {
+			err := w.TxStore.InsertTxScript(txmgrNs, script)
+			if err != nil {
+				return err
+			}
+			_, err = w.Manager.ImportScript(addrmgrNs, script)
+			if err != nil && !errors.Is(err, errors.Exist) {
+				return err
+			}
+		}
+
+		for i := range m.Labels {
+			err := w.TxStore.ImportTxLabel(txmgrNs, labelHashes[i], m.Labels[i].Label)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	// Watch the addresses of the new accounts and scripts, and extend the
+	// address buffers of existing accounts to their restored gap limits.
+	var watch []dcrutil.Address
+	for _, a := range imported {
+		extKey, intKey, err := deriveBranches(a.xpub)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		gapLimit := uint32(w.gapLimit)
+		if a.gapLimit != 0 {
+			gapLimit = a.gapLimit
+		}
+		for _, branchKey := range []*hdkeychain.ExtendedKey{extKey, intKey} {
+			addrs, err := deriveChildAddresses(branchKey, 0, gapLimit, w.chainParams)
+			if err != nil {
+				return errors.E(op, err)
+			}
+			watch = append(watch, addrs...)
+		}
+		albExternal := addressBuffer{
+			branchXpub:  extKey,
+			lastUsed:    ^uint32(0),
+			cursor:      0,
+			lastWatched: gapLimit - 1,
+		}
+		albInternal := albExternal
+		albInternal.branchXpub = intKey
+		w.addressBuffers[a.account] = &bip0044AccountData{
+			xpub:        a.xpub,
+			albExternal: albExternal,
+			albInternal: albInternal,
+			gapLimit:    a.gapLimit,
+		}
+	}
+	for account, limit := range gapLimits {
+		ad, ok := w.addressBuffers[account]
+		if !ok {
+			continue
+		}
+		prevLimit := w.bufferGapLimit(ad)
+		ad.gapLimit = limit
+		if limit <= prevLimit {
+			continue
+		}
+		for _, alb := range []*addressBuffer{&ad.albExternal, &ad.albInternal} {
+			addrs, err := deriveChildAddresses(alb.branchXpub,
+				alb.lastUsed+1+prevLimit, limit-prevLimit, w.chainParams)
+			if err != nil {
+				return errors.E(op, err)
+			}
+			watch = append(watch, addrs...)
+		}
+	}
+	for _, script := range scripts {
+		addr, err := dcrutil.NewAddressScriptHash(script, w.chainParams)
+		if err != nil {
+			return errors.E(op, errors.Invalid, err)
+		}
+		watch = append(watch, addr)
+	}
+
+	n, err := w.NetworkBackend()
+	if err != nil {
+		log.Infof("Imported backup manifest; a rescan is required to " +
+			"discover its transactions")
+		return nil
+	}
+	err = n.LoadTxFilter(ctx, false, watch, nil)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	err = w.RescanFromHeight(ctx, n, 0)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// manifestAccount returns the number of the wallet account with the name of a
+// manifest account, and whether the account exists.  An error with kind
+// errors.Exist is returned if the account exists with a different extended
+// public key.
+func (w *Wallet) manifestAccount(dbtx walletdb.ReadTx, a *manifestAccount) (uint32, bool, error) {
+	ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	account, err := w.Manager.LookupAccount(ns, a.Name)
+	if errors.Is(err, errors.NotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	xpub, err := w.Manager.AccountExtendedPubKey(dbtx, account)
+	if err != nil {
+		return 0, false, err
+	}
+	if xpub.String() != a.Xpub {
+		return 0, false, errors.E(errors.Exist, errors.Errorf("account %q "+
+			"exists with a different extended public key", a.Name))
+	}
+	return account, true, nil
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/udb"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v3"
+)
+
+func TestManifestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	src, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := src.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	savings, err := src.NextAccount(ctx, "savings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = src.SetAccountGapLimit(ctx, savings, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Import an xpub account derived from a seed unrelated to the wallet.
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{0x5e}, 32), cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acctXpriv := master
+	for _, i := range []uint32{44, 1, 0} {
+		acctXpriv, err = acctXpriv.Child(hdkeychain.HardenedKeyStart + i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	watchedXpub, err := acctXpriv.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = src.ImportXpubAccount(ctx, "watched", watchedXpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	script, err := txscript.NewScriptBuilder().AddOp(txscript.OP_TRUE).Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = src.ImportScript(ctx, script, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	labeled := chainhash.Hash{1}
+	err = walletdb.Update(ctx, src.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return src.TxStore.ImportTxLabel(ns, &labeled, "rent")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = src.ExportManifest(ctx, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exported := buf.String()
+
+	// The manifest must not include any private keys.
+	err = walletdb.View(ctx, src.db, func(dbtx walletdb.ReadTx) error {
+		for _, account := range []uint32{udb.DefaultAccountNum, savings} {
+			xpriv, err := src.Manager.AccountExtendedPrivKey(dbtx, account)
+			if err != nil {
+				return err
+			}
+			if strings.Contains(exported, xpriv.String()) {
+				t.Errorf("manifest contains xpriv of account %d", account)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defaultXpub, err := src.MasterPubKey(ctx, udb.DefaultAccountNum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	savingsXpub, err := src.MasterPubKey(ctx, savings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst, teardown := testWatchingOnlyWallet(t, &cfg, defaultXpub.String())
+	defer teardown()
+
+	err = dst.ImportManifest(ctx, strings.NewReader(exported))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.View(ctx, dst.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for _, a := range []struct {
+			name     string
+			xpub     string
+			gapLimit uint32
+		}{
+			{"default", defaultXpub.String(), 0},
+			{"savings", savingsXpub.String(), 50},
+			{"watched", watchedXpub.String(), 0},
+		} {
+			account, err := dst.Manager.LookupAccount(ns, a.name)
+			if err != nil {
+				return err
+			}
+			xpub, err := dst.Manager.AccountExtendedPubKey(dbtx, account)
+			if err != nil {
+				return err
+			}
+			if xpub.String() != a.xpub {
+				t.Errorf("account %q: xpub %v, expected %v", a.name,
+					xpub, a.xpub)
+			}
+			gapLimit, err := dst.Manager.AccountGapLimit(ns, account)
+			if err != nil {
+				return err
+			}
+			if gapLimit != a.gapLimit {
+				t.Errorf("account %q: gap limit %d, expected %d", a.name,
+					gapLimit, a.gapLimit)
+			}
+		}
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, err := dst.TxStore.GetTxScript(txmgrNs, dcrutil.Hash160(script))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	label, err := dst.TransactionLabel(ctx, &labeled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if label != "rent" {
+		t.Errorf("label %q, expected %q", label, "rent")
+	}
+
+	// Importing the manifest again does not modify the wallet.
+	err = dst.ImportManifest(ctx, strings.NewReader(exported))
+	if err != nil {
+		t.Fatalf("reimporting manifest: %v", err)
+	}
+
+	// A modified manifest does not verify.
+	tampered := strings.Replace(exported, `"rent"`, `"food"`, 1)
+	err = dst.ImportManifest(ctx, strings.NewReader(tampered))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("importing tampered manifest: expected Invalid, got %v", err)
+	}
+
+	// A wallet whose default account did not sign the manifest rejects it
+	// without importing any accounts.
+	other, teardown := testWatchingOnlyWallet(t, &cfg, watchedXpub.String())
+	defer teardown()
+	err = other.ImportManifest(ctx, strings.NewReader(exported))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("importing manifest of another wallet: expected Invalid, got %v", err)
+	}
+	_, err = other.AccountNumber(ctx, "savings")
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("manifest of another wallet created account: %v", err)
+	}
+
+	// A conflicting account aborts the import before any records are
+	// written.
+	conflict, teardown := testWatchingOnlyWallet(t, &cfg, defaultXpub.String())
+	defer teardown()
+	_, err = conflict.ImportXpubAccount(ctx, "watched", savingsXpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = conflict.ImportManifest(ctx, strings.NewReader(exported))
+	if !errors.Is(err, errors.Exist) {
+		t.Errorf("importing conflicting manifest: expected Exist, got %v", err)
+	}
+	_, err = conflict.AccountNumber(ctx, "savings")
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("conflicting manifest created account: %v", err)
+	}
+	label, err = conflict.TransactionLabel(ctx, &labeled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if label != "" {
+		t.Errorf("conflicting manifest imported label %q", label)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3/schnorr"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// Message signatures are serialized in one of two formats, depending on the
+// signature algorithm of the signing address:
+//
+//   - secp256k1 ECDSA signatures are serialized as 65 byte compact signatures
+//     from which the public key is recovered.  The first byte is the public
+//     key recovery code, which is always in the range [27, 34].
+//   - Signatures of all other algorithms are serialized as the one byte
+//     dcrec.SignatureType of the algorithm, followed by the serialized public
+//     key and the 64 byte signature.
+const (
+	compactSigSize        = 65
+	compactSigMagicOffset = 27
+	algoSigSize           = 64
+)
+
+// messageHash returns the hash of msg which is signed to create message
+// signatures.
+func messageHash(msg string) []byte {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, "Decred Signed Message:\n")
+	wire.WriteVarString(&buf, 0, msg)
+	return chainhash.HashB(buf.Bytes())
+}
+
+// addressSignatureType returns the signature algorithm used to prove ownership
+// of an address.  Addresses which are not associated with a single public key
+// return an error with kind errors.Invalid.
+func addressSignatureType(addr dcrutil.Address) (dcrec.SignatureType, error) {
+	switch addr := addr.(type) {
+	case *dcrutil.AddressSecpPubKey:
+		return dcrec.STEcdsaSecp256k1, nil
+	case *dcrutil.AddressSecSchnorrPubKey:
+		return dcrec.STSchnorrSecp256k1, nil
+	case *dcrutil.AddressEdwardsPubKey:
+		return dcrec.STEd25519, nil
+	case *dcrutil.AddressPubKeyHash:
+		return addr.DSA(), nil
+	default:
+		return 0, errors.E(errors.Invalid, "address must be P2PK or P2PKH")
+	}
+}
+
+// signMessageHash signs the message hash with the private key using the
+// signature algorithm sigType, returning a serialized message signature.
+func signMessageHash(hash, privKey []byte, sigType dcrec.SignatureType) ([]byte, error) {
+	switch sigType {
+	case dcrec.STEcdsaSecp256k1:
+		key := secp256k1.PrivKeyFromBytes(privKey)
+		return secp256k1.SignCompact(key, hash, true)
+	case dcrec.STSchnorrSecp256k1:
+		key := secp256k1.PrivKeyFromBytes(privKey)
+		r, s, err := schnorr.Sign(key, hash)
+		if err != nil {
+			return nil, err
+		}
+		sig := []byte{byte(sigType)}
+		sig = append(sig, key.PubKey().SerializeCompressed()...)
+		return append(sig, schnorr.NewSignature(r, s).Serialize()...), nil
+	case dcrec.STEd25519:
+		key, pubKey, err := edwards.PrivKeyFromScalar(privKey)
+		if err != nil {
+			return nil, err
+		}
+		r, s, err := edwards.Sign(key, hash)
+		if err != nil {
+			return nil, err
+		}
+		sig := []byte{byte(sigType)}
+		sig = append(sig, pubKey.Serialize()...)
+		return append(sig, edwards.NewSignature(r, s).Serialize()...), nil
+	default:
+		return nil, errors.E(errors.Invalid, errors.Errorf("unknown signature type %d", sigType))
+	}
+}
+
+// SignMessage returns the signature of a signed message using an address'
+// associated private key.  The signature algorithm is selected by the address
+// type.  As the wallet only holds secp256k1 keys, messages may be signed for
+// ECDSA and Schnorr addresses, but not Ed25519 addresses.
+func (w *Wallet) SignMessage(ctx context.Context, msg string, addr dcrutil.Address) (sig []byte, err error) {
+	const op errors.Op = "wallet.SignMessage"
+	sigType, err := addressSignatureType(addr)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if sigType == dcrec.STEd25519 {
+		return nil, errors.E(op, errors.Invalid, "wallet does not hold Ed25519 keys")
+	}
+	var privKey *secp256k1.PrivateKey
+	var done func()
+	defer func() {
+		if done != nil {
+			done()
+		}
+	}()
+	err = walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		privKey, done, err = w.Manager.PrivateKey(addrmgrNs, addr)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sig, err = signMessageHash(messageHash(msg), privKey.Serialize(), sigType)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return sig, nil
+}
+
+// VerifyMessage verifies that sig is a valid signature of msg and was created
+// using the private key for addr.  Verification does not require a wallet, and
+// the signature algorithm is selected by the signature and must match the
+// algorithm of the address.  A signature created with a different algorithm
+// than that of the address is not valid.
+func VerifyMessage(msg string, addr dcrutil.Address, sig []byte, params dcrutil.AddressParams) (bool, error) {
+	const op errors.Op = "wallet.VerifyMessage"
+	addrSigType, err := addressSignatureType(addr)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	if len(sig) == 0 {
+		return false, errors.E(op, errors.Encoding, "empty signature")
+	}
+	hash := messageHash(msg)
+
+	sigType := dcrec.STEcdsaSecp256k1
+	if len(sig) != compactSigSize || sig[0] < compactSigMagicOffset {
+		sigType = dcrec.SignatureType(sig[0])
+	}
+	if sigType != addrSigType {
+		return false, nil
+	}
+
+	var recoveredAddr dcrutil.Address
+	switch sigType {
+	case dcrec.STEcdsaSecp256k1:
+		// Validate the signature - this just shows that it was valid for
+		// any pubkey at all.  Whether the pubkey matches is checked below.
+		pk, wasCompressed, err := secp256k1.RecoverCompact(sig, hash)
+		if err != nil {
+			return false, errors.E(op, err)
+		}
+
+		// Reconstruct the address from the recovered pubkey.
+		var serializedPK []byte
+		if wasCompressed {
+			serializedPK = pk.SerializeCompressed()
+		} else {
+			serializedPK = pk.SerializeUncompressed()
+		}
+		recoveredAddr, err = dcrutil.NewAddressSecpPubKey(serializedPK, params)
+		if err != nil {
+			return false, errors.E(op, err)
+		}
+
+	case dcrec.STSchnorrSecp256k1:
+		const pkLen = secp256k1.PubKeyBytesLenCompressed
+		if len(sig) != 1+pkLen+algoSigSize {
+			return false, errors.E(op, errors.Encoding, "invalid signature length")
+		}
+		serializedPK := sig[1 : 1+pkLen]
+		pk, err := schnorr.ParsePubKey(serializedPK)
+		if err != nil {
+			return false, errors.E(op, errors.Encoding, err)
+		}
+		s, err := schnorr.ParseSignature(sig[1+pkLen:])
+		if err != nil {
+			return false, errors.E(op, errors.Encoding, err)
+		}
+		if !schnorr.Verify(pk, hash, s.GetR(), s.GetS()) {
+			return false, nil
+		}
+		recoveredAddr, err = dcrutil.NewAddressSecSchnorrPubKey(serializedPK, params)
+		if err != nil {
+			return false, errors.E(op, err)
+		}
+
+	case dcrec.STEd25519:
+		const pkLen = edwards.PubKeyBytesLen
+		if len(sig) != 1+pkLen+algoSigSize {
+			return false, errors.E(op, errors.Encoding, "invalid signature length")
+		}
+		serializedPK := sig[1 : 1+pkLen]
+		pk, err := edwards.ParsePubKey(serializedPK)
+		if err != nil {
+			return false, errors.E(op, errors.Encoding, err)
+		}
+		s, err := edwards.ParseSignature(sig[1+pkLen:])
+		if err != nil {
+			return false, errors.E(op, errors.Encoding, err)
+		}
+		if !edwards.Verify(pk, hash, s.GetR(), s.GetS()) {
+			return false, nil
+		}
+		recoveredAddr, err = dcrutil.NewAddressEdwardsPubKey(serializedPK, params)
+		if err != nil {
+			return false, errors.E(op, err)
+		}
+
+	default:
+		return false, errors.E(op, errors.Encoding,
+			errors.Errorf("unknown signature type %d", sigType))
+	}
+
+	// Return whether addresses match.  Pubkey hash addresses are compared
+	// by the hash of the recovered public key.
+	if _, ok := addr.(*dcrutil.AddressPubKeyHash); ok {
+		return *recoveredAddr.Hash160() == *addr.Hash160(), nil
+	}
+	return recoveredAddr.Address() == addr.Address(), nil
+}
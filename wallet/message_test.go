@@ -0,0 +1,148 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+)
+
+func TestVerifyMessage(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	keyBytes := make([]byte, 32)
+	keyBytes[31] = 1
+	secpPubKey := secp256k1.PrivKeyFromBytes(keyBytes).PubKey().SerializeCompressed()
+	_, edPubKey, err := edwards.PrivKeyFromScalar(keyBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mustAddr := func(addr dcrutil.Address, err error) dcrutil.Address {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr
+	}
+	pkh := func(pubKey []byte, sigType dcrec.SignatureType) dcrutil.Address {
+		return mustAddr(dcrutil.NewAddressPubKeyHash(dcrutil.Hash160(pubKey),
+			params, sigType))
+	}
+	ecdsaPKH := pkh(secpPubKey, dcrec.STEcdsaSecp256k1)
+	schnorrPKH := pkh(secpPubKey, dcrec.STSchnorrSecp256k1)
+	edPKH := pkh(edPubKey.Serialize(), dcrec.STEd25519)
+
+	tests := []struct {
+		name    string
+		sigType dcrec.SignatureType
+		addr    dcrutil.Address
+	}{
+		{"ecdsa p2pk", dcrec.STEcdsaSecp256k1,
+			mustAddr(dcrutil.NewAddressSecpPubKey(secpPubKey, params))},
+		{"ecdsa p2pkh", dcrec.STEcdsaSecp256k1, ecdsaPKH},
+		{"schnorr p2pk", dcrec.STSchnorrSecp256k1,
+			mustAddr(dcrutil.NewAddressSecSchnorrPubKey(secpPubKey, params))},
+		{"schnorr p2pkh", dcrec.STSchnorrSecp256k1, schnorrPKH},
+		{"ed25519 p2pk", dcrec.STEd25519,
+			mustAddr(dcrutil.NewAddressEdwardsPubKey(edPubKey.Serialize(), params))},
+		{"ed25519 p2pkh", dcrec.STEd25519, edPKH},
+	}
+	const msg = "message"
+	for _, test := range tests {
+		sig, err := signMessageHash(messageHash(msg), keyBytes, test.sigType)
+		if err != nil {
+			t.Errorf("%s: sign: %v", test.name, err)
+			continue
+		}
+		valid, err := VerifyMessage(msg, test.addr, sig, params)
+		if err != nil || !valid {
+			t.Errorf("%s: signature is invalid (err: %v)", test.name, err)
+		}
+		valid, err = VerifyMessage(msg+"!", test.addr, sig, params)
+		if err == nil && valid {
+			t.Errorf("%s: signature is valid for a different message", test.name)
+		}
+	}
+
+	// Signatures claiming an algorithm other than that of the address are
+	// invalid, even when created by the key of the address.
+	mismatched := []struct {
+		name    string
+		sigType dcrec.SignatureType
+		addr    dcrutil.Address
+	}{
+		{"schnorr sig for ecdsa p2pkh", dcrec.STSchnorrSecp256k1, ecdsaPKH},
+		{"ecdsa sig for schnorr p2pkh", dcrec.STEcdsaSecp256k1, schnorrPKH},
+		{"schnorr sig for ed25519 p2pkh", dcrec.STSchnorrSecp256k1, edPKH},
+	}
+	for _, test := range mismatched {
+		sig, err := signMessageHash(messageHash(msg), keyBytes, test.sigType)
+		if err != nil {
+			t.Fatalf("%s: sign: %v", test.name, err)
+		}
+		valid, err := VerifyMessage(msg, test.addr, sig, params)
+		if err != nil || valid {
+			t.Errorf("%s: expected invalid signature without error, got "+
+				"valid=%v err=%v", test.name, valid, err)
+		}
+	}
+
+	// Script hash addresses can not verify messages.
+	p2sh := mustAddr(dcrutil.NewAddressScriptHash([]byte{0x51}, params))
+	_, err = VerifyMessage(msg, p2sh, make([]byte, 65), params)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for P2SH address, got %v", err)
+	}
+}
+
+func TestSignMessage(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock(ctx, []byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := w.ChainParams()
+	schnorrAddr, err := dcrutil.NewAddressPubKeyHash(addr.Hash160()[:], params,
+		dcrec.STSchnorrSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edAddr, err := dcrutil.NewAddressPubKeyHash(addr.Hash160()[:], params,
+		dcrec.STEd25519)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const msg = "message"
+	for _, a := range []dcrutil.Address{addr, schnorrAddr} {
+		sig, err := w.SignMessage(ctx, msg, a)
+		if err != nil {
+			t.Fatalf("%v: %v", a, err)
+		}
+		valid, err := VerifyMessage(msg, a, sig, params)
+		if err != nil || !valid {
+			t.Errorf("%v: signature is invalid (err: %v)", a, err)
+		}
+	}
+
+	_, err = w.SignMessage(ctx, msg, edAddr)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid signing for Ed25519 address, got %v", err)
+	}
+}
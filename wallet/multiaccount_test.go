@@ -0,0 +1,150 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestNewUnsignedTransactionMultiAccount(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	savings, err := w.NextAccount(ctx, "savings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	empty, err := w.NextAccount(ctx, "empty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript := func(account uint32) []byte {
+		addr, err := w.NewExternalAddress(ctx, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+
+	// Fund the default account with a single output, and the savings
+	// account with an output and two dust outputs.
+	const dust = 5000
+	if !txrules.IsDustAmount(dust, 25, txrules.DefaultRelayFeePerKb) {
+		t.Fatalf("%v is not dust", dcrutil.Amount(dust))
+	}
+	fundingAccounts := []uint32{0, savings, savings, savings}
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 3e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript(0)))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript(savings)))
+	funding.AddTxOut(wire.NewTxOut(dust, pkScript(savings)))
+	funding.AddTxOut(wire.NewTxOut(dust, pkScript(savings)))
+	err = w.AcceptMempoolTx(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundingHash := funding.TxHash()
+
+	// Neither account can pay the output alone.
+	outputs := []*wire.TxOut{wire.NewTxOut(1.5e8, make([]byte, 25))}
+	for _, account := range []uint32{0, savings} {
+		_, err := w.NewUnsignedTransaction(ctx, outputs, 1e4, account, 0,
+			OutputSelectionAlgorithmDefault, nil)
+		if !errors.Is(err, errors.InsufficientBalance) {
+			t.Fatalf("account %d: expected InsufficientBalance, got %v",
+				account, err)
+		}
+	}
+
+	atx, err := w.NewUnsignedTransactionMultiAccount(ctx, outputs, 1e4,
+		[]uint32{empty, 0, savings}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atx.PrevAccounts) != len(atx.Tx.TxIn) {
+		t.Fatalf("%d input accounts for %d inputs", len(atx.PrevAccounts),
+			len(atx.Tx.TxIn))
+	}
+	if len(atx.Tx.TxIn) != len(funding.TxOut) {
+		t.Fatalf("spent %d inputs, expected %d", len(atx.Tx.TxIn),
+			len(funding.TxOut))
+	}
+	for i, in := range atx.Tx.TxIn {
+		prevOut := &in.PreviousOutPoint
+		if prevOut.Hash != fundingHash {
+			t.Fatalf("input %d spends unknown output %v", i, prevOut)
+		}
+		if want := fundingAccounts[prevOut.Index]; atx.PrevAccounts[i] != want {
+			t.Errorf("input %d spending %v recorded account %d, expected %d",
+				i, prevOut, atx.PrevAccounts[i], want)
+		}
+	}
+
+	// The savings account holds more dust and is spent from first.
+	for i := 0; i < 3; i++ {
+		if atx.PrevAccounts[i] != savings {
+			t.Errorf("input %d from account %d, expected savings account",
+				i, atx.PrevAccounts[i])
+		}
+	}
+
+	// Change is returned to the default account.
+	change, ok := atx.ChangeOutput()
+	if !ok {
+		t.Fatal("no change output")
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(change.Version,
+		change.PkScript, cfg.Params)
+	if err != nil || len(addrs) != 1 {
+		t.Fatalf("change script: %v", err)
+	}
+	changeAccount, err := w.AccountOfAddress(ctx, addrs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changeAccount != 0 {
+		t.Errorf("change paid to account %d, expected 0", changeAccount)
+	}
+
+	// Keys of both accounts are used to sign the transaction.
+	signErrs, err := w.SignTransaction(ctx, atx.Tx, txscript.SigHashAll,
+		nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signErrs) != 0 {
+		t.Errorf("signing errors: %v", signErrs)
+	}
+
+	// Spending from only an empty account is insufficient.
+	_, err = w.NewUnsignedTransactionMultiAccount(ctx, outputs, 1e4,
+		[]uint32{empty}, 0, 0)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("empty account: expected InsufficientBalance, got %v", err)
+	}
+	_, err = w.NewUnsignedTransactionMultiAccount(ctx, outputs, 1e4,
+		[]uint32{0, empty + 1}, 0, 0)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("missing account: expected NotExist, got %v", err)
+	}
+}
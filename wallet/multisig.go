@@ -205,7 +205,8 @@ func (w *Wallet) PrepareRedeemMultiSigOutTxOutput(msgTx *wire.MsgTx, p2shOutput
 	scriptSizes := make([]int, 0, len(msgTx.TxIn))
 	// generate the script sizes for the inputs
 	for range msgTx.TxIn {
-		scriptSizes = append(scriptSizes, txsizes.RedeemP2SHSigScriptSize)
+		scriptSizes = append(scriptSizes, txsizes.RedeemP2SHMultisigSigScriptSize(
+			int(p2shOutput.M), int(p2shOutput.N)))
 	}
 
 	// estimate the output fee
@@ -45,6 +45,7 @@ type NotificationServer struct {
 	accountClients    []chan *AccountNotification
 	tipChangedClients []chan *MainTipChangedNotification
 	confClients       []*ConfirmationNotificationsClient
+	authoredTxClients []*AuthoredTxNotificationsClient
 	mu                sync.Mutex // Only protects registered clients
 	wallet            *Wallet    // smells like hacks
 }
@@ -174,6 +175,7 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 		Fee:         fee,
 		Timestamp:   receiveTime.Unix(),
 		Type:        transactionType,
+		Label:       w.TxStore.TxLabel(dbtx.ReadBucket(wtxmgrNamespaceKey), &details.Hash),
 	}
 }
 
@@ -475,6 +477,7 @@ type TransactionSummary struct {
 	Fee         dcrutil.Amount
 	Timestamp   int64
 	Type        TransactionType
+	Label       string
 }
 
 // TransactionType describes the which type of transaction is has been observed to be.
@@ -995,3 +998,186 @@ func (c *ConfirmationNotificationsClient) process(tipHeight int32) {
 	case <-c.ctx.Done():
 	}
 }
+
+// AuthoredTxNotification describes a transaction authored by the wallet.  The
+// transaction is serialized before any input scripts are added, and the
+// previous outputs spent by each input are included in input order.
+type AuthoredTxNotification struct {
+	UnsignedTx []byte
+	Inputs     []AuthoredTxInput
+}
+
+// AuthoredTxInput describes a previous output selected as an input of an
+// authored transaction.
+type AuthoredTxInput struct {
+	OutPoint wire.OutPoint
+	Amount   dcrutil.Amount
+}
+
+// AuthoredTxPolicy describes how notifications are delivered to an
+// AuthoredTxNotificationsClient which is not keeping up with the rate at which
+// transactions are authored.  Transaction authoring never waits on clients.
+type AuthoredTxPolicy int8
+
+const (
+	// AuthoredTxDrop queues a bounded number of notifications for the
+	// client, and drops new notifications when the queue is full.
+	AuthoredTxDrop AuthoredTxPolicy = iota
+
+	// AuthoredTxBuffer queues every notification for the client without
+	// bound.
+	AuthoredTxBuffer
+)
+
+// notifyAuthoredTx notifies clients of an authored transaction.  It must be
+// called before the transaction is signed.
+func (s *NotificationServer) notifyAuthoredTx(tx *wire.MsgTx) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	clients := s.authoredTxClients
+	if len(clients) == 0 {
+		return
+	}
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+	err := tx.Serialize(&buf)
+	if err != nil {
+		log.Errorf("Transaction serialization: %v", err)
+		return
+	}
+	n := &AuthoredTxNotification{
+		UnsignedTx: buf.Bytes(),
+		Inputs:     make([]AuthoredTxInput, len(tx.TxIn)),
+	}
+	for i, in := range tx.TxIn {
+		n.Inputs[i] = AuthoredTxInput{
+			OutPoint: in.PreviousOutPoint,
+			Amount:   dcrutil.Amount(in.ValueIn),
+		}
+	}
+	for _, c := range clients {
+		c.enqueue(n)
+	}
+}
+
+// AuthoredTxNotificationsClient receives AuthoredTxNotifications over the
+// channel C.  Notifications are queued for the client according to its
+// policy, so a client which is slow to receive never blocks transaction
+// authoring.
+type AuthoredTxNotificationsClient struct {
+	C      <-chan *AuthoredTxNotification
+	server *NotificationServer
+
+	policy  AuthoredTxPolicy
+	size    int
+	mu      sync.Mutex
+	queue   []*AuthoredTxNotification
+	dropped uint64
+	signal  chan struct{}
+	quit    chan struct{}
+}
+
+// AuthoredTxNotifications returns a client for receiving
+// AuthoredTxNotifications over a channel.  With the AuthoredTxDrop policy, at
+// most size notifications are queued and newer notifications are dropped while
+// the queue is full.  Sizes less than one are treated as one.  The size is
+// ignored by the AuthoredTxBuffer policy.  When finished, the client's Done
+// method should be called to disassociate the client from the server.
+func (s *NotificationServer) AuthoredTxNotifications(policy AuthoredTxPolicy, size int) *AuthoredTxNotificationsClient {
+	if size < 1 {
+		size = 1
+	}
+	c := make(chan *AuthoredTxNotification)
+	client := &AuthoredTxNotificationsClient{
+		C:      c,
+		server: s,
+		policy: policy,
+		size:   size,
+		signal: make(chan struct{}, 1),
+		quit:   make(chan struct{}),
+	}
+	go client.forward(c)
+	s.mu.Lock()
+	s.authoredTxClients = append(s.authoredTxClients, client)
+	s.mu.Unlock()
+	return client
+}
+
+// enqueue adds a notification to the client's queue, or drops it if the queue
+// is full under the AuthoredTxDrop policy.  It never blocks.
+func (c *AuthoredTxNotificationsClient) enqueue(n *AuthoredTxNotification) {
+	c.mu.Lock()
+	if c.policy == AuthoredTxDrop && len(c.queue) >= c.size {
+		c.dropped++
+		c.mu.Unlock()
+		return
+	}
+	c.queue = append(c.queue, n)
+	c.mu.Unlock()
+
+	select {
+	case c.signal <- struct{}{}:
+	default:
+	}
+}
+
+// forward sends queued notifications over out until the client is done.
+func (c *AuthoredTxNotificationsClient) forward(out chan<- *AuthoredTxNotification) {
+	defer close(out)
+	for {
+		c.mu.Lock()
+		var n *AuthoredTxNotification
+		if len(c.queue) != 0 {
+			n = c.queue[0]
+		}
+		c.mu.Unlock()
+
+		if n == nil {
+			select {
+			case <-c.signal:
+				continue
+			case <-c.quit:
+				return
+			}
+		}
+		select {
+		case out <- n:
+		case <-c.quit:
+			return
+		}
+
+		// The notification remains queued until it is received, so it
+		// counts towards the queue size.
+		c.mu.Lock()
+		c.queue[0] = nil
+		c.queue = c.queue[1:]
+		c.mu.Unlock()
+	}
+}
+
+// Dropped returns the number of notifications dropped for the client because
+// its queue was full.
+func (c *AuthoredTxNotificationsClient) Dropped() uint64 {
+	c.mu.Lock()
+	dropped := c.dropped
+	c.mu.Unlock()
+	return dropped
+}
+
+// Done deregisters the client from the server and discards any queued
+// messages, after which C is closed.  It must be called exactly once
+// when the client is finished receiving notifications.
+func (c *AuthoredTxNotificationsClient) Done() {
+	s := c.server
+	s.mu.Lock()
+	clients := s.authoredTxClients
+	for i, sc := range clients {
+		if c == sc {
+			clients[i] = clients[len(clients)-1]
+			s.authoredTxClients = clients[:len(clients)-1]
+			break
+		}
+	}
+	s.mu.Unlock()
+	close(c.quit)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/udb"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// recvAuthoredTx receives the next notification from c, failing the test if
+// none is received before a timeout.
+func recvAuthoredTx(t *testing.T, c *AuthoredTxNotificationsClient) *AuthoredTxNotification {
+	t.Helper()
+	select {
+	case n := <-c.C:
+		return n
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for authored transaction notification")
+		return nil
+	}
+}
+
+// expectNoAuthoredTx fails the test if a notification is received from c.
+func expectNoAuthoredTx(t *testing.T, c *AuthoredTxNotificationsClient) {
+	t.Helper()
+	select {
+	case n := <-c.C:
+		t.Fatalf("unexpected authored transaction notification %x", n.UnsignedTx)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func checkAuthoredTx(t *testing.T, n *AuthoredTxNotification, atx *txauthor.AuthoredTx) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := atx.Tx.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(n.UnsignedTx, buf.Bytes()) {
+		t.Errorf("notified transaction %x, expected %x", n.UnsignedTx, buf.Bytes())
+	}
+	if len(n.Inputs) != len(atx.PrevOutpoints) {
+		t.Fatalf("notified %d inputs, expected %d", len(n.Inputs), len(atx.PrevOutpoints))
+	}
+	var total dcrutil.Amount
+	for i, in := range n.Inputs {
+		if in.OutPoint != atx.PrevOutpoints[i] {
+			t.Errorf("input %d spends %v, expected %v", i, &in.OutPoint,
+				&atx.PrevOutpoints[i])
+		}
+		total += in.Amount
+	}
+	if total != atx.TotalInput {
+		t.Errorf("notified input amount %v, expected %v", total, atx.TotalInput)
+	}
+}
+
+func TestAuthoredTxNotifications(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Fund the wallet with an unmined output.
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 3e8, nil))
+	funding.AddTxOut(wire.NewTxOut(3e8, pkScript))
+	rec, err := udb.NewTxRecordFromMsgTx(funding, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := w.TxStore.InsertMemPoolTx(ns, rec)
+		if err != nil {
+			return err
+		}
+		return w.TxStore.AddCredit(ns, rec, nil, 0, false, 0)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffered := w.NtfnServer.AuthoredTxNotifications(AuthoredTxBuffer, 0)
+	dropping := w.NtfnServer.AuthoredTxNotifications(AuthoredTxDrop, 1)
+
+	// Author transactions without receiving any notifications.  Authoring
+	// must not be blocked by the clients.
+	const count = 3
+	var authored []*txauthor.AuthoredTx
+	for i := 0; i < count; i++ {
+		outputs := []*wire.TxOut{wire.NewTxOut(int64(i+1)*1e7, pkScript)}
+		atx, err := w.NewUnsignedTransaction(ctx, outputs, dcrutil.Amount(1e4),
+			0, 0, OutputSelectionAlgorithmDefault, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		authored = append(authored, atx)
+	}
+
+	// The buffering client receives one notification per authored
+	// transaction, in order.
+	for _, atx := range authored {
+		checkAuthoredTx(t, recvAuthoredTx(t, buffered), atx)
+	}
+	expectNoAuthoredTx(t, buffered)
+	if d := buffered.Dropped(); d != 0 {
+		t.Errorf("buffering client dropped %d notifications", d)
+	}
+
+	// The dropping client only receives the first notification.
+	checkAuthoredTx(t, recvAuthoredTx(t, dropping), authored[0])
+	expectNoAuthoredTx(t, dropping)
+	if d := dropping.Dropped(); d != count-1 {
+		t.Errorf("dropping client dropped %d notifications, expected %d", d, count-1)
+	}
+
+	// Once caught up, the dropping client receives new notifications.
+	outputs := []*wire.TxOut{wire.NewTxOut(1e7, pkScript)}
+	atx, err := w.NewUnsignedTransaction(ctx, outputs, dcrutil.Amount(1e4),
+		0, 0, OutputSelectionAlgorithmDefault, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkAuthoredTx(t, recvAuthoredTx(t, dropping), atx)
+	checkAuthoredTx(t, recvAuthoredTx(t, buffered), atx)
+
+	// Done closes the channel after deregistering the client, and further
+	// transactions are not queued for it.
+	dropping.Done()
+	for range dropping.C {
+	}
+	_, err = w.NewUnsignedTransaction(ctx, outputs, dcrutil.Amount(1e4),
+		0, 0, OutputSelectionAlgorithmDefault, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := dropping.Dropped(); d != count-1 {
+		t.Errorf("dropped %d notifications after Done, expected %d", d, count-1)
+	}
+	recvAuthoredTx(t, buffered)
+	buffered.Done()
+}
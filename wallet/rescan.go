@@ -16,6 +16,7 @@ import (
 	"github.com/decred/dcrd/dcrutil/v3"
 	"github.com/decred/dcrd/wire"
 	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/sync/errgroup"
 )
 
 const maxBlocksPerRescan = 2000
@@ -165,42 +166,171 @@ func (f *RescanFilter) RemoveUnspentOutPoint(op *wire.OutPoint) {
 func (w *Wallet) SaveRescanned(ctx context.Context, hash *chainhash.Hash, txs []*wire.MsgTx) error {
 	const op errors.Op = "wallet.SaveRescanned"
 	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
-		blockMeta, err := w.TxStore.GetBlockMetaForHash(txmgrNs, hash)
+		return w.saveRescanned(dbtx, hash, txs)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+func (w *Wallet) saveRescanned(dbtx walletdb.ReadWriteTx, hash *chainhash.Hash, txs []*wire.MsgTx) error {
+	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+	blockMeta, err := w.TxStore.GetBlockMetaForHash(txmgrNs, hash)
+	if err != nil {
+		return err
+	}
+	header, err := w.TxStore.GetBlockHeader(dbtx, hash)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range txs {
+		rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
 		if err != nil {
 			return err
 		}
-		header, err := w.TxStore.GetBlockHeader(dbtx, hash)
+		_, err = w.processTransactionRecord(context.Background(), dbtx, rec, header, &blockMeta)
 		if err != nil {
 			return err
 		}
+	}
+	return w.TxStore.UpdateProcessedTxsBlockMarker(dbtx, hash)
+}
 
-		for _, tx := range txs {
-			rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
-			if err != nil {
-				return err
+// errRescanHit is returned by the save function of a speculatively filtered
+// chunk to end its rescan at the first block with relevant transactions.
+var errRescanHit = errors.New("rescan hit")
+
+// rescannedBlock records the relevant transactions discovered in a block by a
+// network backend rescan, before they are saved to the wallet.
+type rescannedBlock struct {
+	index int // Index of the block in its chunk
+	hash  *chainhash.Hash
+	txs   []*wire.MsgTx
+}
+
+// rescanParallel rescans the main chain blocks, which must be ordered by
+// ascending height, by partitioning them into contiguous chunks that are
+// filtered concurrently by up to workers calls to the network backend.
+// Discovered transactions are saved in ascending height order by calling save.
+//
+// Saving transactions may add watched addresses and outpoints to the
+// backend's filter, which must be observed when filtering every later block.
+// The transactions of the first chunk are saved as they are discovered, as
+// with a sequential rescan.  Later chunks are filtered speculatively, and
+// their rescans end at the first block with any discovered transactions.  Once
+// all chunks have been filtered, the results of the speculative chunks are
+// used only if no transactions were saved from an earlier block in the same
+// round: the first discovered block is saved, and every block following it is
+// partitioned and filtered again in the next round.
+func (w *Wallet) rescanParallel(ctx context.Context, n NetworkBackend,
+	blocks []chainhash.Hash, workers int,
+	save func(*chainhash.Hash, []*wire.MsgTx) error) error {
+
+	for len(blocks) != 0 {
+		chunkSize := (len(blocks) + workers - 1) / workers
+		var chunks [][]chainhash.Hash
+		for i := 0; i < len(blocks); i += chunkSize {
+			end := i + chunkSize
+			if end > len(blocks) {
+				end = len(blocks)
 			}
-			_, err = w.processTransactionRecord(context.Background(), dbtx, rec, header, &blockMeta)
-			if err != nil {
+			chunks = append(chunks, blocks[i:end])
+		}
+
+		// The backend calls save sequentially for the blocks of a single
+		// chunk, so each worker may record its own hit without
+		// synchronization.
+		saved := false
+		hits := make([]*rescannedBlock, len(chunks))
+		g, gctx := errgroup.WithContext(ctx)
+		g.Go(func() error {
+			return n.Rescan(gctx, chunks[0], func(block *chainhash.Hash, txs []*wire.MsgTx) error {
+				saved = true
+				return save(block, txs)
+			})
+		})
+		for i := 1; i < len(chunks); i++ {
+			i := i
+			g.Go(func() error {
+				err := n.Rescan(gctx, chunks[i], func(block *chainhash.Hash, txs []*wire.MsgTx) error {
+					index := 0
+					for index < len(chunks[i]) && chunks[i][index] != *block {
+						index++
+					}
+					hits[i] = &rescannedBlock{index, block, txs}
+					return errRescanHit
+				})
+				if errors.Is(err, errRescanHit) {
+					err = nil
+				}
 				return err
+			})
+		}
+		err := g.Wait()
+		if err != nil {
+			return err
+		}
+
+		done := len(chunks[0])
+		for i := 1; i < len(chunks) && !saved; i++ {
+			if hit := hits[i]; hit != nil {
+				err := save(hit.hash, hit.txs)
+				if err != nil {
+					return err
+				}
+				done += hit.index + 1
+				break
 			}
+			done += len(chunks[i])
 		}
-		return w.TxStore.UpdateProcessedTxsBlockMarker(dbtx, hash)
-	})
-	if err != nil {
-		return errors.E(op, err)
+		blocks = blocks[done:]
 	}
 	return nil
 }
 
 // rescan synchronously scans over all blocks on the main chain starting at
 // startHash and height up through the recorded main chain tip block.  The
-// progress channel, if non-nil, is sent non-error progress notifications with
-// the heights the rescan has completed through, starting with the start height.
+// progress function, if non-nil, is called after each range of blocks is
+// rescanned with the height the rescan has completed through, the main chain
+// tip height, and the total number of blocks scanned.
+//
+// Unless an earlier unfinished rescan has not yet reached the start block, the
+// rescan checkpoint records the last block processed by this rescan, so that
+// an interrupted rescan may be continued with ResumeRescan.  The checkpoint
+// is advanced in the same database transaction which saves the transactions
+// of a block, or after all blocks of a range have been processed, and is
+// removed when the rescan completes.
 func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
-	startHash *chainhash.Hash, height int32, p chan<- RescanProgress) error {
+	startHash *chainhash.Hash, height int32,
+	progress func(through, tipHeight int32, scanned int)) error {
 
-	blockHashStorage := make([]chainhash.Hash, maxBlocksPerRescan)
+	var checkpoint bool
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		checkpoint, err = w.startRescanCheckpoint(dbtx, startHash, height)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	save := func(block *chainhash.Hash, txs []*wire.MsgTx) error {
+		return walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			err := w.saveRescanned(dbtx, block, txs)
+			if err != nil || !checkpoint {
+				return err
+			}
+			return w.TxStore.SetRescanCheckpoint(dbtx, block)
+		})
+	}
+
+	batchSize := w.rescanBatchSize
+	if batchSize <= 0 {
+		batchSize = maxBlocksPerRescan
+	}
+	blockHashStorage := make([]chainhash.Hash, batchSize)
+	scanned := 0
 	rescanFrom := *startHash
 	inclusive := true
 	for {
@@ -211,8 +341,10 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 		}
 
 		var rescanBlocks []chainhash.Hash
-		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var tipHeight int32
+		err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			_, tipHeight = w.TxStore.MainChainTip(txmgrNs)
 			var err error
 			rescanBlocks, err = w.TxStore.GetMainChainBlockHashes(txmgrNs,
 				&rescanFrom, inclusive, blockHashStorage)
@@ -235,31 +367,143 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 			}
 		}
 		log.Infof("Rescanning block range [%v, %v]...", height, through)
-		saveRescanned := func(block *chainhash.Hash, txs []*wire.MsgTx) error {
-			return w.SaveRescanned(ctx, block, txs)
+		if w.rescanWorkers > 1 {
+			err = w.rescanParallel(ctx, n, rescanBlocks, w.rescanWorkers, save)
+		} else {
+			err = n.Rescan(ctx, rescanBlocks, save)
 		}
-		err = n.Rescan(ctx, rescanBlocks, saveRescanned)
 		if err != nil {
 			return err
 		}
 		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-			return w.TxStore.UpdateProcessedTxsBlockMarker(dbtx, &rescanBlocks[len(rescanBlocks)-1])
+			last := &rescanBlocks[len(rescanBlocks)-1]
+			err := w.TxStore.UpdateProcessedTxsBlockMarker(dbtx, last)
+			if err != nil || !checkpoint {
+				return err
+			}
+			return w.TxStore.SetRescanCheckpoint(dbtx, last)
 		})
 		if err != nil {
 			return err
 		}
-		if p != nil {
-			p <- RescanProgress{ScannedThrough: through}
+		scanned += len(rescanBlocks)
+		if progress != nil {
+			progress(through, tipHeight, scanned)
 		}
 		rescanFrom = rescanBlocks[len(rescanBlocks)-1]
 		height += int32(len(rescanBlocks))
 		inclusive = false
 	}
 
+	if checkpoint {
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return w.TxStore.ClearRescanCheckpoint(dbtx)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Infof("Rescan complete")
 	return nil
 }
 
+// startRescanCheckpoint records the rescan checkpoint for a rescan beginning
+// at the start block and height, which is the parent of the start block, or
+// the genesis block for rescans starting at the genesis block.  If an
+// unfinished rescan has not yet processed the parent of the start block, the
+// existing checkpoint is kept so that the blocks it has not processed are not
+// skipped when it is resumed, and false is returned to indicate that the new
+// rescan must not modify the checkpoint.
+func (w *Wallet) startRescanCheckpoint(dbtx walletdb.ReadWriteTx,
+	startHash *chainhash.Hash, height int32) (bool, error) {
+
+	prev := startHash
+	if height != 0 {
+		header, err := w.TxStore.GetBlockHeader(dbtx, startHash)
+		if err != nil {
+			return false, err
+		}
+		prev = &header.PrevBlock
+	}
+	existing, err := w.TxStore.RescanCheckpoint(dbtx)
+	if err != nil {
+		return false, err
+	}
+	if existing != nil {
+		header, err := w.TxStore.GetBlockHeader(dbtx, existing)
+		if err != nil {
+			return false, err
+		}
+		if int32(header.Height) < height-1 {
+			return false, nil
+		}
+	}
+	return true, w.TxStore.SetRescanCheckpoint(dbtx, prev)
+}
+
+// RescanCheckpoint returns the hash of the last block processed by an
+// interrupted rescan, or nil if no rescan needs to be resumed.  If the block
+// was reorganized out of the main chain, the hash of its main chain ancestor
+// is returned.
+func (w *Wallet) RescanCheckpoint(ctx context.Context) (*chainhash.Hash, error) {
+	const op errors.Op = "wallet.RescanCheckpoint"
+	var checkpoint *chainhash.Hash
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		checkpoint, err = w.TxStore.RescanCheckpoint(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return checkpoint, nil
+}
+
+// ResumeRescan continues an interrupted rescan from the block following the
+// rescan checkpoint through the main chain tip.  It does nothing if there is
+// no rescan to resume.  This function blocks until the rescan completes, and
+// may be interrupted again by canceling the context.
+func (w *Wallet) ResumeRescan(ctx context.Context, n NetworkBackend) error {
+	const op errors.Op = "wallet.ResumeRescan"
+
+	var startHash chainhash.Hash
+	var startHeight int32
+	var resume bool
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		checkpoint, err := w.TxStore.RescanCheckpoint(dbtx)
+		if err != nil || checkpoint == nil {
+			return err
+		}
+		header, err := w.TxStore.GetBlockHeader(dbtx, checkpoint)
+		if err != nil {
+			return err
+		}
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		if int32(header.Height) >= tipHeight {
+			return w.TxStore.ClearRescanCheckpoint(dbtx)
+		}
+		startHeight = int32(header.Height) + 1
+		startHash, err = w.TxStore.GetMainChainBlockHashForHeight(txmgrNs,
+			startHeight)
+		resume = err == nil
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !resume {
+		return nil
+	}
+
+	err = w.rescan(ctx, n, &startHash, startHeight, nil)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 // Rescan starts a rescan of the wallet for all blocks on the main chain
 // beginning at startHash.  This function blocks until the rescan completes.
 func (w *Wallet) Rescan(ctx context.Context, n NetworkBackend, startHash *chainhash.Hash) error {
@@ -341,12 +585,97 @@ func (w *Wallet) RescanProgressFromHeight(ctx context.Context, n NetworkBackend,
 		return
 	}
 
-	err = w.rescan(ctx, n, &startHash, startHeight, p)
+	progress := func(through, _ int32, _ int) {
+		p <- RescanProgress{ScannedThrough: through}
+	}
+	err = w.rescan(ctx, n, &startHash, startHeight, progress)
 	if err != nil {
 		p <- RescanProgress{Err: errors.E(op, err)}
 	}
 }
 
+// RescanStatus describes the progress of a rescan.  A percent complete and an
+// estimated time remaining may be calculated from the number of blocks scanned
+// since the start time and the number of blocks remaining through the target
+// height.
+type RescanStatus struct {
+	CurrentHeight int32     // Height the rescan has completed through
+	TargetHeight  int32     // Main chain tip height
+	ScannedBlocks int       // Blocks scanned since the rescan started
+	StartTime     time.Time // Time the rescan started
+}
+
+// RescanFromHeightWithStatus rescans for relevant transactions in all blocks
+// in the main chain starting at startHeight, calling fn with the status of
+// the rescan no more often than once per interval.  The final status, with a
+// current height of the target height, is always delivered when the rescan
+// completes.
+//
+// fn is called from a single goroutine, in order of increasing height, and
+// does not block the rescan.  If fn has not returned before a newer status is
+// available, the pending status is replaced by the newer one.  This function
+// blocks until the rescan completes or ends in an error, and until all calls
+// to fn have returned.
+func (w *Wallet) RescanFromHeightWithStatus(ctx context.Context, n NetworkBackend,
+	startHeight int32, interval time.Duration, fn func(*RescanStatus)) error {
+
+	const op errors.Op = "wallet.RescanFromHeightWithStatus"
+
+	var startHash chainhash.Hash
+	err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		startHash, err = w.TxStore.GetMainChainBlockHashForHeight(
+			txmgrNs, startHeight)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	// Statuses are buffered in a single slot which is read by the goroutine
+	// calling fn.  An undelivered status is replaced by a newer one, so the
+	// rescan never waits on fn.
+	statuses := make(chan RescanStatus, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for s := range statuses {
+			s := s
+			fn(&s)
+		}
+	}()
+	defer func() {
+		close(statuses)
+		<-done
+	}()
+
+	start := time.Now()
+	var lastReport time.Time
+	progress := func(through, tipHeight int32, scanned int) {
+		final := through >= tipHeight
+		if !final && time.Since(lastReport) < interval {
+			return
+		}
+		lastReport = time.Now()
+		select {
+		case <-statuses:
+		default:
+		}
+		statuses <- RescanStatus{
+			CurrentHeight: through,
+			TargetHeight:  tipHeight,
+			ScannedBlocks: scanned,
+			StartTime:     start,
+		}
+	}
+	err = w.rescan(ctx, n, &startHash, startHeight, progress)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 func (w *Wallet) mainChainAncestor(dbtx walletdb.ReadTx, hash *chainhash.Hash) (*chainhash.Hash, error) {
 	for {
 		mainChain, _ := w.TxStore.BlockInMainChain(dbtx, hash)
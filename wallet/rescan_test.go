@@ -0,0 +1,666 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/v3/chaingen"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/gcs/blockcf"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// rescanNetwork is a NetworkBackend which rescans a synthetic chain of
+// transactions.  Like the SPV backend, outputs of matched transactions paying
+// to watched scripts are added to the watched outpoints, so spends of these
+// outputs in later blocks are matched as well.
+type rescanNetwork struct {
+	mockNetwork
+	blockTxs map[chainhash.Hash][]*wire.MsgTx
+	delay    time.Duration // Simulated per-block fetch latency
+
+	// visited, if non-nil, is called with each rescanned block and whether
+	// any transactions of the block were matched.
+	visited func(block *chainhash.Hash, matched bool)
+
+	mu        sync.Mutex
+	scripts   map[string]struct{}
+	outpoints map[wire.OutPoint]struct{}
+}
+
+func newRescanNetwork(scripts [][]byte, blockTxs map[chainhash.Hash][]*wire.MsgTx) *rescanNetwork {
+	n := &rescanNetwork{
+		blockTxs:  blockTxs,
+		scripts:   make(map[string]struct{}),
+		outpoints: make(map[wire.OutPoint]struct{}),
+	}
+	for _, s := range scripts {
+		n.scripts[string(s)] = struct{}{}
+	}
+	return n
+}
+
+func (n *rescanNetwork) matchBlock(txs []*wire.MsgTx) []*wire.MsgTx {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var matches []*wire.MsgTx
+	for _, tx := range txs {
+		match := false
+		for _, in := range tx.TxIn {
+			if _, ok := n.outpoints[in.PreviousOutPoint]; ok {
+				match = true
+			}
+		}
+		txHash := tx.TxHash()
+		for i, out := range tx.TxOut {
+			if _, ok := n.scripts[string(out.PkScript)]; ok {
+				n.outpoints[*wire.NewOutPoint(&txHash, uint32(i), wire.TxTreeRegular)] = struct{}{}
+				match = true
+			}
+		}
+		if match {
+			matches = append(matches, tx)
+		}
+	}
+	return matches
+}
+
+// LoadTxFilter adds the output scripts of addrs and the outpoints to the
+// watched scripts and outpoints.
+func (n *rescanNetwork) LoadTxFilter(ctx context.Context, reload bool, addrs []dcrutil.Address, outpoints []wire.OutPoint) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, a := range addrs {
+		script, err := txscript.PayToAddrScript(a)
+		if err != nil {
+			return err
+		}
+		n.scripts[string(script)] = struct{}{}
+	}
+	for _, op := range outpoints {
+		n.outpoints[op] = struct{}{}
+	}
+	return nil
+}
+
+func (n *rescanNetwork) Rescan(ctx context.Context, blocks []chainhash.Hash, save func(*chainhash.Hash, []*wire.MsgTx) error) error {
+	for i := range blocks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		time.Sleep(n.delay)
+		matches := n.matchBlock(n.blockTxs[blocks[i]])
+		if n.visited != nil {
+			n.visited(&blocks[i], len(matches) != 0)
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		err := save(&blocks[i], matches)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rescanTestChain generates a chain of count blocks following the genesis
+// block.
+func rescanTestChain(t testing.TB, params *chaincfg.Params, count int) []*wire.MsgBlock {
+	g, err := chaingen.MakeGenerator(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks := []*wire.MsgBlock{g.CreateBlockOne("b1", 0)}
+	for i := 2; i <= count; i++ {
+		blocks = append(blocks, g.NextBlock(fmt.Sprintf("b%d", i), nil, nil))
+	}
+	return blocks
+}
+
+// attachTestChain extends the wallet's main chain with blocks.
+func attachTestChain(t testing.TB, w *Wallet, blocks []*wire.MsgBlock) {
+	ctx := context.Background()
+	forest := new(SidechainForest)
+	for _, b := range blocks {
+		f, err := blockcf.Regular(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := b.BlockHash()
+		header := b.Header
+		if !forest.AddBlockNode(&BlockNode{Header: &header, Hash: &h, Filter: f}) {
+			t.Fatalf("Could not add block %v to sidechain forest", &h)
+		}
+	}
+	bestChain, err := w.EvaluateBestChain(ctx, forest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bestChain) != len(blocks) {
+		t.Fatalf("expected best chain len %d, got %d", len(blocks), len(bestChain))
+	}
+	_, err = w.ChainSwitch(ctx, forest, bestChain, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// rescanTestTxs creates transactions for each block paying to and spending
+// from the wallet scripts, along with irrelevant transactions.  Spends of
+// wallet outputs are included several blocks after the output is created, so
+// they are only discovered when the creating transaction has been matched.
+// All created transactions and the hashes of the relevant transactions are
+// returned.
+func rescanTestTxs(blocks []*wire.MsgBlock, scripts [][]byte) (map[chainhash.Hash][]*wire.MsgTx, []*wire.MsgTx, map[chainhash.Hash]struct{}) {
+	const spendDelay = 5
+	otherScript := []byte{txscript.OP_TRUE}
+	blockTxs := make(map[chainhash.Hash][]*wire.MsgTx)
+	var all []*wire.MsgTx
+	relevant := make(map[chainhash.Hash]struct{})
+	spends := make(map[int][]*wire.MsgTx)
+	add := func(b *wire.MsgBlock, tx *wire.MsgTx, isRelevant bool) {
+		h := b.BlockHash()
+		blockTxs[h] = append(blockTxs[h], tx)
+		all = append(all, tx)
+		if isRelevant {
+			relevant[tx.TxHash()] = struct{}{}
+		}
+	}
+	for i, b := range blocks {
+		prev := chainhash.HashH([]byte(fmt.Sprintf("prev%d", i)))
+		for _, tx := range spends[i] {
+			add(b, tx, true)
+		}
+		if i%3 == 0 {
+			tx := wire.NewMsgTx()
+			tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prev, 0, 0), 1e8, nil))
+			tx.AddTxOut(wire.NewTxOut(1e8, scripts[i%len(scripts)]))
+			add(b, tx, true)
+
+			txHash := tx.TxHash()
+			spend := wire.NewMsgTx()
+			spend.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&txHash, 0, 0), 1e8, nil))
+			spend.AddTxOut(wire.NewTxOut(1e8-1e5, otherScript))
+			spends[i+spendDelay] = append(spends[i+spendDelay], spend)
+		}
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prev, 1, 0), 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8, otherScript))
+		add(b, tx, false)
+	}
+	return blockTxs, all, relevant
+}
+
+// rescanTestScripts returns the output scripts of the first count external
+// addresses of the default account.
+func rescanTestScripts(t testing.TB, w *Wallet, count int) [][]byte {
+	ctx := context.Background()
+	scripts := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scripts = append(scripts, script)
+	}
+	return scripts
+}
+
+// savedTxs returns the hashes of the transactions from txs which are recorded
+// by the wallet.
+func savedTxs(t testing.TB, w *Wallet, txs []*wire.MsgTx) map[chainhash.Hash]struct{} {
+	saved := make(map[chainhash.Hash]struct{})
+	err := walletdb.View(context.Background(), w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for _, tx := range txs {
+			txHash := tx.TxHash()
+			if w.TxStore.ExistsTx(ns, &txHash) {
+				saved[txHash] = struct{}{}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return saved
+}
+
+func TestParallelRescan(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// The sequential and parallel rescans are performed by wallets for the
+	// same account xpub, which watch the same addresses.
+	seqCfg := basicWalletConfig
+	seq, teardown := testWallet(t, &seqCfg)
+	defer teardown()
+	xpub, err := seq.MasterPubKey(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parCfg := basicWalletConfig
+	parCfg.RescanWorkers = 4
+	par, teardown := testWatchingOnlyWallet(t, &parCfg, xpub.String())
+	defer teardown()
+
+	scripts := rescanTestScripts(t, seq, 3)
+	parScripts := rescanTestScripts(t, par, 3)
+	for i := range scripts {
+		if string(scripts[i]) != string(parScripts[i]) {
+			t.Fatalf("wallets derived different address %d", i)
+		}
+	}
+
+	blocks := rescanTestChain(t, seqCfg.Params, 24)
+	attachTestChain(t, seq, blocks)
+	attachTestChain(t, par, blocks)
+	blockTxs, txs, relevant := rescanTestTxs(blocks, scripts)
+
+	err = seq.RescanFromHeight(ctx, newRescanNetwork(scripts, blockTxs), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = par.RescanFromHeight(ctx, newRescanNetwork(scripts, blockTxs), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seqSaved := savedTxs(t, seq, txs)
+	parSaved := savedTxs(t, par, txs)
+	if len(seqSaved) != len(relevant) {
+		t.Errorf("sequential rescan saved %d transactions, expected %d",
+			len(seqSaved), len(relevant))
+	}
+	for h := range relevant {
+		if _, ok := seqSaved[h]; !ok {
+			t.Errorf("sequential rescan did not save relevant tx %v", &h)
+		}
+	}
+	if len(parSaved) != len(seqSaved) {
+		t.Errorf("parallel rescan saved %d transactions, sequential saved %d",
+			len(parSaved), len(seqSaved))
+	}
+	for h := range seqSaved {
+		if _, ok := parSaved[h]; !ok {
+			t.Errorf("parallel rescan did not save tx %v", &h)
+		}
+	}
+
+	// Both rescans record the tip as the last processed block.
+	tip := blocks[len(blocks)-1].BlockHash()
+	for _, w := range []*Wallet{seq, par} {
+		rp, err := w.RescanPoint(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rp != nil {
+			t.Errorf("rescan point %v after rescan through tip %v", rp, &tip)
+		}
+	}
+}
+
+func TestParallelRescanFilterChange(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const workers = 4
+	watched := []byte{txscript.OP_TRUE}
+	other := []byte{txscript.OP_FALSE}
+
+	blocks := make([]chainhash.Hash, 16)
+	for i := range blocks {
+		blocks[i] = chainhash.HashH([]byte(fmt.Sprintf("block%d", i)))
+	}
+
+	// A transaction in block k funds an unwatched script, and saving it
+	// adds the output to the filter, as the wallet does for outputs it
+	// discovers are relevant.  The output is spent in block k+1, which is
+	// in the first chunk, a later chunk, and the following chunk when
+	// block k ends a chunk.
+	for _, k := range []int{1, 5, 7} {
+		prev := chainhash.HashH([]byte(fmt.Sprintf("prev%d", k)))
+		fund := wire.NewMsgTx()
+		fund.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prev, 0, 0), 2e8, nil))
+		fund.AddTxOut(wire.NewTxOut(1e8, watched))
+		fund.AddTxOut(wire.NewTxOut(1e8, other))
+		fundHash := fund.TxHash()
+		funded := wire.NewOutPoint(&fundHash, 1, wire.TxTreeRegular)
+		spend := wire.NewMsgTx()
+		spend.AddTxIn(wire.NewTxIn(funded, 1e8, nil))
+		spend.AddTxOut(wire.NewTxOut(1e8-1e5, other))
+		later := wire.NewMsgTx()
+		later.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prev, 1, 0), 1e8, nil))
+		later.AddTxOut(wire.NewTxOut(1e8, watched))
+
+		blockTxs := map[chainhash.Hash][]*wire.MsgTx{
+			blocks[k]:   {fund},
+			blocks[k+1]: {spend},
+			blocks[14]:  {later},
+		}
+		n := newRescanNetwork([][]byte{watched}, blockTxs)
+		var saved []int
+		save := func(block *chainhash.Hash, txs []*wire.MsgTx) error {
+			i := 0
+			for i < len(blocks) && blocks[i] != *block {
+				i++
+			}
+			saved = append(saved, i)
+			for _, tx := range txs {
+				if tx.TxHash() == fundHash {
+					return n.LoadTxFilter(ctx, false, nil, []wire.OutPoint{*funded})
+				}
+			}
+			return nil
+		}
+		err := new(Wallet).rescanParallel(ctx, n, blocks, workers, save)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []int{k, k + 1, 14}
+		if len(saved) != len(want) {
+			t.Errorf("k=%d: saved blocks %v, expected %v", k, saved, want)
+			continue
+		}
+		for i := range want {
+			if saved[i] != want[i] {
+				t.Errorf("k=%d: saved blocks %v, expected %v", k, saved, want)
+				break
+			}
+		}
+	}
+}
+
+func TestRescanFromHeightWithStatus(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.rescanBatchSize = 5
+
+	scripts := rescanTestScripts(t, w, 3)
+	blocks := rescanTestChain(t, cfg.Params, 24)
+	attachTestChain(t, w, blocks)
+	blockTxs, _, _ := rescanTestTxs(blocks, scripts)
+	target := int32(len(blocks))
+
+	// A slow consumer sees fewer statuses, but always in increasing height
+	// order and ending at the target height.
+	for _, delay := range []time.Duration{0, 20 * time.Millisecond} {
+		var statuses []RescanStatus
+		var mu sync.Mutex
+		inFlight := 0
+		fn := func(s *RescanStatus) {
+			mu.Lock()
+			inFlight++
+			if inFlight != 1 {
+				t.Errorf("delay %v: concurrent status callbacks", delay)
+			}
+			statuses = append(statuses, *s)
+			mu.Unlock()
+			time.Sleep(delay)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}
+		err := w.RescanFromHeightWithStatus(ctx, newRescanNetwork(scripts, blockTxs),
+			0, 0, fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(statuses) == 0 {
+			t.Fatalf("delay %v: no status reported", delay)
+		}
+		for i, s := range statuses {
+			if s.TargetHeight != target {
+				t.Errorf("delay %v: status %d target height %d, expected %d",
+					delay, i, s.TargetHeight, target)
+			}
+			if i != 0 && s.CurrentHeight <= statuses[i-1].CurrentHeight {
+				t.Errorf("delay %v: status %d height %d does not increase from %d",
+					delay, i, s.CurrentHeight, statuses[i-1].CurrentHeight)
+			}
+			if s.StartTime != statuses[0].StartTime {
+				t.Errorf("delay %v: status %d has a different start time", delay, i)
+			}
+		}
+		final := statuses[len(statuses)-1]
+		if final.CurrentHeight != target {
+			t.Errorf("delay %v: final height %d, expected %d", delay,
+				final.CurrentHeight, target)
+		}
+		if final.ScannedBlocks != len(blocks) {
+			t.Errorf("delay %v: scanned %d blocks, expected %d", delay,
+				final.ScannedBlocks, len(blocks))
+		}
+	}
+
+	// Statuses are throttled to the interval.  Only the first status and the
+	// final status are reported.
+	var heights []int32
+	err := w.RescanFromHeightWithStatus(ctx, newRescanNetwork(scripts, blockTxs),
+		0, time.Hour, func(s *RescanStatus) {
+			heights = append(heights, s.CurrentHeight)
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(heights) == 0 || len(heights) > 2 || heights[len(heights)-1] != target {
+		t.Errorf("reported heights %v with a long interval", heights)
+	}
+}
+
+func TestResumeRescan(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.rescanBatchSize = 5
+
+	scripts := rescanTestScripts(t, w, 3)
+	blocks := rescanTestChain(t, cfg.Params, 24)
+	attachTestChain(t, w, blocks)
+	blockTxs, txs, relevant := rescanTestTxs(blocks, scripts)
+	heights := make(map[chainhash.Hash]int32)
+	for i, b := range blocks {
+		heights[b.BlockHash()] = int32(i + 1)
+	}
+	checkpointHeight := func() int32 {
+		cp, err := w.RescanCheckpoint(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cp == nil {
+			return -1
+		}
+		return heights[*cp]
+	}
+
+	// Cancel the rescan while the third range of blocks is being scanned.
+	n := newRescanNetwork(scripts, blockTxs)
+	cctx, cancel := context.WithCancel(ctx)
+	var visited, matched []int32
+	n.visited = func(block *chainhash.Hash, match bool) {
+		height := heights[*block]
+		visited = append(visited, height)
+		if match {
+			matched = append(matched, height)
+		}
+		if height == 12 {
+			cancel()
+		}
+	}
+	err := w.RescanFromHeight(cctx, n, 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled rescan, got %v", err)
+	}
+
+	// The checkpoint records the last processed block with saved
+	// transactions.  All earlier blocks were scanned.
+	cp := checkpointHeight()
+	if cp < 9 || cp > 12 {
+		t.Fatalf("checkpoint at height %d, expected 9-12", cp)
+	}
+	if len(matched) == 0 || matched[len(matched)-1] != cp {
+		t.Errorf("checkpoint at height %d, last matched block %v", cp, matched)
+	}
+	for i, h := range visited[:cp] {
+		if h != int32(i+1) {
+			t.Fatalf("first rescan visited heights %v", visited)
+		}
+	}
+
+	// Resuming scans exactly the blocks after the checkpoint, and no blocks
+	// with transactions saved by the first rescan are scanned again.
+	visited = nil
+	n.visited = func(block *chainhash.Hash, _ bool) {
+		visited = append(visited, heights[*block])
+	}
+	err = w.ResumeRescan(ctx, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visited) != len(blocks)-int(cp) {
+		t.Fatalf("resumed rescan visited heights %v after checkpoint %d",
+			visited, cp)
+	}
+	for i, h := range visited {
+		if h != cp+1+int32(i) {
+			t.Fatalf("resumed rescan visited heights %v after checkpoint %d",
+				visited, cp)
+		}
+	}
+	if cp := checkpointHeight(); cp != -1 {
+		t.Errorf("checkpoint at height %d after rescan completed", cp)
+	}
+	saved := savedTxs(t, w, txs)
+	if len(saved) != len(relevant) {
+		t.Errorf("saved %d transactions, expected %d", len(saved), len(relevant))
+	}
+	for h := range relevant {
+		if _, ok := saved[h]; !ok {
+			t.Errorf("relevant tx %v was not saved", &h)
+		}
+	}
+
+	// There is nothing to resume after the rescan completes.
+	visited = nil
+	err = w.ResumeRescan(ctx, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visited) != 0 {
+		t.Errorf("completed rescan resumed at heights %v", visited)
+	}
+}
+
+func TestRescanCheckpointReorg(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	g, err := chaingen.MakeGenerator(cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mainChain := []*wire.MsgBlock{g.CreateBlockOne("b1", 0)}
+	for i := 2; i <= 8; i++ {
+		mainChain = append(mainChain, g.NextBlock(fmt.Sprintf("a%d", i), nil, nil))
+	}
+	attachTestChain(t, w, mainChain)
+
+	// Record a checkpoint at block a6 and reorganize the chain from a5.
+	a6 := mainChain[5].BlockHash()
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.TxStore.SetRescanCheckpoint(dbtx, &a6)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.SetTip("a4")
+	var sideChain []*wire.MsgBlock
+	for i := 5; i <= 10; i++ {
+		sideChain = append(sideChain, g.NextBlock(fmt.Sprintf("c%d", i), nil, nil))
+	}
+	attachTestChain(t, w, sideChain)
+
+	// The checkpoint is rolled back to the fork point.
+	cp, err := w.RescanCheckpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a4 := mainChain[3].BlockHash(); cp == nil || *cp != a4 {
+		t.Fatalf("checkpoint %v after reorg, expected %v", cp, &a4)
+	}
+
+	// Resuming rescans every block of the new main chain after the fork.
+	var visited []chainhash.Hash
+	n := newRescanNetwork(nil, nil)
+	n.visited = func(block *chainhash.Hash, _ bool) {
+		visited = append(visited, *block)
+	}
+	err = w.ResumeRescan(ctx, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visited) != len(sideChain) {
+		t.Fatalf("resumed rescan visited %d blocks, expected %d", len(visited),
+			len(sideChain))
+	}
+	for i, b := range sideChain {
+		if h := b.BlockHash(); visited[i] != h {
+			t.Errorf("resumed rescan visited block %d %v, expected %v", i,
+				&visited[i], &h)
+		}
+	}
+}
+
+func BenchmarkRescan(b *testing.B) {
+	ctx := context.Background()
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			cfg := basicWalletConfig
+			cfg.RescanWorkers = workers
+			w, teardown := testWallet(b, &cfg)
+			defer teardown()
+
+			scripts := rescanTestScripts(b, w, 3)
+			blocks := rescanTestChain(b, cfg.Params, 24)
+			attachTestChain(b, w, blocks)
+			blockTxs, _, _ := rescanTestTxs(blocks, scripts)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				n := newRescanNetwork(scripts, blockTxs)
+				n.delay = time.Millisecond
+				err := w.RescanFromHeight(ctx, n, 0)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -23,7 +23,7 @@ var basicWalletConfig = Config{
 	Params:        chaincfg.SimNetParams(),
 }
 
-func testWallet(t *testing.T, cfg *Config) (w *Wallet, teardown func()) {
+func testWallet(t testing.TB, cfg *Config) (w *Wallet, teardown func()) {
 	ctx := context.Background()
 	f, err := ioutil.TempFile("", "dcrwallet.testdb")
 	if err != nil {
@@ -38,7 +38,43 @@ func testWallet(t *testing.T, cfg *Config) (w *Wallet, teardown func()) {
 		db.Close()
 		os.Remove(f.Name())
 	}
-	err = Create(ctx, opaqueDB{db}, []byte(InsecurePubPassphrase), []byte("private"), nil, cfg.Params)
+	err = Create(ctx, opaqueDB{db}, []byte(InsecurePubPassphrase), []byte("private"), nil, cfg.Params,
+		cfg.Params.SLIP0044CoinType)
+	if err != nil {
+		rm()
+		t.Fatal(err)
+	}
+	cfg.DB = opaqueDB{db}
+	w, err = Open(ctx, cfg)
+	if err != nil {
+		rm()
+		t.Fatal(err)
+	}
+	teardown = func() {
+		rm()
+	}
+	return
+}
+
+// testWatchingOnlyWallet creates a watching-only wallet for the account
+// extended public key xpub.
+func testWatchingOnlyWallet(t testing.TB, cfg *Config, xpub string) (w *Wallet, teardown func()) {
+	ctx := context.Background()
+	f, err := ioutil.TempFile("", "dcrwallet.testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	db, err := walletdb.Create("bdb", f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rm := func() {
+		db.Close()
+		os.Remove(f.Name())
+	}
+	err = CreateWatchOnly(ctx, opaqueDB{db}, xpub, []byte(InsecurePubPassphrase), cfg.Params,
+		cfg.Params.SLIP0044CoinType)
 	if err != nil {
 		rm()
 		t.Fatal(err)
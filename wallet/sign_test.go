@@ -0,0 +1,224 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestSignInputDeterministic(t *testing.T) {
+	params := basicWalletConfig.Params
+	keyBytes := make([]byte, 32)
+	keyBytes[31] = 1
+	key := secp256k1.PrivKeyFromBytes(keyBytes)
+	pkh := dcrutil.Hash160(key.PubKey().SerializeCompressed())
+
+	for _, sigType := range []dcrec.SignatureType{dcrec.STEcdsaSecp256k1, dcrec.STSchnorrSecp256k1} {
+		addr, err := dcrutil.NewAddressPubKeyHash(pkh, params, sigType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var kdb txscript.KeyClosure = func(dcrutil.Address) ([]byte, dcrec.SignatureType, bool, error) {
+			return key.Serialize(), sigType, true, nil
+		}
+		var sdb txscript.ScriptClosure = func(dcrutil.Address) ([]byte, error) {
+			return nil, nil
+		}
+
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8-1e5, pkScript))
+		var scripts [2][]byte
+		for i := range scripts {
+			scripts[i], err = signInputDeterministic(params, tx, 0, pkScript,
+				txscript.SigHashAll, kdb, sdb, nil)
+			if err != nil {
+				t.Fatalf("signature type %v: %v", sigType, err)
+			}
+		}
+		if !bytes.Equal(scripts[0], scripts[1]) {
+			t.Errorf("signature type %v: signature scripts differ: %x, %x",
+				sigType, scripts[0], scripts[1])
+		}
+
+		tx.TxIn[0].SignatureScript = scripts[0]
+		vm, err := txscript.NewEngine(pkScript, tx, 0, sanityVerifyFlags, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Errorf("signature type %v: invalid signature script: %v", sigType, err)
+		}
+	}
+}
+
+// derIntegers returns the R and S values of a DER encoded signature, left
+// padded to 32 bytes.
+func derIntegers(t *testing.T, der []byte) (r, s []byte) {
+	t.Helper()
+	if len(der) < 2 || der[0] != 0x30 || int(der[1]) != len(der)-2 {
+		t.Fatalf("malformed DER signature %x", der)
+	}
+	var ints [2][]byte
+	rest := der[2:]
+	for i := range ints {
+		if len(rest) < 2 || rest[0] != 0x02 || int(rest[1]) > len(rest)-2 {
+			t.Fatalf("malformed DER signature %x", der)
+		}
+		n := int(rest[1])
+		v := bytes.TrimLeft(rest[2:2+n], "\x00")
+		if len(v) > 32 {
+			t.Fatalf("malformed DER signature %x", der)
+		}
+		ints[i] = append(make([]byte, 32-len(v)), v...)
+		rest = rest[2+n:]
+	}
+	return ints[0], ints[1]
+}
+
+func TestSignInputRFC6979(t *testing.T) {
+	keyBytes := make([]byte, 32)
+	keyBytes[31] = 1
+	key := secp256k1.PrivKeyFromBytes(keyBytes)
+
+	// The secp256k1 ECDSA signer must produce the well known RFC6979
+	// signature (with SHA-256 nonce derivation and a low S value) by
+	// private key 1 of the SHA-256 hash of "Satoshi Nakamoto".
+	hash := sha256.Sum256([]byte("Satoshi Nakamoto"))
+	wantR, _ := hex.DecodeString("934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d8")
+	wantS, _ := hex.DecodeString("2442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5")
+	compact, err := secp256k1.SignCompact(key, hash[:], true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(compact[1:33], wantR) || !bytes.Equal(compact[33:65], wantS) {
+		t.Fatalf("signature R %x S %x, expected R %x S %x", compact[1:33],
+			compact[33:65], wantR, wantS)
+	}
+
+	// The signature created by signInputDeterministic is the RFC6979
+	// signature of the input's signature hash.
+	params := basicWalletConfig.Params
+	pkh := dcrutil.Hash160(key.PubKey().SerializeCompressed())
+	addr, err := dcrutil.NewAddressPubKeyHash(pkh, params, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kdb txscript.KeyClosure = func(dcrutil.Address) ([]byte, dcrec.SignatureType, bool, error) {
+		return key.Serialize(), dcrec.STEcdsaSecp256k1, true, nil
+	}
+	var sdb txscript.ScriptClosure = func(dcrutil.Address) ([]byte, error) {
+		return nil, nil
+	}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 1e8, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8-1e5, pkScript))
+	script, err := signInputDeterministic(params, tx, 0, pkScript,
+		txscript.SigHashAll, kdb, sdb, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pushes, err := txscript.PushedData(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pushes) != 2 || len(pushes[0]) < 1 {
+		t.Fatalf("unexpected signature script %x", script)
+	}
+	sig := pushes[0]
+	if txscript.SigHashType(sig[len(sig)-1]) != txscript.SigHashAll {
+		t.Errorf("signature hash type %v", sig[len(sig)-1])
+	}
+	sigHash, err := txscript.CalcSignatureHash(pkScript, txscript.SigHashAll,
+		tx, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compact, err = secp256k1.SignCompact(key, sigHash, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, s := derIntegers(t, sig[:len(sig)-1])
+	if !bytes.Equal(r, compact[1:33]) || !bytes.Equal(s, compact[33:65]) {
+		t.Errorf("input signature R %x S %x, expected RFC6979 signature R %x S %x",
+			r, s, compact[1:33], compact[33:65])
+	}
+}
+
+func TestSignTransactionDeterministic(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// sign signs a new transaction spending an output paying to the wallet
+	// address.
+	prevOut := wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular)
+	sign := func() *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(prevOut, 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8-1e5, pkScript))
+		signErrs, err := w.SignTransaction(ctx, tx, txscript.SigHashAll,
+			map[wire.OutPoint][]byte{*prevOut: pkScript}, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(signErrs) != 0 {
+			t.Fatalf("signing errors: %v", signErrs)
+		}
+		return tx
+	}
+	tx1, tx2 := sign(), sign()
+	script1 := tx1.TxIn[0].SignatureScript
+	script2 := tx2.TxIn[0].SignatureScript
+	if len(script1) == 0 || !bytes.Equal(script1, script2) {
+		t.Errorf("signature scripts differ: %x, %x", script1, script2)
+	}
+
+	// Raw signatures are deterministic as well.
+	var sigs [2][]byte
+	for i := range sigs {
+		sigs[i], _, err = w.CreateSignature(ctx, tx1, 0, addr,
+			txscript.SigHashAll, pkScript)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(sigs[0], sigs[1]) {
+		t.Errorf("signatures differ: %x, %x", sigs[0], sigs[1])
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"decred.org/dcrwallet/wallet/walletdb"
 	"github.com/decred/dcrd/blockchain/stake/v3"
 	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/dcrutil/v3"
 	dcrdtypes "github.com/decred/dcrd/rpc/jsonrpc/types"
 	"github.com/decred/dcrd/txscript/v3"
@@ -378,52 +379,77 @@ func (w *Wallet) RevokeTickets(ctx context.Context, rpcCaller Caller) error {
 	return nil
 }
 
-// RevokeExpiredTickets revokes any unspent tickets that cannot be live due to
-// being past expiry.  It is similar to RevokeTickets but is able to be used
-// with any Peer implementation as it will not query the consensus RPC server
-// for missed tickets.
-func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
-	const opf = "wallet.RevokeExpiredTickets(%v)"
-	defer func() {
-		if err != nil {
-			op := errors.Opf(opf, p)
-			err = errors.E(op, err)
+// ticketSpendState describes a ticket recorded by the transaction store and
+// whether it has been spent by a vote or revocation.
+type ticketSpendState struct {
+	hash    chainhash.Hash
+	height  int32 // -1 when unmined
+	spender chainhash.Hash
+}
+
+// selectRevocableTickets returns the hashes of all unspent tickets which have
+// either expired in a chain with tip height tipHeight or are included in
+// missed.
+func selectRevocableTickets(params *chaincfg.Params, tickets []ticketSpendState,
+	tipHeight int32, missed map[chainhash.Hash]struct{}) []chainhash.Hash {
+
+	var revocable []chainhash.Hash
+	for i := range tickets {
+		t := &tickets[i]
+
+		// Spent tickets are excluded
+		if t.spender != (chainhash.Hash{}) {
+			continue
 		}
-	}()
 
-	var expired []chainhash.Hash
-	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		// Include ticket hash when it has reached expiry confirmations or
+		// was called to vote and missed.
+		_, isMissed := missed[t.hash]
+		if ticketExpired(params, t.height, tipHeight) || (isMissed && t.height >= 0) {
+			revocable = append(revocable, t.hash)
+		}
+	}
+	return revocable
+}
+
+// revocableTickets returns the hashes of all unspent tickets recorded by the
+// transaction store which are expired or included in missed.
+func (w *Wallet) revocableTickets(ctx context.Context, missed map[chainhash.Hash]struct{}) ([]chainhash.Hash, error) {
+	var revocable []chainhash.Hash
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
 		_, tipHeight := w.TxStore.MainChainTip(ns)
 
+		var tickets []ticketSpendState
 		it := w.TxStore.IterateTickets(dbtx)
 		defer it.Close()
 		for it.Next() {
-			// Spent tickets are excluded
-			if it.SpenderHash != (chainhash.Hash{}) {
-				continue
-			}
-
-			// Include ticket hash when it has reached expiry confirmations.
-			if ticketExpired(w.chainParams, it.Block.Height, tipHeight) {
-				expired = append(expired, it.TxRecord.Hash)
-			}
+			tickets = append(tickets, ticketSpendState{
+				hash:    it.TxRecord.Hash,
+				height:  it.Block.Height,
+				spender: it.SpenderHash,
+			})
 		}
-		return it.Err()
+		if err := it.Err(); err != nil {
+			return err
+		}
+		revocable = selectRevocableTickets(w.chainParams, tickets, tipHeight, missed)
+		return nil
 	})
-	if err != nil {
-		return err
-	}
+	return revocable, err
+}
 
-	if len(expired) == 0 {
-		return nil
-	}
+// createRevocations creates and signs revocations paying feePerKb for each
+// ticket this wallet has voting authority for, and records them as unmined
+// transactions.  Tickets without voting authority are skipped.  The returned
+// revoked ticket hashes are parallel with the revocations.
+func (w *Wallet) createRevocations(ctx context.Context, tickets []chainhash.Hash,
+	feePerKb dcrutil.Amount) (revoked []*chainhash.Hash, revocations []*wire.MsgTx,
+	watch []wire.OutPoint, err error) {
 
-	feePerKb := w.RelayFee()
-	revocations := make([]*wire.MsgTx, 0, len(expired))
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
-		for i := range expired {
-			ticketHash := &expired[i]
+		for i := range tickets {
+			ticketHash := &tickets[i]
 			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 			ticketPurchase, err := w.TxStore.Tx(txmgrNs, ticketHash)
@@ -450,15 +476,15 @@ func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
 			if err != nil {
 				return err
 			}
+			revoked = append(revoked, ticketHash)
 			revocations = append(revocations, revocation)
 		}
 		return nil
 	})
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
-	var watchOutPoints []wire.OutPoint
 	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 		for i, revocation := range revocations {
 			rec, err := udb.NewTxRecordFromMsgTx(revocation, time.Now())
@@ -466,20 +492,52 @@ func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
 				return err
 			}
 
-			log.Infof("Revoking ticket %v with revocation %v", &expired[i],
+			log.Infof("Revoking ticket %v with revocation %v", revoked[i],
 				&rec.Hash)
 
-			watch, err := w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
+			outpoints, err := w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
 			if err != nil {
 				return err
 			}
-			watchOutPoints = append(watchOutPoints, watch...)
+			watch = append(watch, outpoints...)
 		}
 		return nil
 	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return revoked, revocations, watch, nil
+}
+
+// RevokeExpiredTickets revokes any unspent tickets that cannot be live due to
+// being past expiry.  It is similar to RevokeTickets but is able to be used
+// with any Peer implementation as it will not query the consensus RPC server
+// for missed tickets.
+func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
+	const opf = "wallet.RevokeExpiredTickets(%v)"
+	defer func() {
+		if err != nil {
+			op := errors.Opf(opf, p)
+			err = errors.E(op, err)
+		}
+	}()
+
+	expired, err := w.revocableTickets(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	_, revocations, watchOutPoints, err := w.createRevocations(ctx, expired,
+		w.RelayFee())
 	if err != nil {
 		return err
 	}
+	if len(revocations) == 0 {
+		return nil
+	}
 	err = p.PublishTransactions(ctx, revocations...)
 	if err != nil {
 		return err
@@ -494,3 +552,53 @@ func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
 
 	return nil
 }
+
+// CreateRevocations creates, signs, and records revocations paying relayFee
+// per kB for all unspent tickets this wallet has voting authority for which
+// are either expired or included in missed.  Tickets already spent by a vote
+// or revocation are skipped, and revocations are created even when the fee
+// reduces an output to a dust amount.
+//
+// Missed tickets can not be determined from the wallet's stake store alone and
+// must be provided by the caller, for example from the consensus RPC server's
+// existsmissedtickets results.
+//
+// The revocations are recorded as unmined transactions but are not published.
+// They are published by PublishUnminedTransactions or RevokeExpiredTickets.
+// The hashes of the created revocations are returned.
+func (w *Wallet) CreateRevocations(ctx context.Context, relayFee dcrutil.Amount,
+	missed ...*chainhash.Hash) ([]*chainhash.Hash, error) {
+
+	const op errors.Op = "wallet.CreateRevocations"
+
+	missedSet := make(map[chainhash.Hash]struct{}, len(missed))
+	for _, h := range missed {
+		missedSet[*h] = struct{}{}
+	}
+	tickets, err := w.revocableTickets(ctx, missedSet)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(tickets) == 0 {
+		return nil, nil
+	}
+
+	_, revocations, watch, err := w.createRevocations(ctx, tickets, relayFee)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	hashes := make([]*chainhash.Hash, len(revocations))
+	for i, revocation := range revocations {
+		h := revocation.TxHash()
+		hashes[i] = &h
+	}
+
+	if n, err := w.NetworkBackend(); err == nil && len(watch) > 0 {
+		err := n.LoadTxFilter(ctx, false, nil, watch)
+		if err != nil {
+			log.Errorf("Failed to watch outpoints: %v", err)
+		}
+	}
+
+	return hashes, nil
+}
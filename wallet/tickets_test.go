@@ -0,0 +1,123 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/wallet/txrules"
+	"github.com/decred/dcrd/blockchain/stake/v3"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestSelectRevocableTickets(t *testing.T) {
+	t.Parallel()
+	params := chaincfg.MainNetParams()
+	expiry := int32(params.TicketMaturity) + int32(params.TicketExpiry)
+	const tipHeight = 100000
+
+	live := chainhash.Hash{1}
+	missed := chainhash.Hash{2}
+	expired := chainhash.Hash{3}
+	revokedMissed := chainhash.Hash{4}
+	revokedExpired := chainhash.Hash{5}
+	voted := chainhash.Hash{6}
+	unmined := chainhash.Hash{7}
+	spender := chainhash.Hash{0xff}
+
+	tickets := []ticketSpendState{
+		{hash: live, height: tipHeight - 10},
+		{hash: missed, height: tipHeight - 1000},
+		{hash: expired, height: tipHeight - expiry - 1},
+		{hash: revokedMissed, height: tipHeight - 1000, spender: spender},
+		{hash: revokedExpired, height: tipHeight - expiry - 1, spender: spender},
+		{hash: voted, height: tipHeight - 2000, spender: spender},
+		{hash: unmined, height: -1},
+	}
+	missedSet := map[chainhash.Hash]struct{}{
+		missed:        {},
+		revokedMissed: {},
+		unmined:       {},
+	}
+
+	tests := []struct {
+		missed map[chainhash.Hash]struct{}
+		want   []chainhash.Hash
+	}{
+		{nil, []chainhash.Hash{expired}},
+		{missedSet, []chainhash.Hash{missed, expired}},
+	}
+	for i, test := range tests {
+		got := selectRevocableTickets(params, tickets, tipHeight, test.missed)
+		if len(got) != len(test.want) {
+			t.Errorf("test %d: selected %v, expected %v", i, got, test.want)
+			continue
+		}
+		for j := range got {
+			if got[j] != test.want[j] {
+				t.Errorf("test %d: selected %v, expected %v", i, got, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestCreateUnsignedRevocationDust(t *testing.T) {
+	t.Parallel()
+	params := chaincfg.SimNetParams()
+	addr, err := dcrutil.NewAddressPubKeyHash(make([]byte, 20), params, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const feePerKb = 1e4
+
+	tests := []struct {
+		ticketCost int64
+		dust       bool
+		err        bool
+	}{
+		{1e8, false, false},
+		{6000, true, false}, // revocation output is dust after the fee
+		{1000, false, true}, // output can not pay the fee
+	}
+	for i, test := range tests {
+		input := &extendedOutPoint{
+			op:  &wire.OutPoint{Index: uint32(i)},
+			amt: test.ticketCost,
+		}
+		ticket, err := makeTicket(params, nil, input, addr, addr, test.ticketCost, nil)
+		if err != nil {
+			t.Fatalf("test %d: makeTicket: %v", i, err)
+		}
+		ticketHash := ticket.TxHash()
+		revocation, err := createUnsignedRevocation(&ticketHash, ticket, feePerKb)
+		if test.err {
+			if err == nil {
+				t.Errorf("test %d: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: %v", i, err)
+			continue
+		}
+		if !stake.IsSSRtx(revocation) {
+			t.Errorf("test %d: created transaction is not a revocation", i)
+		}
+		out := revocation.TxOut[0]
+		fee := test.ticketCost - out.Value
+		if fee <= 0 {
+			t.Errorf("test %d: revocation pays no fee", i)
+		}
+		isDust := txrules.IsDustAmount(dcrutil.Amount(out.Value),
+			len(out.PkScript), feePerKb)
+		if isDust != test.dust {
+			t.Errorf("test %d: output dust %v, expected %v", i, isDust, test.dust)
+		}
+	}
+}
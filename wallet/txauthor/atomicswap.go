@@ -0,0 +1,112 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"bytes"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// NewAtomicSwapFundingTx creates an unsigned transaction funding an atomic
+// swap contract.  The first output of the transaction pays amount to the P2SH
+// script OP_HASH160 <HASH160(contractScript)> OP_EQUAL, and inputs, change,
+// and the fee are handled in the same manner as NewUnsignedTransaction.  The
+// fee is estimated for the size of the P2SH contract output.  The signed
+// transaction may not exceed the maximum standard transaction size.
+//
+// An error with kind errors.Invalid is returned if the contract script is
+// empty or too large to be pushed by a redeeming signature script, and
+// errors.Policy is returned if the contract output would be dust.
+func NewAtomicSwapFundingTx(op errors.Op, contractScript []byte, amount, relayFee dcrutil.Amount,
+	inputSource InputSource, changeSource ChangeSource) (*AuthoredTx, error) {
+
+	pkScript, err := contractPkScript(contractScript)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	output := &wire.TxOut{Value: int64(amount), Version: 0, PkScript: pkScript}
+	if err := txrules.CheckOutput(output, relayFee); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	atx, err := NewUnsignedTransaction([]*wire.TxOut{output}, relayFee,
+		inputSource, changeSource, maxStandardTxSize)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return atx, nil
+}
+
+// contractPkScript returns the P2SH output script paying to an atomic swap
+// contract.
+func contractPkScript(contractScript []byte) ([]byte, error) {
+	if len(contractScript) == 0 {
+		return nil, errors.E(errors.Invalid, "empty contract script")
+	}
+	if len(contractScript) > txscript.MaxScriptElementSize {
+		return nil, errors.E(errors.Invalid, errors.Errorf("contract "+
+			"script size %d exceeds maximum %d", len(contractScript),
+			txscript.MaxScriptElementSize))
+	}
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_HASH160).
+		AddData(dcrutil.Hash160(contractScript)).
+		AddOp(txscript.OP_EQUAL).
+		Script()
+}
+
+// NewAtomicSwapRedeemTx creates an unsigned transaction redeeming the atomic
+// swap contract output contractOutput, referenced by contractOutPoint, to a
+// single change output created by changeSource.  The fee is subtracted from
+// the value of the change output and is estimated for a signature script
+// revealing the secret and pushing the contract script.  The caller is
+// responsible for adding the signature script.
+//
+// An error with kind errors.Invalid is returned if the contract output does not
+// pay to the P2SH script of the contract.  If the contract value can not pay
+// the fee and a non-dust change output, an error with kind
+// errors.InsufficientBalance is returned.
+func NewAtomicSwapRedeemTx(op errors.Op, contractOutPoint *wire.OutPoint, contractOutput *wire.TxOut,
+	contractScript, secret []byte, feeRate dcrutil.Amount, changeSource ChangeSource) (*AuthoredTx, error) {
+
+	pkScript, err := contractPkScript(contractScript)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if contractOutput.Version != 0 || !bytes.Equal(contractOutput.PkScript, pkScript) {
+		return nil, errors.E(op, errors.Invalid,
+			"contract output does not pay to the contract script")
+	}
+	changeScript, changeScriptVersion, err := changeSource.Script()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(changeScript) != changeSource.ScriptSize() {
+		return nil, errors.E(op, errors.Invalid,
+			errChangeScriptSize(changeScript, changeSource.ScriptSize()))
+	}
+
+	amount := dcrutil.Amount(contractOutput.Value)
+	inputDetail := &InputDetail{
+		Amount:  amount,
+		Inputs:  []*wire.TxIn{wire.NewTxIn(contractOutPoint, contractOutput.Value, nil)},
+		Scripts: [][]byte{contractOutput.PkScript},
+		RedeemScriptSizes: []int{txsizes.RedeemAtomicSwapSigScriptSize(
+			len(contractScript), len(secret))},
+	}
+	atx, err := newSweepTransaction(op, inputDetail, changeScript, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	atx.Tx.TxOut[0].Version = changeScriptVersion
+	atx.ChangeIndex = 0
+	return atx, nil
+}
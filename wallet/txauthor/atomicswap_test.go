@@ -0,0 +1,234 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestAtomicSwapFundingTx(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	const amount dcrutil.Amount = 1e7
+	contract := bytes.Repeat([]byte{txscript.OP_NOP}, 97)
+
+	tx, err := NewAtomicSwapFundingTx("test", contract, amount, relayFee,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxOut) != 2 || tx.ChangeIndex != 1 {
+		t.Fatalf("expected contract and change outputs, got %d outputs "+
+			"with change index %d", len(tx.Tx.TxOut), tx.ChangeIndex)
+	}
+
+	// The contract output pays to OP_HASH160 <HASH160(contract)> OP_EQUAL.
+	out := tx.Tx.TxOut[0]
+	want := []byte{txscript.OP_HASH160, txscript.OP_DATA_20}
+	want = append(want, dcrutil.Hash160(contract)...)
+	want = append(want, txscript.OP_EQUAL)
+	if !bytes.Equal(out.PkScript, want) {
+		t.Errorf("contract output script %x, expected %x", out.PkScript, want)
+	}
+	if class := txscript.GetScriptClass(out.Version, out.PkScript); class != txscript.ScriptHashTy {
+		t.Errorf("contract output script class %v, expected %v", class,
+			txscript.ScriptHashTy)
+	}
+	if out.Value != int64(amount) {
+		t.Errorf("contract output value %v, expected %v", out.Value, amount)
+	}
+
+	// The fee is estimated for the size of the P2SH contract output, not the
+	// size of the contract script.
+	inputSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+	size := txsizes.EstimateSerializeSize(inputSizes, tx.Tx.TxOut[:1],
+		txsizes.P2PKHPkScriptSize)
+	if tx.EstimatedSignedSerializeSize != size {
+		t.Errorf("estimated size %d, expected %d", tx.EstimatedSignedSerializeSize, size)
+	}
+	if sz := txsizes.EstimateSerializeSize(inputSizes, tx.Tx.TxOut, 0); sz != size {
+		t.Errorf("size with change output %d, expected %d", sz, size)
+	}
+	changeless := txsizes.EstimateSerializeSize(inputSizes, nil, txsizes.P2PKHPkScriptSize)
+	if size-changeless != txsizes.P2SHOutputSize {
+		t.Errorf("contract output adds %d bytes, expected %d", size-changeless,
+			txsizes.P2SHOutputSize)
+	}
+	fee := tx.TotalInput - amount - dcrutil.Amount(tx.Tx.TxOut[1].Value)
+	if want := txrules.FeeForSerializeSize(relayFee, size); fee != want {
+		t.Errorf("fee %v, expected %v", fee, want)
+	}
+
+	tests := []struct {
+		name     string
+		contract []byte
+		amount   dcrutil.Amount
+		kind     errors.Kind
+	}{
+		{"empty contract", nil, amount, errors.Invalid},
+		{"oversized contract", make([]byte, txscript.MaxScriptElementSize+1), amount, errors.Invalid},
+		{"dust amount", contract, 100, errors.Policy},
+		{"insufficient balance", contract, 2e8, errors.InsufficientBalance},
+	}
+	for _, test := range tests {
+		_, err := NewAtomicSwapFundingTx("test", test.contract, test.amount,
+			relayFee, makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{})
+		if !errors.Is(err, test.kind) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.kind, err)
+		}
+	}
+}
+
+// atomicSwapContract returns a contract paying to the hash of pubKey when
+// redeemed with the secret, or to refundPKH after the locktime.
+func atomicSwapContract(t *testing.T, pubKey, refundPKH, secret []byte, locktime int64) []byte {
+	t.Helper()
+	secretHash := sha256.Sum256(secret)
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_IF).
+		AddOp(txscript.OP_SIZE).
+		AddInt64(int64(len(secret))).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_SHA256).
+		AddData(secretHash[:]).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_DUP).
+		AddOp(txscript.OP_HASH160).
+		AddData(dcrutil.Hash160(pubKey)).
+		AddOp(txscript.OP_ELSE).
+		AddInt64(locktime).
+		AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).
+		AddOp(txscript.OP_DROP).
+		AddOp(txscript.OP_DUP).
+		AddOp(txscript.OP_HASH160).
+		AddData(refundPKH).
+		AddOp(txscript.OP_ENDIF).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return script
+}
+
+func TestAtomicSwapRedeemTx(t *testing.T) {
+	const feeRate dcrutil.Amount = 1e4
+	keyBytes := make([]byte, 32)
+	keyBytes[31] = 1
+	key := secp256k1.PrivKeyFromBytes(keyBytes)
+	pubKey := key.PubKey().SerializeCompressed()
+	secret := bytes.Repeat([]byte{0x5a}, 32)
+	contract := atomicSwapContract(t, pubKey, make([]byte, 20), secret, 500000)
+
+	funding, err := NewAtomicSwapFundingTx("test", contract, 1e7, feeRate,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	contractOutput := funding.Tx.TxOut[0]
+	contractOutPoint := wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular)
+
+	changeAddr, err := dcrutil.NewAddressPubKeyHash(dcrutil.Hash160(pubKey),
+		chaincfg.MainNetParams(), dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeSource := scriptChangeSource(changeScript)
+	tx, err := NewAtomicSwapRedeemTx("test", contractOutPoint, contractOutput,
+		contract, secret, feeRate, changeSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxIn) != 1 || tx.Tx.TxIn[0].PreviousOutPoint != *contractOutPoint {
+		t.Fatalf("redeem transaction does not spend only the contract output")
+	}
+	if len(tx.Tx.TxOut) != 1 || tx.ChangeIndex != 0 {
+		t.Fatalf("expected a single change output, got %d outputs with "+
+			"change index %d", len(tx.Tx.TxOut), tx.ChangeIndex)
+	}
+	if !bytes.Equal(tx.Tx.TxOut[0].PkScript, changeScript) {
+		t.Errorf("change script %x, expected %x", tx.Tx.TxOut[0].PkScript, changeScript)
+	}
+
+	// The fee is estimated for a signature script revealing the secret and
+	// pushing the contract.
+	size := txsizes.EstimateSerializeSize([]int{txsizes.RedeemAtomicSwapSigScriptSize(
+		len(contract), len(secret))}, tx.Tx.TxOut, 0)
+	if tx.EstimatedSignedSerializeSize != size {
+		t.Errorf("estimated size %d, expected %d", tx.EstimatedSignedSerializeSize, size)
+	}
+	fee := tx.TotalInput - dcrutil.Amount(tx.Tx.TxOut[0].Value)
+	if want := txrules.FeeForSerializeSize(feeRate, size); fee != want {
+		t.Errorf("fee %v, expected %v", fee, want)
+	}
+
+	// Redeem the contract and check the signed size does not exceed the
+	// estimate.
+	sig, err := txscript.RawTxInSignature(tx.Tx, 0, contract, txscript.SigHashAll,
+		key.Serialize(), dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigScript, err := txscript.NewScriptBuilder().
+		AddData(sig).
+		AddData(pubKey).
+		AddData(secret).
+		AddInt64(1).
+		AddData(contract).
+		Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Tx.TxIn[0].SignatureScript = sigScript
+	vm, err := txscript.NewEngine(contractOutput.PkScript, tx.Tx, 0,
+		txscript.ScriptVerifyCleanStack|txscript.ScriptVerifySHA256, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("redeem script failed to execute: %v", err)
+	}
+	if sz := tx.Tx.SerializeSize(); sz > size {
+		t.Errorf("signed size %d exceeds estimate %d", sz, size)
+	}
+
+	// Contracts which can not pay the fee and a non-dust output are
+	// insufficient.
+	for _, amount := range []int64{1e3, int64(fee), int64(fee) + 100} {
+		out := *contractOutput
+		out.Value = amount
+		_, err := NewAtomicSwapRedeemTx("test", contractOutPoint, &out,
+			contract, secret, feeRate, changeSource)
+		if !errors.Is(err, errors.InsufficientBalance) {
+			t.Errorf("amount %v: expected InsufficientBalance, got %v", amount, err)
+		}
+	}
+
+	// The contract output must pay to the contract.
+	other := atomicSwapContract(t, pubKey, make([]byte, 20), secret, 500001)
+	_, err = NewAtomicSwapRedeemTx("test", contractOutPoint, contractOutput,
+		other, secret, feeRate, changeSource)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for mismatched contract, got %v", err)
+	}
+}
@@ -7,10 +7,15 @@
 package txauthor
 
 import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
 	"decred.org/dcrwallet/errors"
 	"decred.org/dcrwallet/wallet/txrules"
 	"decred.org/dcrwallet/wallet/txsizes"
 	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
 	"github.com/decred/dcrd/dcrutil/v3"
 	"github.com/decred/dcrd/txscript/v3"
 	"github.com/decred/dcrd/wire"
@@ -24,17 +29,72 @@ const (
 	// constant for the generated transaction version could allow creation
 	// of invalid transactions for the updated version.
 	generatedTxVersion = 1
+
+	// sequenceLockTxVersion is the minimum transaction version for which
+	// input sequence numbers are enforced as relative lock times.
+	sequenceLockTxVersion = 2
 )
 
 // InputDetail provides a detailed summary of transaction inputs
 // referencing spendable outputs. This consists of the total spendable
 // amount, the generated inputs, the redeem scripts and the full redeem
 // script sizes.
+//
+// Scripts holds the previous output script of each input and is returned
+// unmodified as the PrevScripts of an authored transaction, so sources which
+// know the scripts of outputs but not the private keys, such as those of
+// watching-only wallets, may provide them for signing by an external signer.
+//
+// Inputs spending time-locked outputs must describe the larger signature
+// scripts required to redeem them in RedeemScriptSizes.  Sequences may
+// optionally provide the sequence number of each input, and must be empty or
+// have the same length as Inputs.  Accounts may optionally record the wallet
+// account of each input, and must be empty or have the same length as Inputs.
 type InputDetail struct {
 	Amount            dcrutil.Amount
 	Inputs            []*wire.TxIn
 	Scripts           [][]byte
 	RedeemScriptSizes []int
+	Sequences         []uint32
+	Accounts          []uint32
+}
+
+// applySequences sets the sequence number of each input to the corresponding
+// sequence of the input detail, if any were provided.  The transaction version
+// required for the sequences to be enforced as relative lock times is
+// returned.
+func applySequences(inputDetail *InputDetail) (txVersion uint16, err error) {
+	txVersion = generatedTxVersion
+	if len(inputDetail.Sequences) == 0 {
+		return txVersion, nil
+	}
+	if len(inputDetail.Sequences) != len(inputDetail.Inputs) {
+		return 0, errors.E(errors.Invalid, "input sequence count does not "+
+			"match input count")
+	}
+	for i, seq := range inputDetail.Sequences {
+		inputDetail.Inputs[i].Sequence = seq
+		if seq&wire.SequenceLockTimeDisabled == 0 {
+			txVersion = sequenceLockTxVersion
+		}
+	}
+	return txVersion, nil
+}
+
+// applyLockTimeSequence ensures the lock time of a transaction spending inputs
+// is enforced.  Lock times are only enforced when at least one input is not
+// final, so if every input has the maximum (final) sequence number, the
+// sequence of the first input is decremented.  The decremented sequence
+// continues to disable relative lock times.
+func applyLockTimeSequence(inputs []*wire.TxIn) {
+	for _, in := range inputs {
+		if in.Sequence != wire.MaxTxInSequenceNum {
+			return
+		}
+	}
+	if len(inputs) != 0 {
+		inputs[0].Sequence = wire.MaxTxInSequenceNum - 1
+	}
 }
 
 // InputSource provides transaction inputs referencing spendable outputs to
@@ -43,14 +103,78 @@ type InputDetail struct {
 // than the target or by returning a more detailed error.
 type InputSource func(target dcrutil.Amount) (detail *InputDetail, err error)
 
+// InsufficientBalanceError describes the input value which was available and
+// the value required when a transaction could not be funded.  It is wrapped by
+// errors with kind errors.InsufficientBalance and may be extracted using
+// errors.As.
+type InsufficientBalanceError struct {
+	Have dcrutil.Amount // total value of all selected inputs
+	Need dcrutil.Amount // output value and fee required
+}
+
+func (e *InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("have %v, need %v", e.Have, e.Need)
+}
+
+// DustOutputError describes a transaction output which was not authored
+// because its value is dust.  It is wrapped by errors with kind
+// errors.DustOutput and may be extracted using errors.As.
+type DustOutputError struct {
+	Index int            // index of the output in the provided outputs
+	Value dcrutil.Amount // output value
+}
+
+func (e *DustOutputError) Error() string {
+	return fmt.Sprintf("output %d with value %v is dust", e.Index, e.Value)
+}
+
+// CheckDustOutputs returns an error with kind errors.DustOutput wrapping a
+// *DustOutputError describing the first output which is dust under the dust
+// threshold policy.  A nil policy selects txrules.DefaultDustPolicy.  This is
+// the check performed on the outputs provided to NewUnsignedTransaction, and
+// may be used to validate outputs before any inputs are selected.
+func CheckDustOutputs(policy txrules.DustThresholdPolicy, outputs []*wire.TxOut,
+	relayFeePerKb dcrutil.Amount) error {
+
+	if policy == nil {
+		policy = txrules.DefaultDustPolicy{}
+	}
+	for i, out := range outputs {
+		if txrules.IsDustOutputPolicy(policy, out, relayFeePerKb) {
+			return errors.E(errors.DustOutput, &DustOutputError{
+				Index: i,
+				Value: dcrutil.Amount(out.Value),
+			})
+		}
+	}
+	return nil
+}
+
+// prevOutpoints returns the previous outpoints spent by each input, in order.
+func prevOutpoints(inputs []*wire.TxIn) []wire.OutPoint {
+	outpoints := make([]wire.OutPoint, len(inputs))
+	for i, in := range inputs {
+		outpoints[i] = in.PreviousOutPoint
+	}
+	return outpoints
+}
+
 // AuthoredTx holds the state of a newly-created transaction and the change
 // output (if one was added).
 type AuthoredTx struct {
 	Tx                           *wire.MsgTx
 	PrevScripts                  [][]byte
+	PrevOutpoints                []wire.OutPoint // in input order
+	PrevAccounts                 []uint32        // account of each input, if known by the input source
 	TotalInput                   dcrutil.Amount
-	ChangeIndex                  int // negative if no change
-	EstimatedSignedSerializeSize int
+	ChangeIndex                  int // negative if no change; first of any split change outputs
+	EstimatedSignedSerializeSize int // estimated using the redeem script sizes of each input
+
+	// ReusedAddresses are the addresses paid by the transaction outputs
+	// which are known to have been used before.  The authoring functions of
+	// this package do not set this field, and it is only recorded by callers
+	// which are able to determine address usage.
+	ReusedAddresses []dcrutil.Address
 }
 
 // ChangeSource provides change output scripts and versions for
@@ -60,6 +184,14 @@ type ChangeSource interface {
 	ScriptSize() int
 }
 
+// errChangeScriptSize describes a change script which does not have the size
+// reported by its ChangeSource.  Fees are estimated using the reported size,
+// so such a script would cause an incorrect fee to be paid.
+func errChangeScriptSize(script []byte, scriptSize int) error {
+	return errors.Errorf("change script size %d does not match reported "+
+		"script size %d", len(script), scriptSize)
+}
+
 func sumOutputValues(outputs []*wire.TxOut) (totalOutput dcrutil.Amount) {
 	for _, txOut := range outputs {
 		totalOutput += dcrutil.Amount(txOut.Value)
@@ -77,28 +209,172 @@ func sumOutputValues(outputs []*wire.TxOut) (totalOutput dcrutil.Amount) {
 // If any remaining output value can be returned to the wallet via a change
 // output without violating mempool dust rules, a P2PKH change output is
 // appended to the transaction outputs.  Since the change output may not be
-// necessary, fetchChange is called zero or one times to generate this script.
-// This function must return a P2PKH script or smaller, otherwise fee estimation
-// will be incorrect.
+// necessary, fetchChange.Script is only called after inputs are selected and
+// non-dust change is known to remain, once for each change output created.
+// Changeless transactions therefore do not consume change addresses.  The
+// length of each change script must equal the size reported by the change
+// source's ScriptSize method, otherwise an error with kind errors.Invalid is
+// returned.
 //
 // If successful, the transaction, total input value spent, and all previous
 // output scripts are returned.  If the input source was unable to provide
-// enough input value to pay for every output any any necessary fees, an
-// InputSourceError is returned.
+// enough input value to pay for every output any any necessary fees, an error
+// with kind errors.InsufficientBalance wrapping an *InsufficientBalanceError is
+// returned.  If the inputs required to pay for the outputs would exceed the
+// maximum number of inputs or push the estimated signed size past maxTxSize,
+// an error with kind errors.TooManyInputs is returned and the outputs may
+// instead be paid by multiple transactions.
+//
+// Outputs are checked against the dust threshold policy before any inputs are
+// selected.  If any output is dust, an error with kind errors.DustOutput
+// wrapping a *DustOutputError naming the output is returned.  If the
+// WithMaxFee option is provided and the fee would exceed its limit, an error
+// with kind errors.FeeExceedsLimit is returned.
+//
+// Additional options may be provided to configure how the transaction is
+// authored.
 func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb dcrutil.Amount,
-	fetchInputs InputSource, fetchChange ChangeSource, maxTxSize int) (*AuthoredTx, error) {
+	fetchInputs InputSource, fetchChange ChangeSource, maxTxSize int,
+	opts ...Option) (*AuthoredTx, error) {
 
 	const op errors.Op = "txauthor.NewUnsignedTransaction"
 
-	targetAmount := sumOutputValues(outputs)
-	scriptSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
-	changeScript, changeScriptVersion, err := fetchChange.Script()
+	o := newOptions(opts)
+
+	err := CheckDustOutputs(o.dustPolicy, outputs, relayFeePerKb)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	targetAmount := sumOutputValues(outputs)
 	changeScriptSize := fetchChange.ScriptSize()
+	sel, err := selectInputs(op, o, outputs, relayFeePerKb, fetchInputs,
+		changeScriptSize, maxTxSize)
+	if err != nil {
+		return nil, err
+	}
+	inputDetail := sel.inputDetail
+	scriptSizes := inputDetail.RedeemScriptSizes
+	maxSignedSize := sel.size
+	maxRequiredFee := sel.fee
+	changeCount := sel.changeCount
+	dustAmount := sel.dustAmount
+
+	txVersion, err := applySequences(inputDetail)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if o.lockTime != 0 {
+		applyLockTimeSequence(inputDetail.Inputs)
+	}
+	unsignedTransaction := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion,
+		TxIn:     inputDetail.Inputs,
+		TxOut:    outputs,
+		LockTime: o.lockTime,
+		Expiry:   0,
+	}
+	changeIndex := -1
+	changeAmount := inputDetail.Amount - targetAmount - maxRequiredFee
+	createChange := changeAmount != 0 && changeAmount >= o.economicChange &&
+		!txrules.IsDustAmountPolicy(o.dustPolicy, changeAmount,
+			changeScriptSize, relayFeePerKb)
+	spent := inputDetail.Amount
+	if createChange {
+		spent -= changeAmount
+	}
+	if o.reserve != 0 && spent > o.balance-o.reserve {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			&InsufficientBalanceError{Have: o.balance - o.reserve, Need: spent})
+	}
+	if fee := spent - targetAmount; o.maxFee != 0 && fee > o.maxFee {
+		return nil, errors.E(op, errors.FeeExceedsLimit,
+			errors.Errorf("fee %v exceeds limit %v", fee, o.maxFee))
+	}
+	if createChange {
+		amounts := []dcrutil.Amount{changeAmount}
+		if changeCount > 1 {
+			amounts = splitAmount(changeAmount, changeCount, dustAmount)
+		}
+		changes := make([]*wire.TxOut, 0, len(amounts))
+		for _, amount := range amounts {
+			script, version, err := fetchChange.Script()
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			if len(script) != changeScriptSize {
+				return nil, errors.E(op, errors.Invalid,
+					errChangeScriptSize(script, changeScriptSize))
+			}
+			if len(script) > txscript.MaxScriptElementSize {
+				return nil, errors.E(op, errors.Invalid, "script size exceed maximum bytes "+
+					"pushable to the stack")
+			}
+			changes = append(changes, &wire.TxOut{
+				Value:    int64(amount),
+				Version:  version,
+				PkScript: script,
+			})
+		}
+		l := len(outputs)
+		changeIndex = l
+		if o.changeRand != nil {
+			r, err := rand.Int(o.changeRand, big.NewInt(int64(l+1)))
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			changeIndex = int(r.Int64())
+		}
+		txOuts := make([]*wire.TxOut, 0, l+len(changes))
+		txOuts = append(txOuts, outputs[:changeIndex]...)
+		txOuts = append(txOuts, changes...)
+		txOuts = append(txOuts, outputs[changeIndex:]...)
+		unsignedTransaction.TxOut = txOuts
+	} else {
+		maxSignedSize = txsizes.EstimateSerializeSize(scriptSizes,
+			unsignedTransaction.TxOut, 0)
+	}
+	tx := &AuthoredTx{
+		Tx:                           unsignedTransaction,
+		PrevScripts:                  inputDetail.Scripts,
+		PrevAccounts:                 inputDetail.Accounts,
+		PrevOutpoints:                prevOutpoints(inputDetail.Inputs),
+		TotalInput:                   inputDetail.Amount,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: maxSignedSize,
+	}
+	if o.bip69 {
+		tx.SortBIP69()
+	}
+	return tx, nil
+}
+
+// selection describes the inputs selected to pay for a transaction's outputs,
+// and the estimated signed size and fee of the transaction.
+type selection struct {
+	inputDetail *InputDetail
+	size        int
+	fee         dcrutil.Amount
+	changeCount int
+	dustAmount  dcrutil.Amount
+}
+
+// selectInputs selects inputs from fetchInputs to pay for the outputs and the
+// fee of the signed transaction, which may include one or more change outputs
+// with scripts of changeScriptSize bytes.  The size and fee of the selection
+// assume the change outputs are created, unless the remaining value after the
+// fee can not pay for change, in which case the remaining value is the fee.
+// Errors are returned with the operation op of the authoring function.
+func selectInputs(op errors.Op, o *options, outputs []*wire.TxOut, relayFeePerKb dcrutil.Amount,
+	fetchInputs InputSource, changeScriptSize, maxTxSize int) (*selection, error) {
+
+	targetAmount := sumOutputValues(outputs)
+	scriptSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
 	maxSignedSize := txsizes.EstimateSerializeSize(scriptSizes, outputs, changeScriptSize)
-	targetFee := txrules.FeeForSerializeSize(relayFeePerKb, maxSignedSize)
+	if maxSignedSize > maxTxSize {
+		return nil, errors.E(op, errors.Invalid, "signed tx size exceeds allowed maximum")
+	}
+	targetFee := o.feeForSize(relayFeePerKb, maxSignedSize)
 
 	for {
 		inputDetail, err := fetchInputs(targetAmount + targetFee)
@@ -107,62 +383,312 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb dcrutil.Amount,
 		}
 
 		if inputDetail.Amount < targetAmount+targetFee {
-			return nil, errors.E(op, errors.InsufficientBalance)
+			// Inputs from an exhausted source may still pay for a
+			// transaction without a change output.
+			changelessSize := txsizes.EstimateSerializeSize(
+				inputDetail.RedeemScriptSizes, outputs, 0)
+			changelessFee := o.feeForSize(relayFeePerKb, changelessSize)
+			if inputDetail.Amount < targetAmount+changelessFee {
+				return nil, errors.E(op, errors.InsufficientBalance,
+					&InsufficientBalanceError{
+						Have: inputDetail.Amount,
+						Need: targetAmount + changelessFee,
+					})
+			}
+		}
+		if o.maxInputs > 0 && len(inputDetail.Inputs) > o.maxInputs {
+			return nil, errors.E(op, errors.TooManyInputs, errors.Errorf("selected "+
+				"%d inputs, exceeding the maximum of %d", len(inputDetail.Inputs),
+				o.maxInputs))
 		}
 
 		scriptSizes := make([]int, 0, len(inputDetail.RedeemScriptSizes))
 		scriptSizes = append(scriptSizes, inputDetail.RedeemScriptSizes...)
 
 		maxSignedSize = txsizes.EstimateSerializeSize(scriptSizes, outputs, changeScriptSize)
-		maxRequiredFee := txrules.FeeForSerializeSize(relayFeePerKb, maxSignedSize)
+		maxRequiredFee := o.feeForSize(relayFeePerKb, maxSignedSize)
 		remainingAmount := inputDetail.Amount - targetAmount
 		if remainingAmount < maxRequiredFee {
-			targetFee = maxRequiredFee
-			continue
+			// Inputs which can not pay for a change output may
+			// still pay the smaller fee of a transaction without
+			// one.  In this case, any remaining amount is added to
+			// the fee.
+			changelessSize := txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+			changelessFee := o.feeForSize(relayFeePerKb, changelessSize)
+			if remainingAmount < changelessFee {
+				targetFee = maxRequiredFee
+				continue
+			}
+			maxSignedSize = changelessSize
+			maxRequiredFee = remainingAmount
 		}
 
-		if maxSignedSize > maxTxSize {
-			return nil, errors.E(errors.Invalid, "signed tx size exceeds allowed maximum")
-		}
-
-		unsignedTransaction := &wire.MsgTx{
-			SerType:  wire.TxSerializeFull,
-			Version:  generatedTxVersion,
-			TxIn:     inputDetail.Inputs,
-			TxOut:    outputs,
-			LockTime: 0,
-			Expiry:   0,
-		}
-		changeIndex := -1
-		changeAmount := inputDetail.Amount - targetAmount - maxRequiredFee
-		if changeAmount != 0 && !txrules.IsDustAmount(changeAmount,
-			changeScriptSize, relayFeePerKb) {
-			if len(changeScript) > txscript.MaxScriptElementSize {
-				return nil, errors.E(errors.Invalid, "script size exceed maximum bytes "+
-					"pushable to the stack")
-			}
-			change := &wire.TxOut{
-				Value:    int64(changeAmount),
-				Version:  changeScriptVersion,
-				PkScript: changeScript,
+		// Change may be split across multiple outputs when the change
+		// amount of each output remains above the dust threshold.
+		// Otherwise, the number of change outputs is reduced.
+		dustAmount := o.dustPolicy.DustAmount(changeScriptSize, relayFeePerKb)
+		if dustAmount < 1 {
+			dustAmount = 1
+		}
+		changeCount := 1
+		for n := o.splitChange; n > 1; n-- {
+			size := estimateSplitChangeSize(scriptSizes, outputs, changeScriptSize, n)
+			fee := o.feeForSize(relayFeePerKb, size)
+			if remainingAmount-fee >= dcrutil.Amount(n)*dustAmount {
+				changeCount = n
+				maxSignedSize = size
+				maxRequiredFee = fee
+				break
 			}
-			l := len(outputs)
-			unsignedTransaction.TxOut = append(outputs[:l:l], change)
-			changeIndex = l
-		} else {
-			maxSignedSize = txsizes.EstimateSerializeSize(scriptSizes,
-				unsignedTransaction.TxOut, 0)
-		}
-		return &AuthoredTx{
-			Tx:                           unsignedTransaction,
-			PrevScripts:                  inputDetail.Scripts,
-			TotalInput:                   inputDetail.Amount,
-			ChangeIndex:                  changeIndex,
-			EstimatedSignedSerializeSize: maxSignedSize,
+		}
+
+		if maxSignedSize > maxTxSize {
+			return nil, errors.E(op, errors.TooManyInputs,
+				"signed tx size exceeds allowed maximum")
+		}
+
+		return &selection{
+			inputDetail: inputDetail,
+			size:        maxSignedSize,
+			fee:         maxRequiredFee,
+			changeCount: changeCount,
+			dustAmount:  dustAmount,
 		}, nil
 	}
 }
 
+// EstimateFee returns the fee of the transaction that NewUnsignedTransaction
+// would author paying to outputs, using the same input selection and size
+// estimation, without creating the transaction.  The change source is only
+// used for the size of its scripts, and no change scripts are created.  The
+// transaction may not exceed the maximum standard transaction size.  Options
+// are applied as by NewUnsignedTransaction.
+//
+// Errors are returned for the same conditions as NewUnsignedTransaction.  If
+// the input source was unable to provide enough input value to pay for every
+// output and the fee, an error with kind errors.InsufficientBalance is
+// returned.
+func EstimateFee(op errors.Op, outputs []*wire.TxOut, relayFee dcrutil.Amount,
+	inputSource InputSource, changeSource ChangeSource, opts ...Option) (dcrutil.Amount, error) {
+
+	o := newOptions(opts)
+
+	err := CheckDustOutputs(o.dustPolicy, outputs, relayFee)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	changeScriptSize := changeSource.ScriptSize()
+	sel, err := selectInputs(op, o, outputs, relayFee, inputSource,
+		changeScriptSize, maxStandardTxSize)
+	if err != nil {
+		return 0, err
+	}
+
+	// Remaining value which would create a dust change output is added to
+	// the fee.
+	remaining := sel.inputDetail.Amount - sumOutputValues(outputs)
+	change := remaining - sel.fee
+	if change != 0 && !txrules.IsDustAmountPolicy(o.dustPolicy, change,
+		changeScriptSize, relayFee) {
+		return sel.fee, nil
+	}
+	return remaining, nil
+}
+
+// NewUnsignedTransactionWithSplitChange creates an unsigned transaction in the
+// same manner as NewUnsignedTransaction, but splits any change across up to
+// changeCount outputs.  It is equivalent to calling NewUnsignedTransaction
+// with the WithSplitChange(changeCount) option.
+func NewUnsignedTransactionWithSplitChange(outputs []*wire.TxOut, relayFeePerKb dcrutil.Amount,
+	fetchInputs InputSource, fetchChange ChangeSource, changeCount, maxTxSize int,
+	opts ...Option) (*AuthoredTx, error) {
+
+	opts = append(opts[:len(opts):len(opts)], WithSplitChange(changeCount))
+	return NewUnsignedTransaction(outputs, relayFeePerKb, fetchInputs,
+		fetchChange, maxTxSize, opts...)
+}
+
+// maxStandardTxSize is the maximum size of a transaction relayed by mempools
+// with default policies.  It is used as the maximum transaction size when none
+// is provided by the caller.
+const maxStandardTxSize = 100000
+
+// NewUnsignedTransactionWithData creates an unsigned transaction in the same
+// manner as NewUnsignedTransaction, with an additional zero-value null data
+// (OP_RETURN) output carrying data following the provided outputs.  The data
+// output is included in the size estimate used to calculate the fee.  Any
+// change output is distinct from the data output.
+//
+// An error with kind errors.Invalid is returned if data exceeds the maximum
+// standard null data payload size.  The signed transaction may not exceed the
+// maximum standard transaction size.
+func NewUnsignedTransactionWithData(outputs []*wire.TxOut, data []byte,
+	relayFeePerKb dcrutil.Amount, fetchInputs InputSource, fetchChange ChangeSource,
+	opts ...Option) (*AuthoredTx, error) {
+
+	const op errors.Op = "txauthor.NewUnsignedTransactionWithData"
+
+	if len(data) > txscript.MaxDataCarrierSize {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("data "+
+			"length %d exceeds maximum %d", len(data), txscript.MaxDataCarrierSize))
+	}
+	script, err := txscript.GenerateProvablyPruneableOut(data)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+
+	withData := make([]*wire.TxOut, 0, len(outputs)+1)
+	withData = append(withData, outputs...)
+	withData = append(withData, &wire.TxOut{Value: 0, Version: 0, PkScript: script})
+	return NewUnsignedTransaction(withData, relayFeePerKb, fetchInputs,
+		fetchChange, maxStandardTxSize, opts...)
+}
+
+// NewUnsignedTransactionAbsoluteFee creates an unsigned transaction paying to
+// one or more non-change outputs and paying exactly absoluteFee, regardless of
+// the transaction size.  Inputs are selected from fetchInputs to pay for the
+// outputs and fee, and any remaining value is returned to a change output
+// created with fetchChange.  The fee is not checked against any relay fee
+// policy.
+//
+// Outputs which are dust under the configured dust policy and the default
+// relay fee are rejected with an error with kind errors.DustOutput, as by
+// NewUnsignedTransaction.  The fee can only be exact when the remaining value
+// is zero or large enough to create a change output which is not dust under
+// the same policy.  If inputs for the outputs and fee, or a non-dust change
+// output, can not be found, an error with kind errors.InsufficientBalance is
+// returned.  If more inputs than allowed by the WithMaxInputs option are
+// selected, an error with kind errors.TooManyInputs is returned.
+func NewUnsignedTransactionAbsoluteFee(op errors.Op, outputs []*wire.TxOut,
+	absoluteFee dcrutil.Amount, fetchInputs InputSource, fetchChange ChangeSource,
+	opts ...Option) (*AuthoredTx, error) {
+
+	o := newOptions(opts)
+
+	if absoluteFee < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative fee")
+	}
+	err := CheckDustOutputs(o.dustPolicy, outputs, txrules.DefaultRelayFeePerKb)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	target := sumOutputValues(outputs) + absoluteFee
+	changeScriptSize := fetchChange.ScriptSize()
+	dustAmount := o.dustPolicy.DustAmount(changeScriptSize,
+		txrules.DefaultRelayFeePerKb)
+
+	inputDetail, err := fetchInputs(target)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if inputDetail.Amount < target {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			&InsufficientBalanceError{Have: inputDetail.Amount, Need: target})
+	}
+	if change := inputDetail.Amount - target; change != 0 && change < dustAmount {
+		// Select additional inputs so that the change is not dust.
+		inputDetail, err = fetchInputs(target + dustAmount)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if inputDetail.Amount < target+dustAmount {
+			return nil, errors.E(op, errors.InsufficientBalance,
+				"remaining value would create dust change")
+		}
+	}
+	if o.maxInputs > 0 && len(inputDetail.Inputs) > o.maxInputs {
+		return nil, errors.E(op, errors.TooManyInputs, errors.Errorf("selected "+
+			"%d inputs, exceeding the maximum of %d", len(inputDetail.Inputs),
+			o.maxInputs))
+	}
+
+	txVersion, err := applySequences(inputDetail)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if o.lockTime != 0 {
+		applyLockTimeSequence(inputDetail.Inputs)
+	}
+	tx := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion,
+		TxIn:     inputDetail.Inputs,
+		TxOut:    outputs,
+		LockTime: o.lockTime,
+		Expiry:   0,
+	}
+	changeIndex := -1
+	if change := inputDetail.Amount - target; change != 0 {
+		script, version, err := fetchChange.Script()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(script) != changeScriptSize {
+			return nil, errors.E(op, errors.Invalid,
+				errChangeScriptSize(script, changeScriptSize))
+		}
+		changeIndex = len(outputs)
+		txOuts := make([]*wire.TxOut, 0, len(outputs)+1)
+		txOuts = append(txOuts, outputs...)
+		txOuts = append(txOuts, &wire.TxOut{
+			Value:    int64(change),
+			Version:  version,
+			PkScript: script,
+		})
+		tx.TxOut = txOuts
+	}
+	size := txsizes.EstimateSerializeSize(inputDetail.RedeemScriptSizes, tx.TxOut, 0)
+	if size > maxStandardTxSize {
+		return nil, errors.E(op, errors.TooManyInputs,
+			"signed tx size exceeds allowed maximum")
+	}
+	return &AuthoredTx{
+		Tx:                           tx,
+		PrevScripts:                  inputDetail.Scripts,
+		PrevAccounts:                 inputDetail.Accounts,
+		PrevOutpoints:                prevOutpoints(inputDetail.Inputs),
+		TotalInput:                   inputDetail.Amount,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: size,
+	}, nil
+}
+
+// estimateSplitChangeSize returns the worst case serialize size estimate of a
+// signed transaction with changeCount change outputs.
+func estimateSplitChangeSize(scriptSizes []int, outputs []*wire.TxOut,
+	changeScriptSize, changeCount int) int {
+
+	txOuts := make([]*wire.TxOut, len(outputs), len(outputs)+changeCount-1)
+	copy(txOuts, outputs)
+	for i := 1; i < changeCount; i++ {
+		txOuts = append(txOuts, &wire.TxOut{PkScript: make([]byte, changeScriptSize)})
+	}
+	return txsizes.EstimateSerializeSize(scriptSizes, txOuts, changeScriptSize)
+}
+
+// splitAmount divides total into n amounts of at least minAmount each.  The
+// value above the minimums is divided using random weights.
+func splitAmount(total dcrutil.Amount, n int, minAmount dcrutil.Amount) []dcrutil.Amount {
+	weights := make([]int64, n)
+	var sum int64
+	for i := range weights {
+		weights[i] = 1000 + int64(cprng.Int31n(1000))
+		sum += weights[i]
+	}
+	surplus := total - dcrutil.Amount(n)*minAmount
+	remaining := surplus
+	amounts := make([]dcrutil.Amount, n)
+	for i := range amounts {
+		share := remaining
+		if i != n-1 {
+			share = surplus * dcrutil.Amount(weights[i]) / dcrutil.Amount(sum)
+		}
+		amounts[i] = minAmount + share
+		remaining -= share
+	}
+	return amounts
+}
+
 // RandomizeOutputPosition randomizes the position of a transaction's output by
 // swapping it with a random output.  The new index is returned.  This should be
 // done before signing.
@@ -178,6 +704,43 @@ func (tx *AuthoredTx) RandomizeChangePosition() {
 	tx.ChangeIndex = RandomizeOutputPosition(tx.Tx.TxOut, tx.ChangeIndex)
 }
 
+// ChangeOutput returns the change output of an authored transaction and true,
+// or nil and false if the transaction has no change output.  When change is
+// split across multiple outputs, the first change output is returned.
+func (tx *AuthoredTx) ChangeOutput() (*wire.TxOut, bool) {
+	if tx.ChangeIndex < 0 || tx.ChangeIndex >= len(tx.Tx.TxOut) {
+		return nil, false
+	}
+	return tx.Tx.TxOut[tx.ChangeIndex], true
+}
+
+// Fee returns the absolute fee paid by the authored transaction, the total
+// input value less the total output value.  This includes any dust change that
+// was added to the fee.
+func (tx *AuthoredTx) Fee() dcrutil.Amount {
+	return tx.TotalInput - sumOutputValues(tx.Tx.TxOut)
+}
+
+// FeeRate returns the fee rate, per kB of the estimated signed serialize size,
+// paid by the authored transaction.  This is the rate actually paid after any
+// rounding of the fee and any dust change added to the fee, and may be shown
+// to users before the transaction is signed.  The rate is rounded down, so
+// txrules.FeeForSerializeSize with this rate and the estimated size never
+// exceeds Fee.  Zero is returned if the estimated signed size is unknown.
+func (tx *AuthoredTx) FeeRate() dcrutil.Amount {
+	if tx.EstimatedSignedSerializeSize <= 0 {
+		return 0
+	}
+	return tx.Fee() * 1000 / dcrutil.Amount(tx.EstimatedSignedSerializeSize)
+}
+
+// EffectiveFeeRate returns the fee rate paid by the authored transaction.
+//
+// Deprecated: Use FeeRate.
+func (tx *AuthoredTx) EffectiveFeeRate() dcrutil.Amount {
+	return tx.FeeRate()
+}
+
 // SecretsSource provides private keys and redeem scripts necessary for
 // constructing transaction input signatures.  Secrets are looked up by the
 // corresponding Address for the previous output script.  Addresses for lookup
@@ -195,30 +758,89 @@ type SecretsSource interface {
 	ChainParams() *chaincfg.Params
 }
 
+// dsaAddress is implemented by addresses which identify the digital signature
+// algorithm of their public key.
+type dsaAddress interface {
+	DSA() dcrec.SignatureType
+}
+
+// checkSignatureType returns an error if inputs redeeming pkScript can not be
+// signed using signatures of sigType.  ECDSA signatures are required to redeem
+// standard P2PK, P2PKH, P2SH, and stake tagged scripts, while scripts created
+// with the alternative signature opcodes require the signature algorithm of
+// their public key.
+func checkSignatureType(pkScript []byte, sigType dcrec.SignatureType, params *chaincfg.Params) error {
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(0, pkScript, params)
+	if err != nil {
+		return err
+	}
+	switch class {
+	case txscript.PubKeyAltTy, txscript.PubkeyHashAltTy:
+		if len(addrs) != 1 {
+			break
+		}
+		addr, ok := addrs[0].(dsaAddress)
+		if ok && addr.DSA() == sigType {
+			return nil
+		}
+	default:
+		if sigType == dcrec.STEcdsaSecp256k1 {
+			return nil
+		}
+	}
+	return errors.E(errors.Invalid, errors.Errorf("signature type %d can not "+
+		"redeem %v script", sigType, class))
+}
+
+// SignInput adds a signature script to the input idx of tx, redeeming the
+// previous output script pkScript with a signature of type sigType.  Private
+// keys and redeem scripts are looked up using a SecretsSource based on the
+// previous output script.  Any private key returned by the SecretsSource must
+// be a key for the requested signature algorithm.  An error with kind
+// errors.Invalid is returned if the previous output script can not be redeemed
+// by signatures of sigType.  Signatures use deterministic nonces, so signing an
+// identical transaction with the same keys creates an identical signature
+// script.
+func SignInput(tx *wire.MsgTx, idx int, pkScript []byte, sigType dcrec.SignatureType,
+	secrets SecretsSource) error {
+
+	chainParams := secrets.ChainParams()
+	err := checkSignatureType(pkScript, sigType, chainParams)
+	if err != nil {
+		return err
+	}
+	var getKey txscript.KeyClosure = func(addr dcrutil.Address) ([]byte, dcrec.SignatureType, bool, error) {
+		key, _, compressed, err := secrets.GetKey(addr)
+		return key, sigType, compressed, err
+	}
+	script, err := txscript.SignTxOutput(chainParams, tx, idx, pkScript,
+		txscript.SigHashAll, getKey, secrets, tx.TxIn[idx].SignatureScript)
+	if err != nil {
+		return err
+	}
+	tx.TxIn[idx].SignatureScript = script
+	return nil
+}
+
 // AddAllInputScripts modifies transaction a transaction by adding inputs
 // scripts for each input.  Previous output scripts being redeemed by each input
 // are passed in prevPkScripts and the slice length must match the number of
 // inputs.  Private keys and redeem scripts are looked up using a SecretsSource
-// based on the previous output script.
-func AddAllInputScripts(tx *wire.MsgTx, prevPkScripts [][]byte, secrets SecretsSource) error {
-	inputs := tx.TxIn
-	chainParams := secrets.ChainParams()
+// based on the previous output script.  Every input is signed with signatures
+// of type sigType, as described by SignInput.
+func AddAllInputScripts(tx *wire.MsgTx, prevPkScripts [][]byte, secrets SecretsSource,
+	sigType dcrec.SignatureType) error {
 
-	if len(inputs) != len(prevPkScripts) {
+	if len(tx.TxIn) != len(prevPkScripts) {
 		return errors.New("tx.TxIn and prevPkScripts slices must " +
 			"have equal length")
 	}
 
-	for i := range inputs {
-		pkScript := prevPkScripts[i]
-		sigScript := inputs[i].SignatureScript
-		script, err := txscript.SignTxOutput(chainParams, tx, i,
-			pkScript, txscript.SigHashAll, secrets, secrets,
-			sigScript)
+	for i := range tx.TxIn {
+		err := SignInput(tx, i, prevPkScripts[i], sigType, secrets)
 		if err != nil {
 			return err
 		}
-		inputs[i].SignatureScript = script
 	}
 
 	return nil
@@ -227,6 +849,7 @@ func AddAllInputScripts(tx *wire.MsgTx, prevPkScripts [][]byte, secrets SecretsS
 // AddAllInputScripts modifies an authored transaction by adding inputs scripts
 // for each input of an authored transaction.  Private keys and redeem scripts
 // are looked up using a SecretsSource based on the previous output script.
-func (tx *AuthoredTx) AddAllInputScripts(secrets SecretsSource) error {
-	return AddAllInputScripts(tx.Tx, tx.PrevScripts, secrets)
+// Every input is signed with signatures of type sigType.
+func (tx *AuthoredTx) AddAllInputScripts(secrets SecretsSource, sigType dcrec.SignatureType) error {
+	return AddAllInputScripts(tx.Tx, tx.PrevScripts, secrets, sigType)
 }
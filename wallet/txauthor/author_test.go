@@ -6,6 +6,8 @@
 package txauthor_test
 
 import (
+	"bytes"
+	mrand "math/rand"
 	"testing"
 
 	"decred.org/dcrwallet/errors"
@@ -13,8 +15,10 @@ import (
 	. "decred.org/dcrwallet/wallet/txauthor"
 	"decred.org/dcrwallet/wallet/txrules"
 	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
 	"github.com/decred/dcrd/wire"
 )
 
@@ -38,6 +42,16 @@ func p2pkhOutputs(amounts ...dcrutil.Amount) []*wire.TxOut {
 	return v
 }
 
+// withOutpoints pairs each output with a distinct, non-null outpoint.
+func withOutpoints(outputs []*wire.TxOut) []Unspent {
+	v := make([]Unspent, 0, len(outputs))
+	for i, out := range outputs {
+		op := wire.OutPoint{Hash: chainhash.Hash{1}, Index: uint32(i)}
+		v = append(v, Unspent{OutPoint: op, TxOut: out})
+	}
+	return v
+}
+
 func makeInputSource(unspents []*wire.TxOut) InputSource {
 	// Return outputs in order.
 	currentTotal := dcrutil.Amount(0)
@@ -238,3 +252,1379 @@ func TestNewUnsignedTransaction(t *testing.T) {
 		}
 	}
 }
+
+type zeroDustPolicy struct{}
+
+func (zeroDustPolicy) DustAmount(int, dcrutil.Amount) dcrutil.Amount { return 0 }
+
+func TestNewUnsignedTransactionDustPolicy(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e3
+	fee := txrules.FeeForSerializeSize(relayFee, txsizes.EstimateSerializeSize(
+		[]int{txsizes.RedeemP2PKHSigScriptSize}, p2pkhOutputs(0), txsizes.P2PKHPkScriptSize))
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+
+	tests := []struct {
+		Policy       txrules.DustThresholdPolicy
+		ChangeAmount dcrutil.Amount
+	}{
+		// Default policy drops change below the 603 atom threshold.
+		0: {nil, 0},
+		1: {txrules.DefaultDustPolicy{}, 0},
+		// A policy without dust allows arbitrarily small change.
+		2: {zeroDustPolicy{}, 1},
+	}
+	for i, test := range tests {
+		outputs := p2pkhOutputs(1e8 - 1 - fee)
+		inputSource := makeInputSource(p2pkhOutputs(1e8))
+		tx, err := NewUnsignedTransaction(outputs, relayFee, inputSource,
+			AuthorTestChangeSource{}, maxTxSize, WithDustPolicy(test.Policy))
+		if err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i, err)
+			continue
+		}
+		var changeAmount dcrutil.Amount
+		if tx.ChangeIndex >= 0 {
+			changeAmount = dcrutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
+		}
+		if changeAmount != test.ChangeAmount {
+			t.Errorf("Test %d: got change amount %v, expected %v", i,
+				changeAmount, test.ChangeAmount)
+		}
+	}
+}
+
+func TestNewUnsignedTransactionDustOutput(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e3
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	// p2pkhOutputs creates outputs with scripts of P2PKHOutputSize bytes.
+	dust := txrules.DefaultDustPolicy{}.DustAmount(txsizes.P2PKHOutputSize, relayFee)
+
+	tests := []struct {
+		name    string
+		outputs []*wire.TxOut
+		policy  txrules.DustThresholdPolicy
+		index   int // -1 if no output is dust
+	}{
+		{"below threshold", p2pkhOutputs(1e6, dust-1, 1e6), nil, 1},
+		{"at threshold", p2pkhOutputs(1e6, dust, 1e6), nil, -1},
+		{"zero value", p2pkhOutputs(1e6, 1e6, 0), nil, 2},
+		{"policy without dust", p2pkhOutputs(1e6, dust-1, 1e6), zeroDustPolicy{}, -1},
+	}
+	for _, test := range tests {
+		_, err := NewUnsignedTransaction(test.outputs, relayFee,
+			makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{},
+			maxTxSize, WithDustPolicy(test.policy))
+		if test.index == -1 {
+			if err != nil {
+				t.Errorf("%s: %v", test.name, err)
+			}
+			continue
+		}
+		if !errors.Is(err, errors.DustOutput) {
+			t.Errorf("%s: expected DustOutput, got %v", test.name, err)
+			continue
+		}
+		var e *DustOutputError
+		if !errors.As(err, &e) {
+			t.Errorf("%s: error %v does not wrap *DustOutputError", test.name, err)
+			continue
+		}
+		if e.Index != test.index {
+			t.Errorf("%s: dust output index %d, expected %d", test.name,
+				e.Index, test.index)
+		}
+		if e.Value != dcrutil.Amount(test.outputs[test.index].Value) {
+			t.Errorf("%s: dust output value %v, expected %v", test.name,
+				e.Value, dcrutil.Amount(test.outputs[test.index].Value))
+		}
+	}
+}
+
+func TestNewUnsignedTransactionEconomicChange(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	const threshold dcrutil.Amount = 1e5
+	const output dcrutil.Amount = 1e8
+	fee := txrules.FeeForSerializeSize(relayFee, txsizes.EstimateSerializeSize(
+		[]int{txsizes.RedeemP2PKHSigScriptSize}, p2pkhOutputs(output), txsizes.P2PKHPkScriptSize))
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+
+	tests := []struct {
+		name   string
+		change dcrutil.Amount
+		folded bool
+	}{
+		{"below threshold", threshold - 1, true},
+		{"at threshold", threshold, false},
+		{"above threshold", threshold + 1, false},
+	}
+	for _, test := range tests {
+		input := output + fee + test.change
+		tx, err := NewUnsignedTransaction(p2pkhOutputs(output), relayFee,
+			makeInputSource(p2pkhOutputs(input)), AuthorTestChangeSource{},
+			maxTxSize, WithEconomicChange(threshold))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if test.folded {
+			if tx.ChangeIndex != -1 || len(tx.Tx.TxOut) != 1 {
+				t.Errorf("%s: change was not added to the fee", test.name)
+				continue
+			}
+		} else {
+			change, ok := tx.ChangeOutput()
+			if !ok {
+				t.Errorf("%s: no change output", test.name)
+				continue
+			}
+			if dcrutil.Amount(change.Value) != test.change {
+				t.Errorf("%s: change amount %v, expected %v", test.name,
+					dcrutil.Amount(change.Value), test.change)
+			}
+		}
+
+		// The recipient output is never reduced.
+		var paid, outputSum dcrutil.Amount
+		for i, out := range tx.Tx.TxOut {
+			outputSum += dcrutil.Amount(out.Value)
+			if i != tx.ChangeIndex {
+				paid += dcrutil.Amount(out.Value)
+			}
+		}
+		if paid != output {
+			t.Errorf("%s: paid %v, expected %v", test.name, paid, output)
+		}
+		wantFee := fee
+		if test.folded {
+			wantFee += test.change
+		}
+		if got := tx.TotalInput - outputSum; got != wantFee {
+			t.Errorf("%s: fee %v, expected %v", test.name, got, wantFee)
+		}
+	}
+
+	// Without the option, the same change is returned.
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(output), relayFee,
+		makeInputSource(p2pkhOutputs(output+fee+threshold-1)),
+		AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := tx.ChangeOutput(); !ok {
+		t.Errorf("no change output without economic change threshold")
+	}
+}
+
+func TestWithFeeSchedule(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	schedule := []txrules.FeeTier{
+		{MinSize: 0, FeePerKb: relayFee},
+		{MinSize: 200, FeePerKb: 10 * relayFee},
+	}
+
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(1e6), relayFee,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{},
+		maxTxSize, WithFeeSchedule(schedule))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.ChangeIndex < 0 {
+		t.Fatal("no change output")
+	}
+	size := tx.EstimatedSignedSerializeSize
+	if size <= schedule[1].MinSize {
+		t.Fatalf("estimated size %d does not span the tier boundary", size)
+	}
+	fee := tx.TotalInput - sumOutputs(tx.Tx.TxOut)
+	if want := txrules.FeeForSerializeSizeSchedule(schedule, size); fee != want {
+		t.Errorf("fee %v, expected %v", fee, want)
+	}
+	if flat := txrules.FeeForSerializeSize(relayFee, size); fee <= flat {
+		t.Errorf("fee %v does not exceed the flat relay fee %v", fee, flat)
+	}
+
+	// A nil schedule charges the relay fee.
+	tx, err = NewUnsignedTransaction(p2pkhOutputs(1e6), relayFee,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{},
+		maxTxSize, WithFeeSchedule(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee = tx.TotalInput - sumOutputs(tx.Tx.TxOut)
+	if want := txrules.FeeForSerializeSize(relayFee, tx.EstimatedSignedSerializeSize); fee != want {
+		t.Errorf("nil schedule: fee %v, expected %v", fee, want)
+	}
+}
+
+func TestNewUnsignedTransactionMaxInputs(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e3
+	outputs := p2pkhOutputs(2.5e8)
+	threeInputs := []int{txsizes.RedeemP2PKHSigScriptSize,
+		txsizes.RedeemP2PKHSigScriptSize, txsizes.RedeemP2PKHSigScriptSize}
+	size := txsizes.EstimateSerializeSize(threeInputs, outputs, txsizes.P2PKHPkScriptSize)
+
+	tests := []struct {
+		MaxInputs     int
+		MaxTxSize     int
+		TooManyInputs bool
+	}{
+		0: {MaxInputs: 0, MaxTxSize: size},
+		1: {MaxInputs: 3, MaxTxSize: size},
+		2: {MaxInputs: 2, MaxTxSize: size, TooManyInputs: true},
+		3: {MaxInputs: 0, MaxTxSize: size - 1, TooManyInputs: true},
+	}
+	for i, test := range tests {
+		inputSource := makeInputSource(p2pkhOutputs(1e8, 1e8, 1e8))
+		tx, err := NewUnsignedTransaction(outputs, relayFee, inputSource,
+			AuthorTestChangeSource{}, test.MaxTxSize, WithMaxInputs(test.MaxInputs))
+		if test.TooManyInputs {
+			if !errors.Is(err, errors.TooManyInputs) {
+				t.Errorf("Test %d: expected TooManyInputs, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i, err)
+			continue
+		}
+		if len(tx.Tx.TxIn) != 3 {
+			t.Errorf("Test %d: used %d inputs, expected 3", i, len(tx.Tx.TxIn))
+		}
+	}
+}
+
+func TestPrevOutpoints(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	unspents := p2pkhOutputs(1e8, 2e8, 3e8, 4e8)
+	var selected []wire.OutPoint
+	inputSource := func(target dcrutil.Amount) (*InputDetail, error) {
+		detail := &InputDetail{}
+		selected = selected[:0]
+		for i, u := range unspents {
+			if detail.Amount >= target {
+				break
+			}
+			op := wire.OutPoint{Hash: chainhash.Hash{byte(i + 1)}, Index: uint32(i)}
+			selected = append(selected, op)
+			detail.Amount += dcrutil.Amount(u.Value)
+			detail.Inputs = append(detail.Inputs, wire.NewTxIn(&op, u.Value, nil))
+			detail.Scripts = append(detail.Scripts, u.PkScript)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+				txsizes.RedeemP2PKHSigScriptSize)
+		}
+		return detail, nil
+	}
+
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(5e8), relayFee, inputSource,
+		AuthorTestChangeSource{}, chaincfg.MainNetParams().MaxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 3 || len(tx.PrevOutpoints) != len(selected) {
+		t.Fatalf("recorded %d outpoints for %d selected inputs, expected 3",
+			len(tx.PrevOutpoints), len(selected))
+	}
+	for i := range selected {
+		if tx.PrevOutpoints[i] != selected[i] {
+			t.Errorf("outpoint %d is %v, expected %v", i,
+				&tx.PrevOutpoints[i], &selected[i])
+		}
+		if tx.Tx.TxIn[i].PreviousOutPoint != tx.PrevOutpoints[i] {
+			t.Errorf("outpoint %d does not match the transaction input", i)
+		}
+	}
+}
+
+func TestPrevScriptsPreserved(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+
+	// The source provides the previous output scripts of each input, as
+	// known by a watching-only wallet, with each script identifying the
+	// outpoint it is paid to.
+	amounts := []dcrutil.Amount{3e7, 1e7, 2e7}
+	scriptsByOutpoint := make(map[wire.OutPoint][]byte)
+	detail := &InputDetail{}
+	for i, amount := range amounts {
+		prevOut := wire.OutPoint{Hash: chainhash.Hash{byte(len(amounts) - i)}}
+		script := make([]byte, txsizes.P2PKHPkScriptSize)
+		script[3] = byte(i + 1)
+		scriptsByOutpoint[prevOut] = script
+		detail.Amount += amount
+		detail.Inputs = append(detail.Inputs, wire.NewTxIn(&prevOut, int64(amount), nil))
+		detail.Scripts = append(detail.Scripts, script)
+		detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+			txsizes.RedeemP2PKHSigScriptSize)
+	}
+	source := func(dcrutil.Amount) (*InputDetail, error) { return detail, nil }
+
+	tests := []struct {
+		name   string
+		author func() (*AuthoredTx, error)
+	}{
+		{"relay fee", func() (*AuthoredTx, error) {
+			return NewUnsignedTransaction(p2pkhOutputs(5e7), relayFee, source,
+				AuthorTestChangeSource{}, maxTxSize)
+		}},
+		{"bip69", func() (*AuthoredTx, error) {
+			return NewUnsignedTransaction(p2pkhOutputs(5e7), relayFee, source,
+				AuthorTestChangeSource{}, maxTxSize, WithBIP69())
+		}},
+		{"absolute fee", func() (*AuthoredTx, error) {
+			return NewUnsignedTransactionAbsoluteFee("test", p2pkhOutputs(5e7),
+				1e5, source, AuthorTestChangeSource{})
+		}},
+	}
+	for _, test := range tests {
+		tx, err := test.author()
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if len(tx.PrevScripts) != len(tx.Tx.TxIn) {
+			t.Errorf("%s: %d previous scripts for %d inputs", test.name,
+				len(tx.PrevScripts), len(tx.Tx.TxIn))
+			continue
+		}
+		for i, in := range tx.Tx.TxIn {
+			want := scriptsByOutpoint[in.PreviousOutPoint]
+			if !bytes.Equal(tx.PrevScripts[i], want) {
+				t.Errorf("%s: input %d previous script %x, expected %x",
+					test.name, i, tx.PrevScripts[i], want)
+			}
+		}
+	}
+}
+
+func TestInputSequences(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	// Time-locked outputs are redeemed by a P2SH signature script which
+	// also pushes the redeem script containing the lock.
+	const timeLockedSigScriptSize = txsizes.RedeemP2PKHSigScriptSize + 1 + 35
+	unspents := p2pkhOutputs(1e8, 2e8, 3e8)
+	sequences := []uint32{
+		10,                            // relative lock of 10 blocks
+		wire.SequenceLockTimeDisabled, // no relative lock
+		wire.MaxTxInSequenceNum,       // final
+	}
+	redeemSizes := []int{
+		timeLockedSigScriptSize,
+		txsizes.RedeemP2PKHSigScriptSize,
+		timeLockedSigScriptSize,
+	}
+	inputSource := func(n int) InputSource {
+		return func(dcrutil.Amount) (*InputDetail, error) {
+			detail := &InputDetail{}
+			for i, u := range unspents[:n] {
+				op := wire.OutPoint{Hash: chainhash.Hash{byte(i + 1)}}
+				detail.Amount += dcrutil.Amount(u.Value)
+				detail.Inputs = append(detail.Inputs, wire.NewTxIn(&op, u.Value, nil))
+				detail.Scripts = append(detail.Scripts, u.PkScript)
+				detail.RedeemScriptSizes = append(detail.RedeemScriptSizes, redeemSizes[i])
+				detail.Sequences = append(detail.Sequences, sequences[i])
+			}
+			return detail, nil
+		}
+	}
+
+	outputs := p2pkhOutputs(5e8)
+	tx, err := NewUnsignedTransaction(outputs, relayFee, inputSource(3),
+		AuthorTestChangeSource{}, chaincfg.MainNetParams().MaxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, in := range tx.Tx.TxIn {
+		if in.PreviousOutPoint.Hash[0] != byte(i+1) {
+			t.Fatalf("input %d spends unexpected outpoint", i)
+		}
+		if in.Sequence != sequences[i] {
+			t.Errorf("input %d sequence %#x, expected %#x", i, in.Sequence,
+				sequences[i])
+		}
+	}
+	if tx.Tx.Version < 2 {
+		t.Errorf("transaction version %d does not enforce relative lock times",
+			tx.Tx.Version)
+	}
+	wantSize := txsizes.EstimateSerializeSize(redeemSizes, outputs,
+		txsizes.P2PKHPkScriptSize)
+	if tx.EstimatedSignedSerializeSize != wantSize {
+		t.Errorf("estimated size %d, expected %d including time-locked "+
+			"signature scripts", tx.EstimatedSignedSerializeSize, wantSize)
+	}
+
+	// Inputs without relative lock times do not require a newer transaction
+	// version.
+	tx, err = NewUnsignedTransaction(p2pkhOutputs(1e8), relayFee,
+		func(target dcrutil.Amount) (*InputDetail, error) {
+			detail, err := inputSource(2)(target)
+			if err != nil {
+				return nil, err
+			}
+			detail.Sequences[0] = wire.MaxTxInSequenceNum
+			return detail, nil
+		}, AuthorTestChangeSource{}, chaincfg.MainNetParams().MaxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Tx.Version != 1 {
+		t.Errorf("transaction version %d, expected 1", tx.Tx.Version)
+	}
+
+	_, err = NewUnsignedTransaction(outputs, relayFee,
+		func(target dcrutil.Amount) (*InputDetail, error) {
+			detail, err := inputSource(3)(target)
+			if err != nil {
+				return nil, err
+			}
+			detail.Sequences = detail.Sequences[:1]
+			return detail, nil
+		}, AuthorTestChangeSource{}, chaincfg.MainNetParams().MaxTxSize)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for mismatched sequences, got %v", err)
+	}
+}
+
+func TestWithReserve(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	const balance = 1e8
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	author := func(input dcrutil.Amount, opts ...Option) (*AuthoredTx, error) {
+		return NewUnsignedTransaction(p2pkhOutputs(5e7), relayFee,
+			makeInputSource(p2pkhOutputs(input)), AuthorTestChangeSource{},
+			maxTxSize, opts...)
+	}
+
+	tests := []struct {
+		name  string
+		input dcrutil.Amount
+	}{
+		{"change", balance},
+		{"changeless", 5e7 + 3e3},
+	}
+	for _, test := range tests {
+		tx, err := author(test.input)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		spent := tx.TotalInput
+		if tx.ChangeIndex >= 0 {
+			spent -= dcrutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
+		}
+
+		// Spending down to exactly the reserve is allowed.
+		reserve := balance - spent
+		_, err = author(test.input, WithReserve(balance, reserve))
+		if err != nil {
+			t.Errorf("%s: spending to reserve boundary: %v", test.name, err)
+		}
+
+		// Spending a single atom of the reserve is not.
+		_, err = author(test.input, WithReserve(balance, reserve+1))
+		if !errors.Is(err, errors.InsufficientBalance) {
+			t.Errorf("%s: spending into reserve: expected InsufficientBalance, got %v",
+				test.name, err)
+			continue
+		}
+		var e *InsufficientBalanceError
+		if !errors.As(err, &e) || e.Have != spent-1 || e.Need != spent {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+	}
+
+	// A send which fits within the balance is rejected when it would spend
+	// into the reserve.
+	_, err := author(balance, WithReserve(balance, 6e7))
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("expected InsufficientBalance, got %v", err)
+	}
+	// A zero reserve has no effect.
+	_, err = author(balance, WithReserve(0, 0))
+	if err != nil {
+		t.Errorf("zero reserve: %v", err)
+	}
+}
+
+func TestWithMaxFee(t *testing.T) {
+	// A small output paying an absurd fee rate.
+	const relayFee dcrutil.Amount = 1e6
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	author := func(opts ...Option) (*AuthoredTx, error) {
+		return NewUnsignedTransaction(p2pkhOutputs(1e6), relayFee,
+			makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{},
+			maxTxSize, opts...)
+	}
+
+	tx, err := author()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee := tx.TotalInput - 1e6 - dcrutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
+
+	// A fee exactly at the limit is allowed.
+	_, err = author(WithMaxFee(fee))
+	if err != nil {
+		t.Errorf("fee at limit: %v", err)
+	}
+	// A fee a single atom over the limit is not.
+	_, err = author(WithMaxFee(fee - 1))
+	if !errors.Is(err, errors.FeeExceedsLimit) {
+		t.Errorf("fee over limit: expected FeeExceedsLimit, got %v", err)
+	}
+	// A zero limit has no effect.
+	_, err = author(WithMaxFee(0))
+	if err != nil {
+		t.Errorf("zero limit: %v", err)
+	}
+}
+
+func TestWithLockTime(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	const lockTime = 500000
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	outputs := func() []*wire.TxOut { return p2pkhOutputs(1e6, 2e6, 3e6) }
+
+	// author creates a transaction spending two inputs with the provided
+	// sequences and a change output at a random position chosen with a
+	// fixed seed.
+	author := func(sequences []uint32, opts ...Option) *AuthoredTx {
+		source := func(dcrutil.Amount) (*InputDetail, error) {
+			detail := &InputDetail{Sequences: sequences}
+			for i := 0; i < 2; i++ {
+				op := wire.OutPoint{Hash: chainhash.Hash{byte(i + 1)}}
+				detail.Amount += 1e8
+				detail.Inputs = append(detail.Inputs, wire.NewTxIn(&op, 1e8, nil))
+				detail.Scripts = append(detail.Scripts, make([]byte, txsizes.P2PKHPkScriptSize))
+				detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+					txsizes.RedeemP2PKHSigScriptSize)
+			}
+			return detail, nil
+		}
+		opts = append(opts, WithRandomChangePosition(mrand.New(mrand.NewSource(1))))
+		tx, err := NewUnsignedTransaction(outputs(), relayFee, source,
+			AuthorTestChangeSource{}, maxTxSize, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx
+	}
+
+	unlocked := author(nil)
+	if unlocked.Tx.LockTime != 0 {
+		t.Errorf("lock time %d without option", unlocked.Tx.LockTime)
+	}
+	for i, in := range unlocked.Tx.TxIn {
+		if in.Sequence != wire.MaxTxInSequenceNum {
+			t.Errorf("unlocked input %d sequence %#x is not final", i, in.Sequence)
+		}
+	}
+
+	// A non-final sequence is applied to the first input when every input
+	// is final.
+	locked := author(nil, WithLockTime(lockTime))
+	if locked.Tx.LockTime != lockTime {
+		t.Errorf("lock time %d, expected %d", locked.Tx.LockTime, lockTime)
+	}
+	wantSequences := []uint32{wire.MaxTxInSequenceNum - 1, wire.MaxTxInSequenceNum}
+	for i, in := range locked.Tx.TxIn {
+		if in.Sequence != wantSequences[i] {
+			t.Errorf("locked input %d sequence %#x, expected %#x", i,
+				in.Sequence, wantSequences[i])
+		}
+	}
+	if locked.Tx.Version != unlocked.Tx.Version {
+		t.Errorf("lock time changed transaction version from %d to %d",
+			unlocked.Tx.Version, locked.Tx.Version)
+	}
+
+	// The lock time does not affect the outputs or change position.
+	if locked.ChangeIndex != unlocked.ChangeIndex {
+		t.Errorf("change index %d with lock time, %d without",
+			locked.ChangeIndex, unlocked.ChangeIndex)
+	}
+	for i := range locked.Tx.TxOut {
+		a, b := locked.Tx.TxOut[i], unlocked.Tx.TxOut[i]
+		if a.Value != b.Value || !bytes.Equal(a.PkScript, b.PkScript) {
+			t.Errorf("output %d differs with lock time", i)
+		}
+	}
+
+	// Inputs which are already non-final are not modified.
+	sequences := []uint32{wire.MaxTxInSequenceNum, wire.SequenceLockTimeDisabled | 1}
+	locked = author(sequences, WithLockTime(lockTime))
+	for i, in := range locked.Tx.TxIn {
+		if in.Sequence != sequences[i] {
+			t.Errorf("non-final input %d sequence %#x, expected %#x", i,
+				in.Sequence, sequences[i])
+		}
+	}
+}
+
+func TestChangeOutput(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+
+	// An input which exactly pays for the output and fee creates no change.
+	outputs := p2pkhOutputs(1e6)
+	size := txsizes.EstimateSerializeSize(
+		[]int{txsizes.RedeemP2PKHSigScriptSize}, outputs, 0)
+	input := 1e6 + txrules.FeeForSerializeSize(relayFee, size)
+	tx, err := NewUnsignedTransaction(outputs, relayFee,
+		makeInputSource(p2pkhOutputs(input)), AuthorTestChangeSource{},
+		maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change, ok := tx.ChangeOutput(); ok || change != nil || tx.ChangeIndex != -1 {
+		t.Errorf("no change: ChangeOutput returned %v, %v with change index %d",
+			change, ok, tx.ChangeIndex)
+	}
+
+	tx, err = NewUnsignedTransaction(p2pkhOutputs(1e6, 2e6), relayFee,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{},
+		maxTxSize, WithRandomChangePosition(mrand.New(mrand.NewSource(0))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	change, ok := tx.ChangeOutput()
+	if !ok || change == nil || change != tx.Tx.TxOut[tx.ChangeIndex] {
+		t.Fatalf("change: ChangeOutput returned %v, %v with change index %d",
+			change, ok, tx.ChangeIndex)
+	}
+	if len(change.PkScript) != txsizes.P2PKHPkScriptSize {
+		t.Errorf("change output script size %d", len(change.PkScript))
+	}
+}
+
+func TestRandomChangePosition(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e3
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	rand := mrand.New(mrand.NewSource(0))
+	positions := make(map[int]int)
+	for i := 0; i < 100; i++ {
+		outputs := p2pkhOutputs(1e6, 2e6, 3e6)
+		inputSource := makeInputSource(p2pkhOutputs(1e8))
+		tx, err := NewUnsignedTransaction(outputs, relayFee, inputSource,
+			AuthorTestChangeSource{}, maxTxSize, WithRandomChangePosition(rand))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tx.ChangeIndex < 0 || tx.ChangeIndex >= len(tx.Tx.TxOut) {
+			t.Fatalf("Iteration %d: invalid change index %d", i, tx.ChangeIndex)
+		}
+		positions[tx.ChangeIndex]++
+
+		// The change output is the only output with a script from the
+		// change source and the payments must keep their order.
+		var payments []int64
+		for j, out := range tx.Tx.TxOut {
+			isChange := len(out.PkScript) == txsizes.P2PKHPkScriptSize
+			if isChange != (j == tx.ChangeIndex) {
+				t.Fatalf("Iteration %d: change index %d does not "+
+					"reference the change output", i, tx.ChangeIndex)
+			}
+			if !isChange {
+				payments = append(payments, out.Value)
+			}
+		}
+		if len(payments) != 3 || payments[0] != 1e6 || payments[1] != 2e6 ||
+			payments[2] != 3e6 {
+			t.Fatalf("Iteration %d: payment outputs reordered: %v", i, payments)
+		}
+	}
+	if len(positions) != 4 {
+		t.Errorf("change was placed at %d distinct positions, expected 4",
+			len(positions))
+	}
+}
+
+// TestRandomChangePositionSeeds checks that the change position is determined
+// by the random source and varies across seeds.
+func TestRandomChangePositionSeeds(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e3
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	author := func(seed int64) *AuthoredTx {
+		outputs := p2pkhOutputs(1e6, 2e6, 3e6, 4e6)
+		inputSource := makeInputSource(p2pkhOutputs(1e8))
+		rand := mrand.New(mrand.NewSource(seed))
+		tx, err := NewUnsignedTransaction(outputs, relayFee, inputSource,
+			AuthorTestChangeSource{}, maxTxSize, WithRandomChangePosition(rand))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx
+	}
+	positions := make(map[int]bool)
+	for seed := int64(0); seed < 50; seed++ {
+		tx := author(seed)
+		if again := author(seed); again.ChangeIndex != tx.ChangeIndex {
+			t.Fatalf("Seed %d: change index %d, then %d", seed,
+				tx.ChangeIndex, again.ChangeIndex)
+		}
+		positions[tx.ChangeIndex] = true
+		for i, out := range tx.Tx.TxOut {
+			isChange := len(out.PkScript) == txsizes.P2PKHPkScriptSize
+			if isChange != (i == tx.ChangeIndex) {
+				t.Fatalf("Seed %d: change index %d does not reference "+
+					"the change output", seed, tx.ChangeIndex)
+			}
+		}
+	}
+	if len(positions) != 5 {
+		t.Errorf("change was placed at %d distinct positions across seeds, "+
+			"expected 5", len(positions))
+	}
+}
+
+func TestNewUnsignedTransactionWithSplitChange(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	inputSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+	dust := txrules.DefaultDustPolicy{}.DustAmount(txsizes.P2PKHPkScriptSize, relayFee)
+
+	// feeWithChange returns the fee of a transaction paying 1e7 with n
+	// change outputs.
+	feeWithChange := func(n int) dcrutil.Amount {
+		outputs := p2pkhOutputs(1e7)
+		for i := 1; i < n; i++ {
+			outputs = append(outputs, wire.NewTxOut(0, make([]byte, txsizes.P2PKHPkScriptSize)))
+		}
+		size := txsizes.EstimateSerializeSize(inputSizes, outputs, txsizes.P2PKHPkScriptSize)
+		return txrules.FeeForSerializeSize(relayFee, size)
+	}
+
+	tests := []struct {
+		Input       dcrutil.Amount
+		ChangeCount int
+		Outputs     int
+	}{
+		0: {1e8, 1, 1},
+		1: {1e8, 2, 2},
+		2: {1e8, 3, 3},
+		// Three change outputs would be dust, so change is split
+		// across two.
+		3: {1e7 + feeWithChange(3) + 3*dust - 1, 3, 2},
+	}
+	for i, test := range tests {
+		outputs := p2pkhOutputs(1e7)
+		inputSource := makeInputSource(p2pkhOutputs(test.Input))
+		tx, err := NewUnsignedTransactionWithSplitChange(outputs, relayFee,
+			inputSource, AuthorTestChangeSource{}, test.ChangeCount, maxTxSize)
+		if err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i, err)
+			continue
+		}
+		changeOutputs := len(tx.Tx.TxOut) - len(outputs)
+		if changeOutputs != test.Outputs {
+			t.Errorf("Test %d: created %d change outputs, expected %d", i,
+				changeOutputs, test.Outputs)
+			continue
+		}
+		var totalOutput dcrutil.Amount
+		for j, out := range tx.Tx.TxOut {
+			totalOutput += dcrutil.Amount(out.Value)
+			isChange := j >= tx.ChangeIndex && j < tx.ChangeIndex+changeOutputs
+			if isChange && txrules.IsDustOutput(out, relayFee) {
+				t.Errorf("Test %d: change output %d is dust", i, j)
+			}
+		}
+		fee := tx.TotalInput - totalOutput
+		if fee != feeWithChange(changeOutputs) {
+			t.Errorf("Test %d: paid fee %v, expected %v", i, fee,
+				feeWithChange(changeOutputs))
+		}
+		if tx.TotalInput != fee+totalOutput || tx.TotalInput != test.Input {
+			t.Errorf("Test %d: outputs and fee do not sum to the total input", i)
+		}
+	}
+}
+
+// countingChangeSource returns a distinct change script from each call to
+// Script.
+type countingChangeSource struct {
+	calls int
+}
+
+func (src *countingChangeSource) Script() ([]byte, uint16, error) {
+	src.calls++
+	script := make([]byte, txsizes.P2PKHPkScriptSize)
+	script[len(script)-1] = byte(src.calls)
+	return script, 0, nil
+}
+
+func (src *countingChangeSource) ScriptSize() int {
+	return txsizes.P2PKHPkScriptSize
+}
+
+func TestWithSplitChange(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	inputSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+	dust := txrules.DefaultDustPolicy{}.DustAmount(txsizes.P2PKHPkScriptSize, relayFee)
+
+	// sizeWithChange returns the estimated size of a transaction paying
+	// 1e7 with n change outputs.
+	sizeWithChange := func(n int) int {
+		outputs := p2pkhOutputs(1e7)
+		for i := 0; i < n; i++ {
+			outputs = append(outputs, wire.NewTxOut(0, make([]byte, txsizes.P2PKHPkScriptSize)))
+		}
+		return txsizes.EstimateSerializeSize(inputSizes, outputs, 0)
+	}
+	feeWithChange := func(n int) dcrutil.Amount {
+		return txrules.FeeForSerializeSize(relayFee, sizeWithChange(n))
+	}
+
+	tests := []struct {
+		name        string
+		input       dcrutil.Amount
+		splitChange int
+		outputs     int
+	}{
+		{"one change output", 1e8, 1, 1},
+		{"two change outputs", 1e8, 2, 2},
+		{"split would create dust", 1e7 + feeWithChange(2) + 2*dust - 1, 2, 1},
+	}
+	for _, test := range tests {
+		outputs := p2pkhOutputs(1e7)
+		changeSource := new(countingChangeSource)
+		tx, err := NewUnsignedTransaction(outputs, relayFee,
+			makeInputSource(p2pkhOutputs(test.input)), changeSource,
+			maxTxSize, WithSplitChange(test.splitChange))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		changeOutputs := len(tx.Tx.TxOut) - len(outputs)
+		if changeOutputs != test.outputs {
+			t.Errorf("%s: created %d change outputs, expected %d",
+				test.name, changeOutputs, test.outputs)
+			continue
+		}
+		if changeSource.calls != changeOutputs {
+			t.Errorf("%s: fetched %d change scripts for %d outputs",
+				test.name, changeSource.calls, changeOutputs)
+		}
+		var change dcrutil.Amount
+		scripts := make(map[string]struct{})
+		for _, out := range tx.Tx.TxOut[tx.ChangeIndex : tx.ChangeIndex+changeOutputs] {
+			change += dcrutil.Amount(out.Value)
+			scripts[string(out.PkScript)] = struct{}{}
+			if txrules.IsDustOutput(out, relayFee) {
+				t.Errorf("%s: change output is dust", test.name)
+			}
+		}
+		if len(scripts) != changeOutputs {
+			t.Errorf("%s: change outputs reuse scripts", test.name)
+		}
+		if tx.EstimatedSignedSerializeSize != sizeWithChange(changeOutputs) {
+			t.Errorf("%s: estimated size %d, expected %d", test.name,
+				tx.EstimatedSignedSerializeSize, sizeWithChange(changeOutputs))
+		}
+		fee := feeWithChange(changeOutputs)
+		if change+fee+1e7 != tx.TotalInput {
+			t.Errorf("%s: change %v + fee %v + target %v != total input %v",
+				test.name, change, fee, dcrutil.Amount(1e7), tx.TotalInput)
+		}
+	}
+}
+
+func TestNewUnsignedTransactionWithData(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	outputs := p2pkhOutputs(1e6)
+	data := make([]byte, 80)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	dataScript, err := txscript.GenerateProvablyPruneableOut(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := NewUnsignedTransactionWithData(outputs, data, relayFee,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxOut) != 3 {
+		t.Fatalf("created %d outputs, expected 3", len(tx.Tx.TxOut))
+	}
+	dataIndex := -1
+	for i, out := range tx.Tx.TxOut {
+		if bytes.Equal(out.PkScript, dataScript) {
+			dataIndex = i
+			if out.Value != 0 {
+				t.Errorf("data output has value %v", out.Value)
+			}
+		}
+	}
+	if dataIndex == -1 {
+		t.Fatalf("no data output")
+	}
+	if tx.ChangeIndex < 0 || tx.ChangeIndex == dataIndex {
+		t.Fatalf("change index %d, data output index %d", tx.ChangeIndex, dataIndex)
+	}
+
+	// The fee must account for the data output.
+	withData := append(p2pkhOutputs(1e6), wire.NewTxOut(0, dataScript))
+	size := txsizes.EstimateSerializeSize([]int{txsizes.RedeemP2PKHSigScriptSize},
+		withData, txsizes.P2PKHPkScriptSize)
+	wantChange := 1e8 - 1e6 - txrules.FeeForSerializeSize(relayFee, size)
+	if got := dcrutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value); got != wantChange {
+		t.Errorf("change amount %v, expected %v", got, wantChange)
+	}
+	if tx.EstimatedSignedSerializeSize != size {
+		t.Errorf("estimated size %d, expected %d", tx.EstimatedSignedSerializeSize, size)
+	}
+
+	// Payloads exceeding the standard null data limit are rejected.
+	_, err = NewUnsignedTransactionWithData(outputs, make([]byte, txscript.MaxDataCarrierSize+1),
+		relayFee, makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for over-limit data, got %v", err)
+	}
+}
+
+func TestNewUnsignedTransactionAbsoluteFee(t *testing.T) {
+	const op errors.Op = "test"
+	tests := []struct {
+		UnspentOutputs      []*wire.TxOut
+		Outputs             []*wire.TxOut
+		Fee                 dcrutil.Amount
+		InputCount          int
+		Change              bool
+		InsufficientBalance bool
+	}{
+		0: {
+			UnspentOutputs: p2pkhOutputs(1e8),
+			Outputs:        p2pkhOutputs(1e6),
+			Fee:            5e4,
+			InputCount:     1,
+			Change:         true,
+		},
+		// The fee does not depend on the transaction size.
+		1: {
+			UnspentOutputs: p2pkhOutputs(1e8, 1e8, 1e8),
+			Outputs:        p2pkhOutputs(2.5e8),
+			Fee:            1e3,
+			InputCount:     3,
+			Change:         true,
+		},
+		2: {
+			UnspentOutputs: p2pkhOutputs(1e6 + 1e4),
+			Outputs:        p2pkhOutputs(1e6),
+			Fee:            1e4,
+			InputCount:     1,
+		},
+		// An additional input is selected to avoid dust change.
+		3: {
+			UnspentOutputs: p2pkhOutputs(1e6+1e4+100, 1e8),
+			Outputs:        p2pkhOutputs(1e6),
+			Fee:            1e4,
+			InputCount:     2,
+			Change:         true,
+		},
+		4: {
+			UnspentOutputs:      p2pkhOutputs(1e6 + 1e4 + 100),
+			Outputs:             p2pkhOutputs(1e6),
+			Fee:                 1e4,
+			InsufficientBalance: true,
+		},
+		5: {
+			UnspentOutputs:      p2pkhOutputs(1e6),
+			Outputs:             p2pkhOutputs(1e6),
+			Fee:                 1,
+			InsufficientBalance: true,
+		},
+	}
+	for i, test := range tests {
+		tx, err := NewUnsignedTransactionAbsoluteFee(op, test.Outputs, test.Fee,
+			makeInputSource(test.UnspentOutputs), AuthorTestChangeSource{})
+		if test.InsufficientBalance {
+			if !errors.Is(err, errors.InsufficientBalance) {
+				t.Errorf("Test %d: expected InsufficientBalance, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i, err)
+			continue
+		}
+		var totalOutput dcrutil.Amount
+		for _, out := range tx.Tx.TxOut {
+			totalOutput += dcrutil.Amount(out.Value)
+		}
+		if fee := tx.TotalInput - totalOutput; fee != test.Fee {
+			t.Errorf("Test %d: fee %v, expected %v", i, fee, test.Fee)
+		}
+		if len(tx.Tx.TxIn) != test.InputCount {
+			t.Errorf("Test %d: used %d inputs, expected %d", i,
+				len(tx.Tx.TxIn), test.InputCount)
+		}
+		if (tx.ChangeIndex >= 0) != test.Change {
+			t.Errorf("Test %d: change index %d, expected change %v", i,
+				tx.ChangeIndex, test.Change)
+		}
+	}
+
+	_, err := NewUnsignedTransactionAbsoluteFee(op, p2pkhOutputs(100), 1e4,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{})
+	if !errors.Is(err, errors.DustOutput) {
+		t.Errorf("dust output: expected DustOutput, got %v", err)
+	}
+	_, err = NewUnsignedTransactionAbsoluteFee(op, p2pkhOutputs(1e6), 1e4,
+		makeInputSource(p2pkhOutputs(1e8)), inconsistentChangeSource{})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("inconsistent change source: expected Invalid, got %v", err)
+	}
+
+	// Options configure the dust policy and the maximum input count.
+	_, err = NewUnsignedTransactionAbsoluteFee(op, p2pkhOutputs(100), 1e4,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{},
+		WithDustPolicy(txrules.DustMultiplierPolicy(0)))
+	if err != nil {
+		t.Errorf("dust output with zero multiplier policy: %v", err)
+	}
+	_, err = NewUnsignedTransactionAbsoluteFee(op, p2pkhOutputs(1.5e6), 1e4,
+		makeInputSource(p2pkhOutputs(1e6, 1e6)), AuthorTestChangeSource{},
+		WithMaxInputs(1))
+	if !errors.Is(err, errors.TooManyInputs) {
+		t.Errorf("max inputs: expected TooManyInputs, got %v", err)
+	}
+}
+
+func TestInsufficientBalanceError(t *testing.T) {
+	const relayFee = 1e4
+	unspents := p2pkhOutputs(1e6, 5e5)
+	outputs := p2pkhOutputs(2e6)
+	_, err := NewUnsignedTransaction(outputs, relayFee, makeInputSource(unspents),
+		AuthorTestChangeSource{}, chaincfg.MainNetParams().MaxTxSize)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Fatalf("expected InsufficientBalance, got %v", err)
+	}
+	var ibe *InsufficientBalanceError
+	if !errors.As(err, &ibe) {
+		t.Fatalf("error %v does not wrap *InsufficientBalanceError", err)
+	}
+	// The fee required is that of a transaction spending every input
+	// without a change output.
+	size := txsizes.EstimateSerializeSize([]int{
+		txsizes.RedeemP2PKHSigScriptSize, txsizes.RedeemP2PKHSigScriptSize,
+	}, outputs, 0)
+	need := 2e6 + txrules.FeeForSerializeSize(relayFee, size)
+	if ibe.Have != 1.5e6 || ibe.Need != need {
+		t.Errorf("have %v need %v, expected have %v need %v", ibe.Have,
+			ibe.Need, dcrutil.Amount(1.5e6), need)
+	}
+
+	_, err = NewUnsignedTransactionAbsoluteFee("test", p2pkhOutputs(1e6), 1,
+		makeInputSource(p2pkhOutputs(1e6)), AuthorTestChangeSource{})
+	ibe = nil
+	if !errors.Is(err, errors.InsufficientBalance) || !errors.As(err, &ibe) {
+		t.Fatalf("expected *InsufficientBalanceError, got %v", err)
+	}
+	if ibe.Have != 1e6 || ibe.Need != 1e6+1 {
+		t.Errorf("have %v need %v, expected have %v need %v", ibe.Have,
+			ibe.Need, dcrutil.Amount(1e6), dcrutil.Amount(1e6+1))
+	}
+}
+
+func TestEffectiveFeeRate(t *testing.T) {
+	redeemScriptSizes := []int{
+		txsizes.RedeemP2PKHSigScriptSize,
+		txsizes.RedeemP2SHMultisigSigScriptSize(2, 3),
+	}
+	mixedInputSource := func(amounts ...dcrutil.Amount) InputSource {
+		return func(target dcrutil.Amount) (*InputDetail, error) {
+			detail := &InputDetail{}
+			for i, a := range amounts {
+				if detail.Amount >= target {
+					break
+				}
+				detail.Amount += a
+				detail.Inputs = append(detail.Inputs,
+					wire.NewTxIn(&wire.OutPoint{Index: uint32(i)}, int64(a), nil))
+				detail.Scripts = append(detail.Scripts, nil)
+				detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+					redeemScriptSizes[i%len(redeemScriptSizes)])
+			}
+			return detail, nil
+		}
+	}
+	// changelessInput returns the input amount which exactly pays for the
+	// outputs and the fee of a transaction without change.
+	changelessInput := func(relayFee dcrutil.Amount, outputs []*wire.TxOut) dcrutil.Amount {
+		size := txsizes.EstimateSerializeSize(redeemScriptSizes[:1], outputs, 0)
+		return 1e6 + txrules.FeeForSerializeSize(relayFee, size)
+	}
+
+	for _, relayFee := range []dcrutil.Amount{1e3, 1e4, 1e5} {
+		tests := []struct {
+			name   string
+			inputs []dcrutil.Amount
+			opts   []Option
+			change bool
+		}{
+			{"change", []dcrutil.Amount{4e5, 4e5, 4e5, 4e5}, nil, true},
+			{"change, ceil fee", []dcrutil.Amount{4e5, 4e5, 4e5, 4e5},
+				[]Option{WithCeilFee()}, true},
+			{"no change", []dcrutil.Amount{changelessInput(relayFee,
+				p2pkhOutputs(1e6))}, nil, false},
+		}
+		for _, test := range tests {
+			tx, err := NewUnsignedTransaction(p2pkhOutputs(1e6), relayFee,
+				mixedInputSource(test.inputs...), AuthorTestChangeSource{},
+				chaincfg.MainNetParams().MaxTxSize, test.opts...)
+			if err != nil {
+				t.Errorf("%v %s: %v", relayFee, test.name, err)
+				continue
+			}
+			if hasChange := tx.ChangeIndex >= 0; hasChange != test.change {
+				t.Errorf("%v %s: change %v, expected %v", relayFee,
+					test.name, hasChange, test.change)
+			}
+			scriptSizes := make([]int, len(tx.Tx.TxIn))
+			for i := range scriptSizes {
+				scriptSizes[i] = redeemScriptSizes[i%len(redeemScriptSizes)]
+			}
+			size := txsizes.EstimateSerializeSize(scriptSizes, tx.Tx.TxOut, 0)
+			if tx.EstimatedSignedSerializeSize != size {
+				t.Errorf("%v %s: estimated size %d, expected %d", relayFee,
+					test.name, tx.EstimatedSignedSerializeSize, size)
+			}
+			// Fees are rounded to whole atoms, so the effective rate
+			// must be within one atom per byte of the relay fee.
+			rate := tx.EffectiveFeeRate()
+			if rate < relayFee-1e3 || rate > relayFee+1e3 {
+				t.Errorf("%v %s: effective fee rate %v", relayFee,
+					test.name, rate)
+			}
+		}
+	}
+
+	if rate := (&AuthoredTx{}).EffectiveFeeRate(); rate != 0 {
+		t.Errorf("effective fee rate of unsized tx is %v, expected 0", rate)
+	}
+}
+
+func TestFeeRate(t *testing.T) {
+	const maxTxSize = 100000
+	tests := []struct {
+		name    string
+		utxos   []*wire.TxOut
+		outputs []*wire.TxOut
+	}{
+		{"one input", p2pkhOutputs(1e8), p2pkhOutputs(1e6)},
+		{"many inputs", p2pkhOutputs(3e5, 3e5, 3e5, 3e5, 3e5), p2pkhOutputs(1e6)},
+		{"p2sh inputs", p2shOutputs(4e5, 4e5, 4e5), p2pkhOutputs(1e6)},
+		{"many outputs", p2pkhOutputs(1e8),
+			p2pkhOutputs(1e6, 2e6, 3e6, 4e6, 5e6, 6e6, 7e6)},
+		{"mixed", append(p2shOutputs(2e6), p2pkhOutputs(2e6, 2e6)...),
+			append(p2pkhOutputs(1e6, 1e6), p2shOutputs(1e6, 1e6)...)},
+	}
+	for _, relayFee := range []dcrutil.Amount{1e3, 1e4, 1e5} {
+		for _, test := range tests {
+			tx, err := NewUnsignedTransaction(test.outputs, relayFee,
+				NewSeededRandomInputSource(withOutpoints(test.utxos), 1),
+				AuthorTestChangeSource{}, maxTxSize)
+			if err != nil {
+				t.Errorf("%v %s: %v", relayFee, test.name, err)
+				continue
+			}
+			if tx.ChangeIndex < 0 {
+				t.Errorf("%v %s: no change output", relayFee, test.name)
+				continue
+			}
+
+			// With change, the fee is exactly the relay fee for the
+			// estimated size.
+			fee := tx.Fee()
+			if fee != tx.TotalInput-sumOutputs(tx.Tx.TxOut) {
+				t.Errorf("%v %s: fee %v does not equal input %v less outputs",
+					relayFee, test.name, fee, tx.TotalInput)
+			}
+			size := tx.EstimatedSignedSerializeSize
+			if want := txrules.FeeForSerializeSize(relayFee, size); fee != want {
+				t.Errorf("%v %s: fee %v, expected %v", relayFee, test.name,
+					fee, want)
+			}
+
+			// The rate is rounded down, and may be less than the relay
+			// fee only by the truncation of the fee to whole atoms.
+			rate := tx.FeeRate()
+			if rate > relayFee || rate < relayFee-1000/dcrutil.Amount(size)-1 {
+				t.Errorf("%v %s: fee rate %v", relayFee, test.name, rate)
+			}
+			if f := txrules.FeeForSerializeSize(rate, size); f > fee {
+				t.Errorf("%v %s: fee %v at rate %v exceeds fee %v",
+					relayFee, test.name, f, rate, fee)
+			}
+		}
+	}
+
+	if rate := (&AuthoredTx{}).FeeRate(); rate != 0 {
+		t.Errorf("fee rate of unsized tx is %v, expected 0", rate)
+	}
+}
+
+// inconsistentChangeSource returns P2SH sized change scripts while reporting
+// the size of a P2PKH script.
+type inconsistentChangeSource struct{}
+
+func (inconsistentChangeSource) Script() ([]byte, uint16, error) {
+	return make([]byte, txsizes.P2SHPkScriptSize), 0, nil
+}
+
+func (inconsistentChangeSource) ScriptSize() int {
+	return txsizes.P2PKHPkScriptSize
+}
+
+func TestChangeScriptSizeMismatch(t *testing.T) {
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(1e6), 1e4,
+		makeInputSource(p2pkhOutputs(1e8)), inconsistentChangeSource{},
+		chaincfg.MainNetParams().MaxTxSize)
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("expected Invalid, got %v", err)
+	}
+	if tx != nil {
+		t.Errorf("transaction was authored with an inconsistent change source")
+	}
+}
+
+func TestChangeScriptFetchedLazily(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	outputs := func() []*wire.TxOut { return p2pkhOutputs(1e7) }
+	changelessFee := txrules.FeeForSerializeSize(relayFee,
+		txsizes.EstimateSerializeSize([]int{txsizes.RedeemP2PKHSigScriptSize},
+			outputs(), 0))
+
+	tests := []struct {
+		name    string
+		input   dcrutil.Amount
+		opts    []Option
+		scripts int
+	}{
+		{"changeless", 1e7 + changelessFee, nil, 0},
+		{"dust change", 1e7 + changelessFee + 100, nil, 0},
+		{"uneconomic change", 1e7 + 1e6, []Option{WithEconomicChange(2e6)}, 0},
+		{"change", 1e8, nil, 1},
+		{"split change", 1e8, []Option{WithSplitChange(3)}, 3},
+	}
+	for _, test := range tests {
+		changeSource := new(countingChangeSource)
+		tx, err := NewUnsignedTransaction(outputs(), relayFee,
+			makeInputSource(p2pkhOutputs(test.input)), changeSource,
+			maxTxSize, test.opts...)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if changeSource.calls != test.scripts {
+			t.Errorf("%s: fetched %d change scripts, expected %d",
+				test.name, changeSource.calls, test.scripts)
+		}
+		if changes := len(tx.Tx.TxOut) - 1; changes != changeSource.calls {
+			t.Errorf("%s: created %d change outputs from %d scripts",
+				test.name, changes, changeSource.calls)
+		}
+	}
+}
+
+func TestEstimateFee(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	const maxTxSize = 100000
+	outputs := p2pkhOutputs(1e7)
+	inputSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+	changelessFee := txrules.FeeForSerializeSize(relayFee,
+		txsizes.EstimateSerializeSize(inputSizes, outputs, 0))
+
+	tests := []struct {
+		name         string
+		unspents     []*wire.TxOut
+		insufficient bool
+	}{
+		{"change", p2pkhOutputs(1e8), false},
+		{"many inputs with change", p2pkhOutputs(3e6, 3e6, 3e6, 3e6, 3e6), false},
+		{"exact changeless", p2pkhOutputs(1e7 + changelessFee), false},
+		{"dust change added to fee", p2pkhOutputs(1e7 + changelessFee + 100), false},
+		{"insufficient", p2pkhOutputs(5e6, 5e6), true},
+	}
+	for _, test := range tests {
+		src := new(countingChangeSource)
+		fee, err := EstimateFee("test", outputs, relayFee,
+			makeInputSource(test.unspents), src)
+		if test.insufficient {
+			if !errors.Is(err, errors.InsufficientBalance) {
+				t.Errorf("%s: expected InsufficientBalance, got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if src.calls != 0 {
+			t.Errorf("%s: change script created %d times", test.name, src.calls)
+		}
+
+		tx, err := NewUnsignedTransaction(outputs, relayFee,
+			makeInputSource(test.unspents), AuthorTestChangeSource{}, maxTxSize)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		want := tx.TotalInput
+		for _, out := range tx.Tx.TxOut {
+			want -= dcrutil.Amount(out.Value)
+		}
+		if fee != want {
+			t.Errorf("%s: estimated fee %v, authored transaction pays %v",
+				test.name, fee, want)
+		}
+	}
+
+	// Dust outputs are rejected under the configured dust policy.
+	_, err := EstimateFee("test", p2pkhOutputs(100), relayFee,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{})
+	if !errors.Is(err, errors.DustOutput) {
+		t.Errorf("dust output: expected DustOutput, got %v", err)
+	}
+	_, err = EstimateFee("test", p2pkhOutputs(100), relayFee,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{},
+		WithDustPolicy(txrules.DustMultiplierPolicy(0)))
+	if err != nil {
+		t.Errorf("dust output with zero multiplier policy: %v", err)
+	}
+}
+
+func TestBareMultisigOutputFee(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	for n := 1; n <= 3; n++ {
+		script := make([]byte, txsizes.MultisigScriptSize(n))
+		outputs := []*wire.TxOut{wire.NewTxOut(1e7, script)}
+		tx, err := NewUnsignedTransaction(outputs, relayFee,
+			makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{},
+			chaincfg.MainNetParams().MaxTxSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tx.ChangeIndex < 0 {
+			t.Fatalf("bare %d of %d: no change output", n, n)
+		}
+
+		// The size of the bare multisig output, rather than that of a
+		// P2PKH output, is included in the fee.
+		size := 12 + 2 + 1 + txsizes.EstimateInputSize(txsizes.RedeemP2PKHSigScriptSize) +
+			txsizes.EstimateBareMultisigOutputSize(n) + txsizes.P2PKHOutputSize
+		if tx.EstimatedSignedSerializeSize != size {
+			t.Errorf("bare %d of %d: estimated size %d, expected %d", n, n,
+				tx.EstimatedSignedSerializeSize, size)
+		}
+		change := dcrutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
+		fee := tx.TotalInput - 1e7 - change
+		if want := txrules.FeeForSerializeSize(relayFee, size); fee != want {
+			t.Errorf("bare %d of %d: fee %v, expected %v", n, n, fee, want)
+		}
+	}
+}
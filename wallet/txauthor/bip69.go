@@ -0,0 +1,102 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// SortBIP69 sorts the transaction inputs and outputs using the deterministic
+// ordering described by BIP0069.  Inputs are sorted by the previous
+// transaction hash, compared lexicographically in the reversed (displayed)
+// byte order, then by the previous output index and tree.  Outputs are sorted
+// by amount, then lexicographically by output script, then by script version.
+//
+// The PrevScripts, PrevOutpoints and PrevAccounts slices are reordered to
+// remain parallel with the inputs, so signing after sorting with
+// AddAllInputScripts continues to work.  ChangeIndex is updated to the sorted
+// position of the change output.  When change is split across multiple
+// outputs, ChangeIndex records the sorted position of the first change output
+// and the change outputs are no longer guaranteed to be contiguous.
+//
+// Sorting inputs invalidates any existing input signatures, so transactions
+// must be sorted before they are signed.
+func (tx *AuthoredTx) SortBIP69() {
+	ins := tx.Tx.TxIn
+	inPerm := make([]int, len(ins))
+	for i := range inPerm {
+		inPerm[i] = i
+	}
+	sort.SliceStable(inPerm, func(i, j int) bool {
+		a, b := &ins[inPerm[i]].PreviousOutPoint, &ins[inPerm[j]].PreviousOutPoint
+		if a.Hash != b.Hash {
+			for k := len(a.Hash) - 1; k >= 0; k-- {
+				if a.Hash[k] != b.Hash[k] {
+					return a.Hash[k] < b.Hash[k]
+				}
+			}
+		}
+		if a.Index != b.Index {
+			return a.Index < b.Index
+		}
+		return a.Tree < b.Tree
+	})
+	sortedIns := make([]*wire.TxIn, len(ins))
+	for i, j := range inPerm {
+		sortedIns[i] = ins[j]
+	}
+	tx.Tx.TxIn = sortedIns
+	if len(tx.PrevScripts) == len(ins) {
+		sorted := make([][]byte, len(ins))
+		for i, j := range inPerm {
+			sorted[i] = tx.PrevScripts[j]
+		}
+		tx.PrevScripts = sorted
+	}
+	if len(tx.PrevAccounts) == len(ins) {
+		sorted := make([]uint32, len(ins))
+		for i, j := range inPerm {
+			sorted[i] = tx.PrevAccounts[j]
+		}
+		tx.PrevAccounts = sorted
+	}
+	if len(tx.PrevOutpoints) == len(ins) {
+		sorted := make([]wire.OutPoint, len(ins))
+		for i, j := range inPerm {
+			sorted[i] = tx.PrevOutpoints[j]
+		}
+		tx.PrevOutpoints = sorted
+	}
+
+	outs := tx.Tx.TxOut
+	var change *wire.TxOut
+	if tx.ChangeIndex >= 0 && tx.ChangeIndex < len(outs) {
+		change = outs[tx.ChangeIndex]
+	}
+	sorted := make([]*wire.TxOut, len(outs))
+	copy(sorted, outs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Value != b.Value {
+			return a.Value < b.Value
+		}
+		if c := bytes.Compare(a.PkScript, b.PkScript); c != 0 {
+			return c < 0
+		}
+		return a.Version < b.Version
+	})
+	tx.Tx.TxOut = sorted
+	if change != nil {
+		for i, out := range sorted {
+			if out == change {
+				tx.ChangeIndex = i
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"bytes"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+type testSecrets struct {
+	keys   map[string][]byte
+	params *chaincfg.Params
+}
+
+func (s *testSecrets) GetKey(addr dcrutil.Address) ([]byte, dcrec.SignatureType, bool, error) {
+	key, ok := s.keys[addr.String()]
+	if !ok {
+		return nil, 0, false, errors.E(errors.NotExist, "no key")
+	}
+	return key, dcrec.STEcdsaSecp256k1, true, nil
+}
+
+func (s *testSecrets) GetScript(addr dcrutil.Address) ([]byte, error) {
+	return nil, errors.E(errors.NotExist, "no script")
+}
+
+func (s *testSecrets) ChainParams() *chaincfg.Params { return s.params }
+
+type scriptChangeSource []byte
+
+func (s scriptChangeSource) Script() ([]byte, uint16, error) { return s, 0, nil }
+func (s scriptChangeSource) ScriptSize() int                 { return len(s) }
+
+func TestSortBIP69(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	params := chaincfg.MainNetParams()
+	secrets := &testSecrets{keys: make(map[string][]byte), params: params}
+	var pkScripts [][]byte
+	for i := 0; i < 4; i++ {
+		keyBytes := make([]byte, 32)
+		keyBytes[31] = byte(i + 1)
+		key := secp256k1.PrivKeyFromBytes(keyBytes)
+		pkh := dcrutil.Hash160(key.PubKey().SerializeCompressed())
+		addr, err := dcrutil.NewAddressPubKeyHash(pkh, params, dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		secrets.keys[addr.String()] = key.Serialize()
+		pkScripts = append(pkScripts, pkScript)
+	}
+
+	// Inputs and outputs are created out of order.
+	prevOuts := []struct {
+		hash  chainhash.Hash
+		index uint32
+		value int64
+	}{
+		{chainhash.Hash{31: 2}, 0, 1e8},
+		{chainhash.Hash{0: 1, 31: 1}, 1, 1e8},
+		{chainhash.Hash{31: 1}, 0, 1e8},
+	}
+	inputSource := func(dcrutil.Amount) (*InputDetail, error) {
+		detail := &InputDetail{}
+		for i, p := range prevOuts {
+			op := wire.NewOutPoint(&p.hash, p.index, wire.TxTreeRegular)
+			detail.Amount += dcrutil.Amount(p.value)
+			detail.Inputs = append(detail.Inputs, wire.NewTxIn(op, p.value, nil))
+			detail.Scripts = append(detail.Scripts, pkScripts[i])
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+				txsizes.RedeemP2PKHSigScriptSize)
+		}
+		return detail, nil
+	}
+	outputs := []*wire.TxOut{
+		wire.NewTxOut(2e8, pkScripts[1]),
+		wire.NewTxOut(3e7, pkScripts[2]),
+		wire.NewTxOut(3e7, pkScripts[0]),
+	}
+	author := func() *AuthoredTx {
+		tx, err := NewUnsignedTransaction(outputs, relayFee, inputSource,
+			scriptChangeSource(pkScripts[3]), params.MaxTxSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx
+	}
+	unsorted, sorted := author(), author()
+	sorted.SortBIP69()
+
+	for i := 1; i < len(sorted.Tx.TxIn); i++ {
+		a := sorted.Tx.TxIn[i-1].PreviousOutPoint
+		b := sorted.Tx.TxIn[i].PreviousOutPoint
+		if a.Hash.String() > b.Hash.String() ||
+			(a.Hash == b.Hash && a.Index > b.Index) {
+			t.Errorf("inputs %d and %d are not sorted", i-1, i)
+		}
+	}
+	for i := 1; i < len(sorted.Tx.TxOut); i++ {
+		a, b := sorted.Tx.TxOut[i-1], sorted.Tx.TxOut[i]
+		if a.Value > b.Value || (a.Value == b.Value &&
+			bytes.Compare(a.PkScript, b.PkScript) > 0) {
+			t.Errorf("outputs %d and %d are not sorted", i-1, i)
+		}
+	}
+	change := unsorted.Tx.TxOut[unsorted.ChangeIndex]
+	if sorted.Tx.TxOut[sorted.ChangeIndex].Value != change.Value ||
+		!bytes.Equal(sorted.Tx.TxOut[sorted.ChangeIndex].PkScript, change.PkScript) {
+		t.Errorf("change index %d does not identify the change output", sorted.ChangeIndex)
+	}
+
+	// Both transactions must sign and validate, spending the same outputs.
+	for _, tx := range []*AuthoredTx{unsorted, sorted} {
+		if err := tx.AddAllInputScripts(secrets, dcrec.STEcdsaSecp256k1); err != nil {
+			t.Fatal(err)
+		}
+		for i, prevScript := range tx.PrevScripts {
+			if tx.Tx.TxIn[i].PreviousOutPoint != tx.PrevOutpoints[i] {
+				t.Errorf("input %d does not match its previous outpoint", i)
+			}
+			vm, err := txscript.NewEngine(prevScript, tx.Tx, i,
+				txscript.ScriptVerifyCleanStack, 0, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := vm.Execute(); err != nil {
+				t.Errorf("input %d does not validate: %v", i, err)
+			}
+		}
+	}
+	spent := make(map[wire.OutPoint]bool)
+	for _, in := range unsorted.Tx.TxIn {
+		spent[in.PreviousOutPoint] = true
+	}
+	for _, in := range sorted.Tx.TxIn {
+		if !spent[in.PreviousOutPoint] {
+			t.Errorf("sorted transaction spends %v", &in.PreviousOutPoint)
+		}
+	}
+	if len(sorted.Tx.TxOut) != len(unsorted.Tx.TxOut) {
+		t.Errorf("sorted transaction has %d outputs, expected %d",
+			len(sorted.Tx.TxOut), len(unsorted.Tx.TxOut))
+	}
+}
+
+func TestWithBIP69(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	changeScript := bytes.Repeat([]byte{0x01}, txsizes.P2PKHPkScriptSize)
+
+	// Inputs are returned out of order.
+	inputSource := func(dcrutil.Amount) (*InputDetail, error) {
+		detail := &InputDetail{}
+		for _, b := range []byte{3, 1, 2} {
+			op := wire.NewOutPoint(&chainhash.Hash{31: b}, 0, wire.TxTreeRegular)
+			detail.Amount += 2e8
+			detail.Inputs = append(detail.Inputs, wire.NewTxIn(op, 2e8, nil))
+			detail.Scripts = append(detail.Scripts, nil)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+				txsizes.RedeemP2PKHSigScriptSize)
+		}
+		return detail, nil
+	}
+
+	tests := []struct {
+		name    string
+		outputs []dcrutil.Amount
+	}{
+		{"change largest", []dcrutil.Amount{1e6, 2e6, 3e6}},
+		{"change smallest", []dcrutil.Amount{5.8e8, 1.9e7}},
+		{"change between", []dcrutil.Amount{1e8, 4e8, 1e6}},
+	}
+	for _, test := range tests {
+		outputs := p2pkhOutputs(test.outputs...)
+		tx, err := NewUnsignedTransaction(outputs, relayFee,
+			inputSource, scriptChangeSource(changeScript),
+			maxTxSize, WithBIP69())
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if len(tx.Tx.TxOut) != len(outputs)+1 {
+			t.Fatalf("%s: %d outputs, expected %d", test.name,
+				len(tx.Tx.TxOut), len(outputs)+1)
+		}
+		for i := 1; i < len(tx.Tx.TxOut); i++ {
+			a, b := tx.Tx.TxOut[i-1], tx.Tx.TxOut[i]
+			if a.Value > b.Value || (a.Value == b.Value &&
+				bytes.Compare(a.PkScript, b.PkScript) > 0) {
+				t.Errorf("%s: outputs %d and %d are not sorted",
+					test.name, i-1, i)
+			}
+		}
+		for i := 1; i < len(tx.Tx.TxIn); i++ {
+			a := tx.Tx.TxIn[i-1].PreviousOutPoint
+			b := tx.Tx.TxIn[i].PreviousOutPoint
+			if a.Hash.String() > b.Hash.String() ||
+				(a.Hash == b.Hash && a.Index > b.Index) {
+				t.Errorf("%s: inputs %d and %d are not sorted",
+					test.name, i-1, i)
+			}
+		}
+		for i, in := range tx.Tx.TxIn {
+			if in.PreviousOutPoint != tx.PrevOutpoints[i] {
+				t.Errorf("%s: input %d does not match its previous "+
+					"outpoint", test.name, i)
+			}
+		}
+		for i, out := range tx.Tx.TxOut {
+			isChange := bytes.Equal(out.PkScript, changeScript)
+			if isChange != (i == tx.ChangeIndex) {
+				t.Errorf("%s: change index %d does not identify the "+
+					"change output", test.name, tx.ChangeIndex)
+			}
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"sort"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// reselectInput is an input considered by BumpFeeByReselect.
+type reselectInput struct {
+	txIn       *wire.TxIn
+	prevScript []byte
+	scriptSize int
+}
+
+// BumpFeeByReselect rebuilds the unsigned original transaction to pay the same
+// recipient outputs at the higher fee rate newRate.  The recipient outputs are
+// all outputs of the original transaction other than its change output.
+//
+// The original inputs are reused when they can pay the higher fee.  Otherwise,
+// additional inputs are selected from inputSource, and original inputs which
+// are no longer needed are replaced by them, smallest first.  At least one
+// original input is always kept so the new transaction double spends the
+// original.  Any remaining value is returned to the original change output, or
+// to a new output created with changeSource if the original had no change.
+// Change that would be dust is paid as an additional fee.
+//
+// Fees are rounded up so the effective fee rate of the returned transaction is
+// no less than newRate.  If the inputs can not pay for the recipient outputs at
+// newRate, an error with kind errors.InsufficientBalance is returned.
+func BumpFeeByReselect(op errors.Op, original *AuthoredTx, newRate dcrutil.Amount,
+	inputSource InputSource, changeSource ChangeSource) (*AuthoredTx, error) {
+
+	if len(original.PrevScripts) != len(original.Tx.TxIn) {
+		return nil, errors.E(op, errors.Invalid, "missing previous output scripts")
+	}
+	if newRate <= original.FeeRate() {
+		return nil, errors.E(op, errors.Invalid, "fee rate does not exceed original")
+	}
+
+	recipients := make([]*wire.TxOut, 0, len(original.Tx.TxOut))
+	for i, out := range original.Tx.TxOut {
+		if i != original.ChangeIndex {
+			out := *out
+			recipients = append(recipients, &out)
+		}
+	}
+	recipientTotal := sumOutputValues(recipients)
+
+	var changeScript []byte
+	var changeScriptVersion uint16
+	var changeScriptSize int
+	if change, ok := original.ChangeOutput(); ok {
+		changeScript = change.PkScript
+		changeScriptVersion = change.Version
+		changeScriptSize = len(changeScript)
+	} else {
+		changeScriptSize = changeSource.ScriptSize()
+	}
+
+	// fund returns the size and change value of a transaction spending
+	// inputs, or the input value required when the inputs can not pay for
+	// the recipients at the new rate.
+	fund := func(inputs []*reselectInput) (size int, change, need dcrutil.Amount) {
+		var total dcrutil.Amount
+		scriptSizes := make([]int, len(inputs))
+		for i, in := range inputs {
+			total += dcrutil.Amount(in.txIn.ValueIn)
+			scriptSizes[i] = in.scriptSize
+		}
+		size = txsizes.EstimateSerializeSize(scriptSizes, recipients, changeScriptSize)
+		fee := txrules.FeeForSerializeSizeCeil(newRate, size)
+		change = total - recipientTotal - fee
+		if change > 0 && !txrules.IsDustAmount(change, changeScriptSize, newRate) {
+			return size, change, 0
+		}
+		size = txsizes.EstimateSerializeSize(scriptSizes, recipients, 0)
+		fee = txrules.FeeForSerializeSizeCeil(newRate, size)
+		if total < recipientTotal+fee {
+			return 0, 0, recipientTotal + fee
+		}
+		return size, 0, 0
+	}
+
+	inputs := make([]*reselectInput, len(original.Tx.TxIn))
+	spent := make(map[wire.OutPoint]struct{}, len(inputs))
+	var originalTotal dcrutil.Amount
+	for i, in := range original.Tx.TxIn {
+		txIn := wire.NewTxIn(&in.PreviousOutPoint, in.ValueIn, nil)
+		txIn.Sequence = in.Sequence
+		inputs[i] = &reselectInput{
+			txIn:       txIn,
+			prevScript: original.PrevScripts[i],
+			scriptSize: redeemScriptSize(0, original.PrevScripts[i]),
+		}
+		spent[in.PreviousOutPoint] = struct{}{}
+		originalTotal += dcrutil.Amount(in.ValueIn)
+	}
+
+	txVersion := original.Tx.Version
+	size, change, need := fund(inputs)
+	if need != 0 {
+		// Select additional inputs until the combined inputs can pay for
+		// the outputs and the fee of every input.
+		var additional []*reselectInput
+		target := need - originalTotal
+		for {
+			inputDetail, err := inputSource(target)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			additional = additional[:0]
+			var amount dcrutil.Amount
+			for i, in := range inputDetail.Inputs {
+				if _, ok := spent[in.PreviousOutPoint]; ok {
+					continue
+				}
+				additional = append(additional, &reselectInput{
+					txIn:       in,
+					prevScript: inputDetail.Scripts[i],
+					scriptSize: inputDetail.RedeemScriptSizes[i],
+				})
+				amount += dcrutil.Amount(in.ValueIn)
+			}
+			if amount < target {
+				return nil, errors.E(op, errors.InsufficientBalance,
+					&InsufficientBalanceError{Have: originalTotal + amount, Need: need})
+			}
+			size, change, need = fund(append(inputs, additional...))
+			if need == 0 {
+				// Sequences are applied to the shared inputs of the
+				// detail, including those of the additional inputs.
+				v, err := applySequences(inputDetail)
+				if err != nil {
+					return nil, errors.E(op, err)
+				}
+				if v > txVersion {
+					txVersion = v
+				}
+				break
+			}
+			target = need - originalTotal
+		}
+
+		// Replace the smallest original inputs with the additional inputs
+		// while the transaction remains funded, keeping at least one.
+		sort.SliceStable(inputs, func(i, j int) bool {
+			return inputs[i].txIn.ValueIn > inputs[j].txIn.ValueIn
+		})
+		inputs = append(inputs, additional...)
+		for len(inputs) > len(additional)+1 {
+			last := len(inputs) - len(additional) - 1
+			pruned := append(inputs[:last:last], inputs[last+1:]...)
+			s, c, n := fund(pruned)
+			if n != 0 {
+				break
+			}
+			inputs = pruned
+			size, change = s, c
+		}
+	}
+
+	outputs := recipients
+	changeIndex := -1
+	if change != 0 {
+		if changeScript == nil {
+			var err error
+			changeScript, changeScriptVersion, err = changeSource.Script()
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+		}
+		changeIndex = len(outputs)
+		if original.ChangeIndex >= 0 && original.ChangeIndex < changeIndex {
+			changeIndex = original.ChangeIndex
+		}
+		outputs = make([]*wire.TxOut, 0, len(recipients)+1)
+		outputs = append(outputs, recipients[:changeIndex]...)
+		outputs = append(outputs, &wire.TxOut{
+			Value:    int64(change),
+			Version:  changeScriptVersion,
+			PkScript: changeScript,
+		})
+		outputs = append(outputs, recipients[changeIndex:]...)
+	}
+
+	tx := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion,
+		TxIn:     make([]*wire.TxIn, len(inputs)),
+		TxOut:    outputs,
+		LockTime: original.Tx.LockTime,
+		Expiry:   original.Tx.Expiry,
+	}
+	prevScripts := make([][]byte, len(inputs))
+	var totalInput dcrutil.Amount
+	for i, in := range inputs {
+		tx.TxIn[i] = in.txIn
+		prevScripts[i] = in.prevScript
+		totalInput += dcrutil.Amount(in.txIn.ValueIn)
+	}
+	return &AuthoredTx{
+		Tx:                           tx,
+		PrevScripts:                  prevScripts,
+		PrevOutpoints:                prevOutpoints(tx.TxIn),
+		TotalInput:                   totalInput,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: size,
+	}, nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// hashedInputSource returns an InputSource which always provides inputs
+// spending P2PKH outputs with each value.  The outputs are referenced by
+// outpoints of a transaction with hash prefix b.
+func hashedInputSource(b byte, values ...dcrutil.Amount) InputSource {
+	return func(dcrutil.Amount) (*InputDetail, error) {
+		detail := new(InputDetail)
+		for i, out := range p2pkhOutputs(values...) {
+			op := wire.OutPoint{Hash: chainhash.Hash{b}, Index: uint32(i)}
+			detail.Amount += dcrutil.Amount(out.Value)
+			detail.Inputs = append(detail.Inputs, wire.NewTxIn(&op, out.Value, nil))
+			detail.Scripts = append(detail.Scripts, out.PkScript)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+				txsizes.RedeemP2PKHSigScriptSize)
+		}
+		return detail, nil
+	}
+}
+
+// changelessTx returns an unsigned transaction spending all inputs of
+// fetchInputs to outputs without change.
+func changelessTx(t *testing.T, fetchInputs InputSource, outputs []*wire.TxOut) *AuthoredTx {
+	t.Helper()
+	detail, err := fetchInputs(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	tx.TxIn = detail.Inputs
+	tx.TxOut = outputs
+	return &AuthoredTx{
+		Tx:          tx,
+		PrevScripts: detail.Scripts,
+		TotalInput:  detail.Amount,
+		ChangeIndex: -1,
+		EstimatedSignedSerializeSize: txsizes.EstimateSerializeSize(
+			detail.RedeemScriptSizes, outputs, 0),
+	}
+}
+
+func TestBumpFeeByReselect(t *testing.T) {
+	const op errors.Op = "test"
+	const (
+		origRate dcrutil.Amount = 1e4
+		newRate  dcrutil.Amount = 1e5
+	)
+
+	checkBumped := func(test string, orig, bumped *AuthoredTx) {
+		t.Helper()
+		if rate := bumped.EffectiveFeeRate(); rate < newRate {
+			t.Errorf("%s: effective fee rate %v, expected at least %v", test,
+				rate, newRate)
+		}
+		var recipients []*wire.TxOut
+		for i, out := range bumped.Tx.TxOut {
+			if i != bumped.ChangeIndex {
+				recipients = append(recipients, out)
+			}
+		}
+		var origRecipients []*wire.TxOut
+		for i, out := range orig.Tx.TxOut {
+			if i != orig.ChangeIndex {
+				origRecipients = append(origRecipients, out)
+			}
+		}
+		if len(recipients) != len(origRecipients) {
+			t.Fatalf("%s: %d recipient outputs, expected %d", test,
+				len(recipients), len(origRecipients))
+		}
+		for i := range recipients {
+			if recipients[i].Value != origRecipients[i].Value ||
+				string(recipients[i].PkScript) != string(origRecipients[i].PkScript) {
+				t.Errorf("%s: recipient output %d changed", test, i)
+			}
+		}
+		if sum := sumInputs(bumped.Tx); sum != bumped.TotalInput {
+			t.Errorf("%s: total input %v, expected %v", test, bumped.TotalInput, sum)
+		}
+	}
+
+	// The original input pays the higher fee from its change.
+	orig, err := NewUnsignedTransaction(p2pkhOutputs(5e5), origRate,
+		hashedInputSource(1, 1e6), AuthorTestChangeSource{}, 1e6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bumped, err := BumpFeeByReselect(op, orig, newRate, hashedInputSource(2),
+		AuthorTestChangeSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBumped("reuse", orig, bumped)
+	if len(bumped.Tx.TxIn) != 1 || bumped.PrevOutpoints[0] != orig.PrevOutpoints[0] {
+		t.Errorf("reuse: original input was not reused")
+	}
+	if bumped.ChangeIndex != orig.ChangeIndex ||
+		bumped.Tx.TxOut[bumped.ChangeIndex].Value >= orig.Tx.TxOut[orig.ChangeIndex].Value {
+		t.Errorf("reuse: change output was not reduced")
+	}
+
+	// The smaller original input is replaced by a larger input, while the
+	// larger original input is kept to double spend the original.
+	orig = changelessTx(t, hashedInputSource(1, 2e5, 3.03e5), p2pkhOutputs(5e5))
+	if orig.EffectiveFeeRate() >= newRate {
+		t.Fatalf("original fee rate %v is too high", orig.EffectiveFeeRate())
+	}
+	bumped, err = BumpFeeByReselect(op, orig, newRate, hashedInputSource(2, 1e7),
+		AuthorTestChangeSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBumped("replace", orig, bumped)
+	if len(bumped.Tx.TxIn) != 2 {
+		t.Fatalf("replace: %d inputs, expected 2", len(bumped.Tx.TxIn))
+	}
+	if bumped.Tx.TxIn[0].PreviousOutPoint != orig.Tx.TxIn[1].PreviousOutPoint ||
+		bumped.Tx.TxIn[1].PreviousOutPoint.Hash != (chainhash.Hash{2}) {
+		t.Errorf("replace: spent %v and %v", &bumped.Tx.TxIn[0].PreviousOutPoint,
+			&bumped.Tx.TxIn[1].PreviousOutPoint)
+	}
+	if bumped.ChangeIndex < 0 {
+		t.Errorf("replace: no change output was created")
+	}
+
+	// Inputs already spent by the original are not counted twice.
+	_, err = BumpFeeByReselect(op, orig, newRate, hashedInputSource(1, 2e5, 3.03e5),
+		AuthorTestChangeSource{})
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("expected InsufficientBalance, got %v", err)
+	}
+
+	// The fee rate must increase.
+	_, err = BumpFeeByReselect(op, orig, orig.EffectiveFeeRate(), hashedInputSource(2, 1e7),
+		AuthorTestChangeSource{})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid, got %v", err)
+	}
+}
+
+func sumInputs(tx *wire.MsgTx) (sum dcrutil.Amount) {
+	for _, in := range tx.TxIn {
+		sum += dcrutil.Amount(in.ValueIn)
+	}
+	return sum
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// bundleVersion is the version of the serialized transaction bundle written
+// by MarshalBinary.  Later versions may append additional fields, and bundles
+// with versions newer than understood by UnmarshalBinary are rejected.
+const bundleVersion = 1
+
+// mergeVerifyFlags are the script flags used to determine whether an input
+// signature script is complete.
+const mergeVerifyFlags = txscript.ScriptDiscourageUpgradableNops |
+	txscript.ScriptVerifyCleanStack |
+	txscript.ScriptVerifyCheckLockTimeVerify |
+	txscript.ScriptVerifyCheckSequenceVerify
+
+// MarshalBinary serializes an authored transaction as a bundle which may be
+// moved to an offline signer.  The bundle records the transaction, the
+// previous output script and amount of each input, and the remaining authored
+// transaction fields.
+//
+// Version 1 bundles are serialized as:
+//
+//   [0]     Bundle version (1 byte)
+//           Transaction (full serialization, var bytes)
+//           Previous output script count (varint)
+//           Previous output scripts (var bytes each)
+//           Input amount count (varint)
+//           Input amounts (8 bytes each)
+//           Total input (8 bytes)
+//           Change index (4 bytes, signed)
+//           Estimated signed serialize size (4 bytes)
+//
+// All integers are little endian.
+func (tx *AuthoredTx) MarshalBinary() ([]byte, error) {
+	const op errors.Op = "txauthor.MarshalBinary"
+
+	var buf bytes.Buffer
+	buf.WriteByte(bundleVersion)
+
+	var txBuf bytes.Buffer
+	txBuf.Grow(tx.Tx.SerializeSize())
+	err := tx.Tx.Serialize(&txBuf)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	err = wire.WriteVarBytes(&buf, 0, txBuf.Bytes())
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+
+	err = wire.WriteVarInt(&buf, 0, uint64(len(tx.PrevScripts)))
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	for _, script := range tx.PrevScripts {
+		err = wire.WriteVarBytes(&buf, 0, script)
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding, err)
+		}
+	}
+
+	var b [8]byte
+	err = wire.WriteVarInt(&buf, 0, uint64(len(tx.Tx.TxIn)))
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	for _, in := range tx.Tx.TxIn {
+		binary.LittleEndian.PutUint64(b[:], uint64(in.ValueIn))
+		buf.Write(b[:])
+	}
+
+	binary.LittleEndian.PutUint64(b[:], uint64(tx.TotalInput))
+	buf.Write(b[:])
+	binary.LittleEndian.PutUint32(b[:4], uint32(int32(tx.ChangeIndex)))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(tx.EstimatedSignedSerializeSize))
+	buf.Write(b[:4])
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary deserializes a transaction bundle created by MarshalBinary
+// into tx.  The amount of each transaction input is set from the amounts
+// recorded by the bundle, and the previous outpoints are recorded from the
+// transaction inputs.
+func (tx *AuthoredTx) UnmarshalBinary(bundle []byte) error {
+	const op errors.Op = "txauthor.UnmarshalBinary"
+
+	r := bytes.NewReader(bundle)
+	version, err := r.ReadByte()
+	if err != nil {
+		return errors.E(op, errors.Encoding, "empty bundle")
+	}
+	if version == 0 || version > bundleVersion {
+		return errors.E(op, errors.Encoding, errors.Errorf("unknown bundle version %d", version))
+	}
+
+	serializedTx, err := wire.ReadVarBytes(r, 0, wire.MaxMessagePayload, "tx")
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	msgTx := new(wire.MsgTx)
+	err = msgTx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	if count != uint64(len(msgTx.TxIn)) {
+		return errors.E(op, errors.Encoding, "previous script count does not match input count")
+	}
+	prevScripts := make([][]byte, count)
+	for i := range prevScripts {
+		prevScripts[i], err = wire.ReadVarBytes(r, 0, txscript.MaxScriptSize, "prevscript")
+		if err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+	}
+
+	count, err = wire.ReadVarInt(r, 0)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	if count != uint64(len(msgTx.TxIn)) {
+		return errors.E(op, errors.Encoding, "input amount count does not match input count")
+	}
+	var b [8]byte
+	for _, in := range msgTx.TxIn {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+		in.ValueIn = int64(binary.LittleEndian.Uint64(b[:]))
+	}
+
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	totalInput := dcrutil.Amount(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	changeIndex := int(int32(binary.LittleEndian.Uint32(b[:4])))
+	size := int(binary.LittleEndian.Uint32(b[4:]))
+
+	*tx = AuthoredTx{
+		Tx:                           msgTx,
+		PrevScripts:                  prevScripts,
+		PrevOutpoints:                prevOutpoints(msgTx.TxIn),
+		TotalInput:                   totalInput,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: size,
+	}
+	return nil
+}
+
+// MergeSignatures combines the input signatures of two copies of the same
+// transaction, such as those returned by different signers of a P2SH multisig
+// input.  prevScripts holds the previous output script of each input.
+//
+// For each input, a signature script which already satisfies the previous
+// output script is preferred.  Otherwise, the signatures of P2SH multisig
+// inputs are combined and ordered by the public keys of the redeem script.
+// Neither a nor b are modified.
+func MergeSignatures(params *chaincfg.Params, a, b *wire.MsgTx, prevScripts [][]byte) (*wire.MsgTx, error) {
+	const op errors.Op = "txauthor.MergeSignatures"
+	if a.TxHash() != b.TxHash() {
+		return nil, errors.E(op, errors.Invalid, "transactions differ")
+	}
+	if len(prevScripts) != len(a.TxIn) {
+		return nil, errors.E(op, errors.Invalid, "previous script count does not match input count")
+	}
+
+	merged := a.Copy()
+	complete := func(idx int, sigScript []byte) bool {
+		merged.TxIn[idx].SignatureScript = sigScript
+		vm, err := txscript.NewEngine(prevScripts[idx], merged, idx,
+			mergeVerifyFlags, 0, nil)
+		if err != nil {
+			return false
+		}
+		return vm.Execute() == nil
+	}
+	for i := range merged.TxIn {
+		sigA, sigB := a.TxIn[i].SignatureScript, b.TxIn[i].SignatureScript
+		switch {
+		case len(sigB) == 0 || bytes.Equal(sigA, sigB):
+			continue
+		case len(sigA) == 0:
+			merged.TxIn[i].SignatureScript = sigB
+			continue
+		case complete(i, sigA):
+			continue
+		case complete(i, sigB):
+			continue
+		}
+		script, err := mergeMultisig(params, merged, i, sigA, sigB)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		merged.TxIn[i].SignatureScript = script
+	}
+	return merged, nil
+}
+
+// mergeMultisig combines the signatures of two P2SH multisig signature
+// scripts for the input idx of tx.  Only signatures which are valid for a
+// public key of the redeem script are included.
+func mergeMultisig(params *chaincfg.Params, tx *wire.MsgTx, idx int, sigA, sigB []byte) ([]byte, error) {
+	pushesA, err := txscript.PushedData(sigA)
+	if err != nil || len(pushesA) == 0 {
+		return nil, errors.E(errors.Invalid, errors.Errorf("input %d: "+
+			"signature scripts can not be merged", idx))
+	}
+	pushesB, err := txscript.PushedData(sigB)
+	if err != nil || len(pushesB) == 0 {
+		return nil, errors.E(errors.Invalid, errors.Errorf("input %d: "+
+			"signature scripts can not be merged", idx))
+	}
+	redeemScript := pushesA[len(pushesA)-1]
+	if !bytes.Equal(redeemScript, pushesB[len(pushesB)-1]) {
+		return nil, errors.E(errors.Invalid, errors.Errorf("input %d: "+
+			"redeem scripts differ", idx))
+	}
+	class, addrs, nRequired, err := txscript.ExtractPkScriptAddrs(0,
+		redeemScript, params)
+	if err != nil || class != txscript.MultiSigTy {
+		return nil, errors.E(errors.Invalid, errors.Errorf("input %d: "+
+			"signature scripts can not be merged", idx))
+	}
+
+	sigs := make([][]byte, 0, len(pushesA)+len(pushesB)-2)
+	sigs = append(sigs, pushesA[:len(pushesA)-1]...)
+	sigs = append(sigs, pushesB[:len(pushesB)-1]...)
+	builder := txscript.NewScriptBuilder()
+	var added int
+	for _, addr := range addrs {
+		if added == nRequired {
+			break
+		}
+		pubKey, err := secp256k1.ParsePubKey(addr.ScriptAddress())
+		if err != nil {
+			continue
+		}
+		for _, sig := range sigs {
+			if len(sig) < 1 {
+				continue
+			}
+			hashType := txscript.SigHashType(sig[len(sig)-1])
+			hash, err := txscript.CalcSignatureHash(redeemScript, hashType,
+				tx, idx, nil)
+			if err != nil {
+				continue
+			}
+			parsed, err := secp256k1.ParseDERSignature(sig[:len(sig)-1])
+			if err != nil {
+				continue
+			}
+			if parsed.Verify(hash, pubKey) {
+				builder.AddData(sig)
+				added++
+				break
+			}
+		}
+	}
+	builder.AddData(redeemScript)
+	script, err := builder.Script()
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+	return script, nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"bytes"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	unspents := p2pkhOutputs(1e8, 2e8)
+	inputSource := func(dcrutil.Amount) (*InputDetail, error) {
+		detail := &InputDetail{}
+		for i, u := range unspents {
+			op := wire.OutPoint{Hash: chainhash.Hash{byte(i + 1)}, Index: uint32(i)}
+			detail.Amount += dcrutil.Amount(u.Value)
+			detail.Inputs = append(detail.Inputs, wire.NewTxIn(&op, u.Value, nil))
+			detail.Scripts = append(detail.Scripts, u.PkScript)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes, 108)
+		}
+		return detail, nil
+	}
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(2.5e8), relayFee, inputSource,
+		AuthorTestChangeSource{}, chaincfg.MainNetParams().MaxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded AuthoredTx
+	err = decoded.UnmarshalBinary(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want, got bytes.Buffer
+	tx.Tx.Serialize(&want)
+	decoded.Tx.Serialize(&got)
+	if !bytes.Equal(want.Bytes(), got.Bytes()) {
+		t.Errorf("decoded transaction differs")
+	}
+	for i, in := range decoded.Tx.TxIn {
+		if in.ValueIn != unspents[i].Value {
+			t.Errorf("input %d amount %v, expected %v", i, in.ValueIn, unspents[i].Value)
+		}
+		if !bytes.Equal(decoded.PrevScripts[i], tx.PrevScripts[i]) {
+			t.Errorf("input %d previous script differs", i)
+		}
+		if decoded.PrevOutpoints[i] != tx.PrevOutpoints[i] {
+			t.Errorf("input %d outpoint %v, expected %v", i,
+				&decoded.PrevOutpoints[i], &tx.PrevOutpoints[i])
+		}
+	}
+	if decoded.TotalInput != tx.TotalInput || decoded.ChangeIndex != tx.ChangeIndex ||
+		decoded.EstimatedSignedSerializeSize != tx.EstimatedSignedSerializeSize {
+		t.Errorf("decoded fields %v/%d/%d, expected %v/%d/%d",
+			decoded.TotalInput, decoded.ChangeIndex, decoded.EstimatedSignedSerializeSize,
+			tx.TotalInput, tx.ChangeIndex, tx.EstimatedSignedSerializeSize)
+	}
+
+	// Bundles from later versions and truncated bundles are rejected.
+	future := append([]byte{bundle[0] + 1}, bundle[1:]...)
+	if err := decoded.UnmarshalBinary(future); !errors.Is(err, errors.Encoding) {
+		t.Errorf("expected Encoding error for future version, got %v", err)
+	}
+	if err := decoded.UnmarshalBinary(bundle[:len(bundle)-1]); !errors.Is(err, errors.Encoding) {
+		t.Errorf("expected Encoding error for truncated bundle, got %v", err)
+	}
+}
+
+func TestMergeSignatures(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	keys := make([]*secp256k1.PrivateKey, 3)
+	builder := txscript.NewScriptBuilder().AddInt64(2)
+	for i := range keys {
+		keyBytes := make([]byte, 32)
+		keyBytes[31] = byte(i + 1)
+		keys[i] = secp256k1.PrivKeyFromBytes(keyBytes)
+		builder.AddData(keys[i].PubKey().SerializeCompressed())
+	}
+	builder.AddInt64(int64(len(keys))).AddOp(txscript.OP_CHECKMULTISIG)
+	redeemScript, err := builder.Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2sh, err := dcrutil.NewAddressScriptHash(redeemScript, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(p2sh)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 1e8, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8-1e5, pkScript))
+	partial := func(key *secp256k1.PrivateKey) *wire.MsgTx {
+		sig, err := txscript.RawTxInSignature(tx, 0, redeemScript,
+			txscript.SigHashAll, key.Serialize(), dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigScript, err := txscript.NewScriptBuilder().AddData(sig).
+			AddData(redeemScript).Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		signed := tx.Copy()
+		signed.TxIn[0].SignatureScript = sigScript
+		return signed
+	}
+	prevScripts := [][]byte{pkScript}
+	valid := func(tx *wire.MsgTx) error {
+		vm, err := txscript.NewEngine(pkScript, tx, 0,
+			txscript.ScriptVerifyCleanStack, 0, nil)
+		if err != nil {
+			return err
+		}
+		return vm.Execute()
+	}
+
+	// Signatures are merged in the order of the redeem script public keys,
+	// regardless of the order of the transactions.
+	a, b := partial(keys[2]), partial(keys[0])
+	if valid(a) == nil || valid(b) == nil {
+		t.Fatal("partially signed transaction is valid")
+	}
+	sigScriptA := a.TxIn[0].SignatureScript
+	merged, err := MergeSignatures(params, a, b, prevScripts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := valid(merged); err != nil {
+		t.Errorf("merged transaction is invalid: %v", err)
+	}
+	if !bytes.Equal(a.TxIn[0].SignatureScript, sigScriptA) {
+		t.Errorf("merging modified the argument transactions")
+	}
+
+	// A complete signature script is kept when merged with a partial one.
+	merged2, err := MergeSignatures(params, partial(keys[1]), merged, prevScripts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(merged2.TxIn[0].SignatureScript, merged.TxIn[0].SignatureScript) {
+		t.Errorf("complete signature script was not preferred")
+	}
+
+	other := partial(keys[1])
+	other.TxOut[0].Value--
+	_, err = MergeSignatures(params, a, other, prevScripts)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid merging different transactions, got %v", err)
+	}
+}
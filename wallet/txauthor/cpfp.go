@@ -0,0 +1,122 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// NewChildPaysForParentTx creates an unsigned child transaction spending an
+// output of an unconfirmed parent transaction which pays a fee large enough for
+// the combined parent and child package to pay targetFeeRate.  The parent is
+// assumed to pay parentFeeRate for its serialize size.
+//
+// Inputs are chosen from fetchInputs and at least one must spend an output of
+// the parent.  All input value, less the child's fee, is returned to a single
+// output created with fetchChange.  If the inputs can not pay for the
+// package's fee shortfall and a non-dust output, an error with kind
+// errors.InsufficientBalance is returned.
+//
+// The child transaction and the resulting fee rate of the package are
+// returned.
+func NewChildPaysForParentTx(op errors.Op, parent *wire.MsgTx, parentFeeRate,
+	targetFeeRate dcrutil.Amount, fetchInputs InputSource,
+	fetchChange ChangeSource) (*AuthoredTx, dcrutil.Amount, error) {
+
+	parentSize := parent.SerializeSize()
+	parentFee := txrules.FeeForSerializeSize(parentFeeRate, parentSize)
+	changeScriptSize := fetchChange.ScriptSize()
+	minChange := txrules.DefaultDustPolicy{}.DustAmount(changeScriptSize, targetFeeRate)
+
+	// childFee returns the fee which must be paid by a child of some size
+	// to bring the package to the target fee rate.  The child always pays
+	// at least the target fee rate for its own size.
+	childFee := func(childSize int) dcrutil.Amount {
+		fee := txrules.FeeForSerializeSize(targetFeeRate, parentSize+childSize) - parentFee
+		if ownFee := txrules.FeeForSerializeSize(targetFeeRate, childSize); fee < ownFee {
+			fee = ownFee
+		}
+		return fee
+	}
+
+	scriptSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+	childSize := txsizes.EstimateSerializeSize(scriptSizes, nil, changeScriptSize)
+	target := childFee(childSize) + minChange
+	var inputDetail *InputDetail
+	for {
+		var err error
+		inputDetail, err = fetchInputs(target)
+		if err != nil {
+			return nil, 0, errors.E(op, err)
+		}
+		if inputDetail.Amount < target {
+			return nil, 0, errors.E(op, errors.InsufficientBalance,
+				&InsufficientBalanceError{Have: inputDetail.Amount, Need: target})
+		}
+
+		childSize = txsizes.EstimateSerializeSize(inputDetail.RedeemScriptSizes,
+			nil, changeScriptSize)
+		if required := childFee(childSize) + minChange; inputDetail.Amount < required {
+			target = required
+			continue
+		}
+		break
+	}
+
+	parentHash := parent.TxHash()
+	spendsParent := false
+	for _, in := range inputDetail.Inputs {
+		if in.PreviousOutPoint.Hash == parentHash {
+			spendsParent = true
+			break
+		}
+	}
+	if !spendsParent {
+		return nil, 0, errors.E(op, errors.Invalid,
+			"child transaction does not spend an output of the parent")
+	}
+
+	changeScript, changeScriptVersion, err := fetchChange.Script()
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	if len(changeScript) > txscript.MaxScriptElementSize {
+		return nil, 0, errors.E(op, errors.Invalid, "script size exceed maximum bytes "+
+			"pushable to the stack")
+	}
+	fee := childFee(childSize)
+	change := &wire.TxOut{
+		Value:    int64(inputDetail.Amount - fee),
+		Version:  changeScriptVersion,
+		PkScript: changeScript,
+	}
+	txVersion, err := applySequences(inputDetail)
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	child := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion,
+		TxIn:     inputDetail.Inputs,
+		TxOut:    []*wire.TxOut{change},
+		LockTime: 0,
+		Expiry:   0,
+	}
+	packageRate := (parentFee + fee) * 1000 / dcrutil.Amount(parentSize+childSize)
+	return &AuthoredTx{
+		Tx:                           child,
+		PrevScripts:                  inputDetail.Scripts,
+		PrevAccounts:                 inputDetail.Accounts,
+		PrevOutpoints:                prevOutpoints(child.TxIn),
+		TotalInput:                   inputDetail.Amount,
+		ChangeIndex:                  0,
+		EstimatedSignedSerializeSize: childSize,
+	}, packageRate, nil
+}
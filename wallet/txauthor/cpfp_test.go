@@ -0,0 +1,75 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// outPointInputSource returns an InputSource which always provides a single
+// input spending the output of the parent transaction at index.
+func outPointInputSource(parent *wire.MsgTx, index uint32) InputSource {
+	return func(dcrutil.Amount) (*InputDetail, error) {
+		out := parent.TxOut[index]
+		hash := parent.TxHash()
+		op := wire.NewOutPoint(&hash, index, wire.TxTreeRegular)
+		return &InputDetail{
+			Amount:            dcrutil.Amount(out.Value),
+			Inputs:            []*wire.TxIn{wire.NewTxIn(op, out.Value, nil)},
+			Scripts:           [][]byte{out.PkScript},
+			RedeemScriptSizes: []int{txsizes.RedeemP2PKHSigScriptSize},
+		}, nil
+	}
+}
+
+func TestNewChildPaysForParentTx(t *testing.T) {
+	const op errors.Op = "test"
+	const parentFeeRate dcrutil.Amount = 1e3
+	const targetFeeRate dcrutil.Amount = 1e5
+
+	parent := wire.NewMsgTx()
+	parent.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 2e8, make([]byte, txsizes.RedeemP2PKHSigScriptSize)))
+	for _, out := range p2pkhOutputs(1e8, 1e6, 500) {
+		parent.AddTxOut(out)
+	}
+	parentSize := parent.SerializeSize()
+	parentFee := txrules.FeeForSerializeSize(parentFeeRate, parentSize)
+
+	child, rate, err := NewChildPaysForParentTx(op, parent, parentFeeRate,
+		targetFeeRate, outPointInputSource(parent, 1), AuthorTestChangeSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	childSize := txsizes.EstimateSerializeSize([]int{txsizes.RedeemP2PKHSigScriptSize},
+		nil, txsizes.P2PKHPkScriptSize)
+	wantFee := txrules.FeeForSerializeSize(targetFeeRate, parentSize+childSize) - parentFee
+	if len(child.Tx.TxOut) != 1 || child.ChangeIndex != 0 {
+		t.Fatalf("child has %d outputs and change index %d, expected a single "+
+			"change output", len(child.Tx.TxOut), child.ChangeIndex)
+	}
+	if fee := child.TotalInput - dcrutil.Amount(child.Tx.TxOut[0].Value); fee != wantFee {
+		t.Errorf("child pays fee %v, expected %v", fee, wantFee)
+	}
+	if rate < targetFeeRate-1 {
+		t.Errorf("package fee rate %v is below target %v", rate, targetFeeRate)
+	}
+	if child.Tx.TxIn[0].PreviousOutPoint.Hash != parent.TxHash() {
+		t.Errorf("child does not spend the parent")
+	}
+
+	// A parent output too small to pay for the package fee shortfall.
+	_, _, err = NewChildPaysForParentTx(op, parent, parentFeeRate,
+		targetFeeRate, outPointInputSource(parent, 2), AuthorTestChangeSource{})
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("expected InsufficientBalance, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// NewFixedInputTransaction creates an unsigned transaction spending every
+// previous output of inputs and paying to one or more non-change outputs.  All
+// inputs are spent, regardless of whether they are required to pay for the
+// outputs.  The fee is calculated at relayFee for the estimated signed size of
+// the transaction, and any remaining value is returned to a change output
+// created with changeSource unless the change would be dust, in which case it
+// is added to the fee.
+//
+// If the inputs can not pay for the outputs and the fee, an error with kind
+// errors.InsufficientBalance wrapping an *InsufficientBalanceError is returned.
+// If any output is dust, an error with kind errors.DustOutput is returned.
+func NewFixedInputTransaction(op errors.Op, inputs []Unspent, outputs []*wire.TxOut,
+	relayFee dcrutil.Amount, changeSource ChangeSource) (*AuthoredTx, error) {
+
+	if len(inputs) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no inputs")
+	}
+	err := CheckDustOutputs(txrules.DefaultDustPolicy{}, outputs, relayFee)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	inputDetail := makeInputDetail(inputs)
+	scriptSizes := inputDetail.RedeemScriptSizes
+	target := sumOutputValues(outputs)
+	size := txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+	fee := txrules.FeeForSerializeSize(relayFee, size)
+	if inputDetail.Amount < target+fee {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			&InsufficientBalanceError{Have: inputDetail.Amount, Need: target + fee})
+	}
+
+	txVersion, err := applySequences(inputDetail)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	tx := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion,
+		TxIn:     inputDetail.Inputs,
+		TxOut:    outputs,
+		LockTime: 0,
+		Expiry:   0,
+	}
+
+	changeIndex := -1
+	changeScriptSize := changeSource.ScriptSize()
+	changeSize := txsizes.EstimateSerializeSize(scriptSizes, outputs, changeScriptSize)
+	change := inputDetail.Amount - target -
+		txrules.FeeForSerializeSize(relayFee, changeSize)
+	if change > 0 && !txrules.IsDustAmount(change, changeScriptSize, relayFee) {
+		script, version, err := changeSource.Script()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(script) != changeScriptSize {
+			return nil, errors.E(op, errors.Invalid,
+				errChangeScriptSize(script, changeScriptSize))
+		}
+		changeIndex = len(outputs)
+		txOuts := make([]*wire.TxOut, 0, len(outputs)+1)
+		txOuts = append(txOuts, outputs...)
+		txOuts = append(txOuts, &wire.TxOut{
+			Value:    int64(change),
+			Version:  version,
+			PkScript: script,
+		})
+		tx.TxOut = txOuts
+		size = changeSize
+	}
+	if size > maxStandardTxSize {
+		return nil, errors.E(op, errors.TooManyInputs,
+			"signed tx size exceeds allowed maximum")
+	}
+
+	return &AuthoredTx{
+		Tx:                           tx,
+		PrevScripts:                  inputDetail.Scripts,
+		PrevAccounts:                 inputDetail.Accounts,
+		PrevOutpoints:                prevOutpoints(inputDetail.Inputs),
+		TotalInput:                   inputDetail.Amount,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: size,
+	}, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+)
+
+func TestNewFixedInputTransaction(t *testing.T) {
+	const op errors.Op = "test"
+	const relayFee dcrutil.Amount = 1e4
+
+	// Every input is spent even though the first alone pays for the output,
+	// and the remaining value is returned as change.
+	inputs := p2pkhOutputs(1e8, 1e6, 1e6)
+	outputs := p2pkhOutputs(1e7)
+	tx, err := NewFixedInputTransaction(op, withOutpoints(inputs), outputs, relayFee,
+		AuthorTestChangeSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxIn) != len(inputs) {
+		t.Fatalf("spent %d inputs, expected %d", len(tx.Tx.TxIn), len(inputs))
+	}
+	if tx.TotalInput != 102e6 {
+		t.Errorf("total input %v, expected %v", tx.TotalInput, dcrutil.Amount(102e6))
+	}
+	if tx.ChangeIndex != 1 || len(tx.Tx.TxOut) != 2 {
+		t.Fatalf("change index %d with %d outputs", tx.ChangeIndex, len(tx.Tx.TxOut))
+	}
+	scriptSizes := []int{txsizes.RedeemP2PKHSigScriptSize,
+		txsizes.RedeemP2PKHSigScriptSize, txsizes.RedeemP2PKHSigScriptSize}
+	size := txsizes.EstimateSerializeSize(scriptSizes, tx.Tx.TxOut, 0)
+	if tx.EstimatedSignedSerializeSize != size {
+		t.Errorf("estimated size %d, expected %d", tx.EstimatedSignedSerializeSize, size)
+	}
+	fee := tx.TotalInput - sumOutputs(tx.Tx.TxOut)
+	if want := txrules.FeeForSerializeSize(relayFee, size); fee != want {
+		t.Errorf("fee %v, expected %v", fee, want)
+	}
+
+	// Change that would be dust is added to the fee.
+	noChangeSize := txsizes.EstimateSerializeSize(scriptSizes[:1], outputs, 0)
+	noChangeFee := txrules.FeeForSerializeSize(relayFee, noChangeSize)
+	inputs = p2pkhOutputs(1e7 + noChangeFee + 100)
+	tx, err = NewFixedInputTransaction(op, withOutpoints(inputs), outputs, relayFee,
+		AuthorTestChangeSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.ChangeIndex != -1 || len(tx.Tx.TxOut) != 1 {
+		t.Errorf("dust change created at index %d", tx.ChangeIndex)
+	}
+
+	// Inputs which can not pay the fee of the outputs are an error.
+	inputs = p2pkhOutputs(1e7 + noChangeFee - 1)
+	_, err = NewFixedInputTransaction(op, withOutpoints(inputs), outputs, relayFee,
+		AuthorTestChangeSource{})
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Fatalf("expected InsufficientBalance, got %v", err)
+	}
+	var e *InsufficientBalanceError
+	if !errors.As(err, &e) {
+		t.Fatalf("error %v does not wrap *InsufficientBalanceError", err)
+	}
+	if e.Have != 1e7+noChangeFee-1 || e.Need != 1e7+noChangeFee {
+		t.Errorf("have %v need %v", e.Have, e.Need)
+	}
+
+	_, err = NewFixedInputTransaction(op, nil, outputs, relayFee,
+		AuthorTestChangeSource{})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("no inputs: expected Invalid, got %v", err)
+	}
+}
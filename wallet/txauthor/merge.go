@@ -0,0 +1,129 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// MergeAuthoredTxs combines two unsigned authored transactions into a single
+// transaction spending the inputs of both and paying the non-change outputs of
+// both, such as for coordinated spends.  The inputs and outputs of a are
+// followed by those of b.  The change outputs of both transactions, the
+// outputs at their ChangeIndex, are dropped and replaced by a single change
+// output created with changeSource and appended to the outputs.  The fee is
+// recalculated at relayFee for the estimated signed size of the merged
+// transaction, and change which would be dust is added to the fee.
+//
+// An error with kind errors.DoubleSpend is returned if both transactions spend
+// the same outpoint, and an error with kind errors.Invalid is returned if
+// either transaction is missing previous output scripts or the transactions
+// have different lock times or expiries.  If the combined inputs can not pay
+// for the combined outputs and fee, an error with kind
+// errors.InsufficientBalance is returned.
+func MergeAuthoredTxs(op errors.Op, a, b *AuthoredTx, relayFee dcrutil.Amount,
+	changeSource ChangeSource) (*AuthoredTx, error) {
+
+	if len(a.PrevScripts) != len(a.Tx.TxIn) || len(b.PrevScripts) != len(b.Tx.TxIn) {
+		return nil, errors.E(op, errors.Invalid, "missing previous output scripts")
+	}
+	if a.Tx.LockTime != b.Tx.LockTime || a.Tx.Expiry != b.Tx.Expiry {
+		return nil, errors.E(op, errors.Invalid,
+			"transactions have different lock times or expiries")
+	}
+
+	nIn := len(a.Tx.TxIn) + len(b.Tx.TxIn)
+	inputs := make([]*wire.TxIn, 0, nIn)
+	prevScripts := make([][]byte, 0, nIn)
+	scriptSizes := make([]int, 0, nIn)
+	spent := make(map[wire.OutPoint]struct{}, nIn)
+	var prevAccounts []uint32
+	if len(a.PrevAccounts) == len(a.Tx.TxIn) && len(b.PrevAccounts) == len(b.Tx.TxIn) {
+		prevAccounts = make([]uint32, 0, nIn)
+		prevAccounts = append(prevAccounts, a.PrevAccounts...)
+		prevAccounts = append(prevAccounts, b.PrevAccounts...)
+	}
+	var outputs []*wire.TxOut
+	var totalInput dcrutil.Amount
+	for _, tx := range []*AuthoredTx{a, b} {
+		for i, in := range tx.Tx.TxIn {
+			if _, ok := spent[in.PreviousOutPoint]; ok {
+				return nil, errors.E(op, errors.DoubleSpend, errors.Errorf(
+					"outpoint %v is spent by both transactions", &in.PreviousOutPoint))
+			}
+			spent[in.PreviousOutPoint] = struct{}{}
+			txIn := wire.NewTxIn(&in.PreviousOutPoint, in.ValueIn, nil)
+			txIn.Sequence = in.Sequence
+			inputs = append(inputs, txIn)
+			prevScripts = append(prevScripts, tx.PrevScripts[i])
+			scriptSizes = append(scriptSizes, redeemScriptSize(0, tx.PrevScripts[i]))
+			totalInput += dcrutil.Amount(in.ValueIn)
+		}
+		for i, out := range tx.Tx.TxOut {
+			if i != tx.ChangeIndex {
+				out := *out
+				outputs = append(outputs, &out)
+			}
+		}
+	}
+	outputTotal := sumOutputValues(outputs)
+
+	changeIndex := -1
+	changeScriptSize := changeSource.ScriptSize()
+	size := txsizes.EstimateSerializeSize(scriptSizes, outputs, changeScriptSize)
+	change := totalInput - outputTotal - txrules.FeeForSerializeSize(relayFee, size)
+	if change > 0 && !txrules.IsDustAmount(change, changeScriptSize, relayFee) {
+		script, version, err := changeSource.Script()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(script) != changeScriptSize {
+			return nil, errors.E(op, errors.Invalid,
+				errChangeScriptSize(script, changeScriptSize))
+		}
+		changeIndex = len(outputs)
+		outputs = append(outputs, &wire.TxOut{
+			Value:    int64(change),
+			Version:  version,
+			PkScript: script,
+		})
+	} else {
+		size = txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+		fee := txrules.FeeForSerializeSize(relayFee, size)
+		if totalInput < outputTotal+fee {
+			return nil, errors.E(op, errors.InsufficientBalance,
+				&InsufficientBalanceError{Have: totalInput, Need: outputTotal + fee})
+		}
+	}
+	if size > maxStandardTxSize {
+		return nil, errors.E(op, errors.TooManyInputs,
+			"signed tx size exceeds allowed maximum")
+	}
+
+	txVersion := a.Tx.Version
+	if b.Tx.Version > txVersion {
+		txVersion = b.Tx.Version
+	}
+	return &AuthoredTx{
+		Tx: &wire.MsgTx{
+			SerType:  wire.TxSerializeFull,
+			Version:  txVersion,
+			TxIn:     inputs,
+			TxOut:    outputs,
+			LockTime: a.Tx.LockTime,
+			Expiry:   a.Tx.Expiry,
+		},
+		PrevScripts:                  prevScripts,
+		PrevAccounts:                 prevAccounts,
+		PrevOutpoints:                prevOutpoints(inputs),
+		TotalInput:                   totalInput,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: size,
+	}, nil
+}
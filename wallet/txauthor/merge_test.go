@@ -0,0 +1,96 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+)
+
+func TestMergeAuthoredTxs(t *testing.T) {
+	const op errors.Op = "test"
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	author := func(fetchInputs InputSource, output dcrutil.Amount) *AuthoredTx {
+		tx, err := NewUnsignedTransaction(p2pkhOutputs(output), relayFee,
+			fetchInputs, AuthorTestChangeSource{}, maxTxSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tx.ChangeIndex < 0 {
+			t.Fatal("no change output")
+		}
+		return tx
+	}
+
+	a := author(hashedInputSource(1, 5e7), 1e7)
+	b := author(hashedInputSource(2, 3e7, 2e7), 4e7)
+	merged, err := MergeAuthoredTxs(op, a, b, relayFee, AuthorTestChangeSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The inputs of both transactions are spent, in order.
+	wantInputs := append(append(a.Tx.TxIn[:0:0], a.Tx.TxIn...), b.Tx.TxIn...)
+	if len(merged.Tx.TxIn) != len(wantInputs) {
+		t.Fatalf("merged %d inputs, expected %d", len(merged.Tx.TxIn), len(wantInputs))
+	}
+	for i, in := range merged.Tx.TxIn {
+		if in.PreviousOutPoint != wantInputs[i].PreviousOutPoint {
+			t.Errorf("input %d spends %v, expected %v", i, &in.PreviousOutPoint,
+				&wantInputs[i].PreviousOutPoint)
+		}
+	}
+	if len(merged.PrevScripts) != len(wantInputs) || len(merged.PrevOutpoints) != len(wantInputs) {
+		t.Errorf("merged %d previous scripts and %d previous outpoints, expected %d",
+			len(merged.PrevScripts), len(merged.PrevOutpoints), len(wantInputs))
+	}
+	if merged.TotalInput != a.TotalInput+b.TotalInput {
+		t.Errorf("total input %v, expected %v", merged.TotalInput,
+			a.TotalInput+b.TotalInput)
+	}
+
+	// Both change outputs are replaced by a single change output following
+	// the non-change outputs.
+	if len(merged.Tx.TxOut) != 3 || merged.ChangeIndex != 2 {
+		t.Fatalf("merged %d outputs with change index %d, expected 3 outputs "+
+			"with change index 2", len(merged.Tx.TxOut), merged.ChangeIndex)
+	}
+	if merged.Tx.TxOut[0].Value != 1e7 || merged.Tx.TxOut[1].Value != 4e7 {
+		t.Errorf("merged output values %d and %d, expected 1e7 and 4e7",
+			merged.Tx.TxOut[0].Value, merged.Tx.TxOut[1].Value)
+	}
+
+	// The fee pays for the estimated size of the merged transaction.
+	scriptSizes := make([]int, len(merged.Tx.TxIn))
+	for i := range scriptSizes {
+		scriptSizes[i] = txsizes.RedeemP2PKHSigScriptSize
+	}
+	size := txsizes.EstimateSerializeSize(scriptSizes, merged.Tx.TxOut, 0)
+	if merged.EstimatedSignedSerializeSize != size {
+		t.Errorf("estimated size %d, expected %d", merged.EstimatedSignedSerializeSize, size)
+	}
+	var outputTotal dcrutil.Amount
+	for _, out := range merged.Tx.TxOut {
+		outputTotal += dcrutil.Amount(out.Value)
+	}
+	fee := merged.TotalInput - outputTotal
+	if want := txrules.FeeForSerializeSize(relayFee, size); fee != want {
+		t.Errorf("fee %v, expected %v", fee, want)
+	}
+
+	// Transactions spending the same outpoint can not be merged.
+	dup := author(hashedInputSource(1, 5e7), 2e7)
+	_, err = MergeAuthoredTxs(op, a, dup, relayFee, AuthorTestChangeSource{})
+	if !errors.Is(err, errors.DoubleSpend) {
+		t.Errorf("duplicate input: expected DoubleSpend, got %v", err)
+	}
+}
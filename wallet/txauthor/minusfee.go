@@ -0,0 +1,232 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"math/big"
+	"sort"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// NewUnsignedTransactionMinusFee creates an unsigned transaction paying to
+// outputs where the fee is subtracted from the value of the recipient output
+// (outputs[recipient]) rather than paid by additional input value.  Inputs are
+// selected from fetchInputs to pay only the output values, and any remaining
+// input value is returned to a change output created with fetchChange.
+//
+// When the remaining value would create a dust change output, the change
+// output is omitted.  If foldDustToRecipient is true, the sub-dust remainder is
+// added to the value of the recipient output and the fee is kept at the
+// minimum required by relayFeePerKb.  Otherwise, the remainder is paid to
+// miners as an additional fee.
+//
+// If preferFeeFromSurplus is true and the selected inputs exceed the output
+// values, the fee is instead paid from the surplus, reducing the change output
+// and leaving the recipient output whole.  The recipient output only pays the
+// part of the fee which the surplus can not, and only when there is no surplus
+// does it pay the whole fee.
+//
+// The outputs slice is not modified.  If the inputs can not pay for the
+// outputs, or the recipient output can not pay the fee without becoming dust,
+// an error with kind errors.InsufficientBalance is returned.
+func NewUnsignedTransactionMinusFee(op errors.Op, outputs []*wire.TxOut, recipient int,
+	relayFeePerKb dcrutil.Amount, fetchInputs InputSource, fetchChange ChangeSource,
+	foldDustToRecipient, preferFeeFromSurplus bool) (*AuthoredTx, error) {
+
+	return newUnsignedTransactionMinusFee(op, outputs, []int{recipient},
+		relayFeePerKb, fetchInputs, fetchChange, foldDustToRecipient,
+		preferFeeFromSurplus)
+}
+
+// NewUnsignedTransactionMinusFeeMulti creates an unsigned transaction like
+// NewUnsignedTransactionMinusFee, but subtracts the fee from several recipient
+// outputs (outputs[i] for each i in recipients).  The fee is divided between
+// the recipients in proportion to their output values.  Atoms which can not be
+// divided evenly are subtracted from the recipients with the largest
+// fractional shares, so the total subtracted is exactly the fee.  When
+// foldDustToRecipients is true, a sub-dust remainder is divided between the
+// recipients in the same way.  When preferFeeFromSurplus is true, only the part
+// of the fee which can not be paid by surplus input value is divided between
+// the recipients.
+//
+// The outputs slice is not modified.  If the inputs can not pay for the
+// outputs, or any recipient output can not pay its share of the fee without
+// becoming dust, an error with kind errors.InsufficientBalance is returned.
+func NewUnsignedTransactionMinusFeeMulti(op errors.Op, outputs []*wire.TxOut, recipients []int,
+	relayFeePerKb dcrutil.Amount, fetchInputs InputSource, fetchChange ChangeSource,
+	foldDustToRecipients, preferFeeFromSurplus bool) (*AuthoredTx, error) {
+
+	return newUnsignedTransactionMinusFee(op, outputs, recipients,
+		relayFeePerKb, fetchInputs, fetchChange, foldDustToRecipients,
+		preferFeeFromSurplus)
+}
+
+func newUnsignedTransactionMinusFee(op errors.Op, outputs []*wire.TxOut, recipients []int,
+	relayFeePerKb dcrutil.Amount, fetchInputs InputSource, fetchChange ChangeSource,
+	foldDust, preferFeeFromSurplus bool) (*AuthoredTx, error) {
+
+	if len(recipients) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no recipient outputs")
+	}
+	seen := make(map[int]struct{}, len(recipients))
+	for _, i := range recipients {
+		if i < 0 || i >= len(outputs) {
+			return nil, errors.E(op, errors.Invalid, "recipient output index out of range")
+		}
+		if _, ok := seen[i]; ok {
+			return nil, errors.E(op, errors.Invalid, "duplicate recipient output index")
+		}
+		seen[i] = struct{}{}
+	}
+
+	target := sumOutputValues(outputs)
+	inputDetail, err := fetchInputs(target)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if inputDetail.Amount < target {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			&InsufficientBalanceError{Have: inputDetail.Amount, Need: target})
+	}
+
+	txOuts := make([]*wire.TxOut, len(outputs), len(outputs)+1)
+	copy(txOuts, outputs)
+	for _, i := range recipients {
+		out := *outputs[i]
+		txOuts[i] = &out
+	}
+
+	changeScriptSize := fetchChange.ScriptSize()
+	scriptSizes := inputDetail.RedeemScriptSizes
+	surplus := inputDetail.Amount - target
+	change := surplus
+	changeIndex := -1
+	var size int
+	var fee, deduction dcrutil.Amount
+	if preferFeeFromSurplus && surplus != 0 {
+		// Pay the fee from the surplus, returning any non-dust remainder as
+		// change.  Recipients only pay the part of the fee the surplus can
+		// not.
+		size = txsizes.EstimateSerializeSize(scriptSizes, outputs, changeScriptSize)
+		fee = txrules.FeeForSerializeSize(relayFeePerKb, size)
+		change = surplus - fee
+		if change <= 0 || txrules.IsDustAmount(change, changeScriptSize, relayFeePerKb) {
+			change = 0
+			size = txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+			fee = txrules.FeeForSerializeSize(relayFeePerKb, size)
+			if surplus < fee || foldDust {
+				deduction = fee - surplus
+			}
+		}
+	} else if change != 0 && !txrules.IsDustAmount(change, changeScriptSize, relayFeePerKb) {
+		size = txsizes.EstimateSerializeSize(scriptSizes, outputs, changeScriptSize)
+		fee = txrules.FeeForSerializeSize(relayFeePerKb, size)
+		deduction = fee
+	} else {
+		size = txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+		fee = txrules.FeeForSerializeSize(relayFeePerKb, size)
+		deduction = fee
+		if foldDust {
+			deduction -= change
+		}
+		change = 0
+	}
+	if change != 0 {
+		changeScript, changeScriptVersion, err := fetchChange.Script()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		changeIndex = len(txOuts)
+		txOuts = append(txOuts, &wire.TxOut{
+			Value:    int64(change),
+			Version:  changeScriptVersion,
+			PkScript: changeScript,
+		})
+	}
+
+	shares, ok := proRataShares(deduction, txOuts, recipients)
+	if !ok {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			"recipient outputs can not pay the transaction fee")
+	}
+	for j, i := range recipients {
+		out := txOuts[i]
+		out.Value -= int64(shares[j])
+		if out.Value <= 0 || txrules.IsDustOutput(out, relayFeePerKb) {
+			return nil, errors.E(op, errors.InsufficientBalance,
+				errors.Errorf("recipient output %d can not pay its share "+
+					"of the transaction fee", i))
+		}
+	}
+
+	txVersion, err := applySequences(inputDetail)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	tx := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion,
+		TxIn:     inputDetail.Inputs,
+		TxOut:    txOuts,
+		LockTime: 0,
+		Expiry:   0,
+	}
+	return &AuthoredTx{
+		Tx:                           tx,
+		PrevScripts:                  inputDetail.Scripts,
+		PrevAccounts:                 inputDetail.Accounts,
+		PrevOutpoints:                prevOutpoints(inputDetail.Inputs),
+		TotalInput:                   inputDetail.Amount,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: size,
+	}, nil
+}
+
+// proRataShares divides amount between the recipient outputs in proportion to
+// their values, using the largest remainder method so the shares sum to
+// exactly amount.  Ties are broken in favor of the earlier recipient.  The
+// amount may be negative.  The second return value is false if the recipients
+// have no total value to divide the amount by.
+func proRataShares(amount dcrutil.Amount, outputs []*wire.TxOut, recipients []int) ([]dcrutil.Amount, bool) {
+	total := new(big.Int)
+	for _, i := range recipients {
+		total.Add(total, big.NewInt(outputs[i].Value))
+	}
+	if total.Sign() <= 0 {
+		return nil, false
+	}
+
+	shares := make([]dcrutil.Amount, len(recipients))
+	remainders := make([]*big.Int, len(recipients))
+	var assigned dcrutil.Amount
+	amt := big.NewInt(int64(amount))
+	for j, i := range recipients {
+		// DivMod rounds towards negative infinity for a positive divisor,
+		// so remainders are never negative and the sum of the shares does
+		// not exceed amount.
+		q, m := new(big.Int), new(big.Int)
+		q.DivMod(new(big.Int).Mul(amt, big.NewInt(outputs[i].Value)), total, m)
+		shares[j] = dcrutil.Amount(q.Int64())
+		remainders[j] = m
+		assigned += shares[j]
+	}
+
+	order := make([]int, len(recipients))
+	for j := range order {
+		order[j] = j
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	for _, j := range order[:amount-assigned] {
+		shares[j]++
+	}
+	return shares, true
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestNewUnsignedTransactionMinusFee(t *testing.T) {
+	const op errors.Op = "test"
+	const relayFee = 1e4
+	scriptSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+
+	// The remaining 100 atoms are dust and can not be returned as change.
+	outputs := p2pkhOutputs(1e6)
+	size := txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+	minFee := txrules.FeeForSerializeSize(relayFee, size)
+	const residue = 100
+
+	tx, err := NewUnsignedTransactionMinusFee(op, outputs, 0, relayFee,
+		makeInputSource(p2pkhOutputs(1e6+residue)), AuthorTestChangeSource{}, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.ChangeIndex != -1 || len(tx.Tx.TxOut) != 1 {
+		t.Fatalf("unexpected change output")
+	}
+	dropped := dcrutil.Amount(tx.Tx.TxOut[0].Value)
+	if dropped != 1e6-minFee {
+		t.Errorf("recipient value %v, expected %v", dropped, 1e6-minFee)
+	}
+	if fee := tx.TotalInput - dropped; fee != minFee+residue {
+		t.Errorf("fee %v, expected %v", fee, minFee+residue)
+	}
+
+	tx, err = NewUnsignedTransactionMinusFee(op, outputs, 0, relayFee,
+		makeInputSource(p2pkhOutputs(1e6+residue)), AuthorTestChangeSource{}, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.ChangeIndex != -1 || len(tx.Tx.TxOut) != 1 {
+		t.Fatalf("unexpected change output")
+	}
+	folded := dcrutil.Amount(tx.Tx.TxOut[0].Value)
+	if folded-dropped != residue {
+		t.Errorf("recipient value increased by %v, expected %v",
+			folded-dropped, dcrutil.Amount(residue))
+	}
+	if fee := tx.TotalInput - folded; fee != minFee {
+		t.Errorf("fee %v, expected %v", fee, minFee)
+	}
+	if outputs[0].Value != 1e6 {
+		t.Errorf("caller outputs were modified")
+	}
+
+	// Non-dust change is returned to a change output regardless of the
+	// flag, and only the fee is subtracted from the recipient.
+	tx, err = NewUnsignedTransactionMinusFee(op, outputs, 0, relayFee,
+		makeInputSource(p2pkhOutputs(2e6)), AuthorTestChangeSource{}, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.ChangeIndex != 1 || tx.Tx.TxOut[1].Value != 1e6 {
+		t.Fatalf("expected change output of %v", dcrutil.Amount(1e6))
+	}
+	size = txsizes.EstimateSerializeSize(scriptSizes, outputs, txsizes.P2PKHPkScriptSize)
+	if fee := txrules.FeeForSerializeSize(relayFee, size); tx.Tx.TxOut[0].Value != int64(1e6-fee) {
+		t.Errorf("recipient value %v, expected %v",
+			dcrutil.Amount(tx.Tx.TxOut[0].Value), 1e6-fee)
+	}
+
+	_, err = NewUnsignedTransactionMinusFee(op, outputs, 0, relayFee,
+		makeInputSource(p2pkhOutputs(5e5)), AuthorTestChangeSource{}, true, false)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("expected InsufficientBalance, got %v", err)
+	}
+}
+
+func TestMinusFeePreferFeeFromSurplus(t *testing.T) {
+	const op errors.Op = "test"
+	const relayFee = 1e4
+	scriptSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+	outputs := p2pkhOutputs(1e6)
+	changelessFee := txrules.FeeForSerializeSize(relayFee,
+		txsizes.EstimateSerializeSize(scriptSizes, outputs, 0))
+	changeFee := txrules.FeeForSerializeSize(relayFee,
+		txsizes.EstimateSerializeSize(scriptSizes, outputs, txsizes.P2PKHPkScriptSize))
+
+	tests := []struct {
+		name      string
+		input     dcrutil.Amount
+		foldDust  bool
+		recipient dcrutil.Amount
+		change    dcrutil.Amount // zero for no change output
+	}{
+		{"surplus with change", 2e6, false, 1e6, 1e6 - changeFee},
+		{"surplus without change", 1e6 + changelessFee + 100, false, 1e6, 0},
+		{"surplus folded to recipient", 1e6 + changelessFee + 100, true, 1e6 + 100, 0},
+		{"partial surplus", 1e6 + 100, false, 1e6 - changelessFee + 100, 0},
+		{"no surplus", 1e6, false, 1e6 - changelessFee, 0},
+	}
+	for _, test := range tests {
+		tx, err := NewUnsignedTransactionMinusFee(op, outputs, 0, relayFee,
+			makeInputSource(p2pkhOutputs(test.input)), AuthorTestChangeSource{},
+			test.foldDust, true)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if v := dcrutil.Amount(tx.Tx.TxOut[0].Value); v != test.recipient {
+			t.Errorf("%s: recipient value %v, expected %v", test.name, v, test.recipient)
+		}
+		var change dcrutil.Amount
+		if tx.ChangeIndex != -1 {
+			change = dcrutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
+		}
+		if change != test.change {
+			t.Errorf("%s: change %v, expected %v", test.name, change, test.change)
+		}
+		fee := tx.TotalInput - sumOutputs(tx.Tx.TxOut)
+		minFee := txrules.FeeForSerializeSize(relayFee, tx.EstimatedSignedSerializeSize)
+		if fee < minFee {
+			t.Errorf("%s: fee %v below minimum %v", test.name, fee, minFee)
+		}
+	}
+	if outputs[0].Value != 1e6 {
+		t.Errorf("caller outputs were modified")
+	}
+}
+
+func TestNewUnsignedTransactionMinusFeeMulti(t *testing.T) {
+	const op errors.Op = "test"
+	const relayFee = 1e4
+	scriptSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+
+	tests := []struct {
+		name       string
+		amounts    []dcrutil.Amount
+		recipients []int
+	}{
+		{"two outputs", []dcrutil.Amount{1e6, 3e6}, []int{0, 1}},
+		{"three outputs", []dcrutil.Amount{1e6, 2e6, 4e6}, []int{0, 1, 2}},
+		{"equal outputs", []dcrutil.Amount{1e6, 1e6, 1e6}, []int{0, 1, 2}},
+		{"recipient subset", []dcrutil.Amount{1e6, 5e6, 3e6}, []int{2, 0}},
+	}
+	for _, test := range tests {
+		outputs := p2pkhOutputs(test.amounts...)
+		target := dcrutil.Amount(0)
+		for _, a := range test.amounts {
+			target += a
+		}
+		size := txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+		fee := txrules.FeeForSerializeSize(relayFee, size)
+
+		tx, err := NewUnsignedTransactionMinusFeeMulti(op, outputs, test.recipients,
+			relayFee, makeInputSource(p2pkhOutputs(target)), AuthorTestChangeSource{}, false, false)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if tx.ChangeIndex != -1 || len(tx.Tx.TxOut) != len(outputs) {
+			t.Fatalf("%s: unexpected change output", test.name)
+		}
+
+		var recipientTotal dcrutil.Amount
+		for _, i := range test.recipients {
+			recipientTotal += test.amounts[i]
+		}
+		var deducted dcrutil.Amount
+		for i, out := range tx.Tx.TxOut {
+			share := test.amounts[i] - dcrutil.Amount(out.Value)
+			deducted += share
+			isRecipient := false
+			for _, r := range test.recipients {
+				isRecipient = isRecipient || r == i
+			}
+			if !isRecipient {
+				if share != 0 {
+					t.Errorf("%s: output %d is not a recipient but paid %v",
+						test.name, i, share)
+				}
+				continue
+			}
+			// Each share is the proportional fee rounded up or down.
+			exact := int64(fee) * int64(test.amounts[i])
+			scaled := int64(share) * int64(recipientTotal)
+			if d := exact - scaled; d <= -int64(recipientTotal) || d >= int64(recipientTotal) {
+				t.Errorf("%s: output %d paid %v, not proportional to fee %v",
+					test.name, i, share, fee)
+			}
+		}
+		if deducted != fee {
+			t.Errorf("%s: deducted %v, expected fee %v", test.name, deducted, fee)
+		}
+		if got := tx.TotalInput - sumOutputs(tx.Tx.TxOut); got != fee {
+			t.Errorf("%s: transaction fee %v, expected %v", test.name, got, fee)
+		}
+		for i, out := range outputs {
+			if dcrutil.Amount(out.Value) != test.amounts[i] {
+				t.Errorf("%s: caller outputs were modified", test.name)
+			}
+		}
+	}
+
+	// Equal outputs pay equal shares, and indivisible atoms are paid by the
+	// earliest recipients.
+	outputs := p2pkhOutputs(1e6, 1e6, 1e6)
+	size := txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+	fee := txrules.FeeForSerializeSize(relayFee, size)
+	tx, err := NewUnsignedTransactionMinusFeeMulti(op, outputs, []int{0, 1, 2},
+		relayFee, makeInputSource(p2pkhOutputs(3e6)), AuthorTestChangeSource{}, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, out := range tx.Tx.TxOut {
+		want := fee / 3
+		if dcrutil.Amount(i) < fee%3 {
+			want++
+		}
+		if share := 1e6 - dcrutil.Amount(out.Value); share != want {
+			t.Errorf("equal output %d paid %v, expected %v", i, share, want)
+		}
+	}
+
+	// An output which would become dust after paying its share is an error.
+	dust := txrules.DefaultDustPolicy{}.DustAmount(txsizes.P2PKHOutputSize, relayFee)
+	outputs = p2pkhOutputs(dust, 1e6)
+	_, err = NewUnsignedTransactionMinusFeeMulti(op, outputs, []int{0, 1},
+		relayFee, makeInputSource(p2pkhOutputs(dust+1e6)), AuthorTestChangeSource{}, false, false)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("expected InsufficientBalance, got %v", err)
+	}
+
+	_, err = NewUnsignedTransactionMinusFeeMulti(op, outputs, []int{1, 1},
+		relayFee, makeInputSource(p2pkhOutputs(dust+1e6)), AuthorTestChangeSource{}, false, false)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for duplicate recipients, got %v", err)
+	}
+}
+
+func sumOutputs(outputs []*wire.TxOut) dcrutil.Amount {
+	var total dcrutil.Amount
+	for _, out := range outputs {
+		total += dcrutil.Amount(out.Value)
+	}
+	return total
+}
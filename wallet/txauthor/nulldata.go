@@ -0,0 +1,42 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/txscript/v3"
+)
+
+// NullDataChangeSource is a ChangeSource which returns change to a provably
+// unspendable null data (OP_RETURN) script, burning any change value.
+type NullDataChangeSource struct {
+	script []byte
+}
+
+// NewNullDataChangeSource creates a NullDataChangeSource for change outputs
+// carrying data, which may be nil.  An error with kind errors.Invalid is
+// returned if data exceeds the maximum standard null data payload size.
+func NewNullDataChangeSource(data []byte) (*NullDataChangeSource, error) {
+	const op errors.Op = "txauthor.NewNullDataChangeSource"
+	if len(data) > txscript.MaxDataCarrierSize {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("data "+
+			"length %d exceeds maximum %d", len(data), txscript.MaxDataCarrierSize))
+	}
+	script, err := txscript.GenerateProvablyPruneableOut(data)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	return &NullDataChangeSource{script: script}, nil
+}
+
+// Script returns the null data change script.
+func (s *NullDataChangeSource) Script() ([]byte, uint16, error) {
+	return s.script, 0, nil
+}
+
+// ScriptSize returns the size of the null data change script.
+func (s *NullDataChangeSource) ScriptSize() int {
+	return len(s.script)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+)
+
+func TestNullDataChangeSource(t *testing.T) {
+	for _, n := range []int{0, 1, 20, 80, txscript.MaxDataCarrierSize} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(i + 0x20)
+		}
+		src, err := NewNullDataChangeSource(data)
+		if err != nil {
+			t.Fatalf("%d bytes: %v", n, err)
+		}
+		script, version, err := src.Script()
+		if err != nil {
+			t.Fatalf("%d bytes: %v", n, err)
+		}
+		if len(script) == 0 || script[0] != txscript.OP_RETURN ||
+			txscript.GetScriptClass(version, script) != txscript.NullDataTy {
+			t.Errorf("%d bytes: script %x is not a null data script", n, script)
+		}
+		if src.ScriptSize() != len(script) {
+			t.Errorf("%d bytes: script size %d, expected %d", n,
+				src.ScriptSize(), len(script))
+		}
+	}
+
+	_, err := NewNullDataChangeSource(make([]byte, txscript.MaxDataCarrierSize+1))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for over-limit data, got %v", err)
+	}
+
+	// Change paid to the source is unspendable.
+	const relayFee dcrutil.Amount = 1e4
+	src, err := NewNullDataChangeSource([]byte("burn"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(1e6), relayFee,
+		makeInputSource(p2pkhOutputs(1e8)), src, chaincfg.MainNetParams().MaxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.ChangeIndex < 0 {
+		t.Fatal("no change output")
+	}
+	change := tx.Tx.TxOut[tx.ChangeIndex]
+	if !txscript.IsUnspendable(change.Value, change.PkScript) {
+		t.Errorf("change output is spendable")
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	cryptorand "crypto/rand"
+	"io"
+
+	"decred.org/dcrwallet/wallet/txrules"
+	"github.com/decred/dcrd/dcrutil/v3"
+)
+
+// Option configures optional behavior of NewUnsignedTransaction.
+type Option func(*options)
+
+type options struct {
+	dustPolicy txrules.DustThresholdPolicy
+	maxInputs  int
+
+	// changeRand is the source of randomness for the change output
+	// position.  The change output is appended when nil.
+	changeRand io.Reader
+
+	// splitChange is the maximum number of outputs that change is split
+	// across.
+	splitChange int
+
+	// economicChange is the smallest change amount which is returned with
+	// a change output.  Smaller, non-dust change is added to the fee.
+	economicChange dcrutil.Amount
+
+	// lockTime is the lock time of the authored transaction.
+	lockTime uint32
+
+	// reserve is the value of balance which the authored transaction may
+	// not spend.  No reserve is kept when zero.
+	balance, reserve dcrutil.Amount
+
+	// maxFee is the largest fee the authored transaction may pay.  The fee
+	// is not limited when zero.
+	maxFee dcrutil.Amount
+
+	// bip69 sorts the inputs and outputs of the authored transaction.
+	bip69 bool
+
+	// feeForSize calculates the fee of a transaction from its size.
+	feeForSize func(relayFeePerKb dcrutil.Amount, txSerializeSize int) dcrutil.Amount
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		dustPolicy: txrules.DefaultDustPolicy{},
+		feeForSize: txrules.FeeForSerializeSize,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithDustPolicy configures the dust threshold policy used to decide whether
+// remaining input value is returned with a change output.  A nil policy
+// selects txrules.DefaultDustPolicy.
+func WithDustPolicy(policy txrules.DustThresholdPolicy) Option {
+	return func(o *options) {
+		if policy == nil {
+			policy = txrules.DefaultDustPolicy{}
+		}
+		o.dustPolicy = policy
+	}
+}
+
+// WithMaxInputs limits the number of inputs which may be spent by the
+// transaction.  If more inputs are required to pay for the outputs and fee,
+// an error with kind errors.TooManyInputs is returned.  A zero limit allows
+// any number of inputs.
+func WithMaxInputs(maxInputs int) Option {
+	return func(o *options) {
+		o.maxInputs = maxInputs
+	}
+}
+
+// WithRandomChangePosition inserts any change output at a uniformly random
+// position among the transaction outputs, using rand as the source of
+// randomness, rather than appending it.  The order of the non-change outputs
+// is preserved.  A nil rand selects crypto/rand.Reader.
+//
+// The position is chosen when the transaction is authored.  Reordering the
+// outputs afterwards, such as with AuthoredTx.SortBIP69 or the WithBIP69
+// option, overrides the random position.
+func WithRandomChangePosition(rand io.Reader) Option {
+	return func(o *options) {
+		if rand == nil {
+			rand = cryptorand.Reader
+		}
+		o.changeRand = rand
+	}
+}
+
+// WithCeilFee calculates fees with txrules.FeeForSerializeSizeCeil, rounding
+// any fractional atom up, rather than txrules.FeeForSerializeSize.  This
+// guarantees the fee rate of the estimated size is never below the relay fee.
+func WithCeilFee() Option {
+	return func(o *options) {
+		o.feeForSize = txrules.FeeForSerializeSizeCeil
+	}
+}
+
+// WithFeeSchedule calculates fees with txrules.FeeForSerializeSizeSchedule
+// using a piecewise fee schedule, rather than charging the relay fee rate for
+// every byte of the transaction.  The relay fee continues to be used to
+// determine dust.  A nil or empty schedule does not modify how fees are
+// calculated.
+func WithFeeSchedule(schedule []txrules.FeeTier) Option {
+	return func(o *options) {
+		if len(schedule) == 0 {
+			return
+		}
+		o.feeForSize = func(_ dcrutil.Amount, txSerializeSize int) dcrutil.Amount {
+			return txrules.FeeForSerializeSizeSchedule(schedule, txSerializeSize)
+		}
+	}
+}
+
+// WithLockTime sets the lock time of the authored transaction, preventing it
+// from being mined before the block height or time it describes.  Lock times
+// below txscript.LockTimeThreshold are block heights, and all others are Unix
+// times.  If every input has a final sequence number, the sequence of the first
+// input is decremented so that the lock time is enforced.  The lock time does
+// not affect the position of the change output.
+func WithLockTime(lockTime uint32) Option {
+	return func(o *options) {
+		o.lockTime = lockTime
+	}
+}
+
+// WithSplitChange splits any change across up to n outputs to obscure which
+// output is change.  Each change output uses a separate script from the
+// ChangeSource, and the change amount is divided among them with random
+// variation.  The additional outputs are included in the fee estimate, and
+// fewer change outputs are created when splitting the change n ways would
+// create dust outputs.  Values of n less than two create a single change
+// output.
+//
+// The change outputs are contiguous, beginning at the ChangeIndex of the
+// authored transaction.
+func WithSplitChange(n int) Option {
+	return func(o *options) {
+		o.splitChange = n
+	}
+}
+
+// WithBIP69 sorts the inputs and outputs of the authored transaction with
+// AuthoredTx.SortBIP69 before it is returned.  Outputs are ordered by amount
+// and then by script, so the position of the change output is determined by
+// its value and script rather than by the order in which outputs were added.
+// ChangeIndex records the sorted position of the change output.
+func WithBIP69() Option {
+	return func(o *options) {
+		o.bip69 = true
+	}
+}
+
+// WithReserve prevents the authored transaction from spending into a reserve
+// of a balance.  The value spent by the transaction, the output values and fee,
+// may not exceed balance less reserve, and an error with kind
+// errors.InsufficientBalance wrapping an *InsufficientBalanceError is returned
+// when it does.  Spending the balance down to exactly the reserve is allowed.
+// A zero reserve has no effect.
+func WithReserve(balance, reserve dcrutil.Amount) Option {
+	return func(o *options) {
+		o.balance = balance
+		o.reserve = reserve
+	}
+}
+
+// WithEconomicChange adds any change below threshold to the fee rather than
+// creating a change output, even when the change is not dust.  This avoids
+// creating outputs which would cost more in fees to spend than they are worth.
+// The output amounts are unchanged, and the ChangeIndex of the authored
+// transaction is negative when the change is added to the fee.  A zero
+// threshold only adds dust change to the fee.
+func WithEconomicChange(threshold dcrutil.Amount) Option {
+	return func(o *options) {
+		o.economicChange = threshold
+	}
+}
+
+// WithMaxFee limits the fee paid by the authored transaction, including any
+// change added to the fee, to maxFee.  If the fee would exceed the limit, an
+// error with kind errors.FeeExceedsLimit is returned.  This guards against
+// paying an excessive fee due to a misconfigured fee rate or a pathological
+// size estimate.  A zero limit has no effect.
+func WithMaxFee(maxFee dcrutil.Amount) Option {
+	return func(o *options) {
+		o.maxFee = maxFee
+	}
+}
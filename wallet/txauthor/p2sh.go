@@ -0,0 +1,50 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+)
+
+// P2SHChangeSource is a ChangeSource which returns change to P2SH scripts.
+// Fee estimation accounts for the size of the P2SH change output script.
+type P2SHChangeSource struct {
+	next func() (dcrutil.Address, error)
+}
+
+// NewP2SHChangeSource creates a P2SHChangeSource which pays change to the
+// script hash addresses returned by next.  next is called once for each change
+// output script that is created.
+func NewP2SHChangeSource(next func() (dcrutil.Address, error)) *P2SHChangeSource {
+	return &P2SHChangeSource{next: next}
+}
+
+// Script returns a P2SH change script paying to the next address.  An error
+// with kind errors.Invalid is returned if the address is not a script hash
+// address.
+func (s *P2SHChangeSource) Script() ([]byte, uint16, error) {
+	const op errors.Op = "txauthor.P2SHChangeSource.Script"
+	addr, err := s.next()
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	if _, ok := addr.(*dcrutil.AddressScriptHash); !ok {
+		return nil, 0, errors.E(op, errors.Invalid, errors.Errorf("change "+
+			"address %v is not a script hash address", addr))
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	return script, 0, nil
+}
+
+// ScriptSize returns the size of a P2SH output script.
+func (s *P2SHChangeSource) ScriptSize() int {
+	return txsizes.P2SHPkScriptSize
+}
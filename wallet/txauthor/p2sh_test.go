@@ -0,0 +1,78 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+)
+
+func TestP2SHChangeSource(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	params := chaincfg.MainNetParams()
+	var n byte
+	src := NewP2SHChangeSource(func() (dcrutil.Address, error) {
+		n++
+		return dcrutil.NewAddressScriptHashFromHash(make([]byte, 20), params)
+	})
+
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(1e6), relayFee,
+		makeInputSource(p2pkhOutputs(1e8)), src, params.MaxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.ChangeIndex < 0 {
+		t.Fatal("no change output")
+	}
+	change := tx.Tx.TxOut[tx.ChangeIndex]
+	if len(change.PkScript) != txsizes.P2SHPkScriptSize {
+		t.Errorf("change script size %d, expected %d", len(change.PkScript),
+			txsizes.P2SHPkScriptSize)
+	}
+	if class := txscript.GetScriptClass(change.Version, change.PkScript); class != txscript.ScriptHashTy {
+		t.Errorf("change script class %v, expected %v", class, txscript.ScriptHashTy)
+	}
+	if n != 1 {
+		t.Errorf("change source called %d times, expected 1", n)
+	}
+
+	// The fee is estimated for the size of the P2SH change output.
+	inputSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+	size := txsizes.EstimateSerializeSize(inputSizes, p2pkhOutputs(1e6),
+		txsizes.P2SHPkScriptSize)
+	if tx.EstimatedSignedSerializeSize != size {
+		t.Errorf("estimated size %d, expected %d", tx.EstimatedSignedSerializeSize, size)
+	}
+	if sz := txsizes.EstimateSerializeSize(inputSizes, tx.Tx.TxOut, 0); sz != size {
+		t.Errorf("size with change output %d, expected %d", sz, size)
+	}
+	changeless := txsizes.EstimateSerializeSize(inputSizes, p2pkhOutputs(1e6), 0)
+	if size-changeless != txsizes.P2SHOutputSize {
+		t.Errorf("change output adds %d bytes, expected %d", size-changeless,
+			txsizes.P2SHOutputSize)
+	}
+	fee := tx.TotalInput - 1e6 - dcrutil.Amount(change.Value)
+	if want := txrules.FeeForSerializeSize(relayFee, size); fee != want {
+		t.Errorf("fee %v, expected %v", fee, want)
+	}
+
+	// Addresses other than script hash addresses are rejected.
+	src = NewP2SHChangeSource(func() (dcrutil.Address, error) {
+		return dcrutil.NewAddressPubKeyHash(make([]byte, 20), params,
+			dcrec.STEcdsaSecp256k1)
+	})
+	_, _, err = src.Script()
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("expected Invalid for P2PKH change address, got %v", err)
+	}
+}
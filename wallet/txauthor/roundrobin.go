@@ -0,0 +1,65 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"sync"
+
+	"decred.org/dcrwallet/errors"
+)
+
+// RoundRobinChangeSource is a ChangeSource which rotates through an ordered
+// list of change sources, returning each change script from the next source in
+// turn.  Successive transactions authored with the same RoundRobinChangeSource
+// therefore spread their change across all of the sources, such as the internal
+// branches of several accounts.
+//
+// ScriptSize reports the script size of the source which the next call to
+// Script will use.  As the size is only reported once for each authored
+// transaction, all sources should return scripts of the same size when used
+// with options creating multiple change outputs.
+//
+// A RoundRobinChangeSource is safe for concurrent use, however a concurrent
+// call to Script between another caller's ScriptSize and Script calls will
+// advance the rotation and may cause a script size mismatch.
+type RoundRobinChangeSource struct {
+	sources []ChangeSource
+	next    int
+	mu      sync.Mutex
+}
+
+// NewRoundRobinChangeSource creates a RoundRobinChangeSource rotating through
+// sources, beginning with the first.  An error with kind errors.Invalid is
+// returned if no sources are provided.
+func NewRoundRobinChangeSource(sources ...ChangeSource) (*RoundRobinChangeSource, error) {
+	const op errors.Op = "txauthor.NewRoundRobinChangeSource"
+	if len(sources) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no change sources")
+	}
+	s := make([]ChangeSource, len(sources))
+	copy(s, sources)
+	return &RoundRobinChangeSource{sources: s}, nil
+}
+
+// Script returns a change script from the next source in the rotation.  The
+// rotation only advances when the source returns a script without error.
+func (s *RoundRobinChangeSource) Script() ([]byte, uint16, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	script, version, err := s.sources[s.next].Script()
+	if err != nil {
+		return nil, 0, err
+	}
+	s.next = (s.next + 1) % len(s.sources)
+	return script, version, nil
+}
+
+// ScriptSize returns the size of the script the next call to Script will
+// return.
+func (s *RoundRobinChangeSource) ScriptSize() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sources[s.next].ScriptSize()
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"bytes"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+)
+
+// fixedChangeSource returns the same script for every change output.
+type fixedChangeSource []byte
+
+func (s fixedChangeSource) Script() ([]byte, uint16, error) { return s, 0, nil }
+func (s fixedChangeSource) ScriptSize() int                 { return len(s) }
+
+// failingChangeSource errors when a change script is requested.
+type failingChangeSource struct{}
+
+func (failingChangeSource) Script() ([]byte, uint16, error) {
+	return nil, 0, errors.New("no change script")
+}
+func (failingChangeSource) ScriptSize() int { return txsizes.P2PKHPkScriptSize }
+
+func TestRoundRobinChangeSource(t *testing.T) {
+	sources := []fixedChangeSource{
+		bytes.Repeat([]byte{1}, txsizes.P2PKHPkScriptSize),
+		bytes.Repeat([]byte{2}, txsizes.P2SHPkScriptSize),
+		bytes.Repeat([]byte{3}, txsizes.P2PKHPkScriptSize),
+	}
+	src, err := NewRoundRobinChangeSource(sources[0], sources[1], sources[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Scripts are returned from each source in turn, and the reported size
+	// is that of the next returned script.
+	for i := 0; i < 2*len(sources); i++ {
+		size := src.ScriptSize()
+		script, _, err := src.Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := sources[i%len(sources)]
+		if !bytes.Equal(script, want) {
+			t.Errorf("call %d: script %x, expected %x", i, script, want)
+		}
+		if size != len(script) {
+			t.Errorf("call %d: script size %d, script has %d bytes", i, size,
+				len(script))
+		}
+	}
+
+	// Successive transactions spread change across the sources.
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	for i := 0; i < len(sources); i++ {
+		tx, err := NewUnsignedTransaction(p2pkhOutputs(1e6), relayFee,
+			makeInputSource(p2pkhOutputs(1e8)), src, maxTxSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tx.ChangeIndex < 0 {
+			t.Fatalf("transaction %d: no change output", i)
+		}
+		change := tx.Tx.TxOut[tx.ChangeIndex].PkScript
+		if !bytes.Equal(change, sources[i]) {
+			t.Errorf("transaction %d: change script %x, expected %x", i,
+				change, sources[i])
+		}
+	}
+
+	// The rotation does not advance when a source errors.
+	src, err = NewRoundRobinChangeSource(failingChangeSource{}, sources[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		_, _, err = src.Script()
+		if err == nil {
+			t.Fatalf("call %d: expected error", i)
+		}
+	}
+
+	_, err = NewRoundRobinChangeSource()
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("no sources: expected Invalid, got %v", err)
+	}
+}
@@ -0,0 +1,707 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// bnbMaxTries limits the number of branches visited by the branch and bound
+// search before falling back to greedy input selection.  This bounds the work
+// performed for wallets with very large numbers of unspent outputs.
+const bnbMaxTries = 100000
+
+// knapsackMaxTries limits the number of subsets evaluated by the knapsack
+// search.
+const knapsackMaxTries = 100000
+
+// redeemScriptSize returns the worst case size of a signature script
+// redeeming an output with the previous output script.  Scripts which are not
+// recognized are assumed to be P2PKH.
+func redeemScriptSize(version uint16, pkScript []byte) int {
+	switch txscript.GetScriptClass(version, pkScript) {
+	case txscript.PubKeyTy:
+		return txsizes.RedeemP2PKSigScriptSize
+	case txscript.ScriptHashTy:
+		return txsizes.RedeemP2SHSigScriptSize
+	default:
+		return txsizes.RedeemP2PKHSigScriptSize
+	}
+}
+
+// p2shRedeemScriptSize returns the worst case size of a signature script
+// redeeming a P2SH output with the provided redeem script.  Multisig redeem
+// scripts are sized by their required number of signatures.  If the redeem
+// script is not known or is not recognized, the size of a single signature
+// P2SH redemption is assumed.
+func p2shRedeemScriptSize(redeemScript []byte) int {
+	if len(redeemScript) == 0 {
+		return txsizes.RedeemP2SHSigScriptSize
+	}
+	var sigsSize int
+	switch txscript.GetScriptClass(0, redeemScript) {
+	case txscript.MultiSigTy:
+		_, numSigs, err := txscript.CalcMultiSigStats(redeemScript)
+		if err != nil {
+			return txsizes.RedeemP2SHSigScriptSize
+		}
+		sigsSize = numSigs * txsizes.MultisigSigPushSize
+	case txscript.PubKeyTy:
+		sigsSize = txsizes.RedeemP2PKSigScriptSize
+	case txscript.PubKeyHashTy:
+		sigsSize = txsizes.RedeemP2PKHSigScriptSize
+	default:
+		return txsizes.RedeemP2SHSigScriptSize
+	}
+	return sigsSize + txsizes.DataPushSize(len(redeemScript)) + len(redeemScript)
+}
+
+// inputFee returns the fee paid at feeRate for the serialized size of an input
+// with a signature script of scriptSize bytes.  The fee is rounded up.
+func inputFee(feeRate dcrutil.Amount, scriptSize int) dcrutil.Amount {
+	size := dcrutil.Amount(txsizes.EstimateInputSize(scriptSize))
+	return (feeRate*size + 999) / 1000
+}
+
+// Unspent is an unspent previous output and the outpoint referencing it.
+type Unspent struct {
+	OutPoint wire.OutPoint
+	TxOut    *wire.TxOut
+}
+
+// makeInputDetail creates an InputDetail with an input redeeming each
+// unspent output.
+func makeInputDetail(unspents []Unspent) *InputDetail {
+	detail := &InputDetail{
+		Inputs:            make([]*wire.TxIn, 0, len(unspents)),
+		Scripts:           make([][]byte, 0, len(unspents)),
+		RedeemScriptSizes: make([]int, 0, len(unspents)),
+	}
+	for i := range unspents {
+		u := &unspents[i]
+		detail.Amount += dcrutil.Amount(u.TxOut.Value)
+		detail.Inputs = append(detail.Inputs, wire.NewTxIn(&u.OutPoint, u.TxOut.Value, nil))
+		detail.Scripts = append(detail.Scripts, u.TxOut.PkScript)
+		detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+			redeemScriptSize(u.TxOut.Version, u.TxOut.PkScript))
+	}
+	return detail
+}
+
+// selectInOrder returns the shortest prefix of unspents with a total value
+// meeting the target.  All outputs are returned if the target can not be met.
+func selectInOrder(unspents []Unspent, target dcrutil.Amount) []Unspent {
+	var total dcrutil.Amount
+	for i := range unspents {
+		if total >= target {
+			return unspents[:i]
+		}
+		total += dcrutil.Amount(unspents[i].TxOut.Value)
+	}
+	return unspents
+}
+
+// BnBInputSource returns an InputSource which performs a branch and bound
+// search of utxos for a subset of outputs that pays for the target without
+// requiring a change output.  A subset is accepted when its value, less the fee
+// of each input at feeRate, is no less than the target without the fee of a
+// P2PKH change output and exceeds it by no more than a dust amount.  The
+// smallest such subset found within a bounded number of search steps is
+// returned.  When no subset is found, outputs are selected in order until the
+// target is met.
+//
+// The target is expected to include the fee of a single P2PKH input and a P2PKH
+// change output, as is the case for the initial target requested by
+// NewUnsignedTransaction.
+func BnBInputSource(utxos []Unspent, feeRate dcrutil.Amount) InputSource {
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		if selected := branchAndBound(utxos, target, feeRate); selected != nil {
+			return makeInputDetail(selected), nil
+		}
+		return makeInputDetail(selectInOrder(utxos, target)), nil
+	}
+}
+
+// NewBranchAndBoundInputSource returns an InputSource which performs a branch
+// and bound search of utxos for a changeless subset of outputs.
+//
+// Deprecated: Use BnBInputSource.
+func NewBranchAndBoundInputSource(utxos []Unspent, feeRate dcrutil.Amount) InputSource {
+	return BnBInputSource(utxos, feeRate)
+}
+
+// ConstrainedInputSource returns an InputSource which spends exactly the
+// previous outputs referenced by outpoints, in order, regardless of the target
+// amount.  The lookup function must return the previous output and, for P2SH
+// outputs, the redeem script (which may be nil if unknown).  The redeem script
+// is used to estimate the signature script size of the input.  Lookup errors,
+// such as for unknown or spent outputs, are returned by the InputSource.
+//
+// Duplicate outpoints are rejected with errors.Invalid.  If the outputs do not
+// pay for the target, NewUnsignedTransaction returns an error with kind
+// errors.InsufficientBalance and no further inputs are selected.
+func ConstrainedInputSource(outpoints []wire.OutPoint,
+	lookup func(wire.OutPoint) (*wire.TxOut, []byte, error)) InputSource {
+
+	const op errors.Op = "txauthor.ConstrainedInputSource"
+	return func(dcrutil.Amount) (*InputDetail, error) {
+		detail := &InputDetail{
+			Inputs:            make([]*wire.TxIn, 0, len(outpoints)),
+			Scripts:           make([][]byte, 0, len(outpoints)),
+			RedeemScriptSizes: make([]int, 0, len(outpoints)),
+		}
+		seen := make(map[wire.OutPoint]struct{}, len(outpoints))
+		for i := range outpoints {
+			prevOut := outpoints[i]
+			if _, ok := seen[prevOut]; ok {
+				return nil, errors.E(op, errors.Invalid,
+					errors.Errorf("duplicate outpoint %v", &prevOut))
+			}
+			seen[prevOut] = struct{}{}
+
+			out, redeemScript, err := lookup(prevOut)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			if out == nil {
+				return nil, errors.E(op, errors.NotExist,
+					errors.Errorf("unknown outpoint %v", &prevOut))
+			}
+
+			scriptSize := redeemScriptSize(out.Version, out.PkScript)
+			if txscript.GetScriptClass(out.Version, out.PkScript) == txscript.ScriptHashTy {
+				scriptSize = p2shRedeemScriptSize(redeemScript)
+			}
+
+			detail.Amount += dcrutil.Amount(out.Value)
+			detail.Inputs = append(detail.Inputs, wire.NewTxIn(&prevOut, out.Value, nil))
+			detail.Scripts = append(detail.Scripts, out.PkScript)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes, scriptSize)
+		}
+		return detail, nil
+	}
+}
+
+// GrindedInputSource returns an InputSource which selects the inputs of source
+// but estimates the signature scripts of P2PKH inputs with
+// txsizes.RedeemP2PKHSigScriptSizeGrinded rather than the worst case size.
+// Signatures created by the wallet always have a low S value and are never
+// larger than the grinded size, so this avoids overestimating the fee by a
+// byte for each input.  It must not be used with inputs which may be signed by
+// software creating high S signatures.
+//
+// Inputs are adjusted only when source reports the worst case P2PKH size and
+// the previous output script is not recognized as a P2PK or P2SH script.  The
+// InputDetail returned by source is not modified.
+func GrindedInputSource(source InputSource) InputSource {
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		detail, err := source(target)
+		if err != nil || detail == nil {
+			return detail, err
+		}
+		grinded := *detail
+		grinded.RedeemScriptSizes = make([]int, len(detail.RedeemScriptSizes))
+		for i, size := range detail.RedeemScriptSizes {
+			if size == txsizes.RedeemP2PKHSigScriptSize {
+				var pkScript []byte
+				if i < len(detail.Scripts) {
+					pkScript = detail.Scripts[i]
+				}
+				if redeemScriptSize(0, pkScript) == txsizes.RedeemP2PKHSigScriptSize {
+					size = txsizes.RedeemP2PKHSigScriptSizeGrinded
+				}
+			}
+			grinded.RedeemScriptSizes[i] = size
+		}
+		return &grinded, nil
+	}
+}
+
+// EffectiveValueInputSource returns an InputSource which selects the inputs of
+// source in order of their effective value, which is the input amount less the
+// fee of spending the input at feeRate, until the target is met.  Inputs with a
+// negative effective value cost more to spend than they are worth, and are
+// only selected when the target can not be met without them.
+//
+// All inputs are requested from source once, with a target of
+// dcrutil.MaxAmount, and an InsufficientBalance error from source is ignored.
+// The InputDetail returned by source is not modified.
+func EffectiveValueInputSource(source InputSource, feeRate dcrutil.Amount) InputSource {
+	var all *InputDetail
+	var order []int
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		if all == nil {
+			detail, err := source(dcrutil.MaxAmount)
+			if err != nil && !errors.Is(err, errors.InsufficientBalance) {
+				return nil, err
+			}
+			if detail == nil {
+				detail = new(InputDetail)
+			}
+			effective := make([]dcrutil.Amount, len(detail.Inputs))
+			order = make([]int, len(detail.Inputs))
+			for i, in := range detail.Inputs {
+				fee := inputFee(feeRate, detail.RedeemScriptSizes[i])
+				effective[i] = dcrutil.Amount(in.ValueIn) - fee
+				order[i] = i
+			}
+			sort.SliceStable(order, func(i, j int) bool {
+				return effective[order[i]] > effective[order[j]]
+			})
+			all = detail
+		}
+
+		selected := &InputDetail{
+			Inputs:            make([]*wire.TxIn, 0, len(order)),
+			Scripts:           make([][]byte, 0, len(order)),
+			RedeemScriptSizes: make([]int, 0, len(order)),
+		}
+		for _, i := range order {
+			if selected.Amount >= target {
+				break
+			}
+			in := all.Inputs[i]
+			selected.Amount += dcrutil.Amount(in.ValueIn)
+			selected.Inputs = append(selected.Inputs, in)
+			selected.Scripts = append(selected.Scripts, all.Scripts[i])
+			selected.RedeemScriptSizes = append(selected.RedeemScriptSizes,
+				all.RedeemScriptSizes[i])
+			if len(all.Sequences) != 0 {
+				selected.Sequences = append(selected.Sequences, all.Sequences[i])
+			}
+			if len(all.Accounts) != 0 {
+				selected.Accounts = append(selected.Accounts, all.Accounts[i])
+			}
+		}
+		return selected, nil
+	}
+}
+
+// NewLargestFirstInputSource returns an InputSource which selects the outputs
+// of utxos with the largest values first until the target is met.  This
+// minimizes the number of inputs and the fee paid for them.  The utxos slice
+// is not modified.
+func NewLargestFirstInputSource(utxos []Unspent) InputSource {
+	sorted := make([]Unspent, len(utxos))
+	copy(sorted, utxos)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TxOut.Value > sorted[j].TxOut.Value
+	})
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		return makeInputDetail(selectInOrder(sorted, target)), nil
+	}
+}
+
+// NewConsolidatingInputSource returns an InputSource which selects the outputs
+// of utxos with the largest values first until the target is met, as with
+// NewLargestFirstInputSource, and which opportunistically consolidates small
+// outputs when fees are low.  When feeRate is below consolidationFeeRate, the
+// remaining outputs are additionally selected, smallest first, if the fee of
+// spending each output at feeRate is less than maxFeeFraction of its value.
+// The value of consolidated outputs is returned to the change output.  No more
+// than maxInputs inputs are selected for consolidation, and a maxInputs of zero
+// does not limit the number of consolidated inputs.  The utxos slice is not
+// modified.
+func NewConsolidatingInputSource(utxos []Unspent, feeRate, consolidationFeeRate dcrutil.Amount,
+	maxFeeFraction float64, maxInputs int) InputSource {
+
+	sorted := make([]Unspent, len(utxos))
+	copy(sorted, utxos)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TxOut.Value > sorted[j].TxOut.Value
+	})
+	consolidate := feeRate < consolidationFeeRate && maxFeeFraction > 0
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		selected := selectInOrder(sorted, target)
+		if !consolidate {
+			return makeInputDetail(selected), nil
+		}
+		consolidated := make([]Unspent, len(selected), len(sorted))
+		copy(consolidated, selected)
+		for i := len(sorted) - 1; i >= len(selected); i-- {
+			if maxInputs > 0 && len(consolidated) >= maxInputs {
+				break
+			}
+			out := sorted[i].TxOut
+			fee := inputFee(feeRate, redeemScriptSize(out.Version, out.PkScript))
+			if float64(fee) >= maxFeeFraction*float64(out.Value) {
+				continue
+			}
+			consolidated = append(consolidated, sorted[i])
+		}
+		return makeInputDetail(consolidated), nil
+	}
+}
+
+// NewSeededRandomInputSource returns an InputSource which selects the outputs
+// of utxos in a random order until the target is met.  The order is shuffled
+// deterministically from seed, so sources created with the same seed and
+// outputs select the same inputs in the same order.  Callers should provide a
+// cryptographically random seed unless reproducible selection is required,
+// such as in tests.  The utxos slice is not modified.
+func NewSeededRandomInputSource(utxos []Unspent, seed int64) InputSource {
+	shuffled := make([]Unspent, len(utxos))
+	copy(shuffled, utxos)
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		return makeInputDetail(selectInOrder(shuffled, target)), nil
+	}
+}
+
+// NewWeightedRandomInputSource returns an InputSource which selects the outputs
+// of utxos in a random order, weighted by the parallel weights, until the
+// target is met.  Each successive output is drawn from the remaining outputs
+// with probability proportional to its weight, so callers may, for example,
+// favor outputs of a particular age rather than always spending the oldest.
+// Outputs with weights that are not positive are only selected after all
+// positively weighted outputs, in their original order.  The order is drawn
+// from rnd when the source is created, and selections of smaller targets are a
+// prefix of the order.  Neither slice is modified.
+//
+// If the number of weights does not match the number of outputs, the returned
+// InputSource returns an error with kind errors.Invalid.
+func NewWeightedRandomInputSource(utxos []Unspent, weights []float64, rnd *rand.Rand) InputSource {
+	const op errors.Op = "txauthor.NewWeightedRandomInputSource"
+	if len(weights) != len(utxos) {
+		return func(dcrutil.Amount) (*InputDetail, error) {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("%d weights "+
+				"for %d outputs", len(weights), len(utxos)))
+		}
+	}
+
+	// Sorting by the key u^(1/w), for uniform random u, draws outputs
+	// without replacement with probability proportional to their weight.
+	type keyedOutput struct {
+		out Unspent
+		key float64
+	}
+	keyed := make([]keyedOutput, len(utxos))
+	for i := range utxos {
+		key := -1.0
+		if w := weights[i]; w > 0 {
+			key = math.Pow(rnd.Float64(), 1/w)
+		}
+		keyed[i] = keyedOutput{out: utxos[i], key: key}
+	}
+	sort.SliceStable(keyed, func(i, j int) bool {
+		return keyed[i].key > keyed[j].key
+	})
+	ordered := make([]Unspent, len(keyed))
+	for i := range keyed {
+		ordered[i] = keyed[i].out
+	}
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		return makeInputDetail(selectInOrder(ordered, target)), nil
+	}
+}
+
+// Candidate is an unspent output which may be selected as a transaction input,
+// along with the outpoint referencing it and the height of the block the
+// output was mined in.  The block height of an unmined output is -1.
+//
+// Maturity is the number of blocks which must be mined on top of the block
+// containing the output before it may be spent, such as the coinbase maturity
+// for coinbase, vote and revocation outputs, or the ticket change maturity for
+// ticket change outputs.  Outputs without any maturity requirement set it to
+// zero.
+type Candidate struct {
+	OutPoint    wire.OutPoint
+	TxOut       *wire.TxOut
+	BlockHeight int32
+	Maturity    int32
+}
+
+// eligible returns whether the candidate has at least minConf confirmations
+// and has matured in a chain with tip height currentHeight.
+func (c *Candidate) eligible(currentHeight, minConf int32) bool {
+	confs := confirmations(c.BlockHeight, currentHeight)
+	if confs < minConf {
+		return false
+	}
+	return c.Maturity == 0 || confs > c.Maturity
+}
+
+// confirmations returns the number of confirmations of an output mined at
+// blockHeight (or -1 if unmined) in a chain with tip height currentHeight.
+func confirmations(blockHeight, currentHeight int32) int32 {
+	if blockHeight == -1 || blockHeight > currentHeight {
+		return 0
+	}
+	return currentHeight - blockHeight + 1
+}
+
+// NewMinConfInputSource returns an InputSource which selects the outputs of
+// candidates, in order, until the target is met.  Outputs with fewer than
+// minConf confirmations in a chain with tip height currentHeight, or which have
+// not yet reached their maturity, are never selected, even when the remaining
+// outputs do not pay for the target.  A minConf of zero allows unmined outputs
+// to be selected.  The candidates slice is not modified.
+func NewMinConfInputSource(candidates []Candidate, currentHeight, minConf int32) InputSource {
+	eligible := make([]Unspent, 0, len(candidates))
+	for i := range candidates {
+		c := &candidates[i]
+		if c.eligible(currentHeight, minConf) {
+			eligible = append(eligible, Unspent{OutPoint: c.OutPoint, TxOut: c.TxOut})
+		}
+	}
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		return makeInputDetail(selectInOrder(eligible, target)), nil
+	}
+}
+
+// UnspentIterator provides unspent outputs one at a time, such as from a
+// database cursor.  NextUnspent returns the next output and true, or false
+// after all outputs have been returned.
+type UnspentIterator interface {
+	NextUnspent() (Unspent, bool, error)
+}
+
+// NewStreamingInputSource returns an InputSource which selects outputs in the
+// order they are returned by iter until the target is met.  Outputs are read
+// from the iterator only as needed, so the unspent outputs of wallets with
+// very large UTXO sets are never held in memory at once.  Outputs read for
+// previous targets are retained, and the iterator is not advanced again once it
+// has been exhausted.
+func NewStreamingInputSource(iter UnspentIterator) InputSource {
+	var read []Unspent
+	var total dcrutil.Amount
+	exhausted := false
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		for !exhausted && total < target {
+			u, ok, err := iter.NextUnspent()
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				exhausted = true
+				break
+			}
+			read = append(read, u)
+			total += dcrutil.Amount(u.TxOut.Value)
+		}
+		return makeInputDetail(selectInOrder(read, target)), nil
+	}
+}
+
+// NewKnapsackInputSource returns an InputSource which searches utxos for the
+// subset of outputs with the lowest total cost of paying for the target.  The
+// cost of a subset is the fee of its inputs at feeRate plus the waste of the
+// value remaining after the target is paid:
+//
+//   - If the remaining value is dust, no change output is created and the
+//     remaining value is wasted as an additional fee.
+//   - Otherwise, the fee of a P2PKH change output and the fee of later
+//     spending it are wasted.  If the change value is less than minChange, the
+//     change is considered uneconomical and its value is added as a penalty.
+//
+// Changeless subsets are therefore preferred over subsets creating change
+// which would cost nearly as much to spend as it is worth.  The lowest cost
+// subset found within a bounded number of search steps is returned.  When no
+// subset pays for the target, all outputs are returned.
+//
+// The target is expected to include the fee of a single P2PKH input and a P2PKH
+// change output, as is the case for the targets requested by
+// NewUnsignedTransaction.
+func NewKnapsackInputSource(utxos []Unspent, feeRate, minChange dcrutil.Amount) InputSource {
+	return func(target dcrutil.Amount) (*InputDetail, error) {
+		if selected := knapsack(utxos, target, feeRate, minChange); selected != nil {
+			return makeInputDetail(selected), nil
+		}
+		return makeInputDetail(utxos), nil
+	}
+}
+
+// knapsack performs the search described by NewKnapsackInputSource.  It returns
+// nil if no subset pays for the target.
+func knapsack(utxos []Unspent, target, feeRate, minChange dcrutil.Amount) []Unspent {
+	type candidate struct {
+		output Unspent
+		value  dcrutil.Amount
+		fee    dcrutil.Amount
+	}
+	candidates := make([]candidate, 0, len(utxos))
+	for i := range utxos {
+		out := utxos[i].TxOut
+		fee := inputFee(feeRate, redeemScriptSize(out.Version, out.PkScript))
+		if dcrutil.Amount(out.Value) <= fee {
+			continue
+		}
+		candidates = append(candidates, candidate{utxos[i], dcrutil.Amount(out.Value), fee})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].value-candidates[i].fee > candidates[j].value-candidates[j].fee
+	})
+
+	// remaining[i] records the total value of candidates[i:] and is used to
+	// prune branches that can not pay for the target.
+	remaining := make([]dcrutil.Amount, len(candidates)+1)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + candidates[i].value - candidates[i].fee
+	}
+
+	// The target without the fees of the input and change output it
+	// includes does not depend on the selected inputs.
+	changeFee := feeRate * txsizes.P2PKHOutputSize / 1000
+	spendChangeFee := inputFee(feeRate, txsizes.RedeemP2PKHSigScriptSize)
+	base := target - spendChangeFee - changeFee
+
+	// cost returns the total cost of a selection paying the target with
+	// effective value total (value less input fees) and the given input fees.
+	cost := func(total, inputFees dcrutil.Amount) dcrutil.Amount {
+		excess := total - base
+		if txrules.IsDustAmount(excess-changeFee, txsizes.P2PKHPkScriptSize, feeRate) {
+			return inputFees + excess
+		}
+		c := inputFees + changeFee + spendChangeFee
+		if change := excess - changeFee; change < minChange {
+			c += change
+		}
+		return c
+	}
+
+	var (
+		selected []int
+		best     []int
+		bestCost dcrutil.Amount
+		tries    int
+	)
+	var search func(i int, total, inputFees dcrutil.Amount)
+	search = func(i int, total, inputFees dcrutil.Amount) {
+		if tries >= knapsackMaxTries {
+			return
+		}
+		tries++
+
+		// Input fees never decrease as inputs are added, so no
+		// extension of this selection can cost less than the best.
+		if best != nil && inputFees >= bestCost {
+			return
+		}
+		if total >= base {
+			if c := cost(total, inputFees); best == nil || c < bestCost {
+				best = append(best[:0:0], selected...)
+				bestCost = c
+			}
+			// Additional inputs may still reduce the cost by
+			// making uneconomical change economical.
+		}
+		if i == len(candidates) || total+remaining[i] < base {
+			return
+		}
+
+		c := &candidates[i]
+		selected = append(selected, i)
+		search(i+1, total+c.value-c.fee, inputFees+c.fee)
+		selected = selected[:len(selected)-1]
+		search(i+1, total, inputFees)
+	}
+	search(0, 0, 0)
+
+	if best == nil {
+		return nil
+	}
+	unspents := make([]Unspent, 0, len(best))
+	for _, i := range best {
+		unspents = append(unspents, candidates[i].output)
+	}
+	return unspents
+}
+
+// branchAndBound performs the depth first search described by BnBInputSource.
+// It returns nil if no changeless subset was found.
+func branchAndBound(utxos []Unspent, target, feeRate dcrutil.Amount) []Unspent {
+	type candidate struct {
+		output         Unspent
+		effectiveValue dcrutil.Amount
+	}
+
+	// Inputs which cost more to spend than they are worth can never bring
+	// the selection closer to the target and are not considered.
+	candidates := make([]candidate, 0, len(utxos))
+	for i := range utxos {
+		out := utxos[i].TxOut
+		fee := inputFee(feeRate, redeemScriptSize(out.Version, out.PkScript))
+		v := dcrutil.Amount(out.Value) - fee
+		if v <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{utxos[i], v})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].effectiveValue > candidates[j].effectiveValue
+	})
+
+	// remaining[i] records the total effective value of candidates[i:] and
+	// is used to prune branches that can not reach the target.
+	remaining := make([]dcrutil.Amount, len(candidates)+1)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + candidates[i].effectiveValue
+	}
+
+	// Subtracting the fee of the input included by the target results in a
+	// target that does not depend on the number of selected inputs.
+	effTarget := target - inputFee(feeRate, txsizes.RedeemP2PKHSigScriptSize)
+	changeFee := feeRate * txsizes.P2PKHOutputSize / 1000
+	low := effTarget - changeFee
+
+	var (
+		selected  []int
+		best      []int
+		bestTotal dcrutil.Amount
+		tries     int
+	)
+	var search func(i int, total dcrutil.Amount)
+	search = func(i int, total dcrutil.Amount) {
+		if tries >= bnbMaxTries {
+			return
+		}
+		tries++
+
+		if total >= low {
+			// Any additional input would only increase the amount
+			// paid over the target, so this branch ends here.
+			excess := total - effTarget
+			if excess > 0 && !txrules.IsDustAmount(excess,
+				txsizes.P2PKHPkScriptSize, feeRate) {
+				return
+			}
+			if best == nil || total < bestTotal {
+				best = append(best[:0:0], selected...)
+				bestTotal = total
+			}
+			return
+		}
+		if i == len(candidates) || total+remaining[i] < low {
+			return
+		}
+
+		selected = append(selected, i)
+		search(i+1, total+candidates[i].effectiveValue)
+		selected = selected[:len(selected)-1]
+		search(i+1, total)
+	}
+	search(0, 0)
+
+	if best == nil {
+		return nil
+	}
+	unspents := make([]Unspent, 0, len(best))
+	for _, i := range best {
+		unspents = append(unspents, candidates[i].output)
+	}
+	return unspents
+}
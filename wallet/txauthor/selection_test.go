@@ -0,0 +1,864 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestBnBInputSource(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	outputs := p2pkhOutputs(5e7)
+	twoInputs := []int{txsizes.RedeemP2PKHSigScriptSize, txsizes.RedeemP2PKHSigScriptSize}
+	changelessFee := txrules.FeeForSerializeSize(relayFee,
+		txsizes.EstimateSerializeSize(twoInputs, outputs, 0))
+	singleChangelessFee := txrules.FeeForSerializeSize(relayFee,
+		txsizes.EstimateSerializeSize(twoInputs[:1], outputs, 0))
+
+	tests := []struct {
+		UnspentOutputs []*wire.TxOut
+		TotalInput     dcrutil.Amount
+		InputCount     int
+		Change         bool
+		Insufficient   bool
+	}{
+		// Two outputs exactly pay for the target and the fee of a
+		// changeless transaction.
+		0: {
+			UnspentOutputs: p2pkhOutputs(1e8, 3e7+changelessFee, 7e6, 2e7),
+			TotalInput:     5e7 + changelessFee,
+			InputCount:     2,
+		},
+		// A small excess over the changeless fee is dust and is paid
+		// as an additional fee.
+		1: {
+			UnspentOutputs: p2pkhOutputs(1e8, 3e7+changelessFee+100, 7e6, 2e7),
+			TotalInput:     5e7 + changelessFee + 100,
+			InputCount:     2,
+		},
+		// No subset matches the target, so outputs are selected in
+		// order and change is returned.
+		2: {
+			UnspentOutputs: p2pkhOutputs(1e8, 7e6),
+			TotalInput:     1e8,
+			InputCount:     1,
+			Change:         true,
+		},
+		// A subset which nearly matches the target leaves too much
+		// value to be paid as a fee, so outputs are selected in order.
+		3: {
+			UnspentOutputs: p2pkhOutputs(3e7+changelessFee+1e6, 2e7),
+			TotalInput:     5e7 + changelessFee + 1e6,
+			InputCount:     2,
+			Change:         true,
+		},
+		// A single output exactly paying for the target.
+		4: {
+			UnspentOutputs: p2pkhOutputs(5e7 + singleChangelessFee),
+			TotalInput:     5e7 + singleChangelessFee,
+			InputCount:     1,
+		},
+		// A single output which can not pay for the target.
+		5: {
+			UnspentOutputs: p2pkhOutputs(1e7),
+			Insufficient:   true,
+		},
+	}
+
+	for i, test := range tests {
+		inputSource := BnBInputSource(withOutpoints(test.UnspentOutputs), relayFee)
+		tx, err := NewUnsignedTransaction(outputs, relayFee, inputSource,
+			AuthorTestChangeSource{}, chaincfg.MainNetParams().MaxTxSize)
+		if test.Insufficient {
+			if !errors.Is(err, errors.InsufficientBalance) {
+				t.Errorf("Test %d: expected InsufficientBalance, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i, err)
+			continue
+		}
+		if (tx.ChangeIndex >= 0) != test.Change {
+			t.Errorf("Test %d: change index %d, expected change %v", i,
+				tx.ChangeIndex, test.Change)
+		}
+		if tx.TotalInput != test.TotalInput {
+			t.Errorf("Test %d: total input %v, expected %v", i,
+				tx.TotalInput, test.TotalInput)
+		}
+		if len(tx.Tx.TxIn) != test.InputCount {
+			t.Errorf("Test %d: used %d inputs, expected %d", i,
+				len(tx.Tx.TxIn), test.InputCount)
+		}
+	}
+}
+
+func p2shOutputs(amounts ...dcrutil.Amount) []*wire.TxOut {
+	v := make([]*wire.TxOut, 0, len(amounts))
+	for _, a := range amounts {
+		outScript := make([]byte, txsizes.P2SHPkScriptSize)
+		outScript[0] = txscript.OP_HASH160
+		outScript[1] = txscript.OP_DATA_20
+		outScript[22] = txscript.OP_EQUAL
+		v = append(v, wire.NewTxOut(int64(a), outScript))
+	}
+	return v
+}
+
+func TestLargestFirstInputSource(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	outputs := p2pkhOutputs(1.5e8)
+	utxos := append(p2pkhOutputs(1e7, 2e7, 3e7), p2shOutputs(1e8)...)
+	utxos = append(utxos, p2pkhOutputs(5e7, 4e7)...)
+
+	inOrder, err := NewUnsignedTransaction(outputs, relayFee,
+		makeInputSource(utxos), AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	largestFirst, err := NewUnsignedTransaction(outputs, relayFee,
+		NewLargestFirstInputSource(withOutpoints(utxos)), AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(largestFirst.Tx.TxIn) != 3 {
+		t.Errorf("largest first source used %d inputs, expected 3",
+			len(largestFirst.Tx.TxIn))
+	}
+	if len(largestFirst.Tx.TxIn) >= len(inOrder.Tx.TxIn) {
+		t.Errorf("largest first source used %d inputs, in order source used %d",
+			len(largestFirst.Tx.TxIn), len(inOrder.Tx.TxIn))
+	}
+
+	// The P2SH output is the largest and must be spent by the first input
+	// with a P2SH redeem script size accounted for.
+	wantValues := []int64{1e8, 5e7, 4e7}
+	wantSizes := []int{txsizes.RedeemP2SHSigScriptSize,
+		txsizes.RedeemP2PKHSigScriptSize, txsizes.RedeemP2PKHSigScriptSize}
+	for i, in := range largestFirst.Tx.TxIn {
+		if in.ValueIn != wantValues[i] {
+			t.Errorf("input %d has value %v, expected %v", i, in.ValueIn, wantValues[i])
+		}
+	}
+	wantSize := txsizes.EstimateSerializeSize(wantSizes, largestFirst.Tx.TxOut, 0)
+	if largestFirst.EstimatedSignedSerializeSize != wantSize {
+		t.Errorf("estimated size %d, expected %d",
+			largestFirst.EstimatedSignedSerializeSize, wantSize)
+	}
+
+	// The caller's slice must not be reordered.
+	if utxos[0].Value != 1e7 {
+		t.Errorf("input source modified the provided outputs")
+	}
+}
+
+func TestConsolidatingInputSource(t *testing.T) {
+	const (
+		consolidationFeeRate dcrutil.Amount = 1e4
+		maxFeeFraction                      = 0.05
+	)
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	outputs := p2pkhOutputs(5e7)
+
+	// Ten small outputs are worth consolidating at low fee rates, while the
+	// three smallest cost more than five percent of their value to spend.
+	utxos := p2pkhOutputs(1e8)
+	for i := 0; i < 10; i++ {
+		utxos = append(utxos, p2pkhOutputs(1e5)...)
+	}
+	utxos = append(utxos, p2pkhOutputs(500, 500, 500)...)
+
+	tests := []struct {
+		name      string
+		feeRate   dcrutil.Amount
+		maxInputs int
+		inputs    int
+	}{
+		{"low fee rate", 1e3, 0, 11},
+		{"low fee rate, limited inputs", 1e3, 4, 4},
+		{"threshold fee rate", consolidationFeeRate, 0, 1},
+		{"high fee rate", 1e5, 0, 1},
+	}
+	for _, test := range tests {
+		source := NewConsolidatingInputSource(withOutpoints(utxos), test.feeRate,
+			consolidationFeeRate, maxFeeFraction, test.maxInputs)
+		tx, err := NewUnsignedTransaction(outputs, test.feeRate, source,
+			AuthorTestChangeSource{}, maxTxSize)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if len(tx.Tx.TxIn) != test.inputs {
+			t.Errorf("%s: selected %d inputs, expected %d", test.name,
+				len(tx.Tx.TxIn), test.inputs)
+		}
+		for i, in := range tx.Tx.TxIn {
+			if in.ValueIn == 500 {
+				t.Errorf("%s: input %d spends an uneconomical output",
+					test.name, i)
+			}
+		}
+
+		// The consolidated value is returned as change.
+		wantInput := dcrutil.Amount(1e8 + (test.inputs-1)*1e5)
+		if tx.TotalInput != wantInput {
+			t.Errorf("%s: total input %v, expected %v", test.name,
+				tx.TotalInput, wantInput)
+		}
+		change, ok := tx.ChangeOutput()
+		if !ok {
+			t.Fatalf("%s: no change output", test.name)
+		}
+		fee := tx.TotalInput - sumOutputs(tx.Tx.TxOut)
+		if fee != txrules.FeeForSerializeSize(test.feeRate,
+			tx.EstimatedSignedSerializeSize) {
+			t.Errorf("%s: fee %v with change %v", test.name, fee,
+				dcrutil.Amount(change.Value))
+		}
+	}
+
+	// The caller's slice must not be reordered.
+	if utxos[0].Value != 1e8 || utxos[len(utxos)-1].Value != 500 {
+		t.Errorf("input source modified the provided outputs")
+	}
+}
+
+func TestSeededRandomInputSource(t *testing.T) {
+	var utxos []*wire.TxOut
+	for i := 1; i <= 20; i++ {
+		if i%3 == 0 {
+			utxos = append(utxos, p2shOutputs(dcrutil.Amount(i)*1e6)...)
+		} else {
+			utxos = append(utxos, p2pkhOutputs(dcrutil.Amount(i)*1e6)...)
+		}
+	}
+	var total dcrutil.Amount
+	for _, u := range utxos {
+		total += dcrutil.Amount(u.Value)
+	}
+
+	const seed = 0x5eed
+	selections := make([]*InputDetail, 2)
+	for i := range selections {
+		detail, err := NewSeededRandomInputSource(withOutpoints(utxos), seed)(total)
+		if err != nil {
+			t.Fatal(err)
+		}
+		selections[i] = detail
+	}
+	a, b := selections[0], selections[1]
+	if len(a.Inputs) != len(utxos) || len(b.Inputs) != len(utxos) {
+		t.Fatalf("selected %d and %d inputs, expected %d", len(a.Inputs),
+			len(b.Inputs), len(utxos))
+	}
+	inOrder := true
+	for i := range a.Inputs {
+		if a.Inputs[i].ValueIn != b.Inputs[i].ValueIn {
+			t.Errorf("input %d differs between selections with the same seed", i)
+		}
+		if a.Inputs[i].ValueIn != utxos[i].Value {
+			inOrder = false
+		}
+		// Redeem script sizes must remain aligned with the shuffled
+		// inputs.
+		wantSize := txsizes.RedeemP2PKHSigScriptSize
+		if a.Inputs[i].ValueIn%3e6 == 0 {
+			wantSize = txsizes.RedeemP2SHSigScriptSize
+		}
+		if a.RedeemScriptSizes[i] != wantSize {
+			t.Errorf("input %d has redeem script size %d, expected %d", i,
+				a.RedeemScriptSizes[i], wantSize)
+		}
+	}
+	if inOrder {
+		t.Errorf("inputs were not shuffled")
+	}
+	if utxos[0].Value != 1e6 {
+		t.Errorf("input source modified the provided outputs")
+	}
+
+	// Selections of smaller targets are a prefix of the shuffled order.
+	detail, err := NewSeededRandomInputSource(withOutpoints(utxos), seed)(total / 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.Amount < total/2 || len(detail.Inputs) >= len(utxos) {
+		t.Errorf("selected %v in %d inputs for target %v", detail.Amount,
+			len(detail.Inputs), total/2)
+	}
+	for i := range detail.Inputs {
+		if detail.Inputs[i].ValueIn != a.Inputs[i].ValueIn {
+			t.Errorf("input %d is not selected in shuffled order", i)
+		}
+	}
+}
+
+func TestWeightedRandomInputSource(t *testing.T) {
+	// Each output has a distinct value identifying it, and every third
+	// output is P2SH.
+	weights := []float64{1, 2, 3, 4, 0}
+	var utxos []*wire.TxOut
+	for i := range weights {
+		value := dcrutil.Amount(i+1) * 1e6
+		if i%3 == 2 {
+			utxos = append(utxos, p2shOutputs(value)...)
+		} else {
+			utxos = append(utxos, p2pkhOutputs(value)...)
+		}
+	}
+	var total dcrutil.Amount
+	for _, u := range utxos {
+		total += dcrutil.Amount(u.Value)
+	}
+
+	const draws = 20000
+	rnd := rand.New(rand.NewSource(0x5eed))
+	firsts := make([]int, len(utxos))
+	for n := 0; n < draws; n++ {
+		detail, err := NewWeightedRandomInputSource(withOutpoints(utxos), weights, rnd)(total)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(detail.Inputs) != len(utxos) {
+			t.Fatalf("selected %d inputs, expected %d", len(detail.Inputs), len(utxos))
+		}
+		for i, in := range detail.Inputs {
+			// Redeem script sizes must remain aligned with the drawn
+			// inputs.
+			index := int(in.ValueIn/1e6) - 1
+			wantSize := txsizes.RedeemP2PKHSigScriptSize
+			if index%3 == 2 {
+				wantSize = txsizes.RedeemP2SHSigScriptSize
+			}
+			if detail.RedeemScriptSizes[i] != wantSize {
+				t.Fatalf("input %d has redeem script size %d, expected %d", i,
+					detail.RedeemScriptSizes[i], wantSize)
+			}
+		}
+		// The zero weight output is always drawn last.
+		if last := detail.Inputs[len(utxos)-1].ValueIn; last != utxos[4].Value {
+			t.Fatalf("zero weight output not drawn last, last input value %d", last)
+		}
+		firsts[int(detail.Inputs[0].ValueIn/1e6)-1]++
+	}
+
+	// The first drawn output follows the weights.
+	var weightSum float64
+	for _, w := range weights {
+		weightSum += w
+	}
+	for i, w := range weights {
+		got := float64(firsts[i]) / draws
+		want := w / weightSum
+		if math.Abs(got-want) > 0.02 {
+			t.Errorf("output %d drawn first with frequency %.3f, expected %.3f",
+				i, got, want)
+		}
+	}
+
+	// Smaller targets select a prefix of the drawn order.
+	detail, err := NewWeightedRandomInputSource(withOutpoints(utxos), weights,
+		rand.New(rand.NewSource(1)))(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(detail.Inputs) != 1 {
+		t.Errorf("selected %d inputs for a one atom target", len(detail.Inputs))
+	}
+
+	_, err = NewWeightedRandomInputSource(withOutpoints(utxos), weights[:2], rnd)(total)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("mismatched weights: expected Invalid, got %v", err)
+	}
+}
+
+func TestConstrainedInputSource(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+
+	// 2-of-3 multisig redeem script.
+	redeemScript := []byte{txscript.OP_2}
+	for i := 0; i < 3; i++ {
+		pubKey := make([]byte, 33)
+		pubKey[0] = 0x02
+		pubKey[1] = byte(i)
+		redeemScript = append(redeemScript, txscript.OP_DATA_33)
+		redeemScript = append(redeemScript, pubKey...)
+	}
+	redeemScript = append(redeemScript, txscript.OP_3, txscript.OP_CHECKMULTISIG)
+	// Two signatures, OP_PUSHDATA1 <len>, and the redeem script.
+	multisigScriptSize := 2*(1+73) + 2 + len(redeemScript)
+
+	prevOuts := map[wire.OutPoint]*wire.TxOut{
+		{Index: 0}: p2pkhOutputs(1e8)[0],
+		{Index: 1}: p2shOutputs(1e8)[0],
+		{Index: 2}: p2shOutputs(1e8)[0],
+		{Index: 3}: p2pkhOutputs(5e7)[0],
+	}
+	redeemScripts := map[wire.OutPoint][]byte{
+		{Index: 1}: redeemScript,
+	}
+	lookup := func(op wire.OutPoint) (*wire.TxOut, []byte, error) {
+		out, ok := prevOuts[op]
+		if !ok {
+			return nil, nil, errors.E(errors.NotExist, "unknown output")
+		}
+		return out, redeemScripts[op], nil
+	}
+
+	outpoints := []wire.OutPoint{{Index: 3}, {Index: 1}, {Index: 0}, {Index: 2}}
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(1e7), relayFee,
+		ConstrainedInputSource(outpoints, lookup), AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxIn) != len(outpoints) {
+		t.Fatalf("spent %d inputs, expected %d", len(tx.Tx.TxIn), len(outpoints))
+	}
+	for i, in := range tx.Tx.TxIn {
+		if in.PreviousOutPoint != outpoints[i] {
+			t.Errorf("input %d spends %v, expected %v", i,
+				&in.PreviousOutPoint, &outpoints[i])
+		}
+	}
+	scriptSizes := []int{
+		txsizes.RedeemP2PKHSigScriptSize,
+		multisigScriptSize,
+		txsizes.RedeemP2PKHSigScriptSize,
+		txsizes.RedeemP2SHSigScriptSize,
+	}
+	wantSize := txsizes.EstimateSerializeSize(scriptSizes, tx.Tx.TxOut, 0)
+	if tx.EstimatedSignedSerializeSize != wantSize {
+		t.Errorf("estimated size %d, expected %d",
+			tx.EstimatedSignedSerializeSize, wantSize)
+	}
+
+	// Unknown outpoints must be rejected.
+	outpoints = []wire.OutPoint{{Index: 0}, {Index: 4}}
+	_, err = NewUnsignedTransaction(p2pkhOutputs(1e7), relayFee,
+		ConstrainedInputSource(outpoints, lookup), AuthorTestChangeSource{}, maxTxSize)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("expected NotExist for unknown outpoint, got %v", err)
+	}
+}
+
+func TestKnapsackInputSource(t *testing.T) {
+	const (
+		relayFee  dcrutil.Amount = 1e4
+		minChange dcrutil.Amount = 1e6
+	)
+	outputs := p2pkhOutputs(5e7)
+	oneInput := []int{txsizes.RedeemP2PKHSigScriptSize}
+	changelessFee := txrules.FeeForSerializeSize(relayFee,
+		txsizes.EstimateSerializeSize(oneInput, outputs, 0))
+	changeFee := txrules.FeeForSerializeSize(relayFee,
+		txsizes.EstimateSerializeSize(oneInput, outputs, txsizes.P2PKHPkScriptSize))
+
+	tests := []struct {
+		UnspentOutputs []*wire.TxOut
+		TotalInput     dcrutil.Amount
+		Change         bool
+	}{
+		// A changeless output is preferred over a larger output which
+		// creates uneconomical change.
+		0: {
+			UnspentOutputs: p2pkhOutputs(5e7+changeFee+5e5, 5e7+changelessFee),
+			TotalInput:     5e7 + changelessFee,
+		},
+		// Economical change is preferred over uneconomical change.
+		1: {
+			UnspentOutputs: p2pkhOutputs(5e7+changelessFee+5e5, 5e7+changeFee+5e6),
+			TotalInput:     5e7 + changeFee + 5e6,
+			Change:         true,
+		},
+		// Two inputs exactly paying the target are preferred over
+		// uneconomical change.
+		2: {
+			UnspentOutputs: p2pkhOutputs(5e7+changeFee+5e5, 3e7, 2e7+2*changelessFee),
+			TotalInput:     5e7 + 2*changelessFee,
+		},
+	}
+
+	for i, test := range tests {
+		inputSource := NewKnapsackInputSource(withOutpoints(test.UnspentOutputs), relayFee, minChange)
+		tx, err := NewUnsignedTransaction(outputs, relayFee, inputSource,
+			AuthorTestChangeSource{}, chaincfg.MainNetParams().MaxTxSize)
+		if err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i, err)
+			continue
+		}
+		if (tx.ChangeIndex >= 0) != test.Change {
+			t.Errorf("Test %d: change index %d, expected change %v", i,
+				tx.ChangeIndex, test.Change)
+		}
+		if tx.TotalInput != test.TotalInput {
+			t.Errorf("Test %d: total input %v, expected %v", i,
+				tx.TotalInput, test.TotalInput)
+		}
+	}
+}
+
+type fakeUnspentIterator struct {
+	outputs  []Unspent
+	advanced int
+	err      error
+}
+
+func (it *fakeUnspentIterator) NextUnspent() (Unspent, bool, error) {
+	it.advanced++
+	if it.err != nil {
+		return Unspent{}, false, it.err
+	}
+	if it.advanced > len(it.outputs) {
+		return Unspent{}, false, nil
+	}
+	return it.outputs[it.advanced-1], true, nil
+}
+
+func TestStreamingInputSource(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	utxos := p2pkhOutputs(1e7, 1e7, 1e7, 1e7, 1e7, 1e7, 1e7, 1e7)
+
+	// Three inputs pay for the output and fee, so the iterator is only
+	// advanced three times.
+	iter := &fakeUnspentIterator{outputs: withOutpoints(utxos)}
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(2.5e7), relayFee,
+		NewStreamingInputSource(iter), AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxIn) != 3 {
+		t.Errorf("used %d inputs, expected 3", len(tx.Tx.TxIn))
+	}
+	if iter.advanced != 3 {
+		t.Errorf("iterator advanced %d times, expected 3", iter.advanced)
+	}
+
+	// An exhausted iterator is not advanced again.
+	iter = &fakeUnspentIterator{outputs: withOutpoints(utxos)}
+	_, err = NewUnsignedTransaction(p2pkhOutputs(1e8), relayFee,
+		NewStreamingInputSource(iter), AuthorTestChangeSource{}, maxTxSize)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("expected InsufficientBalance, got %v", err)
+	}
+	if iter.advanced != len(utxos)+1 {
+		t.Errorf("iterator advanced %d times, expected %d", iter.advanced,
+			len(utxos)+1)
+	}
+
+	// Iterator errors are returned.
+	iter = &fakeUnspentIterator{err: errors.E(errors.IO)}
+	_, err = NewUnsignedTransaction(p2pkhOutputs(1e7), relayFee,
+		NewStreamingInputSource(iter), AuthorTestChangeSource{}, maxTxSize)
+	if !errors.Is(err, errors.IO) {
+		t.Errorf("expected IO error, got %v", err)
+	}
+}
+
+func TestMinConfInputSource(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	const currentHeight = 100
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	utxos := p2pkhOutputs(1e8, 2e7, 3e7, 4e7)
+	candidates := []Candidate{
+		{TxOut: utxos[0], BlockHeight: -1},  // unmined
+		{TxOut: utxos[1], BlockHeight: 100}, // 1 confirmation
+		{TxOut: utxos[2], BlockHeight: 95},  // 6 confirmations
+		{TxOut: utxos[3], BlockHeight: 50},  // 51 confirmations
+	}
+
+	tests := []struct {
+		minConf      int32
+		output       dcrutil.Amount
+		inputValues  []int64
+		insufficient bool
+	}{
+		0: {minConf: 0, output: 5e7, inputValues: []int64{1e8}},
+		1: {minConf: 1, output: 5e7, inputValues: []int64{2e7, 3e7, 4e7}},
+		2: {minConf: 6, output: 5e7, inputValues: []int64{3e7, 4e7}},
+		// The remaining outputs can not pay for the target, and outputs
+		// without enough confirmations are not selected to make up
+		// the difference.
+		3: {minConf: 6, output: 8e7, insufficient: true},
+		4: {minConf: 52, output: 1e6, insufficient: true},
+	}
+	for i, test := range tests {
+		src := NewMinConfInputSource(candidates, currentHeight, test.minConf)
+		tx, err := NewUnsignedTransaction(p2pkhOutputs(test.output), relayFee,
+			src, AuthorTestChangeSource{}, maxTxSize)
+		if test.insufficient {
+			if !errors.Is(err, errors.InsufficientBalance) {
+				t.Errorf("test %d: expected InsufficientBalance, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: %v", i, err)
+			continue
+		}
+		if len(tx.Tx.TxIn) != len(test.inputValues) {
+			t.Errorf("test %d: used %d inputs, expected %d", i,
+				len(tx.Tx.TxIn), len(test.inputValues))
+			continue
+		}
+		for j, in := range tx.Tx.TxIn {
+			if in.ValueIn != test.inputValues[j] {
+				t.Errorf("test %d: input %d has value %v, expected %v",
+					i, j, in.ValueIn, test.inputValues[j])
+			}
+		}
+	}
+}
+
+func TestMinConfInputSourceMaturity(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	const currentHeight = 1000
+	params := chaincfg.MainNetParams()
+	coinbaseMaturity := int32(params.CoinbaseMaturity)
+	ticketChangeMaturity := int32(params.SStxChangeMaturity)
+	utxos := p2pkhOutputs(1e8, 2e7, 3e7, 4e7, 5e7, 6e7)
+	candidates := []Candidate{
+		// Unmined
+		{TxOut: utxos[0], BlockHeight: -1},
+		// Immature and mature coinbase
+		{TxOut: utxos[1], BlockHeight: currentHeight - coinbaseMaturity + 1, Maturity: coinbaseMaturity},
+		{TxOut: utxos[2], BlockHeight: currentHeight - coinbaseMaturity, Maturity: coinbaseMaturity},
+		// 3 confirmations
+		{TxOut: utxos[3], BlockHeight: currentHeight - 2},
+		// Immature ticket change
+		{TxOut: utxos[4], BlockHeight: currentHeight - ticketChangeMaturity + 1, Maturity: ticketChangeMaturity},
+		// Unmined coinbase never matures
+		{TxOut: utxos[5], BlockHeight: -1, Maturity: coinbaseMaturity},
+	}
+
+	tests := []struct {
+		minConf      int32
+		output       dcrutil.Amount
+		inputValues  []int64
+		insufficient bool
+	}{
+		0: {minConf: 0, output: 1.5e8, inputValues: []int64{1e8, 3e7, 4e7}},
+		1: {minConf: 1, output: 6e7, inputValues: []int64{3e7, 4e7}},
+		2: {minConf: 4, output: 2e7, inputValues: []int64{3e7}},
+		// Immature outputs are not selected to make up the difference.
+		3: {minConf: 1, output: 7e7, insufficient: true},
+		4: {minConf: 0, output: 1.8e8, insufficient: true},
+	}
+	for i, test := range tests {
+		src := NewMinConfInputSource(candidates, currentHeight, test.minConf)
+		tx, err := NewUnsignedTransaction(p2pkhOutputs(test.output), relayFee,
+			src, AuthorTestChangeSource{}, params.MaxTxSize)
+		if test.insufficient {
+			if !errors.Is(err, errors.InsufficientBalance) {
+				t.Errorf("test %d: expected InsufficientBalance, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: %v", i, err)
+			continue
+		}
+		if len(tx.Tx.TxIn) != len(test.inputValues) {
+			t.Errorf("test %d: used %d inputs, expected %d", i,
+				len(tx.Tx.TxIn), len(test.inputValues))
+			continue
+		}
+		for j, in := range tx.Tx.TxIn {
+			if in.ValueIn != test.inputValues[j] {
+				t.Errorf("test %d: input %d has value %v, expected %v",
+					i, j, in.ValueIn, test.inputValues[j])
+			}
+		}
+	}
+}
+
+func TestInputSourceOutpoints(t *testing.T) {
+	const feeRate dcrutil.Amount = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	utxos := withOutpoints(p2pkhOutputs(1e8, 2e7, 3e7, 4e7))
+	outpoints := make(map[int64]wire.OutPoint, len(utxos))
+	candidates := make([]Candidate, 0, len(utxos))
+	for _, u := range utxos {
+		outpoints[u.TxOut.Value] = u.OutPoint
+		candidates = append(candidates, Candidate{OutPoint: u.OutPoint,
+			TxOut: u.TxOut, BlockHeight: 1})
+	}
+
+	// Inputs created by every source spend the outpoint of the selected
+	// output.
+	tests := []struct {
+		name   string
+		source InputSource
+	}{
+		{"bnb", BnBInputSource(utxos, feeRate)},
+		{"largest first", NewLargestFirstInputSource(utxos)},
+		{"consolidating", NewConsolidatingInputSource(utxos, feeRate, 2*feeRate, 0.5, 0)},
+		{"seeded random", NewSeededRandomInputSource(utxos, 1)},
+		{"weighted random", NewWeightedRandomInputSource(utxos,
+			[]float64{1, 1, 1, 1}, rand.New(rand.NewSource(1)))},
+		{"min conf", NewMinConfInputSource(candidates, 100, 1)},
+		{"streaming", NewStreamingInputSource(&fakeUnspentIterator{outputs: utxos})},
+		{"knapsack", NewKnapsackInputSource(utxos, feeRate, 0)},
+	}
+	for _, test := range tests {
+		tx, err := NewUnsignedTransaction(p2pkhOutputs(1.5e8), feeRate,
+			test.source, AuthorTestChangeSource{}, maxTxSize)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		for i, in := range tx.Tx.TxIn {
+			op, ok := outpoints[in.ValueIn]
+			if !ok || in.PreviousOutPoint != op {
+				t.Errorf("%s: input %d spends %v, expected %v", test.name, i,
+					&in.PreviousOutPoint, &op)
+			}
+			if tx.PrevOutpoints[i] != in.PreviousOutPoint {
+				t.Errorf("%s: previous outpoint %d is %v, expected %v",
+					test.name, i, &tx.PrevOutpoints[i], &in.PreviousOutPoint)
+			}
+		}
+	}
+}
+
+func TestGrindedInputSource(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e4
+	const numInputs = 100
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	utxos := make([]*wire.TxOut, 0, numInputs)
+	for i := 0; i < numInputs; i++ {
+		utxos = append(utxos, p2pkhOutputs(1e6)...)
+	}
+	outputs := p2pkhOutputs(numInputs*1e6 - 1e6)
+
+	worst, err := NewUnsignedTransaction(outputs, relayFee,
+		makeInputSource(utxos), AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	grinded, err := NewUnsignedTransaction(outputs, relayFee,
+		GrindedInputSource(makeInputSource(utxos)), AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(worst.Tx.TxIn) != numInputs || len(grinded.Tx.TxIn) != numInputs {
+		t.Fatalf("transactions spend %d and %d inputs, expected %d",
+			len(worst.Tx.TxIn), len(grinded.Tx.TxIn), numInputs)
+	}
+
+	// Each grinded signature script is estimated one byte smaller.
+	sizeDiff := worst.EstimatedSignedSerializeSize - grinded.EstimatedSignedSerializeSize
+	wantDiff := numInputs * (txsizes.RedeemP2PKHSigScriptSize -
+		txsizes.RedeemP2PKHSigScriptSizeGrinded)
+	if sizeDiff != wantDiff {
+		t.Errorf("estimated sizes differ by %d bytes, expected %d", sizeDiff, wantDiff)
+	}
+
+	// The fee saved by the smaller estimate is returned as change.
+	worstFee := txrules.FeeForSerializeSize(relayFee, worst.EstimatedSignedSerializeSize)
+	grindedFee := txrules.FeeForSerializeSize(relayFee, grinded.EstimatedSignedSerializeSize)
+	if worstFee <= grindedFee {
+		t.Fatalf("grinded fee %v is not less than worst case fee %v",
+			grindedFee, worstFee)
+	}
+	change := func(tx *AuthoredTx) dcrutil.Amount {
+		return dcrutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
+	}
+	if diff := change(grinded) - change(worst); diff != worstFee-grindedFee {
+		t.Errorf("change differs by %v, expected %v", diff, worstFee-grindedFee)
+	}
+	if want := relayFee * dcrutil.Amount(wantDiff) / 1000; worstFee-grindedFee != want {
+		t.Errorf("fees differ by %v, expected %v", worstFee-grindedFee, want)
+	}
+
+	// P2SH inputs are not adjusted.
+	p2sh, err := GrindedInputSource(NewLargestFirstInputSource(withOutpoints(p2shOutputs(1e8))))(1e8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p2sh.RedeemScriptSizes[0] != txsizes.RedeemP2SHSigScriptSize {
+		t.Errorf("P2SH input estimated with script size %d, expected %d",
+			p2sh.RedeemScriptSizes[0], txsizes.RedeemP2SHSigScriptSize)
+	}
+}
+
+func TestEffectiveValueInputSource(t *testing.T) {
+	const relayFee dcrutil.Amount = 1e5
+	inputFee := (relayFee*dcrutil.Amount(txsizes.EstimateInputSize(
+		txsizes.RedeemP2PKHSigScriptSize)) + 999) / 1000
+	dust := inputFee / 2
+	utxos := p2pkhOutputs(dust, 3e6, dust, 2e6)
+
+	tests := []struct {
+		target dcrutil.Amount
+		values []int64
+	}{
+		{2e6, []int64{3e6}},
+		{5e6, []int64{3e6, 2e6}},
+		{5e6 + 1, []int64{3e6, 2e6, int64(dust)}},
+		{dcrutil.MaxAmount, []int64{3e6, 2e6, int64(dust), int64(dust)}},
+	}
+	source := EffectiveValueInputSource(makeInputSource(utxos), relayFee)
+	for i, test := range tests {
+		detail, err := source(test.target)
+		if err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		}
+		values := make([]int64, len(detail.Inputs))
+		for j, in := range detail.Inputs {
+			values[j] = in.ValueIn
+		}
+		if len(values) != len(test.values) {
+			t.Errorf("test %d: selected inputs %v, expected %v", i, values, test.values)
+			continue
+		}
+		var amount dcrutil.Amount
+		for j := range values {
+			if values[j] != test.values[j] {
+				t.Errorf("test %d: selected inputs %v, expected %v", i, values,
+					test.values)
+				break
+			}
+			amount += dcrutil.Amount(values[j])
+		}
+		if detail.Amount != amount {
+			t.Errorf("test %d: input amount %v, expected %v", i, detail.Amount, amount)
+		}
+		if len(detail.Scripts) != len(values) || len(detail.RedeemScriptSizes) != len(values) {
+			t.Errorf("test %d: %d scripts and %d script sizes for %d inputs", i,
+				len(detail.Scripts), len(detail.RedeemScriptSizes), len(values))
+		}
+	}
+
+	// Transactions funded by the economical outputs do not spend dust.
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+	tx, err := NewUnsignedTransaction(p2pkhOutputs(4e6), relayFee,
+		EffectiveValueInputSource(makeInputSource(utxos), relayFee),
+		AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxIn) != 2 {
+		t.Fatalf("spent %d inputs, expected 2", len(tx.Tx.TxIn))
+	}
+	for _, in := range tx.Tx.TxIn {
+		if dcrutil.Amount(in.ValueIn) == dust {
+			t.Errorf("spent uneconomical input of value %v", dust)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestSignInputSignatureTypes(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	secrets := &testSecrets{keys: make(map[string][]byte), params: params}
+	keyBytes := make([]byte, 32)
+	keyBytes[31] = 1
+	key := secp256k1.PrivKeyFromBytes(keyBytes)
+	pkh := dcrutil.Hash160(key.PubKey().SerializeCompressed())
+
+	// pkScript returns the P2PKH script paying to the key using signatures
+	// of sigType, and records the key for the address.
+	pkScript := func(sigType dcrec.SignatureType) []byte {
+		addr, err := dcrutil.NewAddressPubKeyHash(pkh, params, sigType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		secrets.keys[addr.String()] = key.Serialize()
+		return script
+	}
+	ecdsaScript := pkScript(dcrec.STEcdsaSecp256k1)
+	schnorrScript := pkScript(dcrec.STSchnorrSecp256k1)
+	if txscript.GetScriptClass(0, schnorrScript) != txscript.PubkeyHashAltTy {
+		t.Fatalf("schnorr script is not P2PKH-alt")
+	}
+
+	tests := []struct {
+		name     string
+		pkScript []byte
+		sigType  dcrec.SignatureType
+		invalid  bool
+	}{
+		{"ecdsa p2pkh", ecdsaScript, dcrec.STEcdsaSecp256k1, false},
+		{"schnorr p2pkh-alt", schnorrScript, dcrec.STSchnorrSecp256k1, false},
+		{"schnorr p2pkh", ecdsaScript, dcrec.STSchnorrSecp256k1, true},
+		{"ecdsa p2pkh-alt", schnorrScript, dcrec.STEcdsaSecp256k1, true},
+		{"ed25519 p2pkh-alt", schnorrScript, dcrec.STEd25519, true},
+	}
+	for _, test := range tests {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8-1e5, ecdsaScript))
+		err := AddAllInputScripts(tx, [][]byte{test.pkScript}, secrets, test.sigType)
+		if test.invalid {
+			if !errors.Is(err, errors.Invalid) {
+				t.Errorf("%s: expected Invalid, got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		vm, err := txscript.NewEngine(test.pkScript, tx, 0,
+			txscript.ScriptVerifyCleanStack, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Errorf("%s: signed script is invalid: %v", test.name, err)
+		}
+	}
+}
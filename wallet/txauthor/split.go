@@ -0,0 +1,111 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// NewSplitTransaction creates an unsigned transaction spending every output
+// provided by inputSource to pieces outputs of equal value paying to
+// destScript.  The fee, calculated at relayFee for the estimated signed size
+// of the transaction, is subtracted from the input value before it is divided
+// between the outputs.  The remainder of the division is returned to a change
+// output created with changeSource, unless the change would be dust, in which
+// case it is added to the fee.
+//
+// Inputs are requested from inputSource with a target of dcrutil.MaxAmount and
+// any errors.InsufficientBalance error from the source is ignored.  If the
+// inputs can not pay the fee and pieces non-dust outputs, an error with kind
+// errors.InsufficientBalance is returned.  An error with kind errors.Invalid
+// is returned if pieces is less than one.
+func NewSplitTransaction(op errors.Op, pieces int, destScript []byte, relayFee dcrutil.Amount,
+	inputSource InputSource, changeSource ChangeSource) (*AuthoredTx, error) {
+
+	if pieces < 1 {
+		return nil, errors.E(op, errors.Invalid, "split transaction requires at least one output")
+	}
+	inputDetail, err := inputSource(dcrutil.MaxAmount)
+	if err != nil && !errors.Is(err, errors.InsufficientBalance) {
+		return nil, errors.E(op, err)
+	}
+	if inputDetail == nil || len(inputDetail.Inputs) == 0 {
+		return nil, errors.E(op, errors.InsufficientBalance, "no inputs to split")
+	}
+
+	outputs := make([]*wire.TxOut, pieces)
+	for i := range outputs {
+		outputs[i] = &wire.TxOut{Version: 0, PkScript: destScript}
+	}
+	scriptSizes := inputDetail.RedeemScriptSizes
+	size := txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+	fee := txrules.FeeForSerializeSize(relayFee, size)
+	if inputDetail.Amount <= fee {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			"inputs can not pay the transaction fee")
+	}
+	piece := (inputDetail.Amount - fee) / dcrutil.Amount(pieces)
+	if txrules.IsDustAmount(piece, len(destScript), relayFee) {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			"split outputs would be dust")
+	}
+	for _, out := range outputs {
+		out.Value = int64(piece)
+	}
+
+	txVersion, err := applySequences(inputDetail)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	tx := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion,
+		TxIn:     inputDetail.Inputs,
+		TxOut:    outputs,
+		LockTime: 0,
+		Expiry:   0,
+	}
+
+	changeIndex := -1
+	changeScriptSize := changeSource.ScriptSize()
+	changeSize := txsizes.EstimateSerializeSize(scriptSizes, outputs, changeScriptSize)
+	change := inputDetail.Amount - piece*dcrutil.Amount(pieces) -
+		txrules.FeeForSerializeSize(relayFee, changeSize)
+	if change > 0 && !txrules.IsDustAmount(change, changeScriptSize, relayFee) {
+		script, version, err := changeSource.Script()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(script) != changeScriptSize {
+			return nil, errors.E(op, errors.Invalid,
+				errChangeScriptSize(script, changeScriptSize))
+		}
+		changeIndex = len(outputs)
+		tx.TxOut = append(outputs, &wire.TxOut{
+			Value:    int64(change),
+			Version:  version,
+			PkScript: script,
+		})
+		size = changeSize
+	}
+	if size > maxStandardTxSize {
+		return nil, errors.E(op, errors.TooManyInputs,
+			"signed tx size exceeds allowed maximum")
+	}
+
+	return &AuthoredTx{
+		Tx:                           tx,
+		PrevScripts:                  inputDetail.Scripts,
+		PrevAccounts:                 inputDetail.Accounts,
+		PrevOutpoints:                prevOutpoints(inputDetail.Inputs),
+		TotalInput:                   inputDetail.Amount,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: size,
+	}, nil
+}
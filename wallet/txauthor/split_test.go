@@ -0,0 +1,111 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestNewSplitTransaction(t *testing.T) {
+	const op errors.Op = "test"
+	destScript := make([]byte, txsizes.P2PKHPkScriptSize)
+
+	tests := []struct {
+		UnspentOutputs []*wire.TxOut
+		RelayFee       dcrutil.Amount
+		Pieces         int
+		Change         bool
+	}{
+		0: {p2pkhOutputs(1e8), 1e4, 5, false},
+		1: {p2pkhOutputs(1e8, 3e7, 1234567), 1e4, 7, false},
+		2: {p2pkhOutputs(1e8), 1e4, 1, false},
+		// Without a relay fee, the division remainder is not dust and is
+		// returned as change.
+		3: {p2pkhOutputs(1e8 + 3), 0, 5, true},
+	}
+	for i, test := range tests {
+		tx, err := NewSplitTransaction(op, test.Pieces, destScript, test.RelayFee,
+			makeInputSource(test.UnspentOutputs), AuthorTestChangeSource{})
+		if err != nil {
+			t.Errorf("test %d: %v", i, err)
+			continue
+		}
+		wantOutputs := test.Pieces
+		if test.Change {
+			wantOutputs++
+		}
+		if len(tx.Tx.TxOut) != wantOutputs {
+			t.Errorf("test %d: created %d outputs, expected %d", i,
+				len(tx.Tx.TxOut), wantOutputs)
+			continue
+		}
+		if (tx.ChangeIndex >= 0) != test.Change {
+			t.Errorf("test %d: change index %d", i, tx.ChangeIndex)
+		}
+
+		var pieces, change dcrutil.Amount
+		for j, out := range tx.Tx.TxOut {
+			if j == tx.ChangeIndex {
+				change = dcrutil.Amount(out.Value)
+				continue
+			}
+			if out.Value != tx.Tx.TxOut[0].Value {
+				t.Errorf("test %d: output %d value %v differs from %v", i, j,
+					out.Value, tx.Tx.TxOut[0].Value)
+			}
+			if txrules.IsDustOutput(out, test.RelayFee) {
+				t.Errorf("test %d: output %d is dust", i, j)
+			}
+			pieces += dcrutil.Amount(out.Value)
+		}
+
+		// The pieces, change, and fee sum to the input total, and the fee
+		// pays for the estimated size, plus any remainder which would be
+		// dust as change.
+		fee := tx.TotalInput - pieces - change
+		if pieces+change+fee != tx.TotalInput {
+			t.Errorf("test %d: pieces %v, change %v and fee %v do not sum to "+
+				"input %v", i, pieces, change, fee, tx.TotalInput)
+		}
+		minFee := txrules.FeeForSerializeSize(test.RelayFee, tx.EstimatedSignedSerializeSize)
+		if fee < minFee || fee-minFee >= dcrutil.Amount(test.Pieces) {
+			t.Errorf("test %d: fee %v, expected %v plus less than %d atoms", i,
+				fee, minFee, test.Pieces)
+		}
+		scriptSizes := make([]int, len(tx.Tx.TxIn))
+		for j := range scriptSizes {
+			scriptSizes[j] = txsizes.RedeemP2PKHSigScriptSize
+		}
+		size := txsizes.EstimateSerializeSize(scriptSizes, tx.Tx.TxOut, 0)
+		if tx.EstimatedSignedSerializeSize != size {
+			t.Errorf("test %d: estimated size %d, expected %d", i,
+				tx.EstimatedSignedSerializeSize, size)
+		}
+	}
+
+	// Pieces which would be dust are rejected.
+	_, err := NewSplitTransaction(op, 200, destScript, 1e4,
+		makeInputSource(p2pkhOutputs(1e6)), AuthorTestChangeSource{})
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("dust pieces: expected InsufficientBalance, got %v", err)
+	}
+	_, err = NewSplitTransaction(op, 0, destScript, 1e4,
+		makeInputSource(p2pkhOutputs(1e8)), AuthorTestChangeSource{})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero pieces: expected Invalid, got %v", err)
+	}
+	_, err = NewSplitTransaction(op, 2, destScript, 1e4,
+		makeInputSource(nil), AuthorTestChangeSource{})
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("no inputs: expected InsufficientBalance, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// NewUnsignedSweepTransaction creates an unsigned transaction spending every
+// output provided by fetchInputs to a single output paying to outScript.  The
+// fee is subtracted from the value of the output, and no change output is
+// created.
+//
+// Inputs are requested from fetchInputs with a target of dcrutil.MaxAmount and
+// any errors.InsufficientBalance error from the source is ignored.  If the
+// total input value can not pay the fee and a non-dust output, an error with
+// kind errors.InsufficientBalance is returned.
+func NewUnsignedSweepTransaction(op errors.Op, outScript []byte, relayFeePerKb dcrutil.Amount,
+	fetchInputs InputSource) (*AuthoredTx, error) {
+
+	inputDetail, err := fetchInputs(dcrutil.MaxAmount)
+	if err != nil && !errors.Is(err, errors.InsufficientBalance) {
+		return nil, errors.E(op, err)
+	}
+	if inputDetail == nil || len(inputDetail.Inputs) == 0 {
+		return nil, errors.E(op, errors.InsufficientBalance, "no inputs to sweep")
+	}
+	return newSweepTransaction(op, inputDetail, outScript, relayFeePerKb)
+}
+
+// SweepOutputs creates an unsigned transaction spending every output of
+// outputs to a single output paying to destScript.  The fee, calculated at
+// relayFee for the estimated signed size of the transaction, is subtracted
+// from the value of the destination output, and no change output is created.
+// If the total output value can not pay the fee and a non-dust output, an
+// error with kind errors.InsufficientBalance is returned.
+func SweepOutputs(op errors.Op, outputs []Unspent, destScript []byte,
+	relayFee dcrutil.Amount) (*AuthoredTx, error) {
+
+	if len(outputs) == 0 {
+		return nil, errors.E(op, errors.InsufficientBalance, "no outputs to sweep")
+	}
+	return newSweepTransaction(op, makeInputDetail(outputs), destScript, relayFee)
+}
+
+// newSweepTransaction creates an unsigned transaction spending every input of
+// inputDetail to a single output paying to outScript, less the fee.
+func newSweepTransaction(op errors.Op, inputDetail *InputDetail, outScript []byte,
+	relayFeePerKb dcrutil.Amount) (*AuthoredTx, error) {
+
+	output := &wire.TxOut{
+		Version:  0,
+		PkScript: outScript,
+	}
+	outputs := []*wire.TxOut{output}
+	size := txsizes.EstimateSerializeSize(inputDetail.RedeemScriptSizes, outputs, 0)
+	fee := txrules.FeeForSerializeSize(relayFeePerKb, size)
+	if inputDetail.Amount <= fee {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			"inputs can not pay the transaction fee")
+	}
+	output.Value = int64(inputDetail.Amount - fee)
+	if txrules.IsDustOutput(output, relayFeePerKb) {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			"swept output would be dust")
+	}
+
+	txVersion, err := applySequences(inputDetail)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	tx := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion,
+		TxIn:     inputDetail.Inputs,
+		TxOut:    outputs,
+		LockTime: 0,
+		Expiry:   0,
+	}
+	return &AuthoredTx{
+		Tx:                           tx,
+		PrevScripts:                  inputDetail.Scripts,
+		PrevAccounts:                 inputDetail.Accounts,
+		PrevOutpoints:                prevOutpoints(inputDetail.Inputs),
+		TotalInput:                   inputDetail.Amount,
+		ChangeIndex:                  -1,
+		EstimatedSignedSerializeSize: size,
+	}, nil
+}
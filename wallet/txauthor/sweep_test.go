@@ -0,0 +1,181 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"bytes"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestNewUnsignedSweepTransaction(t *testing.T) {
+	const op errors.Op = "test"
+	p2pkh := make([]byte, txsizes.P2PKHPkScriptSize)
+	sweepFee := func(relayFee dcrutil.Amount, inputs int) dcrutil.Amount {
+		scriptSizes := make([]int, inputs)
+		for i := range scriptSizes {
+			scriptSizes[i] = txsizes.RedeemP2PKHSigScriptSize
+		}
+		outputs := []*wire.TxOut{wire.NewTxOut(0, p2pkh)}
+		size := txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+		return txrules.FeeForSerializeSize(relayFee, size)
+	}
+
+	tests := []struct {
+		UnspentOutputs      []*wire.TxOut
+		RelayFee            dcrutil.Amount
+		InsufficientBalance bool
+	}{
+		0: {
+			UnspentOutputs: p2pkhOutputs(1e8),
+			RelayFee:       1e3,
+		},
+		1: {
+			UnspentOutputs: p2pkhOutputs(1e8, 1e8, 1e6),
+			RelayFee:       1e4,
+		},
+		2: {
+			UnspentOutputs: p2pkhOutputs(1e8),
+			RelayFee:       0,
+		},
+		// Inputs that can not cover the fee.
+		3: {
+			UnspentOutputs:      p2pkhOutputs(sweepFee(1e4, 1)),
+			RelayFee:            1e4,
+			InsufficientBalance: true,
+		},
+		// No inputs.
+		4: {
+			UnspentOutputs:      nil,
+			RelayFee:            1e4,
+			InsufficientBalance: true,
+		},
+	}
+
+	for i, test := range tests {
+		inputSource := makeInputSource(test.UnspentOutputs)
+		tx, err := NewUnsignedSweepTransaction(op, p2pkh, test.RelayFee, inputSource)
+		if test.InsufficientBalance {
+			if !errors.Is(err, errors.InsufficientBalance) {
+				t.Errorf("Test %d: expected InsufficientBalance, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i, err)
+			continue
+		}
+		if len(tx.Tx.TxIn) != len(test.UnspentOutputs) {
+			t.Errorf("Test %d: spent %d inputs, expected %d", i,
+				len(tx.Tx.TxIn), len(test.UnspentOutputs))
+		}
+		if len(tx.Tx.TxOut) != 1 || tx.ChangeIndex != -1 {
+			t.Errorf("Test %d: created %d outputs with change index %d, "+
+				"expected a single output without change", i,
+				len(tx.Tx.TxOut), tx.ChangeIndex)
+			continue
+		}
+		var total dcrutil.Amount
+		for _, u := range test.UnspentOutputs {
+			total += dcrutil.Amount(u.Value)
+		}
+		want := total - sweepFee(test.RelayFee, len(test.UnspentOutputs))
+		if got := dcrutil.Amount(tx.Tx.TxOut[0].Value); got != want {
+			t.Errorf("Test %d: swept %v, expected %v", i, got, want)
+		}
+	}
+}
+
+func TestSweepOutputs(t *testing.T) {
+	const op errors.Op = "test"
+	const relayFee dcrutil.Amount = 1e4
+	dest := make([]byte, txsizes.P2PKHPkScriptSize)
+	p2sh := make([]byte, txsizes.P2SHPkScriptSize)
+	p2sh[0] = txscript.OP_HASH160
+	p2sh[1] = txscript.OP_DATA_20
+	p2sh[len(p2sh)-1] = txscript.OP_EQUAL
+
+	tests := []struct {
+		name        string
+		outputs     []*wire.TxOut
+		scriptSizes []int
+	}{{
+		name:        "single",
+		outputs:     p2pkhOutputs(1e8),
+		scriptSizes: []int{txsizes.RedeemP2PKHSigScriptSize},
+	}, {
+		name: "many",
+		outputs: append(p2pkhOutputs(1e8, 2e6, 3e5),
+			wire.NewTxOut(5e7, p2sh)),
+		scriptSizes: []int{
+			txsizes.RedeemP2PKHSigScriptSize,
+			txsizes.RedeemP2PKHSigScriptSize,
+			txsizes.RedeemP2PKHSigScriptSize,
+			txsizes.RedeemP2SHSigScriptSize,
+		},
+	}}
+	for _, test := range tests {
+		tx, err := SweepOutputs(op, withOutpoints(test.outputs), dest, relayFee)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if len(tx.Tx.TxIn) != len(test.outputs) {
+			t.Errorf("%s: spent %d inputs, expected %d", test.name,
+				len(tx.Tx.TxIn), len(test.outputs))
+		}
+		for i, out := range test.outputs {
+			if !bytes.Equal(tx.PrevScripts[i], out.PkScript) {
+				t.Errorf("%s: input %d previous script %x, expected %x",
+					test.name, i, tx.PrevScripts[i], out.PkScript)
+			}
+		}
+		if len(tx.Tx.TxOut) != 1 || tx.ChangeIndex != -1 {
+			t.Errorf("%s: created %d outputs with change index %d, "+
+				"expected a single output without change", test.name,
+				len(tx.Tx.TxOut), tx.ChangeIndex)
+			continue
+		}
+		if !bytes.Equal(tx.Tx.TxOut[0].PkScript, dest) {
+			t.Errorf("%s: destination script %x, expected %x", test.name,
+				tx.Tx.TxOut[0].PkScript, dest)
+		}
+
+		var total dcrutil.Amount
+		for _, out := range test.outputs {
+			total += dcrutil.Amount(out.Value)
+		}
+		size := txsizes.EstimateSerializeSize(test.scriptSizes, tx.Tx.TxOut, 0)
+		if tx.EstimatedSignedSerializeSize != size {
+			t.Errorf("%s: estimated size %d, expected %d", test.name,
+				tx.EstimatedSignedSerializeSize, size)
+		}
+		want := total - txrules.FeeForSerializeSize(relayFee, size)
+		if got := dcrutil.Amount(tx.Tx.TxOut[0].Value); got != want {
+			t.Errorf("%s: swept %v, expected %v", test.name, got, want)
+		}
+		if tx.TotalInput != total {
+			t.Errorf("%s: total input %v, expected %v", test.name, tx.TotalInput, total)
+		}
+	}
+
+	// Outputs which can not pay the fee are insufficient.
+	fee := txrules.FeeForSerializeSize(relayFee, txsizes.EstimateSerializeSize(
+		[]int{txsizes.RedeemP2PKHSigScriptSize}, []*wire.TxOut{wire.NewTxOut(0, dest)}, 0))
+	for _, outputs := range [][]*wire.TxOut{nil, p2pkhOutputs(fee), p2pkhOutputs(fee - 1)} {
+		_, err := SweepOutputs(op, withOutpoints(outputs), dest, relayFee)
+		if !errors.Is(err, errors.InsufficientBalance) {
+			t.Errorf("sweeping %d outputs: expected InsufficientBalance, got %v",
+				len(outputs), err)
+		}
+	}
+}
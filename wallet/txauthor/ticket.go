@@ -0,0 +1,170 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"encoding/binary"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/blockchain/stake/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// commitmentP2SHFlag is set in the encoded amount of a ticket commitment
+// script when the commitment pays to a script hash.
+const commitmentP2SHFlag = 1 << 63
+
+// zeroTicketChangeScript is the OP_SSTXCHANGE tagged P2PKH script paying to
+// the zero hash, used by ticket change outputs without value.
+var zeroTicketChangeScript = func() []byte {
+	s := make([]byte, txsizes.TicketChangeScriptSize)
+	s[0] = txscript.OP_SSTXCHANGE
+	s[1] = txscript.OP_DUP
+	s[2] = txscript.OP_HASH160
+	s[3] = txscript.OP_DATA_20
+	s[24] = txscript.OP_EQUALVERIFY
+	s[25] = txscript.OP_CHECKSIG
+	return s
+}()
+
+// NewTicketPurchaseTx creates an unsigned ticket purchase (SStx) paying
+// ticketPrice to votingScript, an OP_SSTX tagged output script.  The ticket
+// spends a single input from inputSource and has the following outputs:
+//
+//   - the stake submission output paying ticketPrice to votingScript
+//   - a commitment to the contributed amount, the ticket price and fee
+//   - an OP_SSTXCHANGE tagged change output
+//
+// commitmentScript is a ticket commitment script, as created by
+// txscript.GenerateSStxAddrPush, which provides the reward address and fee
+// limits of the commitment.  Its amount is replaced with the input value less
+// any change.  The change script is created with changeSource, which must
+// provide P2PKH or P2SH scripts, and is tagged with OP_SSTXCHANGE.  Change
+// that would be dust is added to the fee, and the change output instead pays
+// nothing to the zero pubkey hash.  The fee is calculated at relayFee for the
+// estimated signed size of the ticket.
+//
+// Each input of a ticket requires its own commitment, so inputSource must meet
+// the target with a single input, such as an output of a split transaction.
+// An error with kind errors.Invalid is returned if more than one input is
+// selected or if any script is not of the expected form.  If the input can not
+// pay for the ticket price and fee, an error with kind
+// errors.InsufficientBalance is returned.
+func NewTicketPurchaseTx(op errors.Op, ticketPrice, relayFee dcrutil.Amount,
+	votingScript, commitmentScript []byte, inputSource InputSource,
+	changeSource ChangeSource) (*AuthoredTx, error) {
+
+	if txscript.GetScriptClass(0, votingScript) != txscript.StakeSubmissionTy {
+		return nil, errors.E(op, errors.Invalid, "voting script is not a stake submission script")
+	}
+	if len(commitmentScript) != txsizes.TicketCommitmentScriptSize ||
+		commitmentScript[0] != txscript.OP_RETURN ||
+		commitmentScript[1] != txscript.OP_DATA_30 {
+		return nil, errors.E(op, errors.Invalid, "invalid ticket commitment script")
+	}
+
+	// Fees are estimated for the larger of the change script provided by
+	// changeSource and the zero change script, so the fee is sufficient
+	// for either.
+	changeScriptSize := 1 + changeSource.ScriptSize()
+	estChangeScriptSize := changeScriptSize
+	if estChangeScriptSize < len(zeroTicketChangeScript) {
+		estChangeScriptSize = len(zeroTicketChangeScript)
+	}
+	outputs := []*wire.TxOut{
+		{Value: int64(ticketPrice), Version: 0, PkScript: votingScript},
+		{Value: 0, Version: 0, PkScript: make([]byte, len(commitmentScript))},
+		{Value: 0, Version: 0, PkScript: make([]byte, estChangeScriptSize)},
+	}
+	estSize := txsizes.EstimateSerializeSize([]int{txsizes.RedeemP2PKHSigScriptSize},
+		outputs, 0)
+	fee := txrules.FeeForSerializeSize(relayFee, estSize)
+
+	inputDetail, err := inputSource(ticketPrice + fee)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	switch {
+	case len(inputDetail.Inputs) == 0 || inputDetail.Amount < ticketPrice+fee:
+		return nil, errors.E(op, errors.InsufficientBalance,
+			"input can not pay the ticket price and fee")
+	case len(inputDetail.Inputs) != 1:
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("ticket purchase "+
+			"requires a single input, selected %d", len(inputDetail.Inputs)))
+	}
+
+	// Recalculate the fee for the redeem script size of the selected input.
+	scriptSizes := inputDetail.RedeemScriptSizes
+	estSize = txsizes.EstimateSerializeSize(scriptSizes, outputs, 0)
+	fee = txrules.FeeForSerializeSize(relayFee, estSize)
+	if inputDetail.Amount < ticketPrice+fee {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			"input can not pay the ticket price and fee")
+	}
+
+	changeIndex := -1
+	change := inputDetail.Amount - ticketPrice - fee
+	changeOutput := &wire.TxOut{Value: 0, Version: 0, PkScript: zeroTicketChangeScript}
+	if change > 0 && !txrules.IsDustAmount(change, changeScriptSize, relayFee) {
+		script, version, err := changeSource.Script()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(script) != changeScriptSize-1 {
+			return nil, errors.E(op, errors.Invalid,
+				errChangeScriptSize(script, changeScriptSize-1))
+		}
+		tagged := make([]byte, 0, changeScriptSize)
+		tagged = append(tagged, txscript.OP_SSTXCHANGE)
+		tagged = append(tagged, script...)
+		if version != 0 || txscript.GetScriptClass(version, tagged) != txscript.StakeSubChangeTy {
+			return nil, errors.E(op, errors.Invalid,
+				"change script is not a P2PKH or P2SH script")
+		}
+		changeIndex = 2
+		changeOutput = &wire.TxOut{Value: int64(change), Version: version, PkScript: tagged}
+	} else {
+		change = 0
+	}
+
+	// The commitment is to the contribution of the input, which pays the
+	// ticket price and the fee.  The P2SH flag of the encoded amount is
+	// preserved.
+	commitment := make([]byte, len(commitmentScript))
+	copy(commitment, commitmentScript)
+	encoded := binary.LittleEndian.Uint64(commitment[22:30])
+	committed := uint64(inputDetail.Amount-change) | encoded&commitmentP2SHFlag
+	binary.LittleEndian.PutUint64(commitment[22:30], committed)
+
+	tx := &wire.MsgTx{
+		SerType: wire.TxSerializeFull,
+		Version: generatedTxVersion,
+		TxIn:    inputDetail.Inputs,
+		TxOut: []*wire.TxOut{
+			{Value: int64(ticketPrice), Version: 0, PkScript: votingScript},
+			{Value: 0, Version: 0, PkScript: commitment},
+			changeOutput,
+		},
+		LockTime: 0,
+		Expiry:   0,
+	}
+	if err := stake.CheckSStx(tx); err != nil {
+		return nil, errors.E(op, errors.Bug, err)
+	}
+
+	return &AuthoredTx{
+		Tx:                           tx,
+		PrevScripts:                  inputDetail.Scripts,
+		PrevAccounts:                 inputDetail.Accounts,
+		PrevOutpoints:                prevOutpoints(inputDetail.Inputs),
+		TotalInput:                   inputDetail.Amount,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: txsizes.EstimateSerializeSize(scriptSizes, tx.TxOut, 0),
+	}, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"bytes"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	. "decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/blockchain/stake/v3"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestNewTicketPurchaseTx(t *testing.T) {
+	const (
+		op          errors.Op      = "test"
+		ticketPrice dcrutil.Amount = 1e8
+		relayFee    dcrutil.Amount = 1e4
+		limits                     = 0x5800
+	)
+	params := chaincfg.MainNetParams()
+	voteAddr, err := dcrutil.NewAddressPubKeyHash(bytes.Repeat([]byte{1}, 20),
+		params, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	votingScript, err := txscript.PayToSStx(voteAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2pkhReward, err := dcrutil.NewAddressPubKeyHash(bytes.Repeat([]byte{2}, 20),
+		params, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2shReward, err := dcrutil.NewAddressScriptHashFromHash(bytes.Repeat([]byte{3}, 20),
+		params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeAddr, err := dcrutil.NewAddressPubKeyHash(bytes.Repeat([]byte{4}, 20),
+		params, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeSource := fixedChangeSource(changeScript)
+
+	// ticketSize and fee are the size and fee of a ticket spending one P2PKH
+	// input.
+	ticketSize := txsizes.EstimateSerializeSize(
+		[]int{txsizes.RedeemP2PKHSigScriptSize}, []*wire.TxOut{
+			{PkScript: votingScript},
+			{PkScript: make([]byte, txsizes.TicketCommitmentScriptSize)},
+			{PkScript: make([]byte, txsizes.TicketChangeScriptSize)},
+		}, 0)
+	fee := txrules.FeeForSerializeSize(relayFee, ticketSize)
+
+	tests := []struct {
+		name   string
+		input  dcrutil.Amount
+		reward dcrutil.Address
+		change bool
+	}{
+		{"p2pkh commitment", 2e8, p2pkhReward, true},
+		{"p2sh commitment", 2e8, p2shReward, true},
+		{"exact input", ticketPrice + fee, p2pkhReward, false},
+		{"dust change", ticketPrice + fee + 100, p2pkhReward, false},
+	}
+	for _, test := range tests {
+		commitmentScript, err := txscript.GenerateSStxAddrPush(test.reward, 0, limits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := NewTicketPurchaseTx(op, ticketPrice, relayFee, votingScript,
+			commitmentScript, makeInputSource(p2pkhOutputs(test.input)),
+			changeSource)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !stake.IsSStx(tx.Tx) {
+			t.Errorf("%s: authored transaction is not a ticket", test.name)
+		}
+		if len(tx.Tx.TxIn) != 1 || len(tx.Tx.TxOut) != 3 {
+			t.Errorf("%s: ticket has %d inputs and %d outputs, expected 1 and 3",
+				test.name, len(tx.Tx.TxIn), len(tx.Tx.TxOut))
+			continue
+		}
+
+		// The stake submission output pays the ticket price to the
+		// voting script.
+		submission := tx.Tx.TxOut[0]
+		if submission.Value != int64(ticketPrice) || !bytes.Equal(submission.PkScript, votingScript) {
+			t.Errorf("%s: stake submission output pays %v to %x", test.name,
+				submission.Value, submission.PkScript)
+		}
+
+		// The change output is OP_SSTXCHANGE tagged, and pays nothing
+		// when the change would be dust.
+		changeOut := tx.Tx.TxOut[2]
+		if class := txscript.GetScriptClass(changeOut.Version, changeOut.PkScript); class != txscript.StakeSubChangeTy {
+			t.Errorf("%s: change output script class %v", test.name, class)
+		}
+		if (tx.ChangeIndex == 2) != test.change || (changeOut.Value != 0) != test.change {
+			t.Errorf("%s: change index %d with value %v, expected change %v",
+				test.name, tx.ChangeIndex, changeOut.Value, test.change)
+		}
+		if test.change && !bytes.Equal(changeOut.PkScript[1:], changeScript) {
+			t.Errorf("%s: change script %x does not pay to change source script %x",
+				test.name, changeOut.PkScript, changeScript)
+		}
+
+		// The commitment is to the input value less change, retaining
+		// the reward address and fee limits.
+		contribution := tx.TotalInput - dcrutil.Amount(changeOut.Value)
+		wantCommitment, err := txscript.GenerateSStxAddrPush(test.reward,
+			contribution, limits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if commitment := tx.Tx.TxOut[1]; commitment.Value != 0 ||
+			!bytes.Equal(commitment.PkScript, wantCommitment) {
+			t.Errorf("%s: commitment output %x, expected %x", test.name,
+				commitment.PkScript, wantCommitment)
+		}
+
+		// The fee pays for the estimated size, and is only increased by
+		// change which would be dust.
+		size := txsizes.EstimateSerializeSize([]int{txsizes.RedeemP2PKHSigScriptSize},
+			tx.Tx.TxOut, 0)
+		if tx.EstimatedSignedSerializeSize != size || size != ticketSize {
+			t.Errorf("%s: estimated size %d, expected %d", test.name,
+				tx.EstimatedSignedSerializeSize, size)
+		}
+		actualFee := contribution - ticketPrice
+		if actualFee != tx.Fee() {
+			t.Errorf("%s: fee %v does not match authored fee %v", test.name,
+				actualFee, tx.Fee())
+		}
+		minFee := txrules.FeeForSerializeSize(relayFee, size)
+		if actualFee < minFee || (test.change && actualFee != minFee) {
+			t.Errorf("%s: fee %v, expected %v", test.name, actualFee, minFee)
+		}
+	}
+
+	commitmentScript, err := txscript.GenerateSStxAddrPush(p2pkhReward, 0, limits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewTicketPurchaseTx(op, ticketPrice, relayFee, votingScript,
+		commitmentScript, makeInputSource(p2pkhOutputs(ticketPrice)),
+		changeSource)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("insufficient input: expected InsufficientBalance, got %v", err)
+	}
+	_, err = NewTicketPurchaseTx(op, ticketPrice, relayFee, votingScript,
+		commitmentScript, makeInputSource(p2pkhOutputs(6e7, 6e7)),
+		changeSource)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("multiple inputs: expected Invalid, got %v", err)
+	}
+	_, err = NewTicketPurchaseTx(op, ticketPrice, relayFee, make([]byte, 25),
+		commitmentScript, makeInputSource(p2pkhOutputs(2e8)),
+		changeSource)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("untagged voting script: expected Invalid, got %v", err)
+	}
+	_, err = NewTicketPurchaseTx(op, ticketPrice, relayFee, votingScript,
+		votingScript, makeInputSource(p2pkhOutputs(2e8)),
+		changeSource)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("invalid commitment script: expected Invalid, got %v", err)
+	}
+	_, err = NewTicketPurchaseTx(op, ticketPrice, relayFee, votingScript,
+		commitmentScript, makeInputSource(p2pkhOutputs(2e8)),
+		AuthorTestChangeSource{})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("nonstandard change script: expected Invalid, got %v", err)
+	}
+}
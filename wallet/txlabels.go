@@ -0,0 +1,80 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// SetTransactionLabel labels a wallet transaction, replacing any previous
+// label.  An empty label clears the transaction's label.  Labels may not exceed
+// udb.MaxTxLabelLen bytes.
+func (w *Wallet) SetTransactionLabel(ctx context.Context, txHash *chainhash.Hash, label string) error {
+	const op errors.Op = "wallet.SetTransactionLabel"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.SetTxLabel(ns, txHash, label)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// TransactionLabel returns the label of a wallet transaction, or the empty
+// string if the transaction is not labeled.
+func (w *Wallet) TransactionLabel(ctx context.Context, txHash *chainhash.Hash) (string, error) {
+	const op errors.Op = "wallet.TransactionLabel"
+	var label string
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		label = w.TxStore.TxLabel(ns, txHash)
+		return nil
+	})
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+	return label, nil
+}
+
+// TransactionsByLabel returns summaries of all wallet transactions with a
+// label matching label exactly, ordered by their timestamp.
+func (w *Wallet) TransactionsByLabel(ctx context.Context, label string) ([]TransactionSummary, error) {
+	const op errors.Op = "wallet.TransactionsByLabel"
+	if label == "" {
+		return nil, errors.E(op, errors.Invalid, "empty label")
+	}
+	var txs []TransactionSummary
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.ForEachTxLabel(ns, func(txHash *chainhash.Hash, l string) error {
+			if l != label {
+				return nil
+			}
+			details, err := w.TxStore.TxDetails(ns, txHash)
+			if errors.Is(err, errors.NotExist) {
+				// Labels of removed transactions are ignored.
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			txs = append(txs, makeTxSummary(dbtx, w, details))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].Timestamp < txs[j].Timestamp
+	})
+	return txs, nil
+}
@@ -6,6 +6,8 @@
 package txrules
 
 import (
+	"math"
+
 	"decred.org/dcrwallet/errors"
 	"github.com/decred/dcrd/dcrutil/v3"
 	"github.com/decred/dcrd/txscript/v3"
@@ -15,28 +17,94 @@ import (
 // DefaultRelayFeePerKb is the default minimum relay fee policy for a mempool.
 const DefaultRelayFeePerKb dcrutil.Amount = 1e4
 
+// DustThresholdPolicy describes the smallest output value which is not
+// considered dust.  Transactions with dust outputs are not standard and are
+// rejected by mempools enforcing the policy.
+type DustThresholdPolicy interface {
+	// DustAmount returns the smallest value of an output with a script of
+	// scriptSize bytes that is not dust given the relay fee.
+	DustAmount(scriptSize int, relayFeePerKb dcrutil.Amount) dcrutil.Amount
+}
+
+// DefaultDustPolicy is the DustThresholdPolicy of mempools with default
+// policies.
+type DefaultDustPolicy struct{}
+
+// DustAmount returns the smallest value of an output with a script of
+// scriptSize bytes that is not dust given the relay fee.
+func (DefaultDustPolicy) DustAmount(scriptSize int, relayFeePerKb dcrutil.Amount) dcrutil.Amount {
+	// Dust is defined as an output value where the total cost to the network
+	// (output size + input size) is greater than 1/3 of the relay fee.  The
+	// threshold is rounded up so that every smaller value is dust.
+	totalSize := dustCostSize(scriptSize)
+	return (3*dcrutil.Amount(totalSize)*relayFeePerKb + 999) / 1000
+}
+
+// DustMultiplierPolicy is a DustThresholdPolicy which scales the cost of an
+// output to the network by a multiplier rather than the standard factor of 3.
+// This may be used by wallets relaying through nodes with non-default dust
+// policies.  A multiplier of 0 considers no output values dust.
+type DustMultiplierPolicy float64
+
+// DustAmount returns the smallest value of an output with a script of
+// scriptSize bytes that is not dust given the relay fee.
+func (m DustMultiplierPolicy) DustAmount(scriptSize int, relayFeePerKb dcrutil.Amount) dcrutil.Amount {
+	if m <= 0 {
+		return 0
+	}
+	totalSize := dustCostSize(scriptSize)
+	threshold := float64(m) * float64(totalSize) * float64(relayFeePerKb) / 1000
+	return dcrutil.Amount(math.Ceil(threshold))
+}
+
+// dustCostSize returns the size used to calculate the total (estimated) cost
+// to the network of an output.  This is calculated using the serialize size
+// of the output plus the serial size of a transaction input which redeems it.
+// The output is assumed to be compressed P2PKH as this is the most common
+// script type.  Use the average size of a compressed P2PKH redeem input (165)
+// rather than the largest possible (txsizes.RedeemP2PKHInputSize).
+func dustCostSize(scriptSize int) int {
+	return 8 + 2 + wire.VarIntSerializeSize(uint64(scriptSize)) +
+		scriptSize + 165
+}
+
 // IsDustAmount determines whether a transaction output value and script length would
 // cause the output to be considered dust.  Transactions with dust outputs are
 // not standard and are rejected by mempools with default policies.
 func IsDustAmount(amount dcrutil.Amount, scriptSize int, relayFeePerKb dcrutil.Amount) bool {
-	// Calculate the total (estimated) cost to the network.  This is
-	// calculated using the serialize size of the output plus the serial
-	// size of a transaction input which redeems it.  The output is assumed
-	// to be compressed P2PKH as this is the most common script type.  Use
-	// the average size of a compressed P2PKH redeem input (165) rather than
-	// the largest possible (txsizes.RedeemP2PKHInputSize).
-	totalSize := 8 + 2 + wire.VarIntSerializeSize(uint64(scriptSize)) +
-		scriptSize + 165
+	return IsDustAmountPolicy(DefaultDustPolicy{}, amount, scriptSize, relayFeePerKb)
+}
 
-	// Dust is defined as an output value where the total cost to the network
-	// (output size + input size) is greater than 1/3 of the relay fee.
-	return int64(amount)*1000/(3*int64(totalSize)) < int64(relayFeePerKb)
+// IsDustAmountPolicy determines whether a transaction output value and script
+// length would cause the output to be considered dust by a dust threshold
+// policy.
+func IsDustAmountPolicy(policy DustThresholdPolicy, amount dcrutil.Amount,
+	scriptSize int, relayFeePerKb dcrutil.Amount) bool {
+
+	return amount < policy.DustAmount(scriptSize, relayFeePerKb)
+}
+
+// IsDustAmountWithThreshold determines whether a transaction output value and
+// script length would cause the output to be considered dust when the standard
+// dust factor of 3 is replaced by dustMultiplier.  A multiplier of 3.0 is
+// equivalent to IsDustAmount, and a multiplier of 0.0 considers no amount dust.
+func IsDustAmountWithThreshold(amount dcrutil.Amount, scriptSize int,
+	relayFeePerKb dcrutil.Amount, dustMultiplier float64) bool {
+
+	return IsDustAmountPolicy(DustMultiplierPolicy(dustMultiplier), amount,
+		scriptSize, relayFeePerKb)
 }
 
 // IsDustOutput determines whether a transaction output is considered dust.
 // Transactions with dust outputs are not standard and are rejected by mempools
 // with default policies.
 func IsDustOutput(output *wire.TxOut, relayFeePerKb dcrutil.Amount) bool {
+	return IsDustOutputPolicy(DefaultDustPolicy{}, output, relayFeePerKb)
+}
+
+// IsDustOutputPolicy determines whether a transaction output is considered
+// dust by a dust threshold policy.
+func IsDustOutputPolicy(policy DustThresholdPolicy, output *wire.TxOut, relayFeePerKb dcrutil.Amount) bool {
 	// Unspendable outputs which solely carry data are not checked for dust.
 	if txscript.GetScriptClass(output.Version, output.PkScript) == txscript.NullDataTy {
 		return false
@@ -47,8 +115,8 @@ func IsDustOutput(output *wire.TxOut, relayFeePerKb dcrutil.Amount) bool {
 		return true
 	}
 
-	return IsDustAmount(dcrutil.Amount(output.Value), len(output.PkScript),
-		relayFeePerKb)
+	return IsDustAmountPolicy(policy, dcrutil.Amount(output.Value),
+		len(output.PkScript), relayFeePerKb)
 }
 
 // CheckOutput performs simple consensus and policy tests on a transaction
@@ -83,6 +151,77 @@ func FeeForSerializeSize(relayFeePerKb dcrutil.Amount, txSerializeSize int) dcru
 	return fee
 }
 
+// FeeForSerializeSizeCeil calculates the required fee for a transaction of
+// some arbitrary size given a mempool's relay fee policy.  Unlike
+// FeeForSerializeSize, which truncates any fractional atom, the fee is rounded
+// up so that the fee rate paid is never less than the relay fee.  As with
+// FeeForSerializeSize, a nonzero relay fee is the minimum fee.
+func FeeForSerializeSizeCeil(relayFeePerKb dcrutil.Amount, txSerializeSize int) dcrutil.Amount {
+	fee := (relayFeePerKb*dcrutil.Amount(txSerializeSize) + 999) / 1000
+
+	if fee == 0 && relayFeePerKb > 0 {
+		fee = relayFeePerKb
+	}
+
+	if fee < 0 || fee > dcrutil.MaxAmount {
+		fee = dcrutil.MaxAmount
+	}
+
+	return fee
+}
+
+// FeeTier describes the fee rate of a tier of a fee schedule.  The rate
+// applies to every byte of a transaction beyond MinSize bytes, up to the
+// MinSize of the next tier.
+type FeeTier struct {
+	MinSize  int
+	FeePerKb dcrutil.Amount
+}
+
+// FeeForSerializeSizeSchedule calculates the fee for a transaction of some
+// arbitrary size using a piecewise fee schedule.  Each byte of the transaction
+// is charged the rate of the tier it falls in, so larger transactions pay
+// higher rates only for the bytes beyond a tier's minimum size.  The tiers
+// must be ordered by increasing MinSize, and bytes below the MinSize of the
+// first tier are charged at the first tier's rate.  As with
+// FeeForSerializeSize, any fractional atom is truncated, and the fee is never
+// less than the first tier's rate for a single kilobyte.  An empty schedule
+// results in no fee.
+func FeeForSerializeSizeSchedule(schedule []FeeTier, txSerializeSize int) dcrutil.Amount {
+	if len(schedule) == 0 {
+		return 0
+	}
+
+	// Sum the fees of each tier before dividing by the kilobyte size so
+	// that fractional atoms are only truncated once.
+	var feeKb dcrutil.Amount
+	for i := range schedule {
+		start := schedule[i].MinSize
+		if i == 0 {
+			start = 0
+		}
+		end := txSerializeSize
+		if i+1 < len(schedule) && schedule[i+1].MinSize < end {
+			end = schedule[i+1].MinSize
+		}
+		if end <= start {
+			continue
+		}
+		feeKb += schedule[i].FeePerKb * dcrutil.Amount(end-start)
+	}
+	fee := feeKb / 1000
+
+	if fee == 0 && schedule[0].FeePerKb > 0 {
+		fee = schedule[0].FeePerKb
+	}
+
+	if fee < 0 || fee > dcrutil.MaxAmount {
+		fee = dcrutil.MaxAmount
+	}
+
+	return fee
+}
+
 func sumOutputValues(outputs []*wire.TxOut) (totalOutput dcrutil.Amount) {
 	for _, txOut := range outputs {
 		totalOutput += dcrutil.Amount(txOut.Value)
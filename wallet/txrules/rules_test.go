@@ -0,0 +1,143 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txrules_test
+
+import (
+	"testing"
+
+	. "decred.org/dcrwallet/wallet/txrules"
+	"github.com/decred/dcrd/dcrutil/v3"
+)
+
+func TestIsDustAmountWithThreshold(t *testing.T) {
+	const (
+		relayFee   dcrutil.Amount = 1e4
+		scriptSize                = 25
+	)
+	// The cost of a P2PKH output and the input redeeming it is 201 bytes.
+	standard := DefaultDustPolicy{}.DustAmount(scriptSize, relayFee)
+	if standard != 6030 {
+		t.Fatalf("standard dust amount %v, expected 6030", standard)
+	}
+
+	tests := []struct {
+		multiplier float64
+		threshold  dcrutil.Amount
+	}{
+		{1.0, 2010},
+		{3.0, standard},
+		{0.0, 0},
+	}
+	for _, test := range tests {
+		amounts := []dcrutil.Amount{0, test.threshold - 1, test.threshold,
+			test.threshold + 1, 1e8}
+		for _, amount := range amounts {
+			if amount < 0 {
+				continue
+			}
+			want := amount < test.threshold
+			got := IsDustAmountWithThreshold(amount, scriptSize, relayFee,
+				test.multiplier)
+			if got != want {
+				t.Errorf("multiplier %v amount %v: dust %v, expected %v",
+					test.multiplier, amount, got, want)
+			}
+		}
+		p := DustMultiplierPolicy(test.multiplier)
+		if got := p.DustAmount(scriptSize, relayFee); got != test.threshold {
+			t.Errorf("multiplier %v: dust amount %v, expected %v",
+				test.multiplier, got, test.threshold)
+		}
+	}
+
+	// A multiplier of 3 must agree with the default policy.
+	for amount := standard - 2; amount <= standard+2; amount++ {
+		if IsDustAmountWithThreshold(amount, scriptSize, relayFee, 3.0) !=
+			IsDustAmount(amount, scriptSize, relayFee) {
+			t.Errorf("amount %v: multiplier 3 disagrees with default policy", amount)
+		}
+	}
+}
+
+func TestFeeForSerializeSizeCeil(t *testing.T) {
+	relayFees := []dcrutil.Amount{1e3, 2.55e3, 1e4, 1234, 1}
+	for _, relayFee := range relayFees {
+		// Both variants charge the relay fee as a minimum.
+		if fee := FeeForSerializeSizeCeil(relayFee, 0); fee != FeeForSerializeSize(relayFee, 0) {
+			t.Fatalf("relay fee %v: minimum fee %v, expected %v", relayFee,
+				fee, FeeForSerializeSize(relayFee, 0))
+		}
+		for size := 1; size <= 5000; size++ {
+			fee := FeeForSerializeSizeCeil(relayFee, size)
+			exact := relayFee * dcrutil.Amount(size)
+			if fee*1000 < exact {
+				t.Fatalf("relay fee %v size %d: fee %v underpays", relayFee, size, fee)
+			}
+			if (fee-1)*1000 >= exact {
+				t.Fatalf("relay fee %v size %d: fee %v overpays", relayFee, size, fee)
+			}
+			if truncated := FeeForSerializeSize(relayFee, size); exact >= 1000 &&
+				fee-truncated > 1 {
+				t.Fatalf("relay fee %v size %d: fee %v differs from truncated "+
+					"fee %v by more than one atom", relayFee, size, fee, truncated)
+			}
+		}
+	}
+}
+
+func TestFeeForSerializeSizeSchedule(t *testing.T) {
+	// Bytes beyond 1000 pay twice the rate.
+	schedule := []FeeTier{
+		{MinSize: 0, FeePerKb: 1e4},
+		{MinSize: 1000, FeePerKb: 2e4},
+	}
+	tests := []struct {
+		size int
+		fee  dcrutil.Amount
+	}{
+		{0, 1e4}, // minimum fee
+		{1, 1e4}, // minimum fee
+		{250, 2500},
+		{999, 9990},
+		{1000, 1e4},
+		{1001, 1e4 + 20},
+		{1500, 1e4 + 1e4},
+		{2345, 1e4 + 26900},
+	}
+	for _, test := range tests {
+		fee := FeeForSerializeSizeSchedule(schedule, test.size)
+		if fee != test.fee {
+			t.Errorf("size %d: fee %v, expected %v", test.size, fee, test.fee)
+		}
+	}
+
+	// A schedule with a single tier is equivalent to FeeForSerializeSize.
+	single := []FeeTier{{FeePerKb: 1234}}
+	for size := 1; size <= 5000; size++ {
+		fee := FeeForSerializeSizeSchedule(single, size)
+		if want := FeeForSerializeSize(1234, size); fee != want {
+			t.Fatalf("single tier size %d: fee %v, expected %v", size, fee, want)
+		}
+	}
+
+	// The fee across a tier boundary never decreases with size, and each
+	// byte beyond the boundary costs the higher rate.
+	for size := 900; size < 1100; size++ {
+		fee := FeeForSerializeSizeSchedule(schedule, size)
+		next := FeeForSerializeSizeSchedule(schedule, size+1)
+		rate := schedule[0].FeePerKb
+		if size >= 1000 {
+			rate = schedule[1].FeePerKb
+		}
+		if diff := next - fee; diff != rate/1000 {
+			t.Fatalf("size %d: fee increased by %v, expected %v", size,
+				diff, rate/1000)
+		}
+	}
+
+	if fee := FeeForSerializeSizeSchedule(nil, 1000); fee != 0 {
+		t.Errorf("empty schedule: fee %v, expected 0", fee)
+	}
+}
@@ -27,6 +27,19 @@ const (
 	//   - 33 bytes serialized compressed pubkey
 	RedeemP2PKHSigScriptSize = 1 + 73 + 1 + 33
 
+	// RedeemP2PKHSigScriptSizeGrinded is the largest serialize size of a
+	// transaction input script that redeems a compressed P2PKH output with a
+	// canonical low-S signature, as created by the wallet.  A low-S value
+	// never requires a padding byte, so the DER signature is at most 71
+	// bytes, and is 70 bytes when R does not require padding either.  It is
+	// calculated as:
+	//
+	//   - OP_DATA_72
+	//   - 71 bytes DER signature + 1 byte sighash
+	//   - OP_DATA_33
+	//   - 33 bytes serialized compressed pubkey
+	RedeemP2PKHSigScriptSizeGrinded = 1 + 72 + 1 + 33
+
 	// RedeemP2SHSigScriptSize is the worst case (largest) serialize size
 	// of a transaction input script that redeems a P2SH output.
 	// It is calculated as:
@@ -84,6 +97,33 @@ const (
 	//   - 2 byte fee range limits
 	TicketCommitmentScriptSize = 1 + 1 + 20 + 8 + 2
 
+	// TicketCommitmentOutputSize is the serialize size of a ticket purchase
+	// commitment output.  It is calculated as:
+	//
+	//   - 8 bytes output value
+	//   - 2 bytes version
+	//   - 1 byte compact int encoding value 32
+	//   - 32 bytes ticket commitment script
+	TicketCommitmentOutputSize = 8 + 2 + 1 + TicketCommitmentScriptSize
+
+	// TicketChangeScriptSize is the size of an OP_SSTXCHANGE tagged ticket
+	// purchase change script paying to a compressed pubkey hash.  It is
+	// calculated as:
+	//
+	//   - OP_SSTXCHANGE
+	//   - 25 bytes P2PKH output script
+	TicketChangeScriptSize = 1 + P2PKHPkScriptSize
+
+	// TicketChangeOutputSize is the serialize size of an OP_SSTXCHANGE
+	// tagged ticket purchase change output paying to a compressed pubkey
+	// hash.  It is calculated as:
+	//
+	//   - 8 bytes output value
+	//   - 2 bytes version
+	//   - 1 byte compact int encoding value 26
+	//   - 26 bytes ticket change script
+	TicketChangeOutputSize = 8 + 2 + 1 + TicketChangeScriptSize
+
 	// P2PKHOutputSize is the serialize size of a transaction output with a
 	// P2PKH output script.  It is calculated as:
 	//
@@ -92,8 +132,129 @@ const (
 	//   - 1 byte compact int encoding value 25
 	//   - 25 bytes P2PKH output script
 	P2PKHOutputSize = 8 + 2 + 1 + 25
+
+	// P2SHOutputSize is the serialize size of a transaction output with a
+	// P2SH output script.  It is calculated as:
+	//
+	//   - 8 bytes output value
+	//   - 2 bytes version
+	//   - 1 byte compact int encoding value 23
+	//   - 23 bytes P2SH output script
+	P2SHOutputSize = 8 + 2 + 1 + 23
+
+	// MultisigSigPushSize is the worst case (largest) size of a single
+	// signature push in a signature script redeeming a multisig script.  It
+	// is calculated as:
+	//
+	//   - OP_DATA_73
+	//   - 72 bytes DER signature + 1 byte sighash
+	MultisigSigPushSize = 1 + 73
+
+	// MultisigPubKeyPushSize is the size of a single compressed pubkey push
+	// in a multisig script.  It is calculated as:
+	//
+	//   - OP_DATA_33
+	//   - 33 bytes serialized compressed pubkey
+	MultisigPubKeyPushSize = 1 + 33
 )
 
+// MultisigScriptSize returns the size of an m-of-n multisig script with n
+// compressed pubkeys.  It is calculated as:
+//
+//   - OP_m
+//   - n pubkey pushes
+//   - OP_n
+//   - OP_CHECKMULTISIG
+func MultisigScriptSize(n int) int {
+	return 1 + n*MultisigPubKeyPushSize + 1 + 1
+}
+
+// EstimateBareMultisigOutputSize returns the serialize size of a transaction
+// output paying directly to a bare m-of-n multisig script with n compressed
+// pubkeys.  The size does not depend on the number of required signatures.
+// It is calculated as:
+//
+//   - 8 bytes output value
+//   - 2 bytes version
+//   - the compact int representation of the script size
+//   - the m-of-n multisig script
+func EstimateBareMultisigOutputSize(n int) int {
+	return EstimateOutputSize(MultisigScriptSize(n))
+}
+
+// NullDataScriptSize returns the size of a null data (OP_RETURN) output script
+// carrying a payload of n bytes.  It is calculated as:
+//
+//   - OP_RETURN
+//   - the opcodes canonically pushing n bytes of data
+//   - n bytes of data
+//
+// Single byte payloads which are pushed by a small integer opcode are one byte
+// smaller than the returned size.
+func NullDataScriptSize(n int) int {
+	return 1 + DataPushSize(n) + n
+}
+
+// EstimateNullDataOutputSize returns the serialize size of a transaction
+// output with a null data script carrying a payload of n bytes.  It is
+// calculated as:
+//
+//   - 8 bytes output value
+//   - 2 bytes version
+//   - the compact int representation of the script size
+//   - the null data script
+func EstimateNullDataOutputSize(n int) int {
+	return EstimateOutputSize(NullDataScriptSize(n))
+}
+
+// RedeemP2SHMultisigSigScriptSize returns the worst case (largest) serialize
+// size of a transaction input script that redeems a P2SH output paying to an
+// m-of-n multisig script with compressed pubkeys.  It is calculated as:
+//
+//   - m signature pushes
+//   - the canonical push of the multisig redeem script
+//   - the m-of-n multisig redeem script
+func RedeemP2SHMultisigSigScriptSize(m, n int) int {
+	redeemScriptSize := MultisigScriptSize(n)
+	return m*MultisigSigPushSize + DataPushSize(redeemScriptSize) +
+		redeemScriptSize
+}
+
+// RedeemAtomicSwapSigScriptSize returns the worst case (largest) serialize
+// size of a transaction input script that redeems a P2SH output paying to an
+// atomic swap contract by revealing a secret of secretSize bytes.  It is
+// calculated as:
+//
+//   - OP_DATA_73
+//   - 72 bytes DER signature + 1 byte sighash
+//   - OP_DATA_33
+//   - 33 bytes serialized compressed pubkey
+//   - the canonical push of the secret
+//   - the secret
+//   - OP_TRUE
+//   - the canonical push of the contract script
+//   - the contract script
+func RedeemAtomicSwapSigScriptSize(contractSize, secretSize int) int {
+	return 1 + 73 + 1 + 33 + DataPushSize(secretSize) + secretSize + 1 +
+		DataPushSize(contractSize) + contractSize
+}
+
+// DataPushSize returns the size of the opcodes required to canonically push
+// data of length n.  Data of length zero or one which would be pushed by a
+// small integer opcode is counted as a data push.
+func DataPushSize(n int) int {
+	switch {
+	case n < 0x4c: // OP_PUSHDATA1
+		return 1
+	case n <= 0xff:
+		return 2
+	case n <= 0xffff:
+		return 3
+	default:
+		return 5
+	}
+}
+
 func sumOutputSerializeSizes(outputs []*wire.TxOut) (serializeSize int) {
 	for _, txOut := range outputs {
 		serializeSize += txOut.SerializeSize()
@@ -184,3 +345,78 @@ func EstimateInputSize(scriptSize int) int {
 func EstimateOutputSize(scriptSize int) int {
 	return 8 + 2 + wire.VarIntSerializeSize(uint64(scriptSize)) + scriptSize
 }
+
+// SizeCalculator incrementally tracks the worst case serialize size estimate
+// of a signed transaction as inputs and outputs of known script sizes are
+// added and removed.  Each edit and size query is constant time, avoiding a
+// recomputation over every input and output when estimating the size of
+// repeatedly modified transactions.  The estimate always equals the result of
+// EstimateSerializeSizeFromScriptSizes for the current inputs and outputs.
+//
+// The zero value describes a transaction without any inputs or outputs.
+type SizeCalculator struct {
+	inputCount  int
+	outputCount int
+	inputsSize  int
+	outputsSize int
+}
+
+// NewSizeCalculator returns a SizeCalculator for a transaction spending inputs
+// with signature scripts of inputSizes and paying to outputs with scripts of
+// outputSizes.
+func NewSizeCalculator(inputSizes, outputSizes []int) *SizeCalculator {
+	c := new(SizeCalculator)
+	for _, size := range inputSizes {
+		c.AddInput(size)
+	}
+	for _, size := range outputSizes {
+		c.AddOutput(size)
+	}
+	return c
+}
+
+// AddInput adds an input with a signature script of scriptSize.
+func (c *SizeCalculator) AddInput(scriptSize int) {
+	c.inputCount++
+	c.inputsSize += EstimateInputSize(scriptSize)
+}
+
+// RemoveInput removes an input with a signature script of scriptSize.  The
+// input must have been previously added.
+func (c *SizeCalculator) RemoveInput(scriptSize int) {
+	c.inputCount--
+	c.inputsSize -= EstimateInputSize(scriptSize)
+}
+
+// AddOutput adds an output with an output script of scriptSize.
+func (c *SizeCalculator) AddOutput(scriptSize int) {
+	c.outputCount++
+	c.outputsSize += EstimateOutputSize(scriptSize)
+}
+
+// RemoveOutput removes an output with an output script of scriptSize.  The
+// output must have been previously added.
+func (c *SizeCalculator) RemoveOutput(scriptSize int) {
+	c.outputCount--
+	c.outputsSize -= EstimateOutputSize(scriptSize)
+}
+
+// Size returns the worst case serialize size estimate of the transaction.
+func (c *SizeCalculator) Size() int {
+	// 12 additional bytes are for version, locktime and expiry.
+	return 12 + (2 * wire.VarIntSerializeSize(uint64(c.inputCount))) +
+		wire.VarIntSerializeSize(uint64(c.outputCount)) +
+		c.inputsSize + c.outputsSize
+}
+
+// SizeWithChange returns the worst case serialize size estimate of the
+// transaction with an additional change output script of changeScriptSize.
+// Passing 0 does not add a change output.
+func (c *SizeCalculator) SizeWithChange(changeScriptSize int) int {
+	if changeScriptSize <= 0 {
+		return c.Size()
+	}
+	withChange := *c
+	withChange.AddOutput(changeScriptSize)
+	return withChange.Size()
+}
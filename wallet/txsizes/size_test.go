@@ -1,9 +1,15 @@
 package txsizes_test
 
 import (
+	"math/rand"
 	"testing"
 
 	. "decred.org/dcrwallet/wallet/txsizes"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
 	"github.com/decred/dcrd/wire"
 )
 
@@ -68,3 +74,313 @@ func TestEstimateSerializeSize(t *testing.T) {
 		}
 	}
 }
+
+func TestRedeemP2SHMultisigSigScriptSize(t *testing.T) {
+	tests := []struct{ m, n int }{
+		{1, 2},
+		{2, 3},
+		{3, 5},
+	}
+	for _, test := range tests {
+		keys := make([]*secp256k1.PrivateKey, test.n)
+		b := txscript.NewScriptBuilder().AddInt64(int64(test.m))
+		for i := range keys {
+			keyBytes := make([]byte, 32)
+			keyBytes[31] = byte(i + 1)
+			keys[i] = secp256k1.PrivKeyFromBytes(keyBytes)
+			b.AddData(keys[i].PubKey().SerializeCompressed())
+		}
+		b.AddInt64(int64(test.n)).AddOp(txscript.OP_CHECKMULTISIG)
+		redeemScript, err := b.Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(redeemScript) != MultisigScriptSize(test.n) {
+			t.Errorf("%d-of-%d: redeem script size %d, expected %d", test.m,
+				test.n, len(redeemScript), MultisigScriptSize(test.n))
+		}
+
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8, make([]byte, p2pkhScriptSize)))
+		b = txscript.NewScriptBuilder()
+		for _, key := range keys[:test.m] {
+			sig, err := txscript.RawTxInSignature(tx, 0, redeemScript,
+				txscript.SigHashAll, key.Serialize(), dcrec.STEcdsaSecp256k1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b.AddData(sig)
+		}
+		sigScript, err := b.AddData(redeemScript).Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// DER encoded signatures are usually one or two bytes smaller
+		// than the worst case.
+		estimate := RedeemP2SHMultisigSigScriptSize(test.m, test.n)
+		if len(sigScript) > estimate || estimate-len(sigScript) > 2*test.m {
+			t.Errorf("%d-of-%d: estimated size %d, actual signature script "+
+				"size %d", test.m, test.n, estimate, len(sigScript))
+		}
+
+		// The serialized size of the signed transaction must not exceed
+		// its estimate.
+		tx.TxIn[0].SignatureScript = sigScript
+		txEstimate := EstimateSerializeSize([]int{estimate}, tx.TxOut, 0)
+		if size := tx.SerializeSize(); size > txEstimate || txEstimate-size > 2*test.m {
+			t.Errorf("%d-of-%d: estimated transaction size %d, actual size %d",
+				test.m, test.n, txEstimate, size)
+		}
+	}
+}
+
+func TestEstimateBareMultisigOutputSize(t *testing.T) {
+	for n := 1; n <= 3; n++ {
+		for m := 1; m <= n; m++ {
+			b := txscript.NewScriptBuilder().AddInt64(int64(m))
+			for i := 0; i < n; i++ {
+				keyBytes := make([]byte, 32)
+				keyBytes[31] = byte(i + 1)
+				pubKey := secp256k1.PrivKeyFromBytes(keyBytes).PubKey()
+				b.AddData(pubKey.SerializeCompressed())
+			}
+			b.AddInt64(int64(n)).AddOp(txscript.OP_CHECKMULTISIG)
+			script, err := b.Script()
+			if err != nil {
+				t.Fatal(err)
+			}
+			class := txscript.GetScriptClass(0, script)
+			if class != txscript.MultiSigTy {
+				t.Fatalf("%d-of-%d: script class %v", m, n, class)
+			}
+
+			out := wire.NewTxOut(1e8, script)
+			size := EstimateBareMultisigOutputSize(n)
+			if out.SerializeSize() != size {
+				t.Errorf("%d-of-%d: estimated output size %d, actual size %d",
+					m, n, size, out.SerializeSize())
+			}
+			if size <= P2PKHOutputSize {
+				t.Errorf("%d-of-%d: output size %d does not exceed P2PKH output "+
+					"size %d", m, n, size, P2PKHOutputSize)
+			}
+
+			// Transaction estimates account for the full size of bare
+			// multisig outputs.
+			inputs := []int{RedeemP2PKHSigScriptSize}
+			fromOutputs := EstimateSerializeSize(inputs, []*wire.TxOut{out}, p2pkhScriptSize)
+			fromSizes := EstimateSerializeSizeFromScriptSizes(inputs,
+				[]int{MultisigScriptSize(n)}, p2pkhScriptSize)
+			if fromOutputs != fromSizes {
+				t.Errorf("%d-of-%d: estimated transaction size %d from outputs, "+
+					"%d from script sizes", m, n, fromOutputs, fromSizes)
+			}
+		}
+	}
+}
+
+func TestEstimateNullDataOutputSize(t *testing.T) {
+	for _, n := range []int{0, 2, 40, 75, 76, 80, txscript.MaxDataCarrierSize} {
+		script, err := txscript.GenerateProvablyPruneableOut(make([]byte, n))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(script) != NullDataScriptSize(n) {
+			t.Errorf("%d byte payload: estimated script size %d, actual size %d",
+				n, NullDataScriptSize(n), len(script))
+		}
+		out := wire.NewTxOut(0, script)
+		if out.SerializeSize() != EstimateNullDataOutputSize(n) {
+			t.Errorf("%d byte payload: estimated output size %d, actual size %d",
+				n, EstimateNullDataOutputSize(n), out.SerializeSize())
+		}
+	}
+}
+
+func TestDataPushSize(t *testing.T) {
+	for _, n := range []int{2, 75, 76, 255, 256, txscript.MaxScriptElementSize} {
+		script, err := txscript.NewScriptBuilder().AddData(make([]byte, n)).Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if size := len(script) - n; size != DataPushSize(n) {
+			t.Errorf("%d bytes: estimated push size %d, actual size %d",
+				n, DataPushSize(n), size)
+		}
+	}
+}
+
+func TestTicketOutputSizes(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	addr, err := dcrutil.NewAddressPubKeyHash(make([]byte, 20), params,
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commitment, err := txscript.GenerateSStxAddrPush(addr, 1e8, 0x5800)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commitment) != TicketCommitmentScriptSize {
+		t.Errorf("commitment script size %d, expected %d", len(commitment),
+			TicketCommitmentScriptSize)
+	}
+	if n := wire.NewTxOut(0, commitment).SerializeSize(); n != TicketCommitmentOutputSize {
+		t.Errorf("commitment output size %d, expected %d", n,
+			TicketCommitmentOutputSize)
+	}
+
+	change, err := txscript.PayToSStxChange(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(change) != TicketChangeScriptSize {
+		t.Errorf("ticket change script size %d, expected %d", len(change),
+			TicketChangeScriptSize)
+	}
+	if n := wire.NewTxOut(0, change).SerializeSize(); n != TicketChangeOutputSize {
+		t.Errorf("ticket change output size %d, expected %d", n,
+			TicketChangeOutputSize)
+	}
+}
+
+func TestRedeemAtomicSwapSigScriptSize(t *testing.T) {
+	tests := []struct {
+		contractSize, secretSize int
+	}{
+		{97, 32}, // standard contract and secret sizes
+		{75, 20},
+		{76, 32},  // contract pushed with OP_PUSHDATA1
+		{256, 32}, // contract pushed with OP_PUSHDATA2
+		{97, 80},  // secret pushed with OP_PUSHDATA1
+	}
+	for _, test := range tests {
+		// Build the largest signature script, with a DER signature of 72
+		// bytes and a sighash byte.
+		script, err := txscript.NewScriptBuilder().
+			AddData(make([]byte, 73)).
+			AddData(make([]byte, 33)).
+			AddData(make([]byte, test.secretSize)).
+			AddOp(txscript.OP_TRUE).
+			AddData(make([]byte, test.contractSize)).
+			Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		size := RedeemAtomicSwapSigScriptSize(test.contractSize, test.secretSize)
+		if size != len(script) {
+			t.Errorf("contract size %d, secret size %d: estimated size %d, "+
+				"actual size %d", test.contractSize, test.secretSize, size,
+				len(script))
+		}
+	}
+}
+
+func TestRedeemP2PKHSigScriptSizeGrinded(t *testing.T) {
+	if RedeemP2PKHSigScriptSize-RedeemP2PKHSigScriptSizeGrinded != 1 {
+		t.Fatalf("grinded size %d is not one byte smaller than worst case "+
+			"size %d", RedeemP2PKHSigScriptSizeGrinded, RedeemP2PKHSigScriptSize)
+	}
+
+	// Low-S signatures created for many keys never exceed the grinded size.
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 1e8, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, make([]byte, p2pkhScriptSize)))
+	for i := 1; i <= 256; i++ {
+		keyBytes := make([]byte, 32)
+		keyBytes[30] = byte(i >> 8)
+		keyBytes[31] = byte(i)
+		key := secp256k1.PrivKeyFromBytes(keyBytes)
+		pkScript := make([]byte, p2pkhScriptSize)
+		sig, err := txscript.RawTxInSignature(tx, 0, pkScript,
+			txscript.SigHashAll, key.Serialize(), dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigScript, err := txscript.NewScriptBuilder().AddData(sig).
+			AddData(key.PubKey().SerializeCompressed()).Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sigScript) > RedeemP2PKHSigScriptSizeGrinded {
+			t.Errorf("key %d: signature script size %d exceeds grinded "+
+				"size %d", i, len(sigScript), RedeemP2PKHSigScriptSizeGrinded)
+		}
+	}
+}
+
+func TestSizeCalculator(t *testing.T) {
+	scriptSizes := []int{RedeemP2PKHSigScriptSize, RedeemP2PKSigScriptSize,
+		RedeemP2SHMultisigSigScriptSize(2, 3), 0xfd, p2pkhScriptSize, p2shScriptSize}
+	rng := rand.New(rand.NewSource(1))
+	pick := func() int { return scriptSizes[rng.Intn(len(scriptSizes))] }
+
+	var inputs, outputs []int
+	c := NewSizeCalculator(nil, nil)
+	check := func(edit string) {
+		t.Helper()
+		txOuts := make([]*wire.TxOut, len(outputs))
+		for i, size := range outputs {
+			txOuts[i] = &wire.TxOut{PkScript: make([]byte, size)}
+		}
+		for _, change := range []int{0, p2pkhScriptSize} {
+			want := EstimateSerializeSize(inputs, txOuts, change)
+			if got := c.SizeWithChange(change); got != want {
+				t.Fatalf("%s (%d inputs, %d outputs, change script size %d): "+
+					"incremental size %d, full estimate %d", edit,
+					len(inputs), len(outputs), change, got, want)
+			}
+		}
+	}
+	check("empty")
+
+	// Grow the transaction past the 0xfd compact int boundary of both the
+	// input and output counts, randomly removing some inputs and outputs
+	// along the way, and then shrink it back below the boundary.
+	for i := 0; i < 1000; i++ {
+		switch {
+		case rng.Intn(4) == 0 && len(inputs) > 0:
+			j := rng.Intn(len(inputs))
+			c.RemoveInput(inputs[j])
+			inputs = append(inputs[:j], inputs[j+1:]...)
+			check("remove input")
+		case rng.Intn(4) == 0 && len(outputs) > 0:
+			j := rng.Intn(len(outputs))
+			c.RemoveOutput(outputs[j])
+			outputs = append(outputs[:j], outputs[j+1:]...)
+			check("remove output")
+		default:
+			size := pick()
+			c.AddInput(size)
+			inputs = append(inputs, size)
+			check("add input")
+			size = pick()
+			c.AddOutput(size)
+			outputs = append(outputs, size)
+			check("add output")
+		}
+	}
+	if len(inputs) < 0xfd || len(outputs) < 0xfd {
+		t.Fatalf("edits did not cross compact int boundary: %d inputs, "+
+			"%d outputs", len(inputs), len(outputs))
+	}
+	for len(inputs) > 0 {
+		c.RemoveInput(inputs[len(inputs)-1])
+		inputs = inputs[:len(inputs)-1]
+		check("remove input")
+	}
+	for len(outputs) > 0 {
+		c.RemoveOutput(outputs[len(outputs)-1])
+		outputs = outputs[:len(outputs)-1]
+		check("remove output")
+	}
+
+	// A calculator created from script sizes matches the full estimate.
+	inputs = makeInts(RedeemP2PKHSigScriptSize, 3)
+	outputs = makeInts(p2pkhScriptSize, 0xfd)
+	c = NewSizeCalculator(inputs, outputs)
+	check("new")
+}
@@ -182,6 +182,12 @@ var (
 	// xpub account.
 	lastImportedAccountName = []byte("lastimportedaccount")
 
+	// acctGapLimitKeyPrefix is the prefix for metadata keys mapping an
+	// account to its unused address gap limit.  The account number is
+	// appended to this slice in order to derive the key.  Accounts without
+	// a key use the wallet's gap limit.
+	acctGapLimitKeyPrefix = []byte("acctgaplimit")
+
 	mainBucketName = []byte("main")
 
 	// Db related key names (main bucket).
@@ -199,6 +205,7 @@ var (
 	coinTypeLegacyPubKeyName    = []byte("ctpub")
 	coinTypeSLIP0044PrivKeyName = []byte("ctpriv-slip0044")
 	coinTypeSLIP0044PubKeyName  = []byte("ctpub-slip0044")
+	coinTypeName                = []byte("cointype")
 	watchingOnlyName            = []byte("watchonly")
 	slip0044Account0RowName     = []byte("slip0044acct0")
 
@@ -378,6 +385,34 @@ func putCoinTypeSLIP0044Keys(ns walletdb.ReadWriteBucket, coinTypePubKeyEnc []by
 	return nil
 }
 
+// fetchCoinType loads the BIP0044 coin type currently in use.  The returned
+// bool is false if no coin type is recorded, which is only possible for
+// watching-only wallets created from an account xpub before the coin type was
+// recorded.
+func fetchCoinType(ns walletdb.ReadBucket) (uint32, bool, error) {
+	bucket := ns.NestedReadBucket(mainBucketName)
+
+	v := bucket.Get(coinTypeName)
+	if v == nil {
+		return 0, false, nil
+	}
+	if len(v) != 4 {
+		return 0, false, errors.E(errors.IO, errors.Errorf("bad coin type len %d", len(v)))
+	}
+	return binary.LittleEndian.Uint32(v), true, nil
+}
+
+// putCoinType stores the BIP0044 coin type currently in use.
+func putCoinType(ns walletdb.ReadWriteBucket, coinType uint32) error {
+	bucket := ns.NestedReadWriteBucket(mainBucketName)
+
+	err := bucket.Put(coinTypeName, uint32ToBytes(coinType))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // fetchCryptoKeys loads the encrypted crypto keys which are in turn used to
 // protect the extended keys, imported keys, and scripts.  Any of the returned
 // values can be nil, but in practice only the crypto private and script keys
@@ -697,6 +732,29 @@ func fetchLastImportedAccount(ns walletdb.ReadBucket) (uint32, error) {
 	return account, nil
 }
 
+func accountGapLimitKey(account uint32) []byte {
+	k := make([]byte, len(acctGapLimitKeyPrefix)+4)
+	copy(k, acctGapLimitKeyPrefix)
+	binary.LittleEndian.PutUint32(k[len(acctGapLimitKeyPrefix):], account)
+	return k
+}
+
+// fetchAccountGapLimit retreives the unused address gap limit of an account
+// from the database.  Zero is returned if the account does not record a gap
+// limit.
+func fetchAccountGapLimit(ns walletdb.ReadBucket, account uint32) (uint32, error) {
+	bucket := ns.NestedReadBucket(metaBucketName)
+
+	val := bucket.Get(accountGapLimitKey(account))
+	if val == nil {
+		return 0, nil
+	}
+	if len(val) != 4 {
+		return 0, errors.E(errors.IO, errors.Errorf("bad account gap limit len %d", len(val)))
+	}
+	return binary.LittleEndian.Uint32(val), nil
+}
+
 // fetchAccountName retreives the account name given an account number from
 // the database.
 func fetchAccountName(ns walletdb.ReadBucket, account uint32) (string, error) {
@@ -857,6 +915,23 @@ func putLastAccount(ns walletdb.ReadWriteBucket, account uint32) error {
 	return nil
 }
 
+// putAccountGapLimit stores the unused address gap limit of an account to the
+// database.  A zero limit removes any recorded gap limit.
+func putAccountGapLimit(ns walletdb.ReadWriteBucket, account, limit uint32) error {
+	bucket := ns.NestedReadWriteBucket(metaBucketName)
+
+	var err error
+	if limit == 0 {
+		err = bucket.Delete(accountGapLimitKey(account))
+	} else {
+		err = bucket.Put(accountGapLimitKey(account), uint32ToBytes(limit))
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // putLastImportedAccount stores the provided metadata - last account - to the database.
 func putLastImportedAccount(ns walletdb.ReadWriteBucket, account uint32) error {
 	bucket := ns.NestedReadWriteBucket(metaBucketName)
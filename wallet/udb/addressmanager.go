@@ -676,13 +676,26 @@ func (m *Manager) CoinTypePrivKey(dbtx walletdb.ReadTx) (*hdkeychain.ExtendedKey
 // CoinTypes func and upgrades are performed using the UpgradeToSLIP0044CoinType
 // method.
 //
-// Watching-only wallets that are created using an account xpub do not save the
-// coin type keys and this method will return an error with code
+// Wallets may also be created for a coin type other than those of the network,
+// such as the SLIP0044 coin type of a fork.  These wallets only save keys for
+// the coin type they were created with.
+//
+// The coin type is recorded when the wallet is created.  Watching-only wallets
+// that were created using an account xpub before the coin type was recorded
+// do not save the coin type keys and this method will return an error with code
 // WatchingOnly on these wallets.
 func (m *Manager) CoinType(dbtx walletdb.ReadTx) (uint32, error) {
 	ns := dbtx.ReadBucket(waddrmgrBucketKey)
 	mainBucket := ns.NestedReadBucket(mainBucketName)
 
+	coinType, ok, err := fetchCoinType(ns)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		return coinType, nil
+	}
+
 	legacyCoinType, slip0044CoinType := CoinTypes(m.chainParams)
 
 	if mainBucket.Get(coinTypeLegacyPubKeyName) != nil {
@@ -706,7 +719,7 @@ func (m *Manager) UpgradeToSLIP0044CoinType(dbtx walletdb.ReadWriteTx) error {
 	if err != nil {
 		return err
 	}
-	legacyCoinType, _ := CoinTypes(m.chainParams)
+	legacyCoinType, slip0044CoinType := CoinTypes(m.chainParams)
 	if coinType != legacyCoinType {
 		return errors.E(errors.Invalid, "SLIP0044 coin type upgrade only possible on legacy coin type wallets")
 	}
@@ -714,6 +727,13 @@ func (m *Manager) UpgradeToSLIP0044CoinType(dbtx walletdb.ReadWriteTx) error {
 	ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
 	mainBucket := ns.NestedReadWriteBucket(mainBucketName)
 
+	// Wallets created for a coin type other than those of the network do
+	// not save legacy coin type keys, even if the coin type numerically
+	// matches the legacy coin type.
+	if mainBucket.Get(coinTypeLegacyPubKeyName) == nil {
+		return errors.E(errors.Invalid, "SLIP0044 coin type upgrade only possible on legacy coin type wallets")
+	}
+
 	coinTypeSLIP0044PubKeyEnc := mainBucket.Get(coinTypeSLIP0044PubKeyName)
 	coinTypeSLIP0044PrivKeyEnc := mainBucket.Get(coinTypeSLIP0044PrivKeyName)
 	if coinTypeSLIP0044PubKeyEnc == nil || coinTypeSLIP0044PrivKeyEnc == nil {
@@ -746,6 +766,10 @@ func (m *Manager) UpgradeToSLIP0044CoinType(dbtx walletdb.ReadWriteTx) error {
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
+	err = putCoinType(ns, slip0044CoinType)
+	if err != nil {
+		return err
+	}
 
 	// Rewrite the account 0 row using the SLIP0044 coin type key derivations.
 	serializedRow := mainBucket.Get(slip0044Account0RowName)
@@ -825,7 +849,7 @@ func (m *Manager) UpgradeToSLIP0044CoinType(dbtx walletdb.ReadWriteTx) error {
 // This function MUST be called with the manager lock held for writes.
 func (m *Manager) deriveKeyFromPath(ns walletdb.ReadBucket, account, branch, index uint32, private bool) (*hdkeychain.ExtendedKey, error) {
 	if private && account > ImportedAddrAccount {
-		return nil, errors.E(errors.Invalid, "account does not record private keys")
+		return nil, errors.E(errors.WatchingOnly, "account does not record private keys")
 	}
 
 	// Look up the account key information.
@@ -1897,6 +1921,28 @@ func (m *Manager) AccountName(ns walletdb.ReadBucket, account uint32) (string, e
 	return fetchAccountName(ns, account)
 }
 
+// AccountGapLimit returns the unused address gap limit recorded for an
+// account.  Zero is returned if the account uses the wallet's gap limit.
+func (m *Manager) AccountGapLimit(ns walletdb.ReadBucket, account uint32) (uint32, error) {
+	return fetchAccountGapLimit(ns, account)
+}
+
+// SetAccountGapLimit records the unused address gap limit of an account.  A
+// zero limit removes any recorded gap limit so the wallet's gap limit is used.
+func (m *Manager) SetAccountGapLimit(ns walletdb.ReadWriteBucket, account, limit uint32) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if account == ImportedAddrAccount {
+		return errors.E(errors.Invalid, "imported account has no address gap limit")
+	}
+	// Check that the account exists.
+	if _, err := fetchAccountName(ns, account); err != nil {
+		return err
+	}
+	return putAccountGapLimit(ns, account, limit)
+}
+
 // ForEachAccount calls the given function with each account stored in the
 // manager, breaking early on error.
 func (m *Manager) ForEachAccount(ns walletdb.ReadBucket, fn func(account uint32) error) error {
@@ -1932,6 +1978,20 @@ func (m *Manager) ForEachAccountAddress(ns walletdb.ReadBucket, account uint32,
 	return forEachAccountAddress(ns, account, addrFn)
 }
 
+// ForEachAccountAddressPath calls the given function with the branch and child
+// index of each BIP0044 address of an account recorded by the manager,
+// breaking early on error.  Addresses are visited in no particular order.
+// Unlike ForEachAccountAddress, no keys are derived.
+func (m *Manager) ForEachAccountAddressPath(ns walletdb.ReadBucket, account uint32, fn func(branch, index uint32) error) error {
+	return forEachAccountAddress(ns, account, func(rowInterface interface{}) error {
+		row, ok := rowInterface.(*dbChainAddressRow)
+		if !ok {
+			return nil
+		}
+		return fn(row.branch, row.index)
+	})
+}
+
 // ForEachActiveAccountAddress calls the given function with each active
 // address of the given account stored in the manager, breaking early on
 // error.
@@ -2347,12 +2407,17 @@ func CoinTypes(params *chaincfg.Params) (legacyCoinType, slip0044CoinType uint32
 // deterministic addresses are derived.  This allows all chained addresses in
 // the address manager to be recovered by using the same seed.
 //
+// Account keys are derived using the SLIP0044 coin type coinType.  If this is
+// the SLIP0044 coin type of the network, keys for the legacy coin type are
+// saved as well and are used until the manager is upgraded with
+// UpgradeToSLIP0044CoinType.  Otherwise, only keys for coinType are saved.
+//
 // All private and public keys and information are protected by secret keys
 // derived from the provided private and public passphrases.  The public
 // passphrase is required on subsequent opens of the address manager, and the
 // private passphrase is required to unlock the address manager in order to gain
 // access to any private keys and information.
-func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, privPassphrase []byte, chainParams *chaincfg.Params, config *ScryptOptions) error {
+func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, privPassphrase []byte, chainParams *chaincfg.Params, coinType uint32, config *ScryptOptions) error {
 	// Return an error if the manager has already been created in the given
 	// database namespace.
 	if managerExists(ns) {
@@ -2378,8 +2443,15 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 		return err
 	}
 
-	// Derive the cointype keys according to BIP0044.
+	// Derive the cointype keys according to BIP0044.  When the legacy coin
+	// type keys are not saved, the legacy derivations duplicate the SLIP0044
+	// derivations and are discarded.
 	legacyCoinType, slip0044CoinType := CoinTypes(chainParams)
+	saveLegacy := coinType == slip0044CoinType
+	if !saveLegacy {
+		legacyCoinType, slip0044CoinType = coinType, coinType
+	}
+	activeCoinType := legacyCoinType
 	coinTypeLegacyKeyPriv, err := deriveCoinTypeKey(root, legacyCoinType)
 	if err != nil {
 		return err
@@ -2568,9 +2640,11 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 	}
 
 	// Save the encrypted legacy cointype keys to the database.
-	err = putCoinTypeLegacyKeys(ns, coinTypeLegacyPubEnc, coinTypeLegacyPrivEnc)
-	if err != nil {
-		return err
+	if saveLegacy {
+		err = putCoinTypeLegacyKeys(ns, coinTypeLegacyPubEnc, coinTypeLegacyPrivEnc)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Save the encrypted SLIP0044 cointype keys.
@@ -2579,6 +2653,12 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 		return err
 	}
 
+	// Save the coin type which accounts are derived from.
+	err = putCoinType(ns, activeCoinType)
+	if err != nil {
+		return err
+	}
+
 	// Save the fact this is not a watching-only address manager to the
 	// database.
 	err = putWatchingOnly(ns, false)
@@ -2607,13 +2687,16 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 	}
 
 	// Save the information for the default account to the database.  This
-	// account is derived from the legacy coin type.
+	// account is derived from the legacy coin type, when it is saved.
 	defaultRow := bip0044AccountInfo(acctPubLegacyEnc, acctPrivLegacyEnc,
 		0, 0, 0, 0, 0, 0, defaultAccountName, initialVersion)
 	err = putAccountInfo(ns, DefaultAccountNum, defaultRow)
 	if err != nil {
 		return err
 	}
+	if !saveLegacy {
+		return nil
+	}
 
 	// Save the account row for the 0th account derived from the coin type
 	// 42 key.
@@ -2629,12 +2712,13 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 }
 
 // createWatchOnly creates a watching-only address manager in the given
-// namespace.
+// namespace.  The account xpub is recorded as being derived using the BIP0044
+// coin type coinType.
 //
 // All public keys and information are protected by secret keys derived from the
 // provided public passphrase.  The public passphrase is required on subsequent
 // opens of the address manager.
-func createWatchOnly(ns walletdb.ReadWriteBucket, hdPubKey string, pubPassphrase []byte, chainParams *chaincfg.Params, config *ScryptOptions) (err error) {
+func createWatchOnly(ns walletdb.ReadWriteBucket, hdPubKey string, pubPassphrase []byte, chainParams *chaincfg.Params, coinType uint32, config *ScryptOptions) (err error) {
 	// Return an error if the manager has already been created in the given
 	// database namespace.
 	if managerExists(ns) {
@@ -2756,6 +2840,15 @@ func createWatchOnly(ns walletdb.ReadWriteBucket, hdPubKey string, pubPassphrase
 		return err
 	}
 
+	// Save the coin type the account xpub was derived from.
+	if coinType > maxCoinType {
+		return errors.E(errors.Invalid, errors.Errorf("coin type %d", coinType))
+	}
+	err = putCoinType(ns, coinType)
+	if err != nil {
+		return err
+	}
+
 	// Set the next to use addresses as empty for the address pool.
 	err = putNextToUseAddrPoolIdx(ns, false, DefaultAccountNum, 0)
 	if err != nil {
@@ -0,0 +1,90 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"sync"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// balanceCache records the account balances calculated by a full scan of the
+// transaction store.  Balances depend on the set of unspent credits and
+// ticket commitments, which is identified by the database's balance
+// generation, and on the main chain tip, which determines confirmations and
+// the maturity of coinbase and stake outputs.  Cached balances are only
+// returned for the generation and tip they were calculated at, and the cache
+// is reset when either changes.  The cache is not persisted and is rebuilt
+// by the first balance query after the store is opened.
+type balanceCache struct {
+	mu       sync.Mutex
+	gen      uint64
+	tip      chainhash.Hash
+	balances map[int32]map[uint32]*Balances // keyed by minimum confirmations
+}
+
+func copyBalances(balances map[uint32]*Balances) map[uint32]*Balances {
+	c := make(map[uint32]*Balances, len(balances))
+	for acct, b := range balances {
+		bCopy := *b
+		c[acct] = &bCopy
+	}
+	return c
+}
+
+func (c *balanceCache) get(gen uint64, tip *chainhash.Hash, minConf int32) (map[uint32]*Balances, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.gen != gen || c.tip != *tip {
+		return nil, false
+	}
+	balances, ok := c.balances[minConf]
+	if !ok {
+		return nil, false
+	}
+	return copyBalances(balances), true
+}
+
+func (c *balanceCache) put(gen uint64, tip *chainhash.Hash, minConf int32, balances map[uint32]*Balances) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.balances == nil || c.gen != gen || c.tip != *tip {
+		c.gen = gen
+		c.tip = *tip
+		c.balances = make(map[int32]map[uint32]*Balances)
+	}
+	c.balances[minConf] = copyBalances(balances)
+}
+
+// VerifyAccountBalances checks that the cached account balances for outputs
+// with minConf confirmations, if any, match the balances calculated by a full
+// scan of the store.  An error with kind errors.Bug is returned if the
+// balances differ.
+func (s *Store) VerifyAccountBalances(ns, addrmgrNs walletdb.ReadBucket, minConf int32) error {
+	tip, syncHeight := s.MainChainTip(ns)
+	gen := fetchBalanceGeneration(ns)
+	cached, ok := s.balances.get(gen, &tip, minConf)
+	if !ok {
+		return nil
+	}
+	balances, err := s.balanceFullScan(ns, addrmgrNs, minConf, syncHeight)
+	if err != nil {
+		return err
+	}
+	if len(cached) != len(balances) {
+		return errors.E(errors.Bug, errors.Errorf("cached balances "+
+			"record %d accounts, expected %d", len(cached), len(balances)))
+	}
+	for acct, b := range balances {
+		c, ok := cached[acct]
+		if !ok || *c != *b {
+			return errors.E(errors.Bug, errors.Errorf("cached balances "+
+				"of account %d are %+v, expected %+v", acct, c, b))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestBalanceCache(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, _, teardown, err := cloneDB("balance_cache.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	var headers []*wire.BlockHeader
+	for i := 0; i < int(s.chainParams.CoinbaseMaturity)+2; i++ {
+		headers = append(headers, g.generate(dcrutil.BlockValid))
+	}
+	headerData := makeHeaderDataSlice(headers...)
+	filters := emptyFilters(len(headers))
+	b1Hash := headers[0].BlockHash()
+	b2Hash := headers[1].BlockHash()
+
+	cb := newCoinBase(30e8)
+	cbRec, err := NewTxRecordFromMsgTx(cb, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := spendOutput(&chainhash.Hash{1}, 0, wire.TxTreeRegular, 1e8)
+	txRec, err := NewTxRecordFromMsgTx(tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// check compares the cached balances of the default account with a full
+	// scan of the store, and returns the cached balance with one
+	// confirmation.
+	check := func(ns, addrmgrNs walletdb.ReadBucket, desc string) Balances {
+		t.Helper()
+		tip, syncHeight := s.MainChainTip(ns)
+		var bal Balances
+		for _, minConf := range []int32{0, 1, 6} {
+			// The first query may populate the cache, and the second
+			// must be served from it.
+			for i := 0; i < 2; i++ {
+				cached, err := s.AccountBalances(ns, addrmgrNs, minConf)
+				if err != nil {
+					t.Fatal(err)
+				}
+				full, err := s.balanceFullScan(ns, addrmgrNs, minConf, syncHeight)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(cached, full) {
+					t.Fatalf("%s: minconf %d: cached balances %+v, full scan %+v",
+						desc, minConf, cached[0], full[0])
+				}
+				if b, ok := cached[DefaultAccountNum]; ok && minConf == 1 {
+					bal = *b
+				}
+			}
+			if _, ok := s.balances.get(fetchBalanceGeneration(ns), &tip, minConf); !ok {
+				t.Fatalf("%s: minconf %d: balances were not cached", desc, minConf)
+			}
+			err := s.VerifyAccountBalances(ns, addrmgrNs, minConf)
+			if err != nil {
+				t.Fatalf("%s: %v", desc, err)
+			}
+		}
+		return bal
+	}
+	update := func(desc string, f func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error) Balances {
+		t.Helper()
+		var bal Balances
+		err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+			addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+			err := f(ns, addrmgrNs)
+			if err != nil {
+				return err
+			}
+			bal = check(ns, addrmgrNs, desc)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", desc, err)
+		}
+		return bal
+	}
+
+	// A mined coinbase is immature.
+	bal := update("mine coinbase", func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData[:1], filters[:1])
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(ns, addrmgrNs, cbRec, &b1Hash)
+		if err != nil {
+			return err
+		}
+		return s.AddCredit(ns, cbRec, makeBlockMeta(headers[0]), 0, false, 0)
+	})
+	if bal.ImmatureCoinbaseRewards != 30e8 || bal.Spendable != 0 || bal.Total != 30e8 {
+		t.Errorf("mine coinbase: unexpected balances %+v", bal)
+	}
+
+	// An unmined credit is unconfirmed.
+	bal = update("insert unmined", func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		err := s.InsertMemPoolTx(ns, txRec)
+		if err != nil {
+			return err
+		}
+		return s.AddCredit(ns, txRec, nil, 0, false, 0)
+	})
+	if bal.Unconfirmed != 1e8 || bal.Total != 31e8 {
+		t.Errorf("insert unmined: unexpected balances %+v", bal)
+	}
+
+	// Mining the transaction makes its output spendable with one
+	// confirmation.
+	bal = update("mine tx", func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData[1:2], filters[1:2])
+		if err != nil {
+			return err
+		}
+		return s.InsertMinedTx(ns, addrmgrNs, txRec, &b2Hash)
+	})
+	if bal.Unconfirmed != 0 || bal.Spendable != 1e8 || bal.Total != 31e8 {
+		t.Errorf("mine tx: unexpected balances %+v", bal)
+	}
+
+	// Reorganizing the block out of the main chain returns the transaction
+	// to the unmined set.
+	bal = update("reorg", func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		return s.Rollback(ns, addrmgrNs, int32(headers[1].Height))
+	})
+	if bal.Unconfirmed != 1e8 || bal.Spendable != 0 || bal.Total != 31e8 {
+		t.Errorf("reorg: unexpected balances %+v", bal)
+	}
+
+	// Balances cached by a rolled back database transaction are not used
+	// after the rollback.
+	errRollback := errors.New("rollback")
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+		err := s.InsertMinedTx(ns, addrmgrNs, txRec, &b1Hash)
+		if err != nil {
+			return err
+		}
+		check(ns, addrmgrNs, "rolled back")
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("expected rollback, got %v", err)
+	}
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+		bal = check(ns, addrmgrNs, "after rollback")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bal.Unconfirmed != 1e8 || bal.Spendable != 0 {
+		t.Errorf("after rollback: unexpected balances %+v", bal)
+	}
+
+	// Extend the main chain one block at a time until the coinbase matures.
+	// The transaction is mined again in the first block.
+	for i := 1; i < len(headers); i++ {
+		i := i
+		bal = update("extend chain", func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+			err := insertMainChainHeaders(s, ns, addrmgrNs, headerData[i:i+1], filters[i:i+1])
+			if err != nil {
+				return err
+			}
+			if i == 1 {
+				return s.InsertMinedTx(ns, addrmgrNs, txRec, &b2Hash)
+			}
+			return nil
+		})
+		matured := coinbaseMatured(s.chainParams, int32(headers[0].Height),
+			int32(headers[i].Height))
+		switch {
+		case matured && (bal.Spendable != 31e8 || bal.ImmatureCoinbaseRewards != 0):
+			t.Errorf("height %d: coinbase not spendable after maturity: %+v",
+				headers[i].Height, bal)
+		case !matured && (bal.Spendable != 1e8 || bal.ImmatureCoinbaseRewards != 30e8):
+			t.Errorf("height %d: coinbase spendable before maturity: %+v",
+				headers[i].Height, bal)
+		}
+	}
+	if !coinbaseMatured(s.chainParams, int32(headers[0].Height),
+		int32(headers[len(headers)-1].Height)) {
+		t.Fatal("coinbase did not mature")
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// CoinSelector chooses which unspent outputs are spent by a transaction.
+// Select is called with the total output value the inputs must pay for, the
+// fee rate of the transaction being authored, and the outputs which may be
+// spent, and returns the outputs to spend.  It may be called multiple times
+// with increasing targets as the fee of the transaction grows.  Selections
+// which do not meet the target are returned to the transaction author, which
+// reports the insufficient balance.  A target of zero requests every output
+// the selector is willing to spend.
+//
+// Unspent outputs are read from the database only as they are needed, so the
+// outputs provided to Select are those read so far, in database order, which
+// are the fewest outputs whose total value meets the target.  Every spendable
+// output is provided when the target can not be met or is zero.
+type CoinSelector interface {
+	Select(target, feeRate dcrutil.Amount, utxos []*Credit) (selected []*Credit, err error)
+}
+
+// DefaultCoinSelector is the CoinSelector which selects outputs greedily, in
+// the order they are provided, until the target is met.  All outputs are
+// selected if the target can not be met or is zero.  This is the selection
+// performed by the InputSource returned by MakeIgnoredInputSource.
+type DefaultCoinSelector struct{}
+
+// Select implements the CoinSelector interface.
+func (DefaultCoinSelector) Select(target, feeRate dcrutil.Amount, utxos []*Credit) ([]*Credit, error) {
+	var total dcrutil.Amount
+	for i, c := range utxos {
+		if target != 0 && total >= target {
+			return utxos[:i], nil
+		}
+		total += c.Amount
+	}
+	return utxos, nil
+}
+
+// CoinSelectorInputSource creates an InputSource to redeem unspent outputs
+// from an account which are chosen by selector.  The outputs which may be
+// selected are those returned by MakeIgnoredInputSource with the same
+// minConf, syncHeight and ignore parameters, in the same order, and are read
+// from the database as the target of each selection requires them.  feeRate is
+// passed to the selector.
+func (s *Store) CoinSelectorInputSource(ns, addrmgrNs walletdb.ReadBucket, account uint32, minConf,
+	syncHeight int32, ignore func(*wire.OutPoint) bool, selector CoinSelector,
+	feeRate dcrutil.Amount) InputSource {
+
+	eligible := s.MakeIgnoredInputSource(ns, addrmgrNs, account, minConf,
+		syncHeight, ignore)
+	var credits []*Credit
+	scriptSizes := make(map[wire.OutPoint]int)
+	f := func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+		// The eligible source returns all outputs it has read, which
+		// begin with the outputs read by previous calls.
+		detail, err := eligible.SelectInputs(target)
+		if err != nil {
+			return nil, err
+		}
+		for i := len(credits); i < len(detail.Inputs); i++ {
+			op := detail.Inputs[i].PreviousOutPoint
+			var block *Block
+			k := canonicalOutPoint(&op.Hash, op.Index)
+			if v := ns.NestedReadBucket(bucketUnspent).Get(k); v != nil {
+				block = new(Block)
+				err := readUnspentBlock(v, block)
+				if err != nil {
+					return nil, err
+				}
+			}
+			c, err := s.outputCreditInfo(ns, op, block)
+			if err != nil {
+				return nil, err
+			}
+			credits = append(credits, c)
+			scriptSizes[c.OutPoint] = detail.RedeemScriptSizes[i]
+		}
+		return selectorInputSource(selector, feeRate, credits, scriptSizes)(target)
+	}
+	return InputSource{source: f}
+}
+
+// selectorInputSource returns an input source which spends the outputs of
+// credits chosen by selector.  scriptSizes records the worst case redeem
+// script size of each credit by its outpoint.
+func selectorInputSource(selector CoinSelector, feeRate dcrutil.Amount, credits []*Credit,
+	scriptSizes map[wire.OutPoint]int) txauthor.InputSource {
+
+	return func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+		selected, err := selector.Select(target, feeRate, credits)
+		if err != nil {
+			return nil, err
+		}
+		detail := &txauthor.InputDetail{
+			Inputs:            make([]*wire.TxIn, 0, len(selected)),
+			Scripts:           make([][]byte, 0, len(selected)),
+			RedeemScriptSizes: make([]int, 0, len(selected)),
+		}
+		seen := make(map[wire.OutPoint]struct{}, len(selected))
+		for _, c := range selected {
+			scriptSize, ok := scriptSizes[c.OutPoint]
+			if !ok {
+				return nil, errors.E(errors.Invalid, errors.Errorf("selected "+
+					"output %v is not spendable", &c.OutPoint))
+			}
+			if _, ok := seen[c.OutPoint]; ok {
+				return nil, errors.E(errors.Invalid, errors.Errorf("output %v "+
+					"selected more than once", &c.OutPoint))
+			}
+			seen[c.OutPoint] = struct{}{}
+			detail.Amount += c.Amount
+			detail.Inputs = append(detail.Inputs,
+				wire.NewTxIn(&c.OutPoint, int64(c.Amount), nil))
+			detail.Scripts = append(detail.Scripts, c.PkScript)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes, scriptSize)
+		}
+		return detail, nil
+	}
+}
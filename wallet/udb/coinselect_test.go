@@ -0,0 +1,265 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txsizes"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// testCredits returns P2PKH sized credits with distinct outpoints paying each
+// amount, and the redeem script size of each credit.
+func testCredits(amounts ...dcrutil.Amount) ([]*Credit, map[wire.OutPoint]int) {
+	credits := make([]*Credit, len(amounts))
+	scriptSizes := make(map[wire.OutPoint]int, len(amounts))
+	for i, a := range amounts {
+		op := wire.OutPoint{Hash: chainhash.Hash{byte(i + 1)}}
+		credits[i] = &Credit{
+			OutPoint: op,
+			Amount:   a,
+			PkScript: make([]byte, txsizes.P2PKHPkScriptSize),
+		}
+		scriptSizes[op] = txsizes.RedeemP2PKHSigScriptSize
+	}
+	return credits, scriptSizes
+}
+
+// storeCredits clones an empty database and records an unmined transaction
+// paying a P2PKH output of each amount to the default account.  The returned
+// teardown function must be called when the database is no longer used.
+func storeCredits(t *testing.T, name string, amounts ...dcrutil.Amount) (walletdb.DB, *Store, func()) {
+	t.Helper()
+	db, _, s, _, teardown, err := cloneDB(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2pkh, err := txscript.NewScriptBuilder().AddOp(txscript.OP_DUP).
+		AddOp(txscript.OP_HASH160).AddData(make([]byte, 20)).
+		AddOp(txscript.OP_EQUALVERIFY).AddOp(txscript.OP_CHECKSIG).Script()
+	if err != nil {
+		teardown()
+		t.Fatal(err)
+	}
+	values := make([]int64, len(amounts))
+	for i, a := range amounts {
+		values[i] = int64(a)
+	}
+	tx := spendOutput(&chainhash.Hash{1}, 0, wire.TxTreeRegular, values...)
+	for _, out := range tx.TxOut {
+		out.PkScript = p2pkh
+	}
+	rec, err := NewTxRecordFromMsgTx(tx, time.Time{})
+	if err != nil {
+		teardown()
+		t.Fatal(err)
+	}
+	err = walletdb.Update(context.Background(), db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		err := s.InsertMemPoolTx(ns, rec)
+		if err != nil {
+			return err
+		}
+		for i := range tx.TxOut {
+			err := s.AddCredit(ns, rec, nil, uint32(i), false, DefaultAccountNum)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		teardown()
+		t.Fatal(err)
+	}
+	return db, s, teardown
+}
+
+type testChangeSource struct{}
+
+func (testChangeSource) Script() ([]byte, uint16, error) {
+	return make([]byte, txsizes.P2PKHPkScriptSize), 0, nil
+}
+
+func (testChangeSource) ScriptSize() int { return txsizes.P2PKHPkScriptSize }
+
+func TestDefaultCoinSelector(t *testing.T) {
+	credits, _ := testCredits(1e6, 2e6, 3e6)
+	tests := []struct {
+		target dcrutil.Amount
+		count  int
+	}{
+		{0, 3},
+		{1, 1},
+		{1e6, 1},
+		{25e5, 2},
+		{6e6, 3},
+		{1e8, 3},
+	}
+	for _, test := range tests {
+		selected, err := DefaultCoinSelector{}.Select(test.target, 1e4, credits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(selected) != test.count {
+			t.Errorf("target %v: selected %d outputs, expected %d",
+				test.target, len(selected), test.count)
+			continue
+		}
+		for i := range selected {
+			if selected[i] != credits[i] {
+				t.Errorf("target %v: output %d selected out of order",
+					test.target, i)
+			}
+		}
+	}
+}
+
+// TestDefaultCoinSelectorMinusFee checks that transactions authored with
+// inputs from the default selector match those authored with the store's
+// MakeIgnoredInputSource, using the fixtures of the txauthor
+// TestNewUnsignedTransactionMinusFee test.
+func TestDefaultCoinSelectorMinusFee(t *testing.T) {
+	const op errors.Op = "test"
+	const relayFee = 1e4
+	outputs := func() []*wire.TxOut {
+		return []*wire.TxOut{
+			wire.NewTxOut(1e6, make([]byte, txsizes.P2PKHPkScriptSize)),
+		}
+	}
+
+	tests := []struct {
+		inputs   []dcrutil.Amount
+		foldDust bool
+	}{
+		{[]dcrutil.Amount{1e6 + 100}, false},
+		{[]dcrutil.Amount{1e6 + 100}, true},
+		{[]dcrutil.Amount{2e6}, true},
+		{[]dcrutil.Amount{5e5}, true},
+		{[]dcrutil.Amount{4e5, 4e5, 4e5}, false},
+	}
+	for i, test := range tests {
+		db, s, teardown := storeCredits(t, "default_coin_selector.kv", test.inputs...)
+		err := walletdb.View(context.Background(), db, func(dbtx walletdb.ReadTx) error {
+			ns := dbtx.ReadBucket(wtxmgrBucketKey)
+			addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+			_, tip := s.MainChainTip(ns)
+			storeSource := s.MakeIgnoredInputSource(ns, addrmgrNs,
+				DefaultAccountNum, 0, tip, nil)
+			selectorSource := s.CoinSelectorInputSource(ns, addrmgrNs,
+				DefaultAccountNum, 0, tip, nil, DefaultCoinSelector{}, relayFee)
+			want, wantErr := txauthor.NewUnsignedTransactionMinusFee(op, outputs(), 0,
+				relayFee, storeSource.SelectInputs, testChangeSource{},
+				test.foldDust, false)
+			got, err := txauthor.NewUnsignedTransactionMinusFee(op, outputs(), 0,
+				relayFee, selectorSource.SelectInputs, testChangeSource{},
+				test.foldDust, false)
+			if wantErr != nil {
+				if !errors.Is(err, errors.InsufficientBalance) ||
+					!errors.Is(wantErr, errors.InsufficientBalance) {
+					t.Errorf("test %d: error %v, expected %v", i, err, wantErr)
+				}
+				return nil
+			}
+			if err != nil {
+				t.Errorf("test %d: %v", i, err)
+				return nil
+			}
+			if got.Tx.TxHash() != want.Tx.TxHash() {
+				t.Errorf("test %d: authored %v, expected %v", i, got.Tx.TxHash(),
+					want.Tx.TxHash())
+			}
+			if got.TotalInput != want.TotalInput || got.ChangeIndex != want.ChangeIndex ||
+				got.EstimatedSignedSerializeSize != want.EstimatedSignedSerializeSize {
+				t.Errorf("test %d: authored input %v, change index %d, size %d, "+
+					"expected %v, %d, %d", i, got.TotalInput, got.ChangeIndex,
+					got.EstimatedSignedSerializeSize, want.TotalInput,
+					want.ChangeIndex, want.EstimatedSignedSerializeSize)
+			}
+			return nil
+		})
+		teardown()
+		if err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		}
+	}
+}
+
+// countingSelector records the number of outputs provided to each selection
+// and selects them all.
+type countingSelector struct {
+	counts *[]int
+}
+
+func (s countingSelector) Select(target, feeRate dcrutil.Amount, utxos []*Credit) ([]*Credit, error) {
+	*s.counts = append(*s.counts, len(utxos))
+	return utxos, nil
+}
+
+// TestCoinSelectorInputSourceReadsNeeded checks that outputs are only read
+// from the database as selection targets require them.
+func TestCoinSelectorInputSourceReadsNeeded(t *testing.T) {
+	db, s, teardown := storeCredits(t, "coin_selector_reads.kv", 1e6, 1e6, 1e6)
+	defer teardown()
+
+	var counts []int
+	err := walletdb.View(context.Background(), db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+		_, tip := s.MainChainTip(ns)
+		source := s.CoinSelectorInputSource(ns, addrmgrNs, DefaultAccountNum,
+			0, tip, nil, countingSelector{&counts}, 1e4)
+		for _, target := range []dcrutil.Amount{1, 15e5, 0} {
+			_, err := source.SelectInputs(target)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{1, 2, 3}
+	if len(counts) != len(want) {
+		t.Fatalf("made %d selections, expected %d", len(counts), len(want))
+	}
+	for i := range want {
+		if counts[i] != want[i] {
+			t.Errorf("selection %d was provided %d outputs, expected %d",
+				i, counts[i], want[i])
+		}
+	}
+}
+
+// duplicateSelector selects the first output twice.
+type duplicateSelector struct{}
+
+func (duplicateSelector) Select(target, feeRate dcrutil.Amount, utxos []*Credit) ([]*Credit, error) {
+	return []*Credit{utxos[0], utxos[0]}, nil
+}
+
+func TestSelectorInputSourceInvalidSelection(t *testing.T) {
+	credits, scriptSizes := testCredits(1e6, 2e6)
+	_, err := selectorInputSource(duplicateSelector{}, 1e4, credits, scriptSizes)(1)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("duplicate selection: expected Invalid, got %v", err)
+	}
+
+	unknown, _ := testCredits(1e6, 2e6, 3e6)
+	_, err = selectorInputSource(DefaultCoinSelector{}, 1e4, unknown[2:], scriptSizes)(1)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown selection: expected Invalid, got %v", err)
+	}
+}
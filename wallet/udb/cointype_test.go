@@ -65,7 +65,8 @@ func TestCoinTypeUpgrade(t *testing.T) {
 
 	params := chaincfg.TestNet3Params()
 
-	err := Initialize(ctx, db, params, seed, pubPass, privPassphrase)
+	err := Initialize(ctx, db, params, seed, pubPass, privPassphrase,
+		params.SLIP0044CoinType)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -213,3 +214,99 @@ func TestCoinTypeUpgrade(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestCustomCoinType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := chaincfg.TestNet3Params()
+	const coinType = 9999
+
+	err := Initialize(ctx, db, params, seed, pubPass, privPassphrase, coinType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, _, _, err := Open(ctx, db, params, pubPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	masterExtKey, err := hdkeychain.NewMaster(seed, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountExtKeys := func(coinType uint32) []*hdkeychain.ExtendedKey {
+		coinTypeExtKey, err := deriveCoinTypeKey(masterExtKey, coinType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys := make([]*hdkeychain.ExtendedKey, 2)
+		for i := range keys {
+			accountExtKey, err := deriveAccountKey(coinTypeExtKey, uint32(i))
+			if err != nil {
+				t.Fatal(err)
+			}
+			keys[i], err = accountExtKey.Neuter()
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return keys
+	}
+	customKeys := accountExtKeys(coinType)
+	_, slip0044CoinType := CoinTypes(params)
+	slip0044Keys := accountExtKeys(slip0044CoinType)
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+		err := m.Unlock(ns, privPassphrase)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		gotCoinType, err := m.CoinType(dbtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotCoinType != coinType {
+			t.Fatalf("initialized database has wrong coin type %d", gotCoinType)
+		}
+
+		// Both the default and newly created accounts are derived from the
+		// custom coin type.
+		_, err = m.NewAccount(ns, "account-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for account := range customKeys {
+			accountExtKey, err := m.AccountExtendedPubKey(dbtx, uint32(account))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalExtKeys(accountExtKey, customKeys[account]) {
+				t.Errorf("account %d xpub was not derived from the custom coin type",
+					account)
+			}
+			if equalExtKeys(accountExtKey, slip0044Keys[account]) {
+				t.Errorf("account %d xpub was derived from the SLIP0044 coin type",
+					account)
+			}
+		}
+
+		// Custom coin type wallets can not be upgraded to the network's
+		// SLIP0044 coin type.
+		err = m.UpgradeToSLIP0044CoinType(dbtx)
+		if !errors.Is(err, errors.Invalid) {
+			t.Fatalf("custom coin type database did not refuse upgrade with errors.Invalid")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
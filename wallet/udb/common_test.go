@@ -53,13 +53,14 @@ func createEmptyDB() error {
 		return err
 	}
 
-	err = Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPassphrase,
-		privPassphrase)
+	params := chaincfg.TestNet3Params()
+	err = Initialize(ctx, db, params, seed, pubPassphrase,
+		privPassphrase, params.SLIP0044CoinType)
 	if err != nil {
 		return err
 	}
 
-	err = Upgrade(ctx, db, pubPassphrase, chaincfg.TestNet3Params())
+	err = Upgrade(ctx, db, pubPassphrase, params)
 	if err != nil {
 		return err
 	}
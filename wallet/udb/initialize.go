@@ -15,7 +15,11 @@ import (
 // Initialize prepares an empty database for usage by initializing all buckets
 // and key/value pairs.  The database is initialized with the latest version and
 // does not require any upgrades to use.
-func Initialize(ctx context.Context, db walletdb.DB, params *chaincfg.Params, seed, pubPass, privPass []byte) error {
+//
+// Account keys are derived using the SLIP0044 coin type coinType, which is
+// usually the SLIP0044 coin type of params.  Other coin types may be used by
+// forks of the network which register their own coin type.
+func Initialize(ctx context.Context, db walletdb.DB, params *chaincfg.Params, seed, pubPass, privPass []byte, coinType uint32) error {
 	err := walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs, err := tx.CreateTopLevelBucket(waddrmgrBucketKey)
 		if err != nil {
@@ -31,7 +35,7 @@ func Initialize(ctx context.Context, db walletdb.DB, params *chaincfg.Params, se
 		}
 
 		// Create the address manager, transaction store, and stake store.
-		err = createAddressManager(addrmgrNs, seed, pubPass, privPass, params, &defaultScryptOptions)
+		err = createAddressManager(addrmgrNs, seed, pubPass, privPass, params, coinType, &defaultScryptOptions)
 		if err != nil {
 			return err
 		}
@@ -60,8 +64,10 @@ func Initialize(ctx context.Context, db walletdb.DB, params *chaincfg.Params, se
 
 // InitializeWatchOnly prepares an empty database for watching-only wallet usage
 // by initializing all buckets and key/value pairs.  The database is initialized
-// with the latest version and does not require any upgrades to use.
-func InitializeWatchOnly(ctx context.Context, db walletdb.DB, params *chaincfg.Params, hdPubKey string, pubPass []byte) error {
+// with the latest version and does not require any upgrades to use.  The
+// account xpub is recorded as being derived using the BIP0044 coin type
+// coinType.
+func InitializeWatchOnly(ctx context.Context, db walletdb.DB, params *chaincfg.Params, hdPubKey string, pubPass []byte, coinType uint32) error {
 	err := walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs, err := tx.CreateTopLevelBucket(waddrmgrBucketKey)
 		if err != nil {
@@ -77,7 +83,7 @@ func InitializeWatchOnly(ctx context.Context, db walletdb.DB, params *chaincfg.P
 		}
 
 		// Create the address manager, transaction store, and stake store.
-		err = createWatchOnly(addrmgrNs, hdPubKey, pubPass, params, &defaultScryptOptions)
+		err = createWatchOnly(addrmgrNs, hdPubKey, pubPass, params, coinType, &defaultScryptOptions)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,73 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/wire"
+)
+
+// PutLockedOutpoint records op as a persistently locked outpoint.  Outpoints
+// need not be recorded by the transaction store to be locked.
+func (s *Store) PutLockedOutpoint(ns walletdb.ReadWriteBucket, op *wire.OutPoint) error {
+	return putLockedOutpoint(ns, op)
+}
+
+// DeleteLockedOutpoint removes op from the persistently locked outpoints.
+// Removing an outpoint which is not locked is not an error.
+func (s *Store) DeleteLockedOutpoint(ns walletdb.ReadWriteBucket, op *wire.OutPoint) error {
+	return deleteLockedOutpoint(ns, op)
+}
+
+// DeleteAllLockedOutpoints removes all persistently locked outpoints.
+func (s *Store) DeleteAllLockedOutpoints(ns walletdb.ReadWriteBucket) error {
+	err := ns.DeleteNestedBucket(bucketLockedOutpoints)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = ns.CreateBucket(bucketLockedOutpoints)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// LoadLockedOutpoints returns all persistently locked outpoints, sorted by
+// their serialized keys.  Locks on outputs of recorded transactions which are
+// no longer unspent are stale and are removed rather than returned.  Locks on
+// outputs of transactions unknown to the store are always returned, as their
+// spentness can not be determined.
+func (s *Store) LoadLockedOutpoints(dbtx walletdb.ReadWriteTx) ([]wire.OutPoint, error) {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+
+	var locked, stale []wire.OutPoint
+	err := ns.NestedReadBucket(bucketLockedOutpoints).ForEach(func(k, v []byte) error {
+		var op wire.OutPoint
+		err := readLockedOutpoint(k, &op)
+		if err != nil {
+			return err
+		}
+		if s.ExistsTx(ns, &op.Hash) && !s.ExistsUTXO(dbtx, &op) {
+			stale = append(stale, op)
+			return nil
+		}
+		locked = append(locked, op)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove stale locks after iteration completes, as the bucket may not be
+	// modified during ForEach.
+	for i := range stale {
+		err := deleteLockedOutpoint(ns, &stale[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return locked, nil
+}
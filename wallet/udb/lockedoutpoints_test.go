@@ -0,0 +1,147 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestLockedOutpoints(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, _, teardown, err := cloneDB("locked_outpoints.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	b1H := g.generate(dcrutil.BlockValid)
+	b1Hash := b1H.BlockHash()
+	b2H := g.generate(dcrutil.BlockValid)
+	b2Hash := b2H.BlockHash()
+	headerData := makeHeaderDataSlice(b1H, b2H)
+	filters := emptyFilters(2)
+
+	// tx pays two outputs to the wallet.  The first is spent by spendTx.
+	tx := wire.MsgTx{TxOut: []*wire.TxOut{{Value: 1e8}, {Value: 2e8}}}
+	rec, err := NewTxRecordFromMsgTx(&tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	spendTx := spendOutput(&rec.Hash, 0, wire.TxTreeRegular, 1e8)
+	spendRec, err := NewTxRecordFromMsgTx(spendTx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spent := wire.OutPoint{Hash: rec.Hash, Index: 0, Tree: wire.TxTreeRegular}
+	unspent := wire.OutPoint{Hash: rec.Hash, Index: 1, Tree: wire.TxTreeRegular}
+	var unknownHash chainhash.Hash
+	for i := range unknownHash {
+		unknownHash[i] = 0xff
+	}
+	unknown := wire.OutPoint{Hash: unknownHash, Index: 2, Tree: wire.TxTreeStake}
+
+	load := func() []wire.OutPoint {
+		t.Helper()
+		var locked []wire.OutPoint
+		err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			var err error
+			locked, err = s.LoadLockedOutpoints(dbtx)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return locked
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData, filters)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(ns, addrmgrNs, rec, &b1Hash)
+		if err != nil {
+			return err
+		}
+		for i := range tx.TxOut {
+			err = s.AddCredit(ns, rec, makeBlockMeta(b1H), uint32(i), false, 0)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, op := range []*wire.OutPoint{&unknown, &unspent, &spent} {
+			err = s.PutLockedOutpoint(ns, op)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Locks are returned sorted by outpoint, and locks of unspent outputs are
+	// not removed.
+	want := []wire.OutPoint{spent, unspent, unknown}
+	if locked := load(); !reflect.DeepEqual(locked, want) {
+		t.Errorf("locked outpoints %v, expected %v", locked, want)
+	}
+
+	// Mine a transaction spending the first output.  The lock on the spent
+	// output is stale and is removed when loaded.  The lock on the outpoint
+	// unknown to the store is kept.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+		return s.InsertMinedTx(ns, addrmgrNs, spendRec, &b2Hash)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []wire.OutPoint{unspent, unknown}
+	for i := 0; i < 2; i++ {
+		if locked := load(); !reflect.DeepEqual(locked, want) {
+			t.Errorf("locked outpoints %v, expected %v", locked, want)
+		}
+	}
+
+	// Delete a single lock, and then all locks.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		return s.DeleteLockedOutpoint(ns, &unspent)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []wire.OutPoint{unknown}
+	if locked := load(); !reflect.DeepEqual(locked, want) {
+		t.Errorf("locked outpoints %v, expected %v", locked, want)
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		return s.DeleteAllLockedOutpoints(ns)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if locked := load(); len(locked) != 0 {
+		t.Errorf("locked outpoints %v remain after deleting all", locked)
+	}
+}
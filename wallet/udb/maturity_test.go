@@ -0,0 +1,206 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestCreditMaturityRollback(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, _, teardown, err := cloneDB("credit_maturity_rollback.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := s.chainParams
+
+	g := makeBlockGenerator()
+	var headers []*wire.BlockHeader
+	for i := 0; i < int(params.CoinbaseMaturity)+2; i++ {
+		headers = append(headers, g.generate(dcrutil.BlockValid))
+	}
+	headerData := makeHeaderDataSlice(headers...)
+	filters := emptyFilters(len(headers))
+	tipHeight := int32(len(headers))
+	b1Hash := headers[0].BlockHash()
+
+	// p2pkh returns a P2PKH script, optionally tagged by a stake opcode.
+	p2pkh := func(tag byte) []byte {
+		b := txscript.NewScriptBuilder()
+		if tag != 0 {
+			b.AddOp(tag)
+		}
+		script, err := b.AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).
+			AddData(make([]byte, 20)).AddOp(txscript.OP_EQUALVERIFY).
+			AddOp(txscript.OP_CHECKSIG).Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+
+	// Block 1 mines a coinbase and a transaction paying a regular output, a
+	// vote output and a ticket change output to the wallet.
+	cb := newCoinBase(8e8)
+	cb.TxOut[0].PkScript = p2pkh(0)
+	cbRec, err := NewTxRecordFromMsgTx(cb, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := spendOutput(&chainhash.Hash{1}, 0, wire.TxTreeRegular, 1e8, 2e8, 4e8)
+	tx.TxOut[0].PkScript = p2pkh(0)
+	tx.TxOut[1].PkScript = p2pkh(txscript.OP_SSGEN)
+	tx.TxOut[2].PkScript = p2pkh(txscript.OP_SSTXCHANGE)
+	txRec, err := NewTxRecordFromMsgTx(tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// connect extends the main chain from the current tip to the last
+	// header, mining both transactions in block 1 if it is connected.
+	connect := func() {
+		t.Helper()
+		err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+			addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+			_, height := s.MainChainTip(ns)
+			if height == 0 {
+				err := insertMainChainHeaders(s, ns, addrmgrNs,
+					headerData[:1], filters[:1])
+				if err != nil {
+					return err
+				}
+				for _, rec := range []*TxRecord{cbRec, txRec} {
+					err = s.InsertMinedTx(ns, addrmgrNs, rec, &b1Hash)
+					if err != nil {
+						return err
+					}
+					for i := range rec.MsgTx.TxOut {
+						err = s.AddCredit(ns, rec, makeBlockMeta(headers[0]),
+							uint32(i), false, DefaultAccountNum)
+						if err != nil {
+							return err
+						}
+					}
+				}
+				height = 1
+			}
+			return insertMainChainHeaders(s, ns, addrmgrNs,
+				headerData[height:], filters[height:])
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rollback := func(height int32) {
+		t.Helper()
+		err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+			addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+			return s.Rollback(ns, addrmgrNs, height)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// want returns the expected default account balances with one
+	// confirmation at a main chain tip height.
+	want := func(tip int32) Balances {
+		b := Balances{Account: DefaultAccountNum}
+		if tip < 1 {
+			// The coinbase is removed and the transaction is unmined.
+			b.Unconfirmed = 1e8
+			b.ImmatureStakeGeneration = 2e8
+			b.Total = 7e8
+			return b
+		}
+		b.Spendable = 1e8
+		if coinbaseMatured(params, 1, tip) {
+			b.Spendable += 8e8 + 2e8
+		} else {
+			b.ImmatureCoinbaseRewards = 8e8
+			b.ImmatureStakeGeneration = 2e8
+		}
+		if ticketChangeMatured(params, 1, tip) {
+			b.Spendable += 4e8
+		}
+		b.Total = 15e8
+		return b
+	}
+
+	// check compares the balances and the total value of spendable outputs
+	// at the current tip with the expected balances.
+	check := func(desc string, wantTip int32) {
+		t.Helper()
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			ns := dbtx.ReadBucket(wtxmgrBucketKey)
+			addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+			_, tip := s.MainChainTip(ns)
+			if tip != wantTip {
+				t.Fatalf("%s: tip height %d, expected %d", desc, tip, wantTip)
+			}
+			bal, err := s.AccountBalance(ns, addrmgrNs, 1, DefaultAccountNum)
+			if err != nil {
+				return err
+			}
+			if w := want(tip); bal != w {
+				t.Errorf("%s: tip %d: balances %+v, expected %+v", desc,
+					tip, bal, w)
+			}
+			source := s.MakeIgnoredInputSource(ns, addrmgrNs,
+				DefaultAccountNum, 1, tip, nil)
+			inputs, err := source.SelectInputs(0)
+			if err != nil {
+				return err
+			}
+			if inputs.Amount != bal.Spendable {
+				t.Errorf("%s: tip %d: spendable outputs total %v, "+
+					"spendable balance %v", desc, tip, inputs.Amount,
+					bal.Spendable)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	connect()
+	check("connected", tipHeight)
+
+	// Disconnect blocks one at a time, crossing the coinbase and ticket
+	// change maturity boundaries and finally removing block 1.
+	for height := tipHeight; height >= 1; height-- {
+		rollback(height)
+		check("disconnect one block", height-1)
+	}
+
+	// Disconnecting many blocks at once, or in a different order, must result
+	// in the same balances.
+	for _, heights := range [][]int32{
+		{2},
+		{1},
+		{tipHeight - 1, 2},
+		{int32(params.CoinbaseMaturity) + 1, 3, 2},
+	} {
+		connect()
+		check("reconnected", tipHeight)
+		for _, height := range heights {
+			rollback(height)
+			check("disconnect to height", height-1)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/decred/dcrd/blockchain/stake/v3"
+	blockchain "github.com/decred/dcrd/blockchain/standalone"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// Treasury transactions are not yet recognized by the txscript and stake
+// packages, so the opcodes and transaction version introduced by the treasury
+// are defined here.
+const (
+	// opTAdd is the script of the first output of a treasury add (TAdd),
+	// which adds the output's value to the treasury.
+	opTAdd = 0xc1
+
+	// opTSpend ends the signature script of the only input of a treasury
+	// spend (TSpend).
+	opTSpend = 0xc2
+
+	// opTGen tags the P2PKH and P2SH outputs paid by a treasury spend.
+	opTGen = 0xc3
+
+	// txVersionTreasury is the transaction version of treasury adds and
+	// spends.
+	txVersionTreasury = 3
+)
+
+// isTreasurySpend returns whether tx is a treasury spend.  A treasury spend
+// has a single input spending the null outpoint, signed by a treasury key,
+// an OP_RETURN output committing to the spend, and one or more outputs tagged
+// by OP_TGEN.  Treasury spends are mined in the stake tree and their outputs
+// require coinbase maturity.
+func isTreasurySpend(tx *wire.MsgTx) bool {
+	if tx.Version != txVersionTreasury || len(tx.TxIn) != 1 || len(tx.TxOut) < 2 {
+		return false
+	}
+
+	// The input spends the null outpoint with a signature script of the
+	// form <signature> <pubkey> OP_TSPEND.
+	prevOut := &tx.TxIn[0].PreviousOutPoint
+	if prevOut.Index != wire.MaxPrevOutIndex || prevOut.Hash != (chainhash.Hash{}) {
+		return false
+	}
+	sigScript := tx.TxIn[0].SignatureScript
+	if len(sigScript) != 100 || sigScript[0] != txscript.OP_DATA_64 ||
+		sigScript[65] != txscript.OP_DATA_33 || sigScript[99] != opTSpend {
+		return false
+	}
+
+	// The first output pushes 32 bytes of data with OP_RETURN, and all other
+	// outputs are treasury generation outputs.
+	pkScript := tx.TxOut[0].PkScript
+	if len(pkScript) != 34 || pkScript[0] != txscript.OP_RETURN ||
+		pkScript[1] != txscript.OP_DATA_32 {
+		return false
+	}
+	for _, output := range tx.TxOut[1:] {
+		if _, ok := TreasuryGenScript(output.PkScript); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// isTreasuryAdd returns whether tx is a treasury add.  A treasury add pays
+// the value of its first output, with the script OP_TADD, to the treasury,
+// and may return change in a second output tagged by OP_SSTXCHANGE.
+// Treasury adds are mined in the stake tree.
+func isTreasuryAdd(tx *wire.MsgTx) bool {
+	if tx.Version != txVersionTreasury || len(tx.TxIn) == 0 {
+		return false
+	}
+	switch len(tx.TxOut) {
+	case 1:
+	case 2:
+		class := txscript.GetScriptClass(0, tx.TxOut[1].PkScript)
+		if class != txscript.StakeSubChangeTy {
+			return false
+		}
+	default:
+		return false
+	}
+	pkScript := tx.TxOut[0].PkScript
+	return len(pkScript) == 1 && pkScript[0] == opTAdd
+}
+
+// TreasuryGenScript returns the P2PKH or P2SH script of a treasury spend
+// output, tagged by OP_TGEN, with the tag removed.  The untagged script may be
+// used to extract the addresses paid by the output.  The boolean is false if
+// pkScript is not a tagged P2PKH or P2SH script.
+func TreasuryGenScript(pkScript []byte) ([]byte, bool) {
+	if len(pkScript) < 2 || pkScript[0] != opTGen {
+		return nil, false
+	}
+	switch txscript.GetScriptClass(0, pkScript[1:]) {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy:
+		return pkScript[1:], true
+	}
+	return nil, false
+}
+
+// isCoinBaseTx returns whether tx is a coinbase.  Treasury spends, which
+// also have a single input spending the null outpoint, are not coinbases.
+func isCoinBaseTx(tx *wire.MsgTx) bool {
+	return blockchain.IsCoinBaseTx(tx) && !isTreasurySpend(tx)
+}
+
+// isRegularTreeTx returns whether the transaction tx with type txType is
+// mined in the regular transaction tree, and is therefore invalidated when its
+// block is disapproved by stakeholders.  Treasury transactions are reported
+// as regular transactions by the stake package, but are mined in the stake
+// tree.
+func isRegularTreeTx(txType stake.TxType, tx *wire.MsgTx) bool {
+	return txType == stake.TxTypeRegular && !isTreasurySpend(tx) &&
+		!isTreasuryAdd(tx)
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v3"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// newTreasurySpend creates a treasury spend paying each value to a P2PKH
+// script tagged by OP_TGEN.  The data of the OP_RETURN output is set from
+// nonce so that spends paying the same values have different hashes.
+func newTreasurySpend(nonce byte, outputValues ...int64) *wire.MsgTx {
+	sigScript := make([]byte, 100)
+	sigScript[0] = txscript.OP_DATA_64
+	sigScript[65] = txscript.OP_DATA_33
+	sigScript[99] = opTSpend
+	tx := &wire.MsgTx{
+		Version: txVersionTreasury,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+			SignatureScript:  sigScript,
+		}},
+		Expiry: 1000,
+	}
+	opReturn := make([]byte, 34)
+	opReturn[0] = txscript.OP_RETURN
+	opReturn[1] = txscript.OP_DATA_32
+	opReturn[2] = nonce
+	tx.AddTxOut(wire.NewTxOut(0, opReturn))
+	for _, value := range outputValues {
+		pkScript := []byte{opTGen, txscript.OP_DUP, txscript.OP_HASH160,
+			txscript.OP_DATA_20}
+		pkScript = append(pkScript, make([]byte, 20)...)
+		pkScript = append(pkScript, txscript.OP_EQUALVERIFY, txscript.OP_CHECKSIG)
+		tx.AddTxOut(wire.NewTxOut(value, pkScript))
+	}
+	return tx
+}
+
+func TestTreasurySpendCredits(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, _, teardown, err := cloneDB("treasury_spend_credits.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := s.chainParams
+
+	g := makeBlockGenerator()
+	var headers []*wire.BlockHeader
+	for i := 0; i < int(params.CoinbaseMaturity)+1; i++ {
+		headers = append(headers, g.generate(dcrutil.BlockValid))
+	}
+	headerData := makeHeaderDataSlice(headers...)
+	filters := emptyFilters(len(headers))
+	b1Hash := headers[0].BlockHash()
+
+	tspend := newTreasurySpend(1, 5e8)
+	if !isTreasurySpend(tspend) {
+		t.Fatal("synthetic treasury spend is not recognized")
+	}
+	if isCoinBaseTx(tspend) {
+		t.Fatal("treasury spend is recognized as a coinbase")
+	}
+	if isRegularTreeTx(stake.DetermineTxType(tspend), tspend) {
+		t.Fatal("treasury spend is recognized as a regular tree transaction")
+	}
+	if opcode := getP2PKHOpCode(tspend.TxOut[1].PkScript); opcode != opTGen {
+		t.Fatalf("treasury spend output opcode %#x, expected OP_TGEN", opcode)
+	}
+	rec, err := NewTxRecordFromMsgTx(tspend, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	update := func(f func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error) {
+		t.Helper()
+		err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+			addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+			return f(ns, addrmgrNs)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// mine records the treasury spend in block 1 and its output as a credit
+	// of the default account.
+	mine := func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		err := s.InsertMinedTx(ns, addrmgrNs, rec, &b1Hash)
+		if err != nil {
+			return err
+		}
+		return s.AddCredit(ns, rec, makeBlockMeta(headers[0]), 1, false,
+			DefaultAccountNum)
+	}
+
+	// check compares the default account balances with one confirmation
+	// and the spendable outputs with those expected at the current tip.
+	check := func(desc string, wantTip int32, immature, spendable dcrutil.Amount) {
+		t.Helper()
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			ns := dbtx.ReadBucket(wtxmgrBucketKey)
+			addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+			_, tip := s.MainChainTip(ns)
+			if tip != wantTip {
+				t.Fatalf("%s: tip height %d, expected %d", desc, tip, wantTip)
+			}
+			bal, err := s.AccountBalance(ns, addrmgrNs, 1, DefaultAccountNum)
+			if err != nil {
+				return err
+			}
+			want := Balances{
+				Account:                 DefaultAccountNum,
+				ImmatureCoinbaseRewards: immature,
+				Spendable:               spendable,
+				Total:                   5e8,
+			}
+			if bal != want {
+				t.Errorf("%s: balances %+v, expected %+v", desc, bal, want)
+			}
+			source := s.MakeIgnoredInputSource(ns, addrmgrNs,
+				DefaultAccountNum, 1, tip, nil)
+			inputs, err := source.SelectInputs(0)
+			if err != nil {
+				return err
+			}
+			if inputs.Amount != spendable {
+				t.Errorf("%s: spendable outputs total %v, expected %v",
+					desc, inputs.Amount, spendable)
+			}
+			for _, in := range inputs.Inputs {
+				if in.PreviousOutPoint.Tree != wire.TxTreeStake {
+					t.Errorf("%s: treasury spend output %v is not in the "+
+						"stake tree", desc, &in.PreviousOutPoint)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A mined treasury spend output is immature until it reaches coinbase
+	// maturity.
+	update(func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData[:1], filters[:1])
+		if err != nil {
+			return err
+		}
+		return mine(ns, addrmgrNs)
+	})
+	check("mined", 1, 5e8, 0)
+	matureHeight := int32(params.CoinbaseMaturity)
+	update(func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		return insertMainChainHeaders(s, ns, addrmgrNs,
+			headerData[1:matureHeight], filters[1:matureHeight])
+	})
+	check("before maturity", matureHeight, 5e8, 0)
+	update(func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		return insertMainChainHeaders(s, ns, addrmgrNs,
+			headerData[matureHeight:], filters[matureHeight:])
+	})
+	check("matured", matureHeight+1, 0, 5e8)
+
+	// Disconnecting the block mining the treasury spend returns it to the
+	// unmined set, unlike a coinbase, since it may be mined again in another
+	// block.  The null outpoint of its input is not recorded as spent, so
+	// other unmined treasury spends do not conflict with it.
+	update(func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		return s.Rollback(ns, addrmgrNs, 1)
+	})
+	update(func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		if existsRawUnmined(ns, rec.Hash[:]) == nil {
+			t.Fatal("disconnected treasury spend is not unmined")
+		}
+		k := canonicalOutPoint(&rec.Hash, 1)
+		if existsRawUnminedCredit(ns, k) == nil {
+			t.Fatal("disconnected treasury spend credit is not unmined")
+		}
+		nullKey := canonicalOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex)
+		if existsRawUnminedInput(ns, nullKey) != nil {
+			t.Fatal("treasury spend input recorded as an unmined input")
+		}
+		other, err := NewTxRecordFromMsgTx(newTreasurySpend(2, 1e8), time.Time{})
+		if err != nil {
+			return err
+		}
+		return s.InsertMemPoolTx(ns, other)
+	})
+	check("disconnected", 0, 5e8, 0)
+
+	// Mining the treasury spend again restarts its maturity.
+	update(func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData[:1], filters[:1])
+		if err != nil {
+			return err
+		}
+		return mine(ns, addrmgrNs)
+	})
+	check("mined again", 1, 5e8, 0)
+	update(func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket) error {
+		return insertMainChainHeaders(s, ns, addrmgrNs, headerData[1:], filters[1:])
+	})
+	check("matured again", matureHeight+1, 0, 5e8)
+}
@@ -7,6 +7,7 @@ package udb
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/binary"
 	"runtime/debug"
 	"time"
@@ -147,6 +148,9 @@ var (
 	bucketCFilters                = []byte("cf")
 	bucketTicketCommitments       = []byte("cmt")
 	bucketTicketCommitmentsUsp    = []byte("cmu")
+	bucketTxLabels                = []byte("lbl")
+	bucketLockedOutpoints         = []byte("lck")
+	bucketVSPFees                 = []byte("vsp")
 )
 
 // Root (namespace) bucket keys
@@ -157,6 +161,8 @@ var (
 	rootTipBlock     = []byte("tip")
 	rootHaveCFilters = []byte("havecfilters")
 	rootLastTxsBlock = []byte("lasttxsblock")
+	rootBalanceGen   = []byte("balgen")
+	rootRescanCheck  = []byte("rescancheckpoint")
 )
 
 // The root bucket's mined balance k/v pair records the total balance for all
@@ -182,6 +188,47 @@ func putMinedBalance(ns walletdb.ReadWriteBucket, amt dcrutil.Amount) error {
 	return nil
 }
 
+// The root bucket's balance generation k/v pair records a value which is
+// changed by every modification to the credits, unspent outputs, unmined
+// credits and inputs, and ticket commitments which determine account
+// balances.  Cached account balances are only used for the generation and main
+// chain tip they were calculated at.  The value is a random uint64, so a
+// generation written by a database transaction which is rolled back is never
+// mistaken for that of a later modification.  The key is added by the balance
+// generation upgrade.
+func fetchBalanceGeneration(ns walletdb.ReadBucket) uint64 {
+	v := ns.Get(rootBalanceGen)
+	if len(v) != 8 {
+		return 0
+	}
+	return byteOrder.Uint64(v)
+}
+
+func putBalanceGeneration(ns walletdb.ReadWriteBucket) error {
+	var v [8]byte
+	_, err := rand.Read(v[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = ns.Put(rootBalanceGen, v[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// balanceBucket opens the nested bucket with key, which must be one of the
+// buckets determining account balances, for modification.  A new balance
+// generation is recorded, invalidating any cached balances.  These buckets
+// must only be modified through the bucket returned by balanceBucket.
+func balanceBucket(ns walletdb.ReadWriteBucket, key []byte) (walletdb.ReadWriteBucket, error) {
+	err := putBalanceGeneration(ns)
+	if err != nil {
+		return nil, err
+	}
+	return ns.NestedReadWriteBucket(key), nil
+}
+
 // Several data structures are given canonical serialization formats as either
 // keys or values.  These common formats allow keys and values to be reused
 // across different buckets.
@@ -702,6 +749,7 @@ func latestTxRecord(ns walletdb.ReadBucket, txHash []byte) (k, v []byte) {
 //                 0x08: OP_SSGEN
 //                 0x0c: OP_SSRTX
 //                 0x10: OP_SSTXCHANGE
+//                 0x1c: OP_TGEN
 //             0x20: IsCoinbase
 //             0x40: HasExpiry
 //   [9:81]  OPTIONAL Debit bucket key (72 bytes)
@@ -735,10 +783,31 @@ func keyCredit(txHash *chainhash.Hash, index uint32, block *Block) []byte {
 	return k
 }
 
+// condensedOpTGen is the condensed value of the OP_TGEN tag of treasury spend
+// outputs.  Stake tags are condensed to three bits by their offset from
+// OP_NOP10, which cannot represent OP_TGEN, so it is recorded using the
+// otherwise unused largest value.
+const condensedOpTGen = 0x07
+
+// condenseOpCode condenses the stake tag opcode of a credit to three bits of
+// the flags byte of its value.
 func condenseOpCode(opCode uint8) byte {
+	if opCode == opTGen {
+		return condensedOpTGen << 2
+	}
 	return (opCode - 0xb9) << 2
 }
 
+// expandOpCode returns the opcode condensed in the flags byte v of a credit
+// or unmined credit.
+func expandOpCode(v byte) uint8 {
+	c := (v >> 2) & 0x07
+	if c == condensedOpTGen {
+		return opTGen
+	}
+	return c + 0xb9
+}
+
 // valueUnspentCredit creates a new credit value for an unspent credit.  All
 // credits are created unspent, and are only marked spent later, so there is no
 // value function to create either spent or unspent credits.
@@ -772,7 +841,11 @@ func valueUnspentCredit(cred *credit, scrType scriptType, scrLoc uint32,
 }
 
 func putRawCredit(ns walletdb.ReadWriteBucket, k, v []byte) error {
-	err := ns.NestedReadWriteBucket(bucketCredits).Put(k, v)
+	b, err := balanceBucket(ns, bucketCredits)
+	if err != nil {
+		return err
+	}
+	err = b.Put(k, v)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -855,7 +928,7 @@ func fetchRawCreditUnspentValue(k []byte) ([]byte, error) {
 
 // fetchRawCreditTagOpCode fetches the compressed OP code for a transaction.
 func fetchRawCreditTagOpCode(v []byte) uint8 {
-	return (((v[8] >> 2) & 0x07) + 0xb9)
+	return expandOpCode(v[8])
 }
 
 // fetchRawCreditIsCoinbase returns whether or not the credit is a coinbase
@@ -931,8 +1004,7 @@ func spendCredit(ns walletdb.ReadWriteBucket, k []byte, spender *indexedIncidenc
 // output amount of the credit is returned.  It returns without error if no
 // credit exists for the key.
 func unspendRawCredit(ns walletdb.ReadWriteBucket, k []byte) (dcrutil.Amount, error) {
-	b := ns.NestedReadWriteBucket(bucketCredits)
-	v := b.Get(k)
+	v := ns.NestedReadBucket(bucketCredits).Get(k)
 	if v == nil {
 		return 0, nil
 	}
@@ -940,7 +1012,11 @@ func unspendRawCredit(ns walletdb.ReadWriteBucket, k []byte) (dcrutil.Amount, er
 	copy(newv, v)
 	newv[8] &^= 1 << 0
 
-	err := b.Put(k, newv)
+	b, err := balanceBucket(ns, bucketCredits)
+	if err != nil {
+		return 0, err
+	}
+	err = b.Put(k, newv)
 	if err != nil {
 		return 0, errors.E(errors.IO, err)
 	}
@@ -964,7 +1040,11 @@ func existsInvalidatedCredit(ns walletdb.ReadBucket, txHash *chainhash.Hash, ind
 }
 
 func deleteRawCredit(ns walletdb.ReadWriteBucket, k []byte) error {
-	err := ns.NestedReadWriteBucket(bucketCredits).Delete(k)
+	b, err := balanceBucket(ns, bucketCredits)
+	if err != nil {
+		return err
+	}
+	err = b.Delete(k)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -1079,7 +1159,11 @@ func valueUnspent(block *Block) []byte {
 func putUnspent(ns walletdb.ReadWriteBucket, outPoint *wire.OutPoint, block *Block) error {
 	k := canonicalOutPoint(&outPoint.Hash, outPoint.Index)
 	v := valueUnspent(block)
-	err := ns.NestedReadWriteBucket(bucketUnspent).Put(k, v)
+	b, err := balanceBucket(ns, bucketUnspent)
+	if err != nil {
+		return err
+	}
+	err = b.Put(k, v)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -1087,7 +1171,11 @@ func putUnspent(ns walletdb.ReadWriteBucket, outPoint *wire.OutPoint, block *Blo
 }
 
 func putRawUnspent(ns walletdb.ReadWriteBucket, k, v []byte) error {
-	err := ns.NestedReadWriteBucket(bucketUnspent).Put(k, v)
+	b, err := balanceBucket(ns, bucketUnspent)
+	if err != nil {
+		return err
+	}
+	err = b.Put(k, v)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -1130,7 +1218,11 @@ func existsRawUnspent(ns walletdb.ReadBucket, k []byte) (credKey []byte) {
 }
 
 func deleteRawUnspent(ns walletdb.ReadWriteBucket, k []byte) error {
-	err := ns.NestedReadWriteBucket(bucketUnspent).Delete(k)
+	b, err := balanceBucket(ns, bucketUnspent)
+	if err != nil {
+		return err
+	}
+	err = b.Delete(k)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -1378,6 +1470,7 @@ func extractRawUnminedTx(v []byte) []byte {
 //                 0x08: OP_SSGEN
 //                 0x0c: OP_SSRTX
 //                 0x10: OP_SSTXCHANGE
+//                 0x1c: OP_TGEN
 //             0x20: IsCoinbase
 //             0x40: HasExpiry
 //   [9] Script type (P2PKH, P2SH, etc) and bit flag for account stored
@@ -1430,7 +1523,11 @@ func valueUnminedCredit(amount dcrutil.Amount, change bool, opCode uint8,
 }
 
 func putRawUnminedCredit(ns walletdb.ReadWriteBucket, k, v []byte) error {
-	err := ns.NestedReadWriteBucket(bucketUnminedCredits).Put(k, v)
+	b, err := balanceBucket(ns, bucketUnminedCredits)
+	if err != nil {
+		return err
+	}
+	err = b.Put(k, v)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -1461,7 +1558,7 @@ func fetchRawUnminedCreditAmountChange(v []byte) (dcrutil.Amount, bool, error) {
 }
 
 func fetchRawUnminedCreditTagOpcode(v []byte) uint8 {
-	return (((v[8] >> 2) & 0x07) + 0xb9)
+	return expandOpCode(v[8])
 }
 
 func fetchRawUnminedCreditTagIsCoinbase(v []byte) bool {
@@ -1507,7 +1604,11 @@ func existsRawUnminedCredit(ns walletdb.ReadBucket, k []byte) []byte {
 }
 
 func deleteRawUnminedCredit(ns walletdb.ReadWriteBucket, k []byte) error {
-	err := ns.NestedReadWriteBucket(bucketUnminedCredits).Delete(k)
+	b, err := balanceBucket(ns, bucketUnminedCredits)
+	if err != nil {
+		return err
+	}
+	err = b.Delete(k)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -1633,7 +1734,11 @@ func (it *unminedCreditIterator) close() {
 //   [0:32]   Transaction hash (32 bytes)
 
 func putRawUnminedInput(ns walletdb.ReadWriteBucket, k, v []byte) error {
-	err := ns.NestedReadWriteBucket(bucketUnminedInputs).Put(k, v)
+	b, err := balanceBucket(ns, bucketUnminedInputs)
+	if err != nil {
+		return err
+	}
+	err = b.Put(k, v)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -1645,7 +1750,11 @@ func existsRawUnminedInput(ns walletdb.ReadBucket, k []byte) (v []byte) {
 }
 
 func deleteRawUnminedInput(ns walletdb.ReadWriteBucket, k []byte) error {
-	err := ns.NestedReadWriteBucket(bucketUnminedInputs).Delete(k)
+	b, err := balanceBucket(ns, bucketUnminedInputs)
+	if err != nil {
+		return err
+	}
+	err = b.Delete(k)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -1985,7 +2094,11 @@ func existsRawTicketCommitment(ns walletdb.ReadBucket, k []byte) []byte {
 }
 
 func putRawTicketCommitment(ns walletdb.ReadWriteBucket, k, v []byte) error {
-	err := ns.NestedReadWriteBucket(bucketTicketCommitments).Put(k, v)
+	b, err := balanceBucket(ns, bucketTicketCommitments)
+	if err != nil {
+		return err
+	}
+	err = b.Put(k, v)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -2007,7 +2120,11 @@ func fetchRawTicketCommitmentAccount(v []byte) (uint32, error) {
 }
 
 func deleteRawTicketCommitment(ns walletdb.ReadWriteBucket, k []byte) error {
-	err := ns.NestedReadWriteBucket(bucketTicketCommitments).Delete(k)
+	b, err := balanceBucket(ns, bucketTicketCommitments)
+	if err != nil {
+		return err
+	}
+	err = b.Delete(k)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -2040,7 +2157,11 @@ func valueUnspentTicketCommitment(unminedSpent bool) []byte {
 }
 
 func putRawUnspentTicketCommitment(ns walletdb.ReadWriteBucket, k, v []byte) error {
-	err := ns.NestedReadWriteBucket(bucketTicketCommitmentsUsp).Put(k, v)
+	b, err := balanceBucket(ns, bucketTicketCommitmentsUsp)
+	if err != nil {
+		return err
+	}
+	err = b.Put(k, v)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -2048,7 +2169,11 @@ func putRawUnspentTicketCommitment(ns walletdb.ReadWriteBucket, k, v []byte) err
 }
 
 func deleteRawUnspentTicketCommitment(ns walletdb.ReadWriteBucket, k []byte) error {
-	err := ns.NestedReadWriteBucket(bucketTicketCommitmentsUsp).Delete(k)
+	b, err := balanceBucket(ns, bucketTicketCommitmentsUsp)
+	if err != nil {
+		return err
+	}
+	err = b.Delete(k)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -2119,6 +2244,104 @@ func (it *unspentTicketCommitsIterator) close() {
 	it.c.Close()
 }
 
+// The transaction labels bucket records user-provided labels for
+// transactions.  Keys are the transaction hash and values are the UTF-8
+// encoded label.  Transactions without a label have no entry in this bucket.
+
+func fetchTxLabel(ns walletdb.ReadBucket, txHash *chainhash.Hash) string {
+	return string(ns.NestedReadBucket(bucketTxLabels).Get(txHash[:]))
+}
+
+func putTxLabel(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash, label string) error {
+	var err error
+	b := ns.NestedReadWriteBucket(bucketTxLabels)
+	if label == "" {
+		err = b.Delete(txHash[:])
+	} else {
+		err = b.Put(txHash[:], []byte(label))
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// The locked outpoints bucket records outpoints which have been persistently
+// locked and must not be spent by created transactions.  Keys are the
+// canonical outpoint serialization followed by the one byte transaction tree.
+// Values are empty.
+
+func keyLockedOutpoint(op *wire.OutPoint) []byte {
+	k := make([]byte, 37)
+	copy(k, op.Hash[:])
+	byteOrder.PutUint32(k[32:36], op.Index)
+	k[36] = byte(op.Tree)
+	return k
+}
+
+func readLockedOutpoint(k []byte, op *wire.OutPoint) error {
+	if len(k) != 37 {
+		return errors.E(errors.IO, errors.Errorf("locked outpoint key length %d", len(k)))
+	}
+	copy(op.Hash[:], k)
+	op.Index = byteOrder.Uint32(k[32:36])
+	op.Tree = int8(k[36])
+	return nil
+}
+
+func putLockedOutpoint(ns walletdb.ReadWriteBucket, op *wire.OutPoint) error {
+	err := ns.NestedReadWriteBucket(bucketLockedOutpoints).Put(keyLockedOutpoint(op), nil)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+func deleteLockedOutpoint(ns walletdb.ReadWriteBucket, op *wire.OutPoint) error {
+	err := ns.NestedReadWriteBucket(bucketLockedOutpoints).Delete(keyLockedOutpoint(op))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// The VSP fees bucket records the transaction paying the voting service
+// provider fee of a ticket.  Keys are the ticket hash and values are the
+// serialized fee transaction.
+
+func fetchVSPFeeTx(ns walletdb.ReadBucket, ticketHash *chainhash.Hash) (*wire.MsgTx, error) {
+	v := ns.NestedReadBucket(bucketVSPFees).Get(ticketHash[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no VSP fee transaction for ticket %v", ticketHash))
+	}
+	tx := new(wire.MsgTx)
+	err := tx.Deserialize(bytes.NewReader(v))
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return tx, nil
+}
+
+func putVSPFeeTx(ns walletdb.ReadWriteBucket, ticketHash *chainhash.Hash, feeTx *wire.MsgTx) error {
+	v, err := feeTx.Bytes()
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	err = ns.NestedReadWriteBucket(bucketVSPFees).Put(ticketHash[:], v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+func deleteVSPFeeTx(ns walletdb.ReadWriteBucket, ticketHash *chainhash.Hash) error {
+	err := ns.NestedReadWriteBucket(bucketVSPFees).Delete(ticketHash[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // createStore creates the tx store (with the latest db version) in the passed
 // namespace.
 func createStore(ns walletdb.ReadWriteBucket, chainParams *chaincfg.Params) error {
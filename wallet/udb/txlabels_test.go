@@ -0,0 +1,124 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestTxLabels(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, _, teardown, err := cloneDB("tx_labels.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.MsgTx{
+		TxOut: []*wire.TxOut{{Value: 1e8}},
+	}
+	rec, err := NewTxRecordFromMsgTx(&tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	txHash := tx.TxHash()
+
+	labels := func(ns walletdb.ReadBucket) map[chainhash.Hash]string {
+		m := make(map[chainhash.Hash]string)
+		err := s.ForEachTxLabel(ns, func(txHash *chainhash.Hash, label string) error {
+			m[*txHash] = label
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+
+		// Unrecorded transactions can not be labeled.
+		err := s.SetTxLabel(ns, &txHash, "invoice 1")
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("expected NotExist labeling unrecorded tx, got %v", err)
+		}
+
+		err = s.InsertMemPoolTx(ns, rec)
+		if err != nil {
+			return err
+		}
+		if l := s.TxLabel(ns, &txHash); l != "" {
+			t.Errorf("unlabeled tx has label %q", l)
+		}
+
+		// Set
+		err = s.SetTxLabel(ns, &txHash, "invoice 1")
+		if err != nil {
+			return err
+		}
+		if l := s.TxLabel(ns, &txHash); l != "invoice 1" {
+			t.Errorf("label %q, expected %q", l, "invoice 1")
+		}
+
+		// Overwrite
+		err = s.SetTxLabel(ns, &txHash, "invoice 2")
+		if err != nil {
+			return err
+		}
+		if l := s.TxLabel(ns, &txHash); l != "invoice 2" {
+			t.Errorf("label %q, expected %q", l, "invoice 2")
+		}
+		if m := labels(ns); len(m) != 1 || m[txHash] != "invoice 2" {
+			t.Errorf("labels %v, expected only %v: %q", m, &txHash, "invoice 2")
+		}
+
+		// Labels exceeding the maximum length are rejected and do not
+		// replace the existing label.
+		err = s.SetTxLabel(ns, &txHash, strings.Repeat("x", MaxTxLabelLen+1))
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("expected Invalid for long label, got %v", err)
+		}
+		if l := s.TxLabel(ns, &txHash); l != "invoice 2" {
+			t.Errorf("label %q, expected %q", l, "invoice 2")
+		}
+
+		// Clear
+		err = s.SetTxLabel(ns, &txHash, "")
+		if err != nil {
+			return err
+		}
+		if l := s.TxLabel(ns, &txHash); l != "" {
+			t.Errorf("cleared label is %q", l)
+		}
+		if m := labels(ns); len(m) != 0 {
+			t.Errorf("labels %v remain after clearing", m)
+		}
+		return s.SetTxLabel(ns, &txHash, "persisted")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Labels are read back by later database transactions.
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrBucketKey)
+		if l := s.TxLabel(ns, &txHash); l != "persisted" {
+			t.Errorf("label %q, expected %q", l, "persisted")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -14,10 +14,10 @@ import (
 
 	"decred.org/dcrwallet/errors"
 	"decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/txrules"
 	"decred.org/dcrwallet/wallet/txsizes"
 	"decred.org/dcrwallet/wallet/walletdb"
 	"github.com/decred/dcrd/blockchain/stake/v3"
-	blockchain "github.com/decred/dcrd/blockchain/standalone"
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/dcrutil/v3"
@@ -165,6 +165,7 @@ type Credit struct {
 type Store struct {
 	chainParams    *chaincfg.Params
 	acctLookupFunc func(walletdb.ReadBucket, dcrutil.Address) (uint32, error)
+	balances       balanceCache
 }
 
 // MainChainTip returns the hash and height of the currently marked tip-most
@@ -309,6 +310,63 @@ func (s *Store) UpdateProcessedTxsBlockMarker(dbtx walletdb.ReadWriteTx, hash *c
 	return nil
 }
 
+// RescanCheckpoint returns the hash of the last block fully processed by an
+// unfinished rescan, or nil if no rescan checkpoint is recorded.  If the
+// recorded block has been reorganized out of the main chain, its main chain
+// ancestor is returned, as blocks of the new main chain following the
+// ancestor have not been rescanned.
+func (s *Store) RescanCheckpoint(dbtx walletdb.ReadTx) (*chainhash.Hash, error) {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	v := ns.Get(rootRescanCheck)
+	if v == nil {
+		return nil, nil
+	}
+	if len(v) != chainhash.HashSize {
+		return nil, errors.E(errors.IO, errors.Errorf("bad rescan checkpoint length %d", len(v)))
+	}
+	var h chainhash.Hash
+	copy(h[:], v)
+	hash := &h
+	for {
+		mainChain, _ := s.BlockInMainChain(dbtx, hash)
+		if mainChain {
+			return hash, nil
+		}
+		header, err := s.GetBlockHeader(dbtx, hash)
+		if err != nil {
+			return nil, err
+		}
+		hash = &header.PrevBlock
+	}
+}
+
+// SetRescanCheckpoint records hash as the last block fully processed by an
+// unfinished rescan.  Hash must describe a main chain block, and the
+// transactions of the block and all earlier rescanned blocks must already be
+// saved.
+func (s *Store) SetRescanCheckpoint(dbtx walletdb.ReadWriteTx, hash *chainhash.Hash) error {
+	if mainChain, _ := s.BlockInMainChain(dbtx, hash); !mainChain {
+		return errors.E(errors.Invalid, errors.Errorf("%v is not a main chain block", hash))
+	}
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	err := ns.Put(rootRescanCheck, hash[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ClearRescanCheckpoint removes any recorded rescan checkpoint after a rescan
+// completes.
+func (s *Store) ClearRescanCheckpoint(dbtx walletdb.ReadWriteTx) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	err := ns.Delete(rootRescanCheck)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // IsMissingMainChainCFilters returns whether all compact filters for main chain
 // blocks have been recorded to the database after the upgrade which began to
 // require them to extend the main chain.  If compact filters are missing, they
@@ -572,7 +630,7 @@ func stakeValidate(ns walletdb.ReadWriteBucket, height int32) error {
 		}
 
 		// Only regular tree transactions must be considered.
-		if txRec.TxType != stake.TxTypeRegular {
+		if !isRegularTreeTx(txRec.TxType, &txRec.MsgTx) {
 			continue
 		}
 
@@ -708,7 +766,7 @@ func stakeInvalidate(ns walletdb.ReadWriteBucket, height int32) error {
 		}
 
 		// Only regular tree transactions must be considered.
-		if txRec.TxType != stake.TxTypeRegular {
+		if !isRegularTreeTx(txRec.TxType, &txRec.MsgTx) {
 			continue
 		}
 
@@ -1127,7 +1185,7 @@ func (s *Store) InsertMinedTx(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.Re
 	txType := stake.DetermineTxType(&rec.MsgTx)
 
 	invalidated := false
-	if txType == stake.TxTypeRegular {
+	if isRegularTreeTx(txType, &rec.MsgTx) {
 		height := extractBlockHeaderHeight(blockHeader)
 		_, rawBlockRecVal := existsBlockRecord(ns, height)
 		invalidated = extractRawBlockRecordStakeInvalid(rawBlockRecVal)
@@ -1273,7 +1331,7 @@ func (s *Store) AddCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 	}
 
 	invalidated := false
-	if rec.TxType == stake.TxTypeRegular && block != nil {
+	if isRegularTreeTx(rec.TxType, &rec.MsgTx) && block != nil {
 		blockHeader := existsBlockHeader(ns, block.Hash[:])
 		height := extractBlockHeaderHeight(blockHeader)
 		_, rawBlockRecVal := existsBlockRecord(ns, height)
@@ -1294,7 +1352,7 @@ func (s *Store) AddCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 			change:     change,
 			spentBy:    indexedIncidence{index: ^uint32(0)},
 			opCode:     getP2PKHOpCode(pkScript),
-			isCoinbase: blockchain.IsCoinBaseTx(&rec.MsgTx),
+			isCoinbase: isCoinBaseTx(&rec.MsgTx),
 			hasExpiry:  rec.MsgTx.Expiry != 0,
 		}
 		scTy := pkScriptType(pkScript)
@@ -1313,8 +1371,11 @@ func (s *Store) AddCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 }
 
 // getP2PKHOpCode returns opNonstake for non-stake transactions, or
-// the stake op code tag for stake transactions.
+// the stake op code tag for stake and treasury spend transactions.
 func getP2PKHOpCode(pkScript []byte) uint8 {
+	if _, ok := TreasuryGenScript(pkScript); ok {
+		return opTGen
+	}
 	class := txscript.GetScriptClass(0, pkScript)
 	switch {
 	case class == txscript.StakeSubmissionTy:
@@ -1333,6 +1394,14 @@ func getP2PKHOpCode(pkScript []byte) uint8 {
 // pkScriptType determines the general type of pkScript for the purposes of
 // fast extraction of pkScript data from a raw transaction record.
 func pkScriptType(pkScript []byte) scriptType {
+	if script, ok := TreasuryGenScript(pkScript); ok {
+		switch txscript.GetScriptClass(0, script) {
+		case txscript.PubKeyHashTy:
+			return scriptTypeSP2PKH
+		case txscript.ScriptHashTy:
+			return scriptTypeSP2SH
+		}
+	}
 	class := txscript.GetScriptClass(0, pkScript)
 	switch class {
 	case txscript.PubKeyHashTy:
@@ -1371,7 +1440,7 @@ func (s *Store) addCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 	index uint32, change bool, account uint32) (bool, error) {
 
 	opCode := getP2PKHOpCode(rec.MsgTx.TxOut[index].PkScript)
-	isCoinbase := blockchain.IsCoinBaseTx(&rec.MsgTx)
+	isCoinbase := isCoinBaseTx(&rec.MsgTx)
 	hasExpiry := rec.MsgTx.Expiry != wire.NoExpiryValue
 
 	if block == nil {
@@ -1825,8 +1894,10 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBuc
 			// Handle coinbase transactions specially since they are
 			// not moved to the unconfirmed store.  A coinbase cannot
 			// contain any debits, but all credits should be removed
-			// and the mined balance decremented.
-			if blockchain.IsCoinBaseTx(&rec.MsgTx) {
+			// and the mined balance decremented.  Treasury spends are
+			// not coinbases, and are moved to the unconfirmed store
+			// since they may be mined again in another block.
+			if isCoinBaseTx(&rec.MsgTx) {
 				for i, output := range rec.MsgTx.TxOut {
 					k, v := existsCredit(ns, &rec.Hash,
 						uint32(i), &b.Block)
@@ -1885,8 +1956,10 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBuc
 			// recorded in the unconfirmed store for every previous
 			// output, not just debits.
 			for i, input := range rec.MsgTx.TxIn {
-				// Skip stakebases.
-				if i == 0 && txType == stake.TxTypeSSGen {
+				// Skip stakebases and the null input of treasury
+				// spends.
+				if i == 0 && (txType == stake.TxTypeSSGen ||
+					isTreasurySpend(&rec.MsgTx)) {
 					continue
 				}
 
@@ -2114,7 +2187,11 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBuc
 		return err
 	}
 
-	// Mark block hash for height-1 as the new main chain tip.
+	// Mark block hash for height-1 as the new main chain tip.  The maturity
+	// of the remaining mined credits is derived from the tip height when
+	// balances and spendable outputs are queried (see creditMatured), so
+	// coinbase, vote, revocation and ticket change outputs are reclassified
+	// by the new tip without modifying the credits.
 	_, newTipBlockRecord := existsBlockRecord(ns, height-1)
 	newTipHash := extractRawBlockRecordHash(newTipBlockRecord)
 	err = ns.Put(rootTipBlock, newTipHash)
@@ -2236,15 +2313,34 @@ func (s *Store) outputCreditInfo(ns walletdb.ReadBucket, op wire.OutPoint, block
 // The order is undefined.
 func (s *Store) UnspentOutputs(ns walletdb.ReadBucket) ([]*Credit, error) {
 	var unspent []*Credit
+	err := s.ForEachUnspentOutput(ns, func(c *Credit) error {
+		unspent = append(unspent, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("%v many utxos found in database", len(unspent))
+
+	return unspent, nil
+}
 
+// ForEachUnspentOutput calls f with each unspent received transaction output,
+// reading the outputs from the database cursors as they are visited rather
+// than collecting all outputs first.  Iteration stops and the error is
+// returned if f returns an error.  The order is undefined.
+func (s *Store) ForEachUnspentOutput(ns walletdb.ReadBucket, f func(*Credit) error) error {
 	var op wire.OutPoint
 	var block Block
 	c := ns.NestedReadBucket(bucketUnspent).ReadCursor()
+	defer func() {
+		c.Close()
+	}()
 	for k, v := c.First(); k != nil; k, v = c.Next() {
 		err := readCanonicalOutPoint(k, &op)
 		if err != nil {
-			c.Close()
-			return nil, err
+			return err
 		}
 		if existsRawUnminedInput(ns, k) != nil {
 			// Output is spent by an unmined transaction.
@@ -2254,20 +2350,20 @@ func (s *Store) UnspentOutputs(ns walletdb.ReadBucket) ([]*Credit, error) {
 
 		err = readUnspentBlock(v, &block)
 		if err != nil {
-			c.Close()
-			return nil, err
+			return err
 		}
 
 		cred, err := s.outputCreditInfo(ns, op, &block)
 		if err != nil {
-			c.Close()
-			return nil, err
+			return err
 		}
 
-		unspent = append(unspent, cred)
+		if err := f(cred); err != nil {
+			return err
+		}
 	}
-	c.Close()
 
+	c.Close()
 	c = ns.NestedReadBucket(bucketUnminedCredits).ReadCursor()
 	for k, _ := c.First(); k != nil; k, _ = c.Next() {
 		if existsRawUnminedInput(ns, k) != nil {
@@ -2278,23 +2374,20 @@ func (s *Store) UnspentOutputs(ns walletdb.ReadBucket) ([]*Credit, error) {
 
 		err := readCanonicalOutPoint(k, &op)
 		if err != nil {
-			c.Close()
-			return nil, err
+			return err
 		}
 
 		cred, err := s.outputCreditInfo(ns, op, nil)
 		if err != nil {
-			c.Close()
-			return nil, err
+			return err
 		}
 
-		unspent = append(unspent, cred)
+		if err := f(cred); err != nil {
+			return err
+		}
 	}
-	c.Close()
-
-	log.Tracef("%v many utxos found in database", len(unspent))
 
-	return unspent, nil
+	return nil
 }
 
 // ForEachUnspentOutpoint calls f on each UTXO outpoint.
@@ -2703,6 +2796,29 @@ func ticketChangeMatured(params *chaincfg.Params, txHeight, curHeight int32) boo
 	return txHeight >= 0 && curHeight-txHeight+1 > int32(params.SStxChangeMaturity)
 }
 
+// creditMatured returns whether a mined credit with the stake tag opcode
+// (opNonstake for outputs without a stake tag) recorded in a block at txHeight
+// has matured in a chain with tip height tipHeight.  Coinbase, vote,
+// revocation and treasury spend outputs require coinbase maturity, and ticket
+// change outputs require ticket change maturity.  Ticket outputs never mature since they may
+// only be spent by votes and revocations.
+//
+// Maturity is not recorded with credits and must always be derived from the
+// current main chain tip.  This reclassifies credits when a reorg moves the tip
+// without rewriting them, and independently of the order in which blocks are
+// disconnected.
+func creditMatured(params *chaincfg.Params, opcode uint8, isCoinbase bool, txHeight, tipHeight int32) bool {
+	switch opcode {
+	case txscript.OP_SSTX:
+		return false
+	case txscript.OP_SSGEN, txscript.OP_SSRTX, opTGen:
+		return coinbaseMatured(params, txHeight, tipHeight)
+	case txscript.OP_SSTXCHANGE:
+		return ticketChangeMatured(params, txHeight, tipHeight)
+	}
+	return !isCoinbase || coinbaseMatured(params, txHeight, tipHeight)
+}
+
 // ticketMatured returns whether a ticket mined at txHeight has
 // reached ticket maturity in a chain with a tip height curHeight.
 func ticketMatured(params *chaincfg.Params, txHeight, curHeight int32) bool {
@@ -2881,10 +2997,9 @@ func (s *Store) UnspentOutputsForAmount(ns, addrmgrNs walletdb.ReadBucket, neede
 		}
 
 		// Skip outputs that are not mature.
-		if fetchRawCreditIsCoinbase(cVal) {
-			if !coinbaseMatured(s.chainParams, txHeight, syncHeight) {
-				continue
-			}
+		isCoinbase := fetchRawCreditIsCoinbase(cVal)
+		if !creditMatured(s.chainParams, opcode, isCoinbase, txHeight, syncHeight) {
+			continue
 		}
 		// Skip outputs that have an expiry but have not yet reached
 		// coinbase maturity .
@@ -2893,16 +3008,6 @@ func (s *Store) UnspentOutputsForAmount(ns, addrmgrNs walletdb.ReadBucket, neede
 				continue
 			}
 		}
-		if opcode == txscript.OP_SSGEN || opcode == txscript.OP_SSRTX {
-			if !coinbaseMatured(s.chainParams, txHeight, syncHeight) {
-				continue
-			}
-		}
-		if opcode == txscript.OP_SSTXCHANGE {
-			if !ticketChangeMatured(s.chainParams, txHeight, syncHeight) {
-				continue
-			}
-		}
 
 		// Determine the txtree for the outpoint by whether or not it's
 		// using stake tagged outputs.
@@ -2969,7 +3074,7 @@ func (s *Store) UnspentOutputsForAmount(ns, addrmgrNs walletdb.ReadBucket, neede
 
 			// Skip outputs that are not mature.
 			switch opcode {
-			case txscript.OP_SSGEN, txscript.OP_SSRTX, txscript.OP_SSTXCHANGE:
+			case txscript.OP_SSGEN, txscript.OP_SSRTX, txscript.OP_SSTXCHANGE, opTGen:
 				continue
 			}
 
@@ -3167,20 +3272,9 @@ func (s *Store) MakeIgnoredInputSource(ns, addrmgrNs walletdb.ReadBucket, accoun
 			}
 
 			// Skip outputs that are not mature.
-			if opcode == opNonstake && fetchRawCreditIsCoinbase(cVal) {
-				if !coinbaseMatured(s.chainParams, txHeight, syncHeight) {
-					continue
-				}
-			}
-			if opcode == txscript.OP_SSGEN || opcode == txscript.OP_SSRTX {
-				if !coinbaseMatured(s.chainParams, txHeight, syncHeight) {
-					continue
-				}
-			}
-			if opcode == txscript.OP_SSTXCHANGE {
-				if !ticketChangeMatured(s.chainParams, txHeight, syncHeight) {
-					continue
-				}
+			isCoinbase := fetchRawCreditIsCoinbase(cVal)
+			if !creditMatured(s.chainParams, opcode, isCoinbase, txHeight, syncHeight) {
+				continue
 			}
 
 			// Determine the txtree for the outpoint by whether or not it's
@@ -3304,7 +3398,7 @@ func (s *Store) MakeIgnoredInputSource(ns, addrmgrNs walletdb.ReadBucket, accoun
 			if opcode == txscript.OP_SSGEN || opcode == txscript.OP_SSRTX {
 				continue
 			}
-			if opcode == txscript.OP_SSTXCHANGE {
+			if opcode == txscript.OP_SSTXCHANGE || opcode == opTGen {
 				continue
 			}
 
@@ -3378,6 +3472,102 @@ func (s *Store) MakeIgnoredInputSource(ns, addrmgrNs walletdb.ReadBucket, accoun
 	return InputSource{source: f}
 }
 
+// MultiAccountInputSource creates an InputSource to redeem unspent outputs
+// from several accounts in a single transaction.  Outputs are filtered using
+// minConf, syncHeight and ignore in the same manner as MakeIgnoredInputSource,
+// and accounts without any eligible outputs are skipped.  Accounts holding
+// more outputs which are dust at the default relay fee are drawn from first,
+// so that these outputs are consolidated.  The account of each input is
+// recorded in the Accounts field of the returned input details.
+func (s *Store) MultiAccountInputSource(ns, addrmgrNs walletdb.ReadBucket, accounts []uint32,
+	minConf, syncHeight int32, ignore func(*wire.OutPoint) bool) InputSource {
+
+	// All eligible outputs are loaded when inputs are first selected.
+	var all *txauthor.InputDetail
+	f := func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+		if all == nil {
+			var err error
+			all, err = s.multiAccountInputs(ns, addrmgrNs, accounts, minConf,
+				syncHeight, ignore)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var total dcrutil.Amount
+		n := 0
+		for ; n < len(all.Inputs); n++ {
+			if target != 0 && total >= target {
+				break
+			}
+			total += dcrutil.Amount(all.Inputs[n].ValueIn)
+		}
+		inputDetail := &txauthor.InputDetail{
+			Amount:            total,
+			Inputs:            all.Inputs[:n],
+			Scripts:           all.Scripts[:n],
+			RedeemScriptSizes: all.RedeemScriptSizes[:n],
+			Accounts:          all.Accounts[:n],
+		}
+		return inputDetail, nil
+	}
+
+	return InputSource{source: f}
+}
+
+// multiAccountInputs returns every eligible output of the accounts, ordered by
+// the descending number of dust outputs held by each account.
+func (s *Store) multiAccountInputs(ns, addrmgrNs walletdb.ReadBucket, accounts []uint32,
+	minConf, syncHeight int32, ignore func(*wire.OutPoint) bool) (*txauthor.InputDetail, error) {
+
+	type accountInputs struct {
+		account uint32
+		detail  *txauthor.InputDetail
+		dust    int
+	}
+	sources := make([]accountInputs, 0, len(accounts))
+	seen := make(map[uint32]struct{}, len(accounts))
+	for _, account := range accounts {
+		if _, ok := seen[account]; ok {
+			continue
+		}
+		seen[account] = struct{}{}
+
+		source := s.MakeIgnoredInputSource(ns, addrmgrNs, account, minConf,
+			syncHeight, ignore)
+		detail, err := source.SelectInputs(0)
+		if err != nil {
+			return nil, err
+		}
+		if len(detail.Inputs) == 0 {
+			continue
+		}
+		dust := 0
+		for i, in := range detail.Inputs {
+			if txrules.IsDustAmount(dcrutil.Amount(in.ValueIn),
+				len(detail.Scripts[i]), txrules.DefaultRelayFeePerKb) {
+				dust++
+			}
+		}
+		sources = append(sources, accountInputs{account, detail, dust})
+	}
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].dust > sources[j].dust
+	})
+
+	all := new(txauthor.InputDetail)
+	for _, src := range sources {
+		all.Amount += src.detail.Amount
+		all.Inputs = append(all.Inputs, src.detail.Inputs...)
+		all.Scripts = append(all.Scripts, src.detail.Scripts...)
+		all.RedeemScriptSizes = append(all.RedeemScriptSizes,
+			src.detail.RedeemScriptSizes...)
+		for range src.detail.Inputs {
+			all.Accounts = append(all.Accounts, src.account)
+		}
+	}
+	return all, nil
+}
+
 // balanceFullScan does a fullscan of the UTXO set to get the current balance.
 // It is less efficient than the other balance functions, but works fine for
 // accounts.
@@ -3432,17 +3622,18 @@ func (s *Store) balanceFullScan(ns, addrmgrNs walletdb.ReadBucket, minConf int32
 			accountBalances[thisAcct] = ab
 		}
 
+		creditFromCoinbase := fetchRawCreditIsCoinbase(cVal)
+		matured := creditMatured(s.chainParams, opcode, creditFromCoinbase,
+			height, syncHeight)
+
 		switch opcode {
 		case opNonstake:
 			isConfirmed := confirmed(minConf, height, syncHeight)
-			creditFromCoinbase := fetchRawCreditIsCoinbase(cVal)
-			matureCoinbase := (creditFromCoinbase &&
-				coinbaseMatured(s.chainParams, height, syncHeight))
 
 			if (isConfirmed && !creditFromCoinbase) ||
-				matureCoinbase {
+				(creditFromCoinbase && matured) {
 				ab.Spendable += utxoAmt
-			} else if creditFromCoinbase && !matureCoinbase {
+			} else if creditFromCoinbase {
 				ab.ImmatureCoinbaseRewards += utxoAmt
 			}
 
@@ -3452,7 +3643,7 @@ func (s *Store) balanceFullScan(ns, addrmgrNs walletdb.ReadBucket, minConf int32
 		case txscript.OP_SSGEN:
 			fallthrough
 		case txscript.OP_SSRTX:
-			if coinbaseMatured(s.chainParams, height, syncHeight) {
+			if matured {
 				ab.Spendable += utxoAmt
 			} else {
 				ab.ImmatureStakeGeneration += utxoAmt
@@ -3460,8 +3651,18 @@ func (s *Store) balanceFullScan(ns, addrmgrNs walletdb.ReadBucket, minConf int32
 
 			ab.Total += utxoAmt
 		case txscript.OP_SSTXCHANGE:
-			if ticketChangeMatured(s.chainParams, height, syncHeight) {
+			if matured {
+				ab.Spendable += utxoAmt
+			}
+
+			ab.Total += utxoAmt
+		case opTGen:
+			// Treasury spends create new coins like coinbases, and
+			// are reported with immature coinbase rewards.
+			if matured {
 				ab.Spendable += utxoAmt
+			} else {
+				ab.ImmatureCoinbaseRewards += utxoAmt
 			}
 
 			ab.Total += utxoAmt
@@ -3525,6 +3726,9 @@ func (s *Store) balanceFullScan(ns, addrmgrNs walletdb.ReadBucket, minConf int32
 		case txscript.OP_SSTXCHANGE:
 			ab.Total += utxoAmt
 			continue
+		case opTGen:
+			ab.ImmatureCoinbaseRewards += utxoAmt
+			ab.Total += utxoAmt
 		default:
 			log.Warnf("Unhandled unconfirmed opcode %v: %v", opcode, v)
 		}
@@ -3591,11 +3795,22 @@ func (s *Store) AccountBalance(ns, addrmgrNs walletdb.ReadBucket, minConf int32,
 	return *balance, nil
 }
 
-// AccountBalances returns a map of all account balances at syncHeight block
-// height with all UTXOs that have minConf many confirms.
+// AccountBalances returns a map of all account balances at the main chain tip
+// for outputs with minConf confirmations.  Balances are cached until a
+// modification to the store's credits or ticket commitments or a change to
+// the main chain tip, including when blocks are removed by a reorganize.
 func (s *Store) AccountBalances(ns, addrmgrNs walletdb.ReadBucket, minConf int32) (map[uint32]*Balances, error) {
-	_, syncHeight := s.MainChainTip(ns)
-	return s.balanceFullScan(ns, addrmgrNs, minConf, syncHeight)
+	tip, syncHeight := s.MainChainTip(ns)
+	gen := fetchBalanceGeneration(ns)
+	if balances, ok := s.balances.get(gen, &tip, minConf); ok {
+		return balances, nil
+	}
+	balances, err := s.balanceFullScan(ns, addrmgrNs, minConf, syncHeight)
+	if err != nil {
+		return nil, err
+	}
+	s.balances.put(gen, &tip, minConf, balances)
+	return balances, nil
 }
 
 // InsertTxScript inserts a transaction script into the database.
@@ -0,0 +1,106 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestMinedTransactionsPage(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, _, teardown, err := cloneDB("mined_txs_page.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	var value int64
+	var recorded []chainhash.Hash
+	// mineBlock extends the main chain with a block mining n new
+	// transactions.
+	mineBlock := func(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket, n int) error {
+		headerData := makeHeaderDataSlice(g.generate(dcrutil.BlockValid))
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData, emptyFilters(1))
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			value++
+			tx := &wire.MsgTx{TxOut: []*wire.TxOut{{Value: value}}}
+			rec, err := NewTxRecordFromMsgTx(tx, time.Time{})
+			if err != nil {
+				return err
+			}
+			err = s.InsertMinedTx(ns, addrmgrNs, rec, &headerData[0].BlockHash)
+			if err != nil {
+				return err
+			}
+			recorded = append(recorded, rec.Hash)
+		}
+		return nil
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+		for _, n := range []int{3, 0, 2, 1} {
+			if err := mineBlock(ns, addrmgrNs, n); err != nil {
+				return err
+			}
+		}
+
+		const limit = 2
+		var paged []chainhash.Hash
+		var height int32
+		var index int
+		for page := 0; ; page++ {
+			// Attach a new block midway through paging.
+			if page == 2 {
+				if err := mineBlock(ns, addrmgrNs, 3); err != nil {
+					return err
+				}
+			}
+			details, nextHeight, nextIndex, err := s.MinedTransactionsPage(ns,
+				height, index, limit)
+			if err != nil {
+				return err
+			}
+			if len(details) == 0 {
+				if nextHeight != height || nextIndex != index {
+					t.Errorf("empty page moved cursor")
+				}
+				break
+			}
+			if len(details) > limit {
+				t.Errorf("page %d has %d transactions", page, len(details))
+			}
+			for i := range details {
+				paged = append(paged, details[i].Hash)
+			}
+			height, index = nextHeight, nextIndex
+		}
+
+		if len(paged) != len(recorded) {
+			t.Fatalf("paged %d transactions, expected %d", len(paged), len(recorded))
+		}
+		for i := range recorded {
+			if paged[i] != recorded[i] {
+				t.Errorf("transaction %d is %v, expected %v", i, &paged[i], &recorded[i])
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -6,6 +6,8 @@
 package udb
 
 import (
+	"math"
+
 	"decred.org/dcrwallet/errors"
 	"decred.org/dcrwallet/wallet/walletdb"
 	"github.com/decred/dcrd/blockchain/stake/v3"
@@ -271,6 +273,54 @@ func (s *Store) Tx(ns walletdb.ReadBucket, txHash *chainhash.Hash) (*wire.MsgTx,
 	return s.parseTx(*txHash, v)
 }
 
+// MaxTxLabelLen is the maximum length, in bytes, of a transaction label.
+const MaxTxLabelLen = 500
+
+// TxLabel returns the label of a transaction, or the empty string if the
+// transaction is not labeled.
+func (s *Store) TxLabel(ns walletdb.ReadBucket, txHash *chainhash.Hash) string {
+	return fetchTxLabel(ns, txHash)
+}
+
+// SetTxLabel labels a recorded transaction, replacing any previous label.  An
+// empty label removes the transaction's label.  Labels may not exceed
+// MaxTxLabelLen bytes.
+func (s *Store) SetTxLabel(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash, label string) error {
+	if len(label) > MaxTxLabelLen {
+		return errors.E(errors.Invalid, errors.Errorf("label exceeds "+
+			"maximum length of %d bytes", MaxTxLabelLen))
+	}
+	if !s.ExistsTx(ns, txHash) {
+		return errors.E(errors.NotExist, errors.Errorf("no transaction %v", txHash))
+	}
+	return putTxLabel(ns, txHash, label)
+}
+
+// ImportTxLabel labels a transaction which may not yet be recorded by the
+// store, such as a transaction which will be discovered by a rescan after a
+// wallet is restored from a backup.  The label is returned for the transaction
+// once it is recorded.  Labels may not exceed MaxTxLabelLen bytes.
+func (s *Store) ImportTxLabel(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash, label string) error {
+	if len(label) > MaxTxLabelLen {
+		return errors.E(errors.Invalid, errors.Errorf("label exceeds "+
+			"maximum length of %d bytes", MaxTxLabelLen))
+	}
+	return putTxLabel(ns, txHash, label)
+}
+
+// ForEachTxLabel calls f with the hash and label of every labeled transaction.
+// Transactions are visited in order of their hash.
+func (s *Store) ForEachTxLabel(ns walletdb.ReadBucket, f func(txHash *chainhash.Hash, label string) error) error {
+	return ns.NestedReadBucket(bucketTxLabels).ForEach(func(k, v []byte) error {
+		var txHash chainhash.Hash
+		if len(k) != len(txHash) {
+			return errors.E(errors.IO, errors.Errorf("transaction label key length %d", len(k)))
+		}
+		copy(txHash[:], k)
+		return f(&txHash, string(v))
+	})
+}
+
 // ExistsTx checks to see if a transaction exists in the database.
 func (s *Store) ExistsTx(ns walletdb.ReadBucket, txHash *chainhash.Hash) bool {
 	// First, check whether there exists an unmined transaction with this
@@ -523,6 +573,40 @@ func (s *Store) RangeTransactions(ns walletdb.ReadBucket, begin, end int32,
 	return err
 }
 
+// MinedTransactionsPage returns details for up to limit main chain
+// transactions, beginning with the transaction at index in the block at height.
+// Transactions are ordered by block height, and then by the order they were
+// recorded in each block.  The height and index of the transaction following
+// the last returned transaction are also returned, and may be used to request
+// the next page.  These remain valid as later blocks are attached to the main
+// chain.
+func (s *Store) MinedTransactionsPage(ns walletdb.ReadBucket, height int32, index, limit int) (
+	details []TxDetails, nextHeight int32, nextIndex int, err error) {
+
+	if height < 0 || index < 0 || limit < 1 {
+		return nil, 0, 0, errors.E(errors.Invalid, "invalid transaction page")
+	}
+	nextHeight, nextIndex = height, index
+	_, err = s.rangeBlockTransactions(ns, height, math.MaxInt32, func(blockDetails []TxDetails) (bool, error) {
+		i := 0
+		if blockDetails[0].Block.Height == height {
+			i = index
+		}
+		for ; i < len(blockDetails); i++ {
+			details = append(details, blockDetails[i])
+			nextHeight, nextIndex = blockDetails[i].Block.Height, i+1
+			if len(details) == limit {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return details, nextHeight, nextIndex, nil
+}
+
 // PreviousPkScripts returns a slice of previous output scripts for each credit
 // output this transaction record debits from.
 func (s *Store) PreviousPkScripts(ns walletdb.ReadBucket, rec *TxRecord, block *Block) ([][]byte, error) {
@@ -92,8 +92,9 @@ func (s *Store) InsertMemPoolTx(ns walletdb.ReadWriteBucket, rec *TxRecord) erro
 	txType := stake.DetermineTxType(&rec.MsgTx)
 
 	for i, input := range rec.MsgTx.TxIn {
-		// Skip stakebases for votes.
-		if i == 0 && txType == stake.TxTypeSSGen {
+		// Skip stakebases for votes and the null input of treasury
+		// spends.
+		if i == 0 && (txType == stake.TxTypeSSGen || isTreasurySpend(&rec.MsgTx)) {
 			continue
 		}
 		prevOut := &input.PreviousOutPoint
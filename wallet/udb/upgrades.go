@@ -122,10 +122,38 @@ const (
 	// panics.
 	importedXpubAccountVersion = 13
 
+	// txLabelsVersion is the fourteenth version of the database.  It adds a
+	// bucket to the transaction store namespace to record user-provided
+	// transaction labels.
+	txLabelsVersion = 14
+
+	// lockedOutpointsVersion is the fifteenth version of the database.  It
+	// adds a bucket to the transaction store namespace to record outpoints
+	// which remain locked across wallet restarts.
+	lockedOutpointsVersion = 15
+
+	// vspFeesVersion is the sixteenth version of the database.  It adds a
+	// bucket to the transaction store namespace to record the transactions
+	// paying the voting service provider fees of tickets.
+	vspFeesVersion = 16
+
+	// coinTypeVersion is the seventeenth version of the database.  It
+	// records the BIP0044 coin type in use in the address manager, rather
+	// than determining it from which coin type keys are saved, allowing
+	// wallets to be created for coin types other than those of the network.
+	coinTypeVersion = 17
+
+	// balanceGenerationVersion is the eighteenth version of the database.
+	// It adds a value to the transaction store namespace identifying the
+	// current state of the records which determine account balances, which
+	// is changed by every modification of them and used to invalidate cached
+	// balances.
+	balanceGenerationVersion = 18
+
 	// DBVersion is the latest version of the database that is understood by the
 	// program.  Databases with recorded versions higher than this will fail to
 	// open (meaning any upgrades prevent reverting to older software).
-	DBVersion = importedXpubAccountVersion
+	DBVersion = balanceGenerationVersion
 )
 
 // upgrades maps between old database versions and the upgrade function to
@@ -144,6 +172,11 @@ var upgrades = [...]func(walletdb.ReadWriteTx, []byte, *chaincfg.Params) error{
 	lastProcessedTxsBlockVersion - 1: lastProcessedTxsBlockUpgrade,
 	ticketCommitmentsVersion - 1:     ticketCommitmentsUpgrade,
 	importedXpubAccountVersion - 1:   importedXpubAccountUpgrade,
+	txLabelsVersion - 1:              txLabelsUpgrade,
+	lockedOutpointsVersion - 1:       lockedOutpointsUpgrade,
+	vspFeesVersion - 1:               vspFeesUpgrade,
+	coinTypeVersion - 1:              coinTypeUpgrade,
+	balanceGenerationVersion - 1:     balanceGenerationUpgrade,
 }
 
 func lastUsedAddressIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -1026,6 +1059,149 @@ func importedXpubAccountUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
 
+func txLabelsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 13
+	const newVersion = 14
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 13 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "txLabelsUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketTxLabels)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func lockedOutpointsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 14
+	const newVersion = 15
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 14 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "lockedOutpointsUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketLockedOutpoints)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func vspFeesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 15
+	const newVersion = 16
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 15 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "vspFeesUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketVSPFees)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func coinTypeUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 16
+	const newVersion = 17
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+	mainBucket := addrmgrBucket.NestedReadWriteBucket(mainBucketName)
+
+	// Assert that this function is only called on version 16 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "coinTypeUpgrade inappropriately called")
+	}
+
+	// Record the coin type of the saved coin type keys.  Newly created
+	// databases, which are upgraded from the initial version, already record
+	// the coin type they were created with.  Watching-only wallets created
+	// from an account xpub do not save any coin type keys, and their coin type
+	// remains unknown.
+	_, ok, err := fetchCoinType(addrmgrBucket)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		legacyCoinType, slip0044CoinType := CoinTypes(params)
+		switch {
+		case mainBucket.Get(coinTypeLegacyPubKeyName) != nil:
+			err = putCoinType(addrmgrBucket, legacyCoinType)
+		case mainBucket.Get(coinTypeSLIP0044PubKeyName) != nil:
+			err = putCoinType(addrmgrBucket, slip0044CoinType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func balanceGenerationUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 17
+	const newVersion = 18
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 17 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "balanceGenerationUpgrade inappropriately called")
+	}
+
+	err = putBalanceGeneration(txmgrBucket)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
 // Upgrade checks whether the any upgrades are necessary before the database is
 // ready for application usage.  If any are, they are performed.
 func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -38,6 +38,12 @@ var dbUpgradeTests = [...]struct {
 	// No upgrade test for V9, it is a fix for V8 and the previous test still applies
 	// TODO: V10 upgrade test
 	{verifyV12Upgrade, "v11.db.gz"},
+	// No upgrade test for V13, it is a backwards-compatible upgrade
+	{verifyV14Upgrade, "v11.db.gz"},
+	{verifyV15Upgrade, "v11.db.gz"},
+	{verifyV16Upgrade, "v11.db.gz"},
+	{verifyV17Upgrade, "v11.db.gz"},
+	{verifyV18Upgrade, "v11.db.gz"},
 }
 
 var pubPass = []byte("public")
@@ -540,3 +546,87 @@ func verifyV12Upgrade(t *testing.T, db walletdb.DB) {
 		t.Error(err)
 	}
 }
+
+func verifyV14Upgrade(t *testing.T, db walletdb.DB) {
+	ctx := context.Background()
+	err := walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		txmgrns := tx.ReadBucket(wtxmgrBucketKey)
+		if b := txmgrns.NestedReadBucket(bucketTxLabels); b == nil {
+			t.Fatalf("upgrade should have created bucketTxLabels")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func verifyV15Upgrade(t *testing.T, db walletdb.DB) {
+	ctx := context.Background()
+	err := walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		txmgrns := tx.ReadBucket(wtxmgrBucketKey)
+		if b := txmgrns.NestedReadBucket(bucketLockedOutpoints); b == nil {
+			t.Fatalf("upgrade should have created bucketLockedOutpoints")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func verifyV16Upgrade(t *testing.T, db walletdb.DB) {
+	ctx := context.Background()
+	err := walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		txmgrns := tx.ReadBucket(wtxmgrBucketKey)
+		if b := txmgrns.NestedReadBucket(bucketVSPFees); b == nil {
+			t.Fatalf("upgrade should have created bucketVSPFees")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func verifyV17Upgrade(t *testing.T, db walletdb.DB) {
+	ctx := context.Background()
+	params := chaincfg.TestNet3Params()
+	legacyCoinType, slip0044CoinType := CoinTypes(params)
+	err := walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrBucketKey)
+		mainBucket := ns.NestedReadBucket(mainBucketName)
+		coinType, ok, err := fetchCoinType(ns)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			t.Fatalf("upgrade should have recorded the coin type")
+		}
+		expected := slip0044CoinType
+		if mainBucket.Get(coinTypeLegacyPubKeyName) != nil {
+			expected = legacyCoinType
+		}
+		if coinType != expected {
+			t.Errorf("upgrade recorded coin type %d, expected %d", coinType, expected)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func verifyV18Upgrade(t *testing.T, db walletdb.DB) {
+	ctx := context.Background()
+	err := walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		txmgrns := tx.ReadBucket(wtxmgrBucketKey)
+		if v := txmgrns.Get(rootBalanceGen); len(v) != 8 {
+			t.Fatalf("upgrade should have recorded the balance generation")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+// PutVSPFeeTx records feeTx as the transaction paying the voting service
+// provider fee of a ticket.  A ticket is associated with at most one fee
+// transaction, and an error with kind errors.Exist is returned if a fee
+// transaction has already been recorded for the ticket.
+func (s *Store) PutVSPFeeTx(ns walletdb.ReadWriteBucket, ticketHash *chainhash.Hash, feeTx *wire.MsgTx) error {
+	if ns.NestedReadBucket(bucketVSPFees).Get(ticketHash[:]) != nil {
+		return errors.E(errors.Exist, errors.Errorf("VSP fee for ticket %v already recorded", ticketHash))
+	}
+	return putVSPFeeTx(ns, ticketHash, feeTx)
+}
+
+// VSPFeeTx returns the transaction paying the voting service provider fee of
+// a ticket.  If no fee transaction has been recorded for the ticket, an error
+// with kind errors.NotExist is returned.
+func (s *Store) VSPFeeTx(ns walletdb.ReadBucket, ticketHash *chainhash.Hash) (*wire.MsgTx, error) {
+	return fetchVSPFeeTx(ns, ticketHash)
+}
+
+// DeleteVSPFeeTx removes the recorded voting service provider fee transaction
+// of a ticket.  If no fee transaction has been recorded for the ticket, an
+// error with kind errors.NotExist is returned.
+func (s *Store) DeleteVSPFeeTx(ns walletdb.ReadWriteBucket, ticketHash *chainhash.Hash) error {
+	if ns.NestedReadBucket(bucketVSPFees).Get(ticketHash[:]) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no VSP fee transaction for ticket %v", ticketHash))
+	}
+	return deleteVSPFeeTx(ns, ticketHash)
+}
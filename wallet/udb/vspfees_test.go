@@ -0,0 +1,84 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestVSPFeeTx(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, _, teardown, err := cloneDB("vsp_fees.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ticketHash := chainhash.Hash{1}
+	feeTx := spendOutput(&chainhash.Hash{2}, 0, wire.TxTreeRegular, 1e6)
+	otherFeeTx := spendOutput(&chainhash.Hash{3}, 0, wire.TxTreeRegular, 2e6)
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		_, err := s.VSPFeeTx(ns, &ticketHash)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("unrecorded fee: expected NotExist, got %v", err)
+		}
+		err = s.PutVSPFeeTx(ns, &ticketHash, feeTx)
+		if err != nil {
+			return err
+		}
+		err = s.PutVSPFeeTx(ns, &ticketHash, otherFeeTx)
+		if !errors.Is(err, errors.Exist) {
+			t.Errorf("second fee: expected Exist, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrBucketKey)
+		got, err := s.VSPFeeTx(ns, &ticketHash)
+		if err != nil {
+			return err
+		}
+		if got.TxHash() != feeTx.TxHash() {
+			t.Errorf("fee tx %v, expected %v", got.TxHash(), feeTx.TxHash())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A deleted fee may be replaced by another.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		err := s.DeleteVSPFeeTx(ns, &ticketHash)
+		if err != nil {
+			return err
+		}
+		_, err = s.VSPFeeTx(ns, &ticketHash)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("deleted fee: expected NotExist, got %v", err)
+		}
+		err = s.DeleteVSPFeeTx(ns, &ticketHash)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("second delete: expected NotExist, got %v", err)
+		}
+		return s.PutVSPFeeTx(ns, &ticketHash, otherFeeTx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
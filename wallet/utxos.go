@@ -34,24 +34,43 @@ func (p *OutputSelectionPolicy) meetsRequiredConfs(txHeight, curHeight int32) bo
 func (w *Wallet) UnspentOutputs(ctx context.Context, policy OutputSelectionPolicy) ([]*TransactionOutput, error) {
 	const op errors.Op = "wallet.UnspentOutputs"
 	var outputResults []*TransactionOutput
+	err := w.ForEachUnspentOutput(ctx, policy.Account, policy.RequiredConfirmations,
+		func(output *TransactionOutput) error {
+			outputResults = append(outputResults, output)
+			return nil
+		})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return outputResults, nil
+}
+
+// ForEachUnspentOutput calls fn with each unspent output of an account with at
+// least minConf confirmations.  Outputs are read from the database as they are
+// visited, so the full set of unspent outputs is never held in memory.
+// Iteration stops and the error is returned if fn returns an error.
+//
+// fn is called while a database view is open and must not call back into the
+// wallet.
+func (w *Wallet) ForEachUnspentOutput(ctx context.Context, account uint32, minConf int32,
+	fn func(*TransactionOutput) error) error {
+
+	const op errors.Op = "wallet.ForEachUnspentOutput"
+	policy := OutputSelectionPolicy{
+		Account:               account,
+		RequiredConfirmations: minConf,
+	}
 	err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
 		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
 
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
 
-		// TODO: actually stream outputs from the db instead of fetching
-		// all of them at once.
-		outputs, err := w.TxStore.UnspentOutputs(txmgrNs)
-		if err != nil {
-			return err
-		}
-
-		for _, output := range outputs {
+		return w.TxStore.ForEachUnspentOutput(txmgrNs, func(output *udb.Credit) error {
 			// Ignore outputs that haven't reached the required
 			// number of confirmations.
 			if !policy.meetsRequiredConfs(output.Height, tipHeight) {
-				continue
+				return nil
 			}
 
 			// Ignore outputs that are not controlled by the account.
@@ -63,14 +82,14 @@ func (w *Wallet) UnspentOutputs(ctx context.Context, policy OutputSelectionPolic
 				// to without a valid address.  TODO: Fix this
 				// by saving outputs per account, or accounts
 				// per output.
-				continue
+				return nil
 			}
 			outputAcct, err := w.Manager.AddrAccount(addrmgrNs, addrs[0])
 			if err != nil {
 				return err
 			}
 			if outputAcct != policy.Account {
-				continue
+				return nil
 			}
 
 			// Stakebase isn't exposed by wtxmgr so those will be
@@ -80,7 +99,7 @@ func (w *Wallet) UnspentOutputs(ctx context.Context, policy OutputSelectionPolic
 				outputSource = OutputKindCoinbase
 			}
 
-			result := &TransactionOutput{
+			return fn(&TransactionOutput{
 				OutPoint: output.OutPoint,
 				Output: wire.TxOut{
 					Value: int64(output.Amount),
@@ -92,16 +111,13 @@ func (w *Wallet) UnspentOutputs(ctx context.Context, policy OutputSelectionPolic
 				OutputKind:      outputSource,
 				ContainingBlock: BlockIdentity(output.Block),
 				ReceiveTime:     output.Received,
-			}
-			outputResults = append(outputResults, result)
-		}
-
-		return nil
+			})
+		})
 	})
 	if err != nil {
-		return nil, errors.E(op, err)
+		return errors.E(op, err)
 	}
-	return outputResults, nil
+	return nil
 }
 
 // SelectInputs selects transaction inputs to redeem unspent outputs stored in
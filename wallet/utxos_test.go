@@ -0,0 +1,104 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestForEachUnspentOutput(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	savings, err := w.NextAccount(ctx, "savings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript := func(account uint32) []byte {
+		addr, err := w.NewExternalAddress(ctx, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+
+	// Fund the default account with three outputs and the savings account
+	// with one.  The final output does not pay the wallet.
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 6e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript(0)))
+	funding.AddTxOut(wire.NewTxOut(2e8, pkScript(savings)))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript(0)))
+	funding.AddTxOut(wire.NewTxOut(1e8, pkScript(0)))
+	funding.AddTxOut(wire.NewTxOut(1e8, make([]byte, 25)))
+	err = w.AcceptMempoolTx(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundingHash := funding.TxHash()
+
+	// Exactly the outputs of the default account are visited.
+	visited := make(map[uint32]int)
+	err = w.ForEachUnspentOutput(ctx, 0, 0, func(output *TransactionOutput) error {
+		if output.OutPoint.Hash != fundingHash {
+			t.Errorf("visited unknown output %v", &output.OutPoint)
+		}
+		visited[output.OutPoint.Index]++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visited) != 3 || visited[0] != 1 || visited[2] != 1 || visited[3] != 1 {
+		t.Errorf("visited outputs %v, expected indexes 0, 2 and 3 once", visited)
+	}
+	unspent, err := w.UnspentOutputs(ctx, OutputSelectionPolicy{Account: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unspent) != len(visited) {
+		t.Errorf("UnspentOutputs returned %d outputs, expected %d",
+			len(unspent), len(visited))
+	}
+
+	// Unmined outputs are not visited when confirmations are required.
+	err = w.ForEachUnspentOutput(ctx, 0, 1, func(output *TransactionOutput) error {
+		t.Errorf("visited unconfirmed output %v", &output.OutPoint)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Iteration stops at the first error returned by fn.
+	errStop := errors.New("stop")
+	calls := 0
+	err = w.ForEachUnspentOutput(ctx, 0, 0, func(*TransactionOutput) error {
+		calls++
+		return errStop
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("expected iteration error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times after returning an error, expected 1", calls)
+	}
+}
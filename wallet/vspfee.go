@@ -0,0 +1,212 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/txauthor"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v3"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// PayVSPFee creates and signs a transaction spending outputs of account to pay
+// a voting service provider fee of feeAmount to feeAddress for a wallet
+// ticket.  Outputs need not be confirmed to be spent, as the fee is commonly
+// paid before the ticket is mined.  The fee transaction is not published, as
+// it is submitted to the VSP together with the ticket.  Instead, it is
+// recorded as the ticket's fee transaction and its inputs are persistently
+// locked so they are not spent by other transactions.
+//
+// A ticket's fee is only paid once.  If a fee transaction has already been
+// recorded for the ticket, it is returned instead of creating another, and the
+// PrevScripts and PrevAccounts fields of the returned AuthoredTx are not set.
+// A fee which will not be submitted is released with AbandonVSPFee.
+func (w *Wallet) PayVSPFee(ctx context.Context, ticketHash *chainhash.Hash, feeAddress dcrutil.Address,
+	feeAmount dcrutil.Amount, account uint32) (*txauthor.AuthoredTx, error) {
+
+	const opf = "wallet.PayVSPFee(%v)"
+	op := errors.Opf(opf, ticketHash)
+
+	feeScript, err := txscript.PayToAddrScript(feeAddress)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+
+	var feeTx *wire.MsgTx
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		details, err := w.TxStore.TxDetails(ns, ticketHash)
+		if err != nil {
+			return err
+		}
+		if !stake.IsSStx(&details.MsgTx) {
+			return errors.E(errors.Invalid, "not a ticket")
+		}
+		feeTx, err = w.TxStore.VSPFeeTx(ns, ticketHash)
+		if errors.Is(err, errors.NotExist) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if feeTx != nil {
+		return recordedVSPFeeTx(feeTx, feeScript), nil
+	}
+
+	// The selected inputs remain locked in memory until they are also locked
+	// persistently when the fee transaction is recorded, so they are never
+	// available to other transactions.  They are unlocked if the fee
+	// transaction is not recorded.
+	outputs := []*wire.TxOut{wire.NewTxOut(int64(feeAmount), feeScript)}
+	atx, err := w.newUnsignedTransaction(ctx, outputs, w.RelayFee(), account, 0,
+		OutputSelectionAlgorithmDefault, nil, true)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	recorded := false
+	defer func() {
+		if recorded {
+			return
+		}
+		w.lockedOutpointMu.Lock()
+		for _, prevOut := range atx.PrevOutpoints {
+			delete(w.lockedOutpoints, prevOut)
+		}
+		w.lockedOutpointMu.Unlock()
+	}()
+	w.NtfnServer.notifyAuthoredTx(atx.Tx)
+
+	signErrs, err := w.SignTransaction(ctx, atx.Tx, txscript.SigHashAll, nil, nil, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(signErrs) != 0 {
+		return nil, errors.E(op, signErrs[0].Error)
+	}
+
+	// Record the fee transaction unless another was recorded while this one
+	// was being created, in which case the recorded transaction is returned.
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := w.TxStore.PutVSPFeeTx(ns, ticketHash, atx.Tx)
+		if errors.Is(err, errors.Exist) {
+			feeTx, err = w.TxStore.VSPFeeTx(ns, ticketHash)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		for i := range atx.PrevOutpoints {
+			err := w.TxStore.PutLockedOutpoint(ns, &atx.PrevOutpoints[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if feeTx != nil {
+		return recordedVSPFeeTx(feeTx, feeScript), nil
+	}
+	recorded = true
+
+	return atx, nil
+}
+
+// recordedVSPFeeTx returns an AuthoredTx describing a previously recorded VSP
+// fee transaction.  The change output, if any, is the output which does not pay
+// the fee script.  As the transaction is signed, its serialize size is the
+// estimated signed size.
+func recordedVSPFeeTx(feeTx *wire.MsgTx, feeScript []byte) *txauthor.AuthoredTx {
+	changeIndex := -1
+	for i, out := range feeTx.TxOut {
+		if !bytes.Equal(out.PkScript, feeScript) {
+			changeIndex = i
+			break
+		}
+	}
+	var totalInput dcrutil.Amount
+	prevOutpoints := make([]wire.OutPoint, 0, len(feeTx.TxIn))
+	for _, in := range feeTx.TxIn {
+		totalInput += dcrutil.Amount(in.ValueIn)
+		prevOutpoints = append(prevOutpoints, in.PreviousOutPoint)
+	}
+	return &txauthor.AuthoredTx{
+		Tx:                           feeTx,
+		PrevOutpoints:                prevOutpoints,
+		TotalInput:                   totalInput,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: feeTx.SerializeSize(),
+	}
+}
+
+// AbandonVSPFee removes the recorded voting service provider fee transaction
+// of a ticket and unlocks its inputs, so they may be spent by other
+// transactions and another fee may be paid for the ticket.  It is intended for
+// fees which were never accepted by the VSP, and must not be used after the
+// fee transaction has been submitted.  If no fee has been paid for the ticket,
+// an error with kind errors.NotExist is returned.
+func (w *Wallet) AbandonVSPFee(ctx context.Context, ticketHash *chainhash.Hash) error {
+	const opf = "wallet.AbandonVSPFee(%v)"
+	var feeTx *wire.MsgTx
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		var err error
+		feeTx, err = w.TxStore.VSPFeeTx(ns, ticketHash)
+		if err != nil {
+			return err
+		}
+		err = w.TxStore.DeleteVSPFeeTx(ns, ticketHash)
+		if err != nil {
+			return err
+		}
+		for _, in := range feeTx.TxIn {
+			err := w.TxStore.DeleteLockedOutpoint(ns, &in.PreviousOutPoint)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(errors.Opf(opf, ticketHash), err)
+	}
+
+	w.lockedOutpointMu.Lock()
+	for _, in := range feeTx.TxIn {
+		delete(w.lockedOutpoints, in.PreviousOutPoint)
+	}
+	w.lockedOutpointMu.Unlock()
+	return nil
+}
+
+// VSPFeeTransaction returns the recorded transaction paying the voting service
+// provider fee of a ticket.  If no fee has been paid for the ticket, an error
+// with kind errors.NotExist is returned.
+func (w *Wallet) VSPFeeTransaction(ctx context.Context, ticketHash *chainhash.Hash) (*wire.MsgTx, error) {
+	const opf = "wallet.VSPFeeTransaction(%v)"
+	var feeTx *wire.MsgTx
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		feeTx, err = w.TxStore.VSPFeeTx(ns, ticketHash)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(errors.Opf(opf, ticketHash), err)
+	}
+	return feeTx, nil
+}
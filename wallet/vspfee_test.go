@@ -0,0 +1,174 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/txscript/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestPayVSPFee(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustScript := func(script []byte, err error) []byte {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+
+	// ticket returns a new unmined ticket paying to the wallet address.
+	ticket := func(prevHash byte) *chainhash.Hash {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{prevHash}, 0, 0), 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8, mustScript(txscript.PayToSStx(addr))))
+		tx.AddTxOut(wire.NewTxOut(0, mustScript(txscript.GenerateSStxAddrPush(addr, 1e8, 0x0058))))
+		tx.AddTxOut(wire.NewTxOut(0, mustScript(txscript.PayToSStxChange(addr))))
+		err := w.AcceptMempoolTx(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := tx.TxHash()
+		return &h
+	}
+	ticketHash := ticket(1)
+	otherTicketHash := ticket(2)
+
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{3}, 0, 0), 1e8, nil))
+	funding.AddTxOut(wire.NewTxOut(1e8, mustScript(txscript.PayToAddrScript(addr))))
+	err = w.AcceptMempoolTx(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundingOut := wire.OutPoint{Hash: funding.TxHash(), Index: 0, Tree: wire.TxTreeRegular}
+
+	feeAddr, err := dcrutil.NewAddressPubKeyHash(make([]byte, 20), cfg.Params,
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeScript := mustScript(txscript.PayToAddrScript(feeAddr))
+
+	_, err = w.VSPFeeTransaction(ctx, ticketHash)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("fee before payment: expected NotExist, got %v", err)
+	}
+
+	const feeAmount = 1e6
+	atx, err := w.PayVSPFee(ctx, ticketHash, feeAddr, feeAmount, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeHash := atx.Tx.TxHash()
+	if len(atx.Tx.TxIn) != 1 || atx.Tx.TxIn[0].PreviousOutPoint != fundingOut {
+		t.Fatalf("fee tx does not spend the funding output")
+	}
+	if len(atx.Tx.TxIn[0].SignatureScript) == 0 {
+		t.Errorf("fee tx is not signed")
+	}
+	var paid bool
+	for i, out := range atx.Tx.TxOut {
+		if i == atx.ChangeIndex {
+			continue
+		}
+		if out.Value == feeAmount && string(out.PkScript) == string(feeScript) {
+			paid = true
+		}
+	}
+	if !paid || atx.ChangeIndex < 0 {
+		t.Errorf("fee tx does not pay fee with change: %v", atx.Tx.TxOut)
+	}
+	if !w.LockedOutpoint(fundingOut) {
+		t.Errorf("fee tx input is not locked")
+	}
+
+	// The fee tx is recorded for the ticket.
+	recorded, err := w.VSPFeeTransaction(ctx, ticketHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recorded.TxHash() != feeHash {
+		t.Errorf("recorded fee tx %v, expected %v", recorded.TxHash(), &feeHash)
+	}
+
+	// Paying the fee again returns the recorded transaction.
+	again, err := w.PayVSPFee(ctx, ticketHash, feeAddr, feeAmount, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := again.Tx.TxHash(); h != feeHash {
+		t.Errorf("second payment created fee tx %v, expected %v", &h, &feeHash)
+	}
+	if again.ChangeIndex != atx.ChangeIndex {
+		t.Errorf("second payment change index %d, expected %d",
+			again.ChangeIndex, atx.ChangeIndex)
+	}
+	if again.TotalInput != atx.TotalInput || again.Fee() != atx.Fee() {
+		t.Errorf("second payment input %v and fee %v, expected %v and %v",
+			again.TotalInput, again.Fee(), atx.TotalInput, atx.Fee())
+	}
+	if again.EstimatedSignedSerializeSize != atx.Tx.SerializeSize() {
+		t.Errorf("second payment size %d, expected %d",
+			again.EstimatedSignedSerializeSize, atx.Tx.SerializeSize())
+	}
+
+	// The locked funding output can not pay the fee of another ticket.
+	_, err = w.PayVSPFee(ctx, otherTicketHash, feeAddr, feeAmount, 0)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("other ticket: expected InsufficientBalance, got %v", err)
+	}
+
+	// An abandoned fee unlocks its input, which may then pay the fee of
+	// another ticket.
+	err = w.AbandonVSPFee(ctx, ticketHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.LockedOutpoint(fundingOut) {
+		t.Errorf("abandoned fee tx input is locked")
+	}
+	_, err = w.VSPFeeTransaction(ctx, ticketHash)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("abandoned fee: expected NotExist, got %v", err)
+	}
+	err = w.AbandonVSPFee(ctx, ticketHash)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("second abandon: expected NotExist, got %v", err)
+	}
+	other, err := w.PayVSPFee(ctx, otherTicketHash, feeAddr, feeAmount, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(other.Tx.TxIn) != 1 || other.Tx.TxIn[0].PreviousOutPoint != fundingOut {
+		t.Errorf("other ticket fee tx does not spend the funding output")
+	}
+
+	// Fees are only paid for tickets.
+	fundingHash := funding.TxHash()
+	_, err = w.PayVSPFee(ctx, &fundingHash, feeAddr, feeAmount, 0)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("non-ticket: expected Invalid, got %v", err)
+	}
+}
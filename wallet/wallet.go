@@ -34,7 +34,6 @@ import (
 	"github.com/decred/dcrd/dcrutil/v3"
 	"github.com/decred/dcrd/gcs"
 	"github.com/decred/dcrd/hdkeychain/v3"
-	dcrdtypes "github.com/decred/dcrd/rpc/jsonrpc/types"
 	"github.com/decred/dcrd/txscript/v3"
 	"github.com/decred/dcrd/wire"
 	"golang.org/x/sync/errgroup"
@@ -94,8 +93,12 @@ type Wallet struct {
 	subsidyCache       *blockchain.SubsidyCache
 
 	// Start up flags/settings
-	gapLimit        int
-	accountGapLimit int
+	gapLimit             int
+	accountGapLimit      int
+	rescanWorkers        int
+	rescanBatchSize      int // maxBlocksPerRescan when zero
+	incrementalDiscovery bool
+	discoveryMaxIndex    uint32
 
 	networkBackend   NetworkBackend
 	networkBackendMu sync.Mutex
@@ -109,6 +112,14 @@ type Wallet struct {
 	ticketFeeIncrement      dcrutil.Amount
 	DisallowFree            bool
 	AllowHighFees           bool
+	DustPolicy              txrules.DustThresholdPolicy // nil for default
+	EconomicChange          dcrutil.Amount              // change below this is added to the fee
+	FeeSchedule             []txrules.FeeTier           // nil to charge the relay fee for every byte
+	CeilFee                 bool                        // round fees up to whole atoms rather than truncating
+	Reserve                 dcrutil.Amount              // spendable balance created transactions may not spend
+	MaxFee                  dcrutil.Amount              // largest fee of created transactions, zero for no limit
+	CheckAddressReuse       bool                        // record used wallet addresses paid by created transactions
+	CoinSelector            udb.CoinSelector            // nil uses udb.DefaultCoinSelector
 	disableCoinTypeUpgrades bool
 	recentlyPublished       map[chainhash.Hash]struct{}
 	recentlyPublishedMu     sync.Mutex
@@ -119,6 +130,12 @@ type Wallet struct {
 	addressBuffers   map[uint32]*bip0044AccountData
 	addressBuffersMu sync.Mutex
 
+	// Change address policy, and the change addresses of each account
+	// reused by the RoundRobin policy.
+	changePolicy       ChangeAddressPolicy
+	changeRoundRobin   map[uint32]*roundRobinChange
+	changeRoundRobinMu sync.Mutex
+
 	// Passphrase unlock
 	passphraseUsedMu        sync.RWMutex
 	passphraseTimeoutMu     sync.Mutex
@@ -146,6 +163,26 @@ type Config struct {
 	AccountGapLimit         int
 	DisableCoinTypeUpgrades bool
 
+	// IncrementalDiscovery extends address discovery past the last used
+	// address of each account branch.  Whenever a used address is found
+	// within the gap limit following the last used address, the search
+	// continues with the addresses following it, until a full gap of
+	// unused addresses is confirmed or DiscoveryMaxIndex is reached.
+	IncrementalDiscovery bool
+
+	// DiscoveryMaxIndex is the child index at which incremental discovery
+	// stops extending a branch.  Zero selects a default of 10000.
+	DiscoveryMaxIndex uint32
+
+	// ChangeAddressPolicy selects the addresses which change is paid to
+	// when creating transactions.  The zero value is FreshPerTx.
+	ChangeAddressPolicy ChangeAddressPolicy
+
+	// RescanWorkers is the number of concurrent workers used to filter
+	// block ranges during a rescan.  Values less than two rescan blocks
+	// sequentially.
+	RescanWorkers int
+
 	StakePoolColdExtKey string
 	AllowHighFees       bool
 	RelayFee            float64
@@ -521,12 +558,15 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 			return err
 		}
 
-		gapLimit := uint32(w.gapLimit)
 		loadAccount := func(acct uint32) error {
 			props, err := w.Manager.AccountProperties(addrmgrNs, acct)
 			if err != nil {
 				return err
 			}
+			gapLimit, err := w.accountGapLimit(addrmgrNs, acct)
+			if err != nil {
+				return err
+			}
 			hdAccounts[acct] = hdAccount{
 				externalCount:        minUint32(props.LastReturnedExternalIndex+gapLimit, hdkeychain.HardenedKeyStart-1),
 				internalCount:        minUint32(props.LastReturnedInternalIndex+gapLimit, hdkeychain.HardenedKeyStart-1),
@@ -658,9 +698,9 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 	return count, err
 }
 
-// CoinType returns the active BIP0044 coin type. For watching-only wallets,
-// which do not save the coin type keys, this method will return an error with
-// code errors.WatchingOnly.
+// CoinType returns the active BIP0044 coin type. For watching-only wallets
+// created before the coin type was recorded, which do not save the coin type
+// keys, this method will return an error with code errors.WatchingOnly.
 func (w *Wallet) CoinType(ctx context.Context) (uint32, error) {
 	const op errors.Op = "wallet.CoinType"
 	var coinType uint32
@@ -1326,6 +1366,9 @@ type PurchaseTicketsRequest struct {
 	spendLimit dcrutil.Amount
 	txFee      dcrutil.Amount
 	ticketFee  dcrutil.Amount
+
+	// set by PurchaseTicketsBatch
+	allowPartial bool
 }
 
 // PurchaseTicketsResponse describes the response for purchasing tickets request.
@@ -1351,6 +1394,31 @@ func (w *Wallet) PurchaseTicketsWithResponse(ctx context.Context, n NetworkBacke
 	return w.purchaseTickets(ctx, op, n, req)
 }
 
+// PurchaseTicketsBatch purchases up to req.Count tickets funded by the outputs
+// of a single split transaction, authored with one input selection.  Each
+// ticket commits to the request's voting address and, if set, the VSP address
+// and fees.  If the source account can not fund all requested tickets, the
+// largest number of tickets which can be funded is purchased instead, and the
+// number purchased is reported by the length of the response's TicketHashes.
+// Partial purchases are not supported when mixing the split transaction with
+// CoinShuffle++.
+func (w *Wallet) PurchaseTicketsBatch(ctx context.Context, n NetworkBackend,
+	req *PurchaseTicketsRequest) (*PurchaseTicketsResponse, error) {
+	const op errors.Op = "wallet.PurchaseTicketsBatch"
+
+	if !req.DontSignTx {
+		heldUnlock, err := w.holdUnlock()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		defer heldUnlock.release()
+	}
+
+	batchReq := *req
+	batchReq.allowPartial = true
+	return w.purchaseTickets(ctx, op, n, &batchReq)
+}
+
 // PurchaseTicketsContext purchases tickets, returning the hashes of all ticket
 // purchase transactions.
 func (w *Wallet) PurchaseTicketsContext(ctx context.Context, n NetworkBackend, req *PurchaseTicketsRequest) ([]*chainhash.Hash, error) {
@@ -1625,68 +1693,6 @@ func (w *Wallet) PubKeyForAddress(ctx context.Context, a dcrutil.Address) (*secp
 	return pubKey, nil
 }
 
-// SignMessage returns the signature of a signed message using an address'
-// associated private key.
-func (w *Wallet) SignMessage(ctx context.Context, msg string, addr dcrutil.Address) (sig []byte, err error) {
-	const op errors.Op = "wallet.SignMessage"
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Decred Signed Message:\n")
-	wire.WriteVarString(&buf, 0, msg)
-	messageHash := chainhash.HashB(buf.Bytes())
-	var privKey *secp256k1.PrivateKey
-	var done func()
-	defer func() {
-		if done != nil {
-			done()
-		}
-	}()
-	err = walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
-		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
-		var err error
-		privKey, done, err = w.Manager.PrivateKey(addrmgrNs, addr)
-		return err
-	})
-	if err != nil {
-		return nil, errors.E(op, err)
-	}
-	sig, err = secp256k1.SignCompact(privKey, messageHash, true)
-	if err != nil {
-		return nil, errors.E(op, err)
-	}
-	return sig, nil
-}
-
-// VerifyMessage verifies that sig is a valid signature of msg and was created
-// using the secp256k1 private key for addr.
-func VerifyMessage(msg string, addr dcrutil.Address, sig []byte, params dcrutil.AddressParams) (bool, error) {
-	const op errors.Op = "wallet.VerifyMessage"
-	// Validate the signature - this just shows that it was valid for any pubkey
-	// at all. Whether the pubkey matches is checked below.
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Decred Signed Message:\n")
-	wire.WriteVarString(&buf, 0, msg)
-	expectedMessageHash := chainhash.HashB(buf.Bytes())
-	pk, wasCompressed, err := secp256k1.RecoverCompact(sig, expectedMessageHash)
-	if err != nil {
-		return false, errors.E(op, err)
-	}
-
-	// Reconstruct the address from the recovered pubkey.
-	var serializedPK []byte
-	if wasCompressed {
-		serializedPK = pk.SerializeCompressed()
-	} else {
-		serializedPK = pk.SerializeUncompressed()
-	}
-	recoveredAddr, err := dcrutil.NewAddressSecpPubKey(serializedPK, params)
-	if err != nil {
-		return false, errors.E(op, err)
-	}
-
-	// Return whether addresses match.
-	return recoveredAddr.Address() == addr.Address(), nil
-}
-
 // HaveAddress returns whether the wallet is the owner of the address a.
 func (w *Wallet) HaveAddress(ctx context.Context, a dcrutil.Address) (bool, error) {
 	const op errors.Op = "wallet.HaveAddress"
@@ -2946,6 +2952,84 @@ func (w *Wallet) GetTransactions(ctx context.Context, f func(*Block) (bool, erro
 	return nil
 }
 
+// Transaction history cursors are serialized as the big endian block height
+// followed by the big endian in-block index of the next mined transaction to
+// return.
+const txHistoryCursorLen = 8
+
+func encodeTxHistoryCursor(height int32, index int) []byte {
+	c := make([]byte, txHistoryCursorLen)
+	binary.BigEndian.PutUint32(c, uint32(height))
+	binary.BigEndian.PutUint32(c[4:], uint32(index))
+	return c
+}
+
+func decodeTxHistoryCursor(c []byte) (height int32, index int, err error) {
+	if c == nil {
+		return 0, 0, nil
+	}
+	if len(c) != txHistoryCursorLen {
+		return 0, 0, errors.E(errors.Invalid, "invalid transaction history cursor")
+	}
+	height = int32(binary.BigEndian.Uint32(c))
+	index = int(binary.BigEndian.Uint32(c[4:]))
+	if height < 0 || index < 0 {
+		return 0, 0, errors.E(errors.Invalid, "invalid transaction history cursor")
+	}
+	return height, index, nil
+}
+
+// TransactionsPaged returns a page of up to limit transactions from the
+// wallet's history, beginning at cursor.  A nil cursor begins at the oldest
+// transaction.  Mined transactions are returned first, ordered by block height
+// and their order in each block.  The returned cursor may be passed to a later
+// call to request the next page, including after the wallet is restarted.
+// Blocks attached to the main chain between calls do not change the contents
+// of pages which were already returned.
+//
+// After all mined transactions have been returned, all unconfirmed
+// transactions are returned as a final page, without regard to the limit,
+// with a nil cursor.  Callers wishing to later read newly mined transactions
+// may retain the last non-nil cursor.
+func (w *Wallet) TransactionsPaged(ctx context.Context, cursor []byte, limit int) (txs []TransactionSummary, nextCursor []byte, err error) {
+	const op errors.Op = "wallet.TransactionsPaged"
+	if limit < 1 {
+		return nil, nil, errors.E(op, errors.Invalid, "page limit must be positive")
+	}
+	height, index, err := decodeTxHistoryCursor(cursor)
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		details, nextHeight, nextIndex, err := w.TxStore.MinedTransactionsPage(ns,
+			height, index, limit)
+		if err != nil {
+			return err
+		}
+		if len(details) != 0 {
+			txs = make([]TransactionSummary, 0, len(details))
+			for i := range details {
+				txs = append(txs, makeTxSummary(dbtx, w, &details[i]))
+			}
+			nextCursor = encodeTxHistoryCursor(nextHeight, nextIndex)
+			return nil
+		}
+
+		// Return the final page of unmined transactions.
+		return w.TxStore.RangeTransactions(ns, -1, -1, func(details []udb.TxDetails) (bool, error) {
+			for i := range details {
+				txs = append(txs, makeTxSummary(dbtx, w, &details[i]))
+			}
+			return false, nil
+		})
+	})
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+	return txs, nextCursor, nil
+}
+
 // AccountResult is a single account result for the AccountsResult type.
 type AccountResult struct {
 	udb.AccountProperties
@@ -3316,12 +3400,30 @@ func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF) (string
 	return addrStr, nil
 }
 
-// ImportScript imports a redeemscript to the wallet. If it also allows the
-// user to specify whether or not they want the redeemscript to be rescanned,
-// and how far back they wish to rescan.
-func (w *Wallet) ImportScript(ctx context.Context, rs []byte) error {
+// ImportScript imports a redeem script to the wallet and returns its P2SH
+// address.  Outputs paying to the P2SH address are recorded as unspent outputs
+// of the imported account and the address is watched by the network backend.
+// If rescan is true, the main chain is rescanned for transactions paying to
+// the address, which requires a network backend.  Importing a script which is
+// already recorded by the wallet is not an error.
+//
+// The wallet can only spend the imported outputs when it holds keys required
+// by the script.  Signing an input redeeming a script for which the wallet
+// holds no keys results in an error with kind errors.WatchingOnly.
+func (w *Wallet) ImportScript(ctx context.Context, rs []byte, rescan bool) (dcrutil.Address, error) {
 	const op errors.Op = "wallet.ImportScript"
-	err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
+	p2shAddr, err := dcrutil.NewAddressScriptHash(rs, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	var n NetworkBackend
+	if rescan {
+		n, err = w.NetworkBackend()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	err = walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 		txmgrNs := tx.ReadWriteBucket(wtxmgrNamespaceKey)
 
@@ -3357,36 +3459,49 @@ func (w *Wallet) ImportScript(ctx context.Context, rs []byte) error {
 		return nil
 	})
 	if err != nil {
-		return errors.E(op, err)
+		return nil, errors.E(op, err)
 	}
-	return nil
+	if rescan {
+		err = w.RescanFromHeight(ctx, n, 0)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	return p2shAddr, nil
 }
 
-func (w *Wallet) ImportXpubAccount(ctx context.Context, name string, xpub *hdkeychain.ExtendedKey) error {
+// ImportXpubAccount creates a watch-only account with the provided name from an
+// account-level extended public key and returns the new account number.
+// External and internal branch addresses are derived from the xpub, so
+// balances, transaction history, and new addresses of the account are
+// available without the private key.  Transactions spending from the account
+// can not be signed by the wallet, and attempts to do so fail with an error
+// with kind errors.WatchingOnly.
+func (w *Wallet) ImportXpubAccount(ctx context.Context, name string, xpub *hdkeychain.ExtendedKey) (uint32, error) {
 	const op errors.Op = "wallet.ImportXpubAccount"
 	if xpub.IsPrivate() {
-		return errors.E(op, "extended key must be an xpub")
+		return 0, errors.E(op, errors.Invalid, "extended key must be an xpub")
 	}
 
 	extKey, intKey, err := deriveBranches(xpub)
 	if err != nil {
-		return errors.E(op, err)
+		return 0, errors.E(op, err)
 	}
 
 	gapLimit := uint32(w.gapLimit)
 	if n, err := w.NetworkBackend(); err == nil {
 		extAddrs, err := deriveChildAddresses(extKey, 0, gapLimit, w.chainParams)
 		if err != nil {
-			return errors.E(op, err)
+			return 0, errors.E(op, err)
 		}
 		intAddrs, err := deriveChildAddresses(intKey, 0, gapLimit, w.chainParams)
 		if err != nil {
-			return errors.E(op, err)
+			return 0, errors.E(op, err)
 		}
 		watch := append(extAddrs, intAddrs...)
 		err = n.LoadTxFilter(ctx, false, watch, nil)
 		if err != nil {
-			return errors.E(op, err)
+			return 0, errors.E(op, err)
 		}
 	}
 
@@ -3401,7 +3516,7 @@ func (w *Wallet) ImportXpubAccount(ctx context.Context, name string, xpub *hdkey
 		return err
 	})
 	if err != nil {
-		return errors.E(op, err)
+		return 0, errors.E(op, err)
 	}
 
 	defer w.addressBuffersMu.Unlock()
@@ -3420,7 +3535,7 @@ func (w *Wallet) ImportXpubAccount(ctx context.Context, name string, xpub *hdkey
 		albInternal: albInternal,
 	}
 
-	return nil
+	return account, nil
 }
 
 // RedeemScriptCopy returns a copy of a redeem script to redeem outputs paid to
@@ -3756,45 +3871,140 @@ func (w *Wallet) LockedOutpoint(op wire.OutPoint) bool {
 }
 
 // LockOutpoint marks an outpoint as locked, that is, it should not be used as
-// an input for newly created transactions.
-func (w *Wallet) LockOutpoint(op wire.OutPoint) {
+// an input for newly created transactions.  Persistent locks are recorded in
+// the database and remain locked after the wallet is reopened, until the
+// outpoint is unlocked or the output is spent.  Other locks are only held in
+// memory.
+func (w *Wallet) LockOutpoint(ctx context.Context, op wire.OutPoint, persistent bool) error {
+	const opf = "wallet.LockOutpoint(%v)"
+	if persistent {
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+			return w.TxStore.PutLockedOutpoint(ns, &op)
+		})
+		if err != nil {
+			return errors.E(errors.Opf(opf, &op), err)
+		}
+	}
+
 	w.lockedOutpointMu.Lock()
 	w.lockedOutpoints[op] = struct{}{}
 	w.lockedOutpointMu.Unlock()
+	return nil
 }
 
 // UnlockOutpoint marks an outpoint as unlocked, that is, it may be used as an
-// input for newly created transactions.
-func (w *Wallet) UnlockOutpoint(op wire.OutPoint) {
+// input for newly created transactions.  Both persistent and in-memory locks
+// are removed.
+func (w *Wallet) UnlockOutpoint(ctx context.Context, op wire.OutPoint) error {
+	const opf = "wallet.UnlockOutpoint(%v)"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.DeleteLockedOutpoint(ns, &op)
+	})
+	if err != nil {
+		return errors.E(errors.Opf(opf, &op), err)
+	}
+
 	w.lockedOutpointMu.Lock()
 	delete(w.lockedOutpoints, op)
 	w.lockedOutpointMu.Unlock()
+	return nil
 }
 
-// ResetLockedOutpoints resets the set of locked outpoints so all may be used
-// as inputs for new transactions.
-func (w *Wallet) ResetLockedOutpoints() {
+// ResetLockedOutpoints resets the set of locked outpoints, including all
+// persistent locks, so all may be used as inputs for new transactions.
+func (w *Wallet) ResetLockedOutpoints(ctx context.Context) error {
+	const op errors.Op = "wallet.ResetLockedOutpoints"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.DeleteAllLockedOutpoints(ns)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
 	w.lockedOutpointMu.Lock()
 	w.lockedOutpoints = map[wire.OutPoint]struct{}{}
 	w.lockedOutpointMu.Unlock()
+	return nil
 }
 
-// LockedOutpoints returns a slice of currently locked outpoints.  This is
-// intended to be used by marshaling the result as a JSON array for
-// listlockunspent RPC results.
-func (w *Wallet) LockedOutpoints() []dcrdtypes.TransactionInput {
+// LockedOutpoints returns a slice of currently locked outpoints, sorted by
+// transaction hash and output index.  The amount and account of each output
+// recorded by the wallet is included.  Locked outpoints unknown to the wallet
+// are returned with a zero amount and an empty account name.  This is intended
+// to be used by marshaling the result as a JSON array for listlockunspent RPC
+// results.
+func (w *Wallet) LockedOutpoints(ctx context.Context) ([]types.ListLockUnspentResult, error) {
+	const op errors.Op = "wallet.LockedOutpoints"
+
 	w.lockedOutpointMu.Lock()
-	locked := make([]dcrdtypes.TransactionInput, len(w.lockedOutpoints))
-	i := 0
+	outpoints := make([]wire.OutPoint, 0, len(w.lockedOutpoints))
 	for op := range w.lockedOutpoints {
-		locked[i] = dcrdtypes.TransactionInput{
-			Txid: op.Hash.String(),
-			Vout: op.Index,
-		}
-		i++
+		outpoints = append(outpoints, op)
 	}
 	w.lockedOutpointMu.Unlock()
-	return locked
+	sort.Slice(outpoints, func(i, j int) bool {
+		a, b := &outpoints[i], &outpoints[j]
+		if a.Hash != b.Hash {
+			return bytes.Compare(a.Hash[:], b.Hash[:]) < 0
+		}
+		if a.Index != b.Index {
+			return a.Index < b.Index
+		}
+		return a.Tree < b.Tree
+	})
+
+	locked := make([]types.ListLockUnspentResult, len(outpoints))
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		for i := range outpoints {
+			out := &outpoints[i]
+			locked[i] = types.ListLockUnspentResult{
+				TxID: out.Hash.String(),
+				Vout: out.Index,
+				Tree: out.Tree,
+			}
+
+			details, err := w.TxStore.TxDetails(txmgrNs, &out.Hash)
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if out.Index >= uint32(len(details.MsgTx.TxOut)) {
+				continue
+			}
+			output := details.MsgTx.TxOut[out.Index]
+			locked[i].Amount = dcrutil.Amount(output.Value).ToCoin()
+
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(output.Version,
+				output.PkScript, w.chainParams)
+			if err != nil || len(addrs) == 0 {
+				continue
+			}
+			acct, err := w.Manager.AddrAccount(addrmgrNs, addrs[0])
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			locked[i].Account, err = w.Manager.AccountName(addrmgrNs, acct)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return locked, nil
 }
 
 // UnminedTransactions returns all unmined transactions from the wallet.
@@ -4054,6 +4264,55 @@ func (s sigDataSource) GetKey(a dcrutil.Address) ([]byte, dcrec.SignatureType, b
 }
 func (s sigDataSource) GetScript(a dcrutil.Address) ([]byte, error) { return s.script(a) }
 
+// redeemScriptKeysHeld returns whether the wallet holds the private key of any
+// address of the redeem script of a P2SH previous output script.  Previous
+// output scripts which are not P2SH, and redeem scripts which are unknown or
+// do not pay to any addresses, are reported as held so that signing is
+// attempted.  An error with kind errors.Locked is returned if the wallet is
+// locked.
+func (w *Wallet) redeemScriptKeysHeld(addrmgrNs walletdb.ReadBucket, prevOutScript []byte,
+	redeemScript func(dcrutil.Address) ([]byte, error)) (bool, error) {
+
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(0, prevOutScript, w.chainParams)
+	if err != nil || class != txscript.ScriptHashTy || len(addrs) != 1 {
+		return true, nil
+	}
+	script, err := redeemScript(addrs[0])
+	if err != nil {
+		return true, nil
+	}
+	_, addrs, _, err = txscript.ExtractPkScriptAddrs(0, script, w.chainParams)
+	if err != nil || len(addrs) == 0 {
+		return true, nil
+	}
+	for _, addr := range addrs {
+		_, done, err := w.Manager.PrivateKey(addrmgrNs, addr)
+		if err == nil {
+			done()
+			return true, nil
+		}
+		if errors.Is(err, errors.Locked) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// signInputDeterministic returns the signature script for input idx of tx
+// redeeming prevOutScript, merged with any existing signature script
+// prevSigScript.  Signing is deterministic: ECDSA and Schnorr signatures over
+// secp256k1 use RFC6979 nonces derived from the private key and signature
+// hash, and Ed25519 signatures are deterministic by design, so signing the
+// same input of an identical transaction with the same keys always creates a
+// byte-identical signature script.  No randomness is used when signing.
+func signInputDeterministic(params *chaincfg.Params, tx *wire.MsgTx, idx int,
+	prevOutScript []byte, hashType txscript.SigHashType, kdb txscript.KeyDB,
+	sdb txscript.ScriptDB, prevSigScript []byte) ([]byte, error) {
+
+	return txscript.SignTxOutput(params, tx, idx, prevOutScript, hashType,
+		kdb, sdb, prevSigScript)
+}
+
 // SignTransaction uses secrets of the wallet, as well as additional secrets
 // passed in by the caller, to create and add input signatures to a transaction.
 //
@@ -4156,13 +4415,35 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 				return script, nil
 			}
 
+			// P2SH inputs redeeming scripts for which the wallet holds
+			// none of the keys, such as imported scripts of watched
+			// multisig addresses, can not be signed by the wallet.
+			if len(additionalKeysByAddress) == 0 {
+				held, err := w.redeemScriptKeysHeld(addrmgrNs, prevOutScript, source.script)
+				if err != nil {
+					signErrors = append(signErrors, SignatureError{
+						InputIndex: uint32(i),
+						Error:      errors.E(op, err),
+					})
+					continue
+				}
+				if !held {
+					signErrors = append(signErrors, SignatureError{
+						InputIndex: uint32(i),
+						Error: errors.E(op, errors.WatchingOnly,
+							"wallet holds no keys of the P2SH redeem script"),
+					})
+					continue
+				}
+			}
+
 			// SigHashSingle inputs can only be signed if there's a
 			// corresponding output. However this could be already signed,
 			// so we always verify the output.
 			if (hashType&txscript.SigHashSingle) !=
 				txscript.SigHashSingle || i < len(tx.TxOut) {
 
-				script, err := txscript.SignTxOutput(w.ChainParams(),
+				script, err := signInputDeterministic(w.ChainParams(),
 					tx, i, prevOutScript, hashType, source, source, txIn.SignatureScript)
 				// Failure to sign isn't an error, it just means that
 				// the tx isn't complete.
@@ -4511,7 +4792,11 @@ func (w *Wallet) NeedsAccountsSync(ctx context.Context) (bool, error) {
 // Create creates an new wallet, writing it to an empty database.  If the passed
 // seed is non-nil, it is used.  Otherwise, a secure random seed of the
 // recommended length is generated.
-func Create(ctx context.Context, db DB, pubPass, privPass, seed []byte, params *chaincfg.Params) error {
+//
+// Account keys are derived using the BIP0044 coin type coinType.  This should
+// be the SLIP0044 coin type of the network (params.SLIP0044CoinType) unless the
+// wallet is used with a fork registering a different coin type.
+func Create(ctx context.Context, db DB, pubPass, privPass, seed []byte, params *chaincfg.Params, coinType uint32) error {
 	const op errors.Op = "wallet.Create"
 	// If a seed was provided, ensure that it is of valid length. Otherwise,
 	// we generate a random seed for the wallet with the recommended seed
@@ -4527,17 +4812,19 @@ func Create(ctx context.Context, db DB, pubPass, privPass, seed []byte, params *
 		return errors.E(op, hdkeychain.ErrInvalidSeedLen)
 	}
 
-	err := udb.Initialize(ctx, db.internal(), params, seed, pubPass, privPass)
+	err := udb.Initialize(ctx, db.internal(), params, seed, pubPass, privPass, coinType)
 	if err != nil {
 		return errors.E(op, err)
 	}
 	return nil
 }
 
-// CreateWatchOnly creates a watchonly wallet on the provided db.
-func CreateWatchOnly(ctx context.Context, db DB, extendedPubKey string, pubPass []byte, params *chaincfg.Params) error {
+// CreateWatchOnly creates a watchonly wallet on the provided db.  The coin type
+// of the account extended public key can not be determined from the key
+// itself, and is recorded as coinType.
+func CreateWatchOnly(ctx context.Context, db DB, extendedPubKey string, pubPass []byte, params *chaincfg.Params, coinType uint32) error {
 	const op errors.Op = "wallet.CreateWatchOnly"
-	err := udb.InitializeWatchOnly(ctx, db.internal(), params, extendedPubKey, pubPass)
+	err := udb.InitializeWatchOnly(ctx, db.internal(), params, extendedPubKey, pubPass, coinType)
 	if err != nil {
 		return errors.E(op, err)
 	}
@@ -4607,6 +4894,10 @@ func decodeStakePoolColdExtKey(encStr string, params *chaincfg.Params) (map[stri
 // configuration options and sets it up it according to the rest of options.
 func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 	const op errors.Op = "wallet.Open"
+	if err := cfg.ChangeAddressPolicy.check(); err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	// Migrate to the unified DB if necessary.
 	db := cfg.DB.internal()
 	needsMigration, err := udb.NeedsMigration(ctx, db)
@@ -4641,6 +4932,11 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 		AllowHighFees:           cfg.AllowHighFees,
 		accountGapLimit:         cfg.AccountGapLimit,
 		disableCoinTypeUpgrades: cfg.DisableCoinTypeUpgrades,
+		rescanWorkers:           cfg.RescanWorkers,
+		incrementalDiscovery:    cfg.IncrementalDiscovery,
+		discoveryMaxIndex:       cfg.DiscoveryMaxIndex,
+		changePolicy:            cfg.ChangeAddressPolicy,
+		changeRoundRobin:        make(map[uint32]*roundRobinChange),
 
 		// Chain params
 		subsidyCache: blockchain.NewSubsidyCache(cfg.Params),
@@ -4684,8 +4980,13 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 			if err != nil {
 				return err
 			}
+			gapLimit, err := w.Manager.AccountGapLimit(ns, acct)
+			if err != nil {
+				return err
+			}
 			w.addressBuffers[acct] = &bip0044AccountData{
-				xpub: xpub,
+				xpub:     xpub,
+				gapLimit: gapLimit,
 				albExternal: addressBuffer{
 					branchXpub: extKey,
 					lastUsed:   props.LastUsedExternalIndex,
@@ -4718,6 +5019,22 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 		return nil, errors.E(op, err)
 	}
 
+	// Reload persistently locked outpoints.  Locks on outputs which were
+	// spent while the wallet was not running are removed.
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		locked, err := w.TxStore.LoadLockedOutpoints(dbtx)
+		if err != nil {
+			return err
+		}
+		for _, op := range locked {
+			w.lockedOutpoints[op] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	w.NtfnServer = newNotificationServer(w)
 	w.voteBits = vb
 
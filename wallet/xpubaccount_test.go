@@ -0,0 +1,121 @@
+// Copyright (c) 2020 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/errors"
+	"decred.org/dcrwallet/wallet/udb"
+	"decred.org/dcrwallet/wallet/walletdb"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v3"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestImportXpubAccount(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Derive an account xpub from a seed unrelated to the wallet.
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{0x5e}, 32), cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acctXpriv := master
+	for _, i := range []uint32{44, 1, 0} {
+		acctXpriv, err = acctXpriv.Child(hdkeychain.HardenedKeyStart + i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	xpub, err := acctXpriv.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.ImportXpubAccount(ctx, "xpriv", acctXpriv)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("importing xpriv: expected Invalid, got %v", err)
+	}
+	account, err := w.ImportXpubAccount(ctx, "watch", xpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account <= udb.ImportedAddrAccount {
+		t.Fatalf("imported xpub account number %d", account)
+	}
+
+	// knownAddress derives the address of a branch child from the xpub.
+	knownAddress := func(branch, child uint32) string {
+		branchKey, err := xpub.Child(branch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		childKey, err := branchKey.Child(child)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkh := dcrutil.Hash160(childKey.SerializedPubKey())
+		addr, err := dcrutil.NewAddressPubKeyHash(pkh, cfg.Params, dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr.String()
+	}
+	var addrs []dcrutil.Address
+	for i := uint32(0); i < 3; i++ {
+		addr, err := w.NewExternalAddress(ctx, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := knownAddress(udb.ExternalBranch, i); addr.String() != want {
+			t.Errorf("external address %d is %v, expected %v", i, addr, want)
+		}
+		addrs = append(addrs, addr)
+	}
+	addr, err := w.NewInternalAddress(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := knownAddress(udb.InternalBranch, 0); addr.String() != want {
+		t.Errorf("internal address is %v, expected %v", addr, want)
+	}
+	addrs = append(addrs, addr)
+
+	// Private keys must not be available for any account address.
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for _, addr := range addrs {
+			_, done, err := w.Manager.PrivateKey(ns, addr)
+			if err == nil {
+				done()
+			}
+			if !errors.Is(err, errors.WatchingOnly) {
+				t.Errorf("private key of %v: expected WatchingOnly, got %v", addr, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Spending from the account must be rejected.
+	outputs := []*wire.TxOut{wire.NewTxOut(1e8, make([]byte, 25))}
+	_, err = w.txToOutputs(ctx, "", outputs, account, account, 1, nil, false, 0, false)
+	if !errors.Is(err, errors.WatchingOnly) {
+		t.Errorf("spending from xpub account: expected WatchingOnly, got %v", err)
+	}
+}
@@ -56,7 +56,7 @@ func createWallet(ctx context.Context, cfg *config) error {
 	}
 	loader := loader.NewLoader(activeNet.Params, dbDir, stakeOptions,
 		cfg.GapLimit, cfg.AllowHighFees, cfg.RelayFee.ToCoin(),
-		cfg.AccountGapLimit, cfg.DisableCoinTypeUpgrades)
+		cfg.AccountGapLimit, cfg.DisableCoinTypeUpgrades, cfg.RescanWorkers)
 
 	var privPass, pubPass, seed []byte
 	var imported bool
@@ -162,7 +162,8 @@ func createSimulationWallet(ctx context.Context, cfg *config) error {
 	defer db.Close()
 
 	// Create the wallet.
-	err = wallet.Create(ctx, db, pubPass, privPass, seed, activeNet.Params)
+	err = wallet.Create(ctx, db, pubPass, privPass, seed, activeNet.Params,
+		activeNet.Params.SLIP0044CoinType)
 	if err != nil {
 		return err
 	}
@@ -216,7 +217,8 @@ func createWatchingOnlyWallet(ctx context.Context, cfg *config) error {
 	}
 	defer db.Close()
 
-	err = wallet.CreateWatchOnly(ctx, db, pubKeyString, pubPass, activeNet.Params)
+	err = wallet.CreateWatchOnly(ctx, db, pubKeyString, pubPass, activeNet.Params,
+		activeNet.Params.SLIP0044CoinType)
 	if err != nil {
 		errOS := os.Remove(dbPath)
 		if errOS != nil {